@@ -101,6 +101,11 @@ type InternalRaftRequest struct {
 	LeaseRevoke              *LeaseRevokeRequest                       `protobuf:"bytes,9,opt,name=lease_revoke,json=leaseRevoke,proto3" json:"lease_revoke,omitempty"`
 	Alarm                    *AlarmRequest                             `protobuf:"bytes,10,opt,name=alarm,proto3" json:"alarm,omitempty"`
 	LeaseCheckpoint          *LeaseCheckpointRequest                   `protobuf:"bytes,11,opt,name=lease_checkpoint,json=leaseCheckpoint,proto3" json:"lease_checkpoint,omitempty"`
+	RevisionHold             *InternalRevisionHoldRequest              `protobuf:"bytes,12,opt,name=revision_hold,json=revisionHold,proto3" json:"revision_hold,omitempty"`
+	Subscription             *SubscriptionRequest                      `protobuf:"bytes,13,opt,name=subscription,proto3" json:"subscription,omitempty"`
+	LeaseUpdateTtl           *LeaseUpdateTTLRequest                    `protobuf:"bytes,14,opt,name=lease_update_ttl,json=leaseUpdateTtl,proto3" json:"lease_update_ttl,omitempty"`
+	LeaseRevokeBatch         *LeaseRevokeBatchRequest                  `protobuf:"bytes,15,opt,name=lease_revoke_batch,json=leaseRevokeBatch,proto3" json:"lease_revoke_batch,omitempty"`
+	LeaseExpire              *LeaseRevokeRequest                       `protobuf:"bytes,16,opt,name=lease_expire,json=leaseExpire,proto3" json:"lease_expire,omitempty"`
 	AuthEnable               *AuthEnableRequest                        `protobuf:"bytes,1000,opt,name=auth_enable,json=authEnable,proto3" json:"auth_enable,omitempty"`
 	AuthDisable              *AuthDisableRequest                       `protobuf:"bytes,1011,opt,name=auth_disable,json=authDisable,proto3" json:"auth_disable,omitempty"`
 	AuthStatus               *AuthStatusRequest                        `protobuf:"bytes,1013,opt,name=auth_status,json=authStatus,proto3" json:"auth_status,omitempty"`
@@ -233,6 +238,41 @@ func (x *InternalRaftRequest) GetLeaseCheckpoint() *LeaseCheckpointRequest {
 	return nil
 }
 
+func (x *InternalRaftRequest) GetRevisionHold() *InternalRevisionHoldRequest {
+	if x != nil {
+		return x.RevisionHold
+	}
+	return nil
+}
+
+func (x *InternalRaftRequest) GetSubscription() *SubscriptionRequest {
+	if x != nil {
+		return x.Subscription
+	}
+	return nil
+}
+
+func (x *InternalRaftRequest) GetLeaseUpdateTtl() *LeaseUpdateTTLRequest {
+	if x != nil {
+		return x.LeaseUpdateTtl
+	}
+	return nil
+}
+
+func (x *InternalRaftRequest) GetLeaseRevokeBatch() *LeaseRevokeBatchRequest {
+	if x != nil {
+		return x.LeaseRevokeBatch
+	}
+	return nil
+}
+
+func (x *InternalRaftRequest) GetLeaseExpire() *LeaseRevokeRequest {
+	if x != nil {
+		return x.LeaseExpire
+	}
+	return nil
+}
+
 func (x *InternalRaftRequest) GetAuthEnable() *AuthEnableRequest {
 	if x != nil {
 		return x.AuthEnable
@@ -480,6 +520,61 @@ func (x *InternalAuthenticateRequest) GetSimpleToken() string {
 	return ""
 }
 
+// InternalRevisionHoldRequest carries the creation time of a hold, which is
+// filled by the proposing member (etcdserver/v3_server.go) so that all members
+// persist the same hold.
+type InternalRevisionHoldRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Request       *RevisionHoldRequest   `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	CreateTime    int64                  `protobuf:"varint,2,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalRevisionHoldRequest) Reset() {
+	*x = InternalRevisionHoldRequest{}
+	mi := &file_raft_internal_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalRevisionHoldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalRevisionHoldRequest) ProtoMessage() {}
+
+func (x *InternalRevisionHoldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_raft_internal_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalRevisionHoldRequest.ProtoReflect.Descriptor instead.
+func (*InternalRevisionHoldRequest) Descriptor() ([]byte, []int) {
+	return file_raft_internal_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *InternalRevisionHoldRequest) GetRequest() *RevisionHoldRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+func (x *InternalRevisionHoldRequest) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
 var File_raft_internal_proto protoreflect.FileDescriptor
 
 const file_raft_internal_proto_rawDesc = "" +
@@ -488,7 +583,7 @@ const file_raft_internal_proto_rawDesc = "" +
 	"\rRequestHeader\x12\x0e\n" +
 	"\x02ID\x18\x01 \x01(\x04R\x02ID\x12\x1a\n" +
 	"\busername\x18\x02 \x01(\tR\busername\x12,\n" +
-	"\rauth_revision\x18\x03 \x01(\x04B\a\x8a\xb5\x18\x033.1R\fauthRevision:\a\x82\xb5\x18\x033.0\"\x98\x13\n" +
+	"\rauth_revision\x18\x03 \x01(\x04B\a\x8a\xb5\x18\x033.1R\fauthRevision:\a\x82\xb5\x18\x033.0\"\xc5\x16\n" +
 	"\x13InternalRaftRequest\x123\n" +
 	"\x06header\x18d \x01(\v2\x1b.etcdserverpb.RequestHeaderR\x06header\x12\x0e\n" +
 	"\x02ID\x18\x01 \x01(\x04R\x02ID\x120\n" +
@@ -504,7 +599,12 @@ const file_raft_internal_proto_rawDesc = "" +
 	"\flease_revoke\x18\t \x01(\v2 .etcdserverpb.LeaseRevokeRequestR\vleaseRevoke\x120\n" +
 	"\x05alarm\x18\n" +
 	" \x01(\v2\x1a.etcdserverpb.AlarmRequestR\x05alarm\x12X\n" +
-	"\x10lease_checkpoint\x18\v \x01(\v2$.etcdserverpb.LeaseCheckpointRequestB\a\x8a\xb5\x18\x033.4R\x0fleaseCheckpoint\x12A\n" +
+	"\x10lease_checkpoint\x18\v \x01(\v2$.etcdserverpb.LeaseCheckpointRequestB\a\x8a\xb5\x18\x033.4R\x0fleaseCheckpoint\x12W\n" +
+	"\rrevision_hold\x18\f \x01(\v2).etcdserverpb.InternalRevisionHoldRequestB\a\x8a\xb5\x18\x033.8R\frevisionHold\x12N\n" +
+	"\fsubscription\x18\r \x01(\v2!.etcdserverpb.SubscriptionRequestB\a\x8a\xb5\x18\x033.8R\fsubscription\x12V\n" +
+	"\x10lease_update_ttl\x18\x0e \x01(\v2#.etcdserverpb.LeaseUpdateTTLRequestB\a\x8a\xb5\x18\x033.8R\x0eleaseUpdateTtl\x12\\\n" +
+	"\x12lease_revoke_batch\x18\x0f \x01(\v2%.etcdserverpb.LeaseRevokeBatchRequestB\a\x8a\xb5\x18\x033.8R\x10leaseRevokeBatch\x12L\n" +
+	"\flease_expire\x18\x10 \x01(\v2 .etcdserverpb.LeaseRevokeRequestB\a\x8a\xb5\x18\x033.8R\vleaseExpire\x12A\n" +
 	"\vauth_enable\x18\xe8\a \x01(\v2\x1f.etcdserverpb.AuthEnableRequestR\n" +
 	"authEnable\x12D\n" +
 	"\fauth_disable\x18\xf3\a \x01(\v2 .etcdserverpb.AuthDisableRequestR\vauthDisable\x12J\n" +
@@ -535,7 +635,11 @@ const file_raft_internal_proto_rawDesc = "" +
 	"\x1bInternalAuthenticateRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1a\n" +
 	"\bpassword\x18\x02 \x01(\tR\bpassword\x12!\n" +
-	"\fsimple_token\x18\x03 \x01(\tR\vsimpleToken:\a\x82\xb5\x18\x033.0B%Z#go.etcd.io/etcd/api/v3/etcdserverpbb\x06proto3"
+	"\fsimple_token\x18\x03 \x01(\tR\vsimpleToken:\a\x82\xb5\x18\x033.0\"\x84\x01\n" +
+	"\x1bInternalRevisionHoldRequest\x12;\n" +
+	"\arequest\x18\x01 \x01(\v2!.etcdserverpb.RevisionHoldRequestR\arequest\x12\x1f\n" +
+	"\vcreate_time\x18\x02 \x01(\x03R\n" +
+	"createTime:\a\x82\xb5\x18\x033.8B%Z#go.etcd.io/etcd/api/v3/etcdserverpbb\x06proto3"
 
 var (
 	file_raft_internal_proto_rawDescOnce sync.Once
@@ -549,79 +653,90 @@ func file_raft_internal_proto_rawDescGZIP() []byte {
 	return file_raft_internal_proto_rawDescData
 }
 
-var file_raft_internal_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_raft_internal_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_raft_internal_proto_goTypes = []any{
 	(*RequestHeader)(nil),                            // 0: etcdserverpb.RequestHeader
 	(*InternalRaftRequest)(nil),                      // 1: etcdserverpb.InternalRaftRequest
 	(*EmptyResponse)(nil),                            // 2: etcdserverpb.EmptyResponse
 	(*InternalAuthenticateRequest)(nil),              // 3: etcdserverpb.InternalAuthenticateRequest
-	(*RangeRequest)(nil),                             // 4: etcdserverpb.RangeRequest
-	(*PutRequest)(nil),                               // 5: etcdserverpb.PutRequest
-	(*DeleteRangeRequest)(nil),                       // 6: etcdserverpb.DeleteRangeRequest
-	(*TxnRequest)(nil),                               // 7: etcdserverpb.TxnRequest
-	(*CompactionRequest)(nil),                        // 8: etcdserverpb.CompactionRequest
-	(*LeaseGrantRequest)(nil),                        // 9: etcdserverpb.LeaseGrantRequest
-	(*LeaseRevokeRequest)(nil),                       // 10: etcdserverpb.LeaseRevokeRequest
-	(*AlarmRequest)(nil),                             // 11: etcdserverpb.AlarmRequest
-	(*LeaseCheckpointRequest)(nil),                   // 12: etcdserverpb.LeaseCheckpointRequest
-	(*AuthEnableRequest)(nil),                        // 13: etcdserverpb.AuthEnableRequest
-	(*AuthDisableRequest)(nil),                       // 14: etcdserverpb.AuthDisableRequest
-	(*AuthStatusRequest)(nil),                        // 15: etcdserverpb.AuthStatusRequest
-	(*AuthUserAddRequest)(nil),                       // 16: etcdserverpb.AuthUserAddRequest
-	(*AuthUserDeleteRequest)(nil),                    // 17: etcdserverpb.AuthUserDeleteRequest
-	(*AuthUserGetRequest)(nil),                       // 18: etcdserverpb.AuthUserGetRequest
-	(*AuthUserChangePasswordRequest)(nil),            // 19: etcdserverpb.AuthUserChangePasswordRequest
-	(*AuthUserGrantRoleRequest)(nil),                 // 20: etcdserverpb.AuthUserGrantRoleRequest
-	(*AuthUserRevokeRoleRequest)(nil),                // 21: etcdserverpb.AuthUserRevokeRoleRequest
-	(*AuthUserListRequest)(nil),                      // 22: etcdserverpb.AuthUserListRequest
-	(*AuthRoleListRequest)(nil),                      // 23: etcdserverpb.AuthRoleListRequest
-	(*AuthRoleAddRequest)(nil),                       // 24: etcdserverpb.AuthRoleAddRequest
-	(*AuthRoleDeleteRequest)(nil),                    // 25: etcdserverpb.AuthRoleDeleteRequest
-	(*AuthRoleGetRequest)(nil),                       // 26: etcdserverpb.AuthRoleGetRequest
-	(*AuthRoleGrantPermissionRequest)(nil),           // 27: etcdserverpb.AuthRoleGrantPermissionRequest
-	(*AuthRoleRevokePermissionRequest)(nil),          // 28: etcdserverpb.AuthRoleRevokePermissionRequest
-	(*membershippb.ClusterVersionSetRequest)(nil),    // 29: membershippb.ClusterVersionSetRequest
-	(*membershippb.ClusterMemberAttrSetRequest)(nil), // 30: membershippb.ClusterMemberAttrSetRequest
-	(*membershippb.DowngradeInfoSetRequest)(nil),     // 31: membershippb.DowngradeInfoSetRequest
-	(*DowngradeVersionTestRequest)(nil),              // 32: etcdserverpb.DowngradeVersionTestRequest
+	(*InternalRevisionHoldRequest)(nil),              // 4: etcdserverpb.InternalRevisionHoldRequest
+	(*RangeRequest)(nil),                             // 5: etcdserverpb.RangeRequest
+	(*PutRequest)(nil),                               // 6: etcdserverpb.PutRequest
+	(*DeleteRangeRequest)(nil),                       // 7: etcdserverpb.DeleteRangeRequest
+	(*TxnRequest)(nil),                               // 8: etcdserverpb.TxnRequest
+	(*CompactionRequest)(nil),                        // 9: etcdserverpb.CompactionRequest
+	(*LeaseGrantRequest)(nil),                        // 10: etcdserverpb.LeaseGrantRequest
+	(*LeaseRevokeRequest)(nil),                       // 11: etcdserverpb.LeaseRevokeRequest
+	(*AlarmRequest)(nil),                             // 12: etcdserverpb.AlarmRequest
+	(*LeaseCheckpointRequest)(nil),                   // 13: etcdserverpb.LeaseCheckpointRequest
+	(*SubscriptionRequest)(nil),                      // 14: etcdserverpb.SubscriptionRequest
+	(*LeaseUpdateTTLRequest)(nil),                    // 15: etcdserverpb.LeaseUpdateTTLRequest
+	(*LeaseRevokeBatchRequest)(nil),                  // 16: etcdserverpb.LeaseRevokeBatchRequest
+	(*AuthEnableRequest)(nil),                        // 17: etcdserverpb.AuthEnableRequest
+	(*AuthDisableRequest)(nil),                       // 18: etcdserverpb.AuthDisableRequest
+	(*AuthStatusRequest)(nil),                        // 19: etcdserverpb.AuthStatusRequest
+	(*AuthUserAddRequest)(nil),                       // 20: etcdserverpb.AuthUserAddRequest
+	(*AuthUserDeleteRequest)(nil),                    // 21: etcdserverpb.AuthUserDeleteRequest
+	(*AuthUserGetRequest)(nil),                       // 22: etcdserverpb.AuthUserGetRequest
+	(*AuthUserChangePasswordRequest)(nil),            // 23: etcdserverpb.AuthUserChangePasswordRequest
+	(*AuthUserGrantRoleRequest)(nil),                 // 24: etcdserverpb.AuthUserGrantRoleRequest
+	(*AuthUserRevokeRoleRequest)(nil),                // 25: etcdserverpb.AuthUserRevokeRoleRequest
+	(*AuthUserListRequest)(nil),                      // 26: etcdserverpb.AuthUserListRequest
+	(*AuthRoleListRequest)(nil),                      // 27: etcdserverpb.AuthRoleListRequest
+	(*AuthRoleAddRequest)(nil),                       // 28: etcdserverpb.AuthRoleAddRequest
+	(*AuthRoleDeleteRequest)(nil),                    // 29: etcdserverpb.AuthRoleDeleteRequest
+	(*AuthRoleGetRequest)(nil),                       // 30: etcdserverpb.AuthRoleGetRequest
+	(*AuthRoleGrantPermissionRequest)(nil),           // 31: etcdserverpb.AuthRoleGrantPermissionRequest
+	(*AuthRoleRevokePermissionRequest)(nil),          // 32: etcdserverpb.AuthRoleRevokePermissionRequest
+	(*membershippb.ClusterVersionSetRequest)(nil),    // 33: membershippb.ClusterVersionSetRequest
+	(*membershippb.ClusterMemberAttrSetRequest)(nil), // 34: membershippb.ClusterMemberAttrSetRequest
+	(*membershippb.DowngradeInfoSetRequest)(nil),     // 35: membershippb.DowngradeInfoSetRequest
+	(*DowngradeVersionTestRequest)(nil),              // 36: etcdserverpb.DowngradeVersionTestRequest
+	(*RevisionHoldRequest)(nil),                      // 37: etcdserverpb.RevisionHoldRequest
 }
 var file_raft_internal_proto_depIdxs = []int32{
 	0,  // 0: etcdserverpb.InternalRaftRequest.header:type_name -> etcdserverpb.RequestHeader
-	4,  // 1: etcdserverpb.InternalRaftRequest.range:type_name -> etcdserverpb.RangeRequest
-	5,  // 2: etcdserverpb.InternalRaftRequest.put:type_name -> etcdserverpb.PutRequest
-	6,  // 3: etcdserverpb.InternalRaftRequest.delete_range:type_name -> etcdserverpb.DeleteRangeRequest
-	7,  // 4: etcdserverpb.InternalRaftRequest.txn:type_name -> etcdserverpb.TxnRequest
-	8,  // 5: etcdserverpb.InternalRaftRequest.compaction:type_name -> etcdserverpb.CompactionRequest
-	9,  // 6: etcdserverpb.InternalRaftRequest.lease_grant:type_name -> etcdserverpb.LeaseGrantRequest
-	10, // 7: etcdserverpb.InternalRaftRequest.lease_revoke:type_name -> etcdserverpb.LeaseRevokeRequest
-	11, // 8: etcdserverpb.InternalRaftRequest.alarm:type_name -> etcdserverpb.AlarmRequest
-	12, // 9: etcdserverpb.InternalRaftRequest.lease_checkpoint:type_name -> etcdserverpb.LeaseCheckpointRequest
-	13, // 10: etcdserverpb.InternalRaftRequest.auth_enable:type_name -> etcdserverpb.AuthEnableRequest
-	14, // 11: etcdserverpb.InternalRaftRequest.auth_disable:type_name -> etcdserverpb.AuthDisableRequest
-	15, // 12: etcdserverpb.InternalRaftRequest.auth_status:type_name -> etcdserverpb.AuthStatusRequest
-	3,  // 13: etcdserverpb.InternalRaftRequest.authenticate:type_name -> etcdserverpb.InternalAuthenticateRequest
-	16, // 14: etcdserverpb.InternalRaftRequest.auth_user_add:type_name -> etcdserverpb.AuthUserAddRequest
-	17, // 15: etcdserverpb.InternalRaftRequest.auth_user_delete:type_name -> etcdserverpb.AuthUserDeleteRequest
-	18, // 16: etcdserverpb.InternalRaftRequest.auth_user_get:type_name -> etcdserverpb.AuthUserGetRequest
-	19, // 17: etcdserverpb.InternalRaftRequest.auth_user_change_password:type_name -> etcdserverpb.AuthUserChangePasswordRequest
-	20, // 18: etcdserverpb.InternalRaftRequest.auth_user_grant_role:type_name -> etcdserverpb.AuthUserGrantRoleRequest
-	21, // 19: etcdserverpb.InternalRaftRequest.auth_user_revoke_role:type_name -> etcdserverpb.AuthUserRevokeRoleRequest
-	22, // 20: etcdserverpb.InternalRaftRequest.auth_user_list:type_name -> etcdserverpb.AuthUserListRequest
-	23, // 21: etcdserverpb.InternalRaftRequest.auth_role_list:type_name -> etcdserverpb.AuthRoleListRequest
-	24, // 22: etcdserverpb.InternalRaftRequest.auth_role_add:type_name -> etcdserverpb.AuthRoleAddRequest
-	25, // 23: etcdserverpb.InternalRaftRequest.auth_role_delete:type_name -> etcdserverpb.AuthRoleDeleteRequest
-	26, // 24: etcdserverpb.InternalRaftRequest.auth_role_get:type_name -> etcdserverpb.AuthRoleGetRequest
-	27, // 25: etcdserverpb.InternalRaftRequest.auth_role_grant_permission:type_name -> etcdserverpb.AuthRoleGrantPermissionRequest
-	28, // 26: etcdserverpb.InternalRaftRequest.auth_role_revoke_permission:type_name -> etcdserverpb.AuthRoleRevokePermissionRequest
-	29, // 27: etcdserverpb.InternalRaftRequest.cluster_version_set:type_name -> membershippb.ClusterVersionSetRequest
-	30, // 28: etcdserverpb.InternalRaftRequest.cluster_member_attr_set:type_name -> membershippb.ClusterMemberAttrSetRequest
-	31, // 29: etcdserverpb.InternalRaftRequest.downgrade_info_set:type_name -> membershippb.DowngradeInfoSetRequest
-	32, // 30: etcdserverpb.InternalRaftRequest.downgrade_version_test:type_name -> etcdserverpb.DowngradeVersionTestRequest
-	31, // [31:31] is the sub-list for method output_type
-	31, // [31:31] is the sub-list for method input_type
-	31, // [31:31] is the sub-list for extension type_name
-	31, // [31:31] is the sub-list for extension extendee
-	0,  // [0:31] is the sub-list for field type_name
+	5,  // 1: etcdserverpb.InternalRaftRequest.range:type_name -> etcdserverpb.RangeRequest
+	6,  // 2: etcdserverpb.InternalRaftRequest.put:type_name -> etcdserverpb.PutRequest
+	7,  // 3: etcdserverpb.InternalRaftRequest.delete_range:type_name -> etcdserverpb.DeleteRangeRequest
+	8,  // 4: etcdserverpb.InternalRaftRequest.txn:type_name -> etcdserverpb.TxnRequest
+	9,  // 5: etcdserverpb.InternalRaftRequest.compaction:type_name -> etcdserverpb.CompactionRequest
+	10, // 6: etcdserverpb.InternalRaftRequest.lease_grant:type_name -> etcdserverpb.LeaseGrantRequest
+	11, // 7: etcdserverpb.InternalRaftRequest.lease_revoke:type_name -> etcdserverpb.LeaseRevokeRequest
+	12, // 8: etcdserverpb.InternalRaftRequest.alarm:type_name -> etcdserverpb.AlarmRequest
+	13, // 9: etcdserverpb.InternalRaftRequest.lease_checkpoint:type_name -> etcdserverpb.LeaseCheckpointRequest
+	4,  // 10: etcdserverpb.InternalRaftRequest.revision_hold:type_name -> etcdserverpb.InternalRevisionHoldRequest
+	14, // 11: etcdserverpb.InternalRaftRequest.subscription:type_name -> etcdserverpb.SubscriptionRequest
+	15, // 12: etcdserverpb.InternalRaftRequest.lease_update_ttl:type_name -> etcdserverpb.LeaseUpdateTTLRequest
+	16, // 13: etcdserverpb.InternalRaftRequest.lease_revoke_batch:type_name -> etcdserverpb.LeaseRevokeBatchRequest
+	11, // 14: etcdserverpb.InternalRaftRequest.lease_expire:type_name -> etcdserverpb.LeaseRevokeRequest
+	17, // 15: etcdserverpb.InternalRaftRequest.auth_enable:type_name -> etcdserverpb.AuthEnableRequest
+	18, // 16: etcdserverpb.InternalRaftRequest.auth_disable:type_name -> etcdserverpb.AuthDisableRequest
+	19, // 17: etcdserverpb.InternalRaftRequest.auth_status:type_name -> etcdserverpb.AuthStatusRequest
+	3,  // 18: etcdserverpb.InternalRaftRequest.authenticate:type_name -> etcdserverpb.InternalAuthenticateRequest
+	20, // 19: etcdserverpb.InternalRaftRequest.auth_user_add:type_name -> etcdserverpb.AuthUserAddRequest
+	21, // 20: etcdserverpb.InternalRaftRequest.auth_user_delete:type_name -> etcdserverpb.AuthUserDeleteRequest
+	22, // 21: etcdserverpb.InternalRaftRequest.auth_user_get:type_name -> etcdserverpb.AuthUserGetRequest
+	23, // 22: etcdserverpb.InternalRaftRequest.auth_user_change_password:type_name -> etcdserverpb.AuthUserChangePasswordRequest
+	24, // 23: etcdserverpb.InternalRaftRequest.auth_user_grant_role:type_name -> etcdserverpb.AuthUserGrantRoleRequest
+	25, // 24: etcdserverpb.InternalRaftRequest.auth_user_revoke_role:type_name -> etcdserverpb.AuthUserRevokeRoleRequest
+	26, // 25: etcdserverpb.InternalRaftRequest.auth_user_list:type_name -> etcdserverpb.AuthUserListRequest
+	27, // 26: etcdserverpb.InternalRaftRequest.auth_role_list:type_name -> etcdserverpb.AuthRoleListRequest
+	28, // 27: etcdserverpb.InternalRaftRequest.auth_role_add:type_name -> etcdserverpb.AuthRoleAddRequest
+	29, // 28: etcdserverpb.InternalRaftRequest.auth_role_delete:type_name -> etcdserverpb.AuthRoleDeleteRequest
+	30, // 29: etcdserverpb.InternalRaftRequest.auth_role_get:type_name -> etcdserverpb.AuthRoleGetRequest
+	31, // 30: etcdserverpb.InternalRaftRequest.auth_role_grant_permission:type_name -> etcdserverpb.AuthRoleGrantPermissionRequest
+	32, // 31: etcdserverpb.InternalRaftRequest.auth_role_revoke_permission:type_name -> etcdserverpb.AuthRoleRevokePermissionRequest
+	33, // 32: etcdserverpb.InternalRaftRequest.cluster_version_set:type_name -> membershippb.ClusterVersionSetRequest
+	34, // 33: etcdserverpb.InternalRaftRequest.cluster_member_attr_set:type_name -> membershippb.ClusterMemberAttrSetRequest
+	35, // 34: etcdserverpb.InternalRaftRequest.downgrade_info_set:type_name -> membershippb.DowngradeInfoSetRequest
+	36, // 35: etcdserverpb.InternalRaftRequest.downgrade_version_test:type_name -> etcdserverpb.DowngradeVersionTestRequest
+	37, // 36: etcdserverpb.InternalRevisionHoldRequest.request:type_name -> etcdserverpb.RevisionHoldRequest
+	37, // [37:37] is the sub-list for method output_type
+	37, // [37:37] is the sub-list for method input_type
+	37, // [37:37] is the sub-list for extension type_name
+	37, // [37:37] is the sub-list for extension extendee
+	0,  // [0:37] is the sub-list for field type_name
 }
 
 func init() { file_raft_internal_proto_init() }
@@ -636,7 +751,7 @@ func file_raft_internal_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_raft_internal_proto_rawDesc), len(file_raft_internal_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
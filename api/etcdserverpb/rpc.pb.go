@@ -180,6 +180,101 @@ func (RangeRequest_SortTarget) EnumDescriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{1, 1}
 }
 
+type RangeRequest_LeaseFilter int32
+
+const (
+	RangeRequest_ANY      RangeRequest_LeaseFilter = 0 // default, no filtering on lease
+	RangeRequest_ATTACHED RangeRequest_LeaseFilter = 1 // only keys attached to a lease
+	RangeRequest_DETACHED RangeRequest_LeaseFilter = 2 // only keys not attached to a lease
+)
+
+// Enum value maps for RangeRequest_LeaseFilter.
+var (
+	RangeRequest_LeaseFilter_name = map[int32]string{
+		0: "ANY",
+		1: "ATTACHED",
+		2: "DETACHED",
+	}
+	RangeRequest_LeaseFilter_value = map[string]int32{
+		"ANY":      0,
+		"ATTACHED": 1,
+		"DETACHED": 2,
+	}
+)
+
+func (x RangeRequest_LeaseFilter) Enum() *RangeRequest_LeaseFilter {
+	p := new(RangeRequest_LeaseFilter)
+	*p = x
+	return p
+}
+
+func (x RangeRequest_LeaseFilter) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RangeRequest_LeaseFilter) Descriptor() protoreflect.EnumDescriptor {
+	return file_rpc_proto_enumTypes[3].Descriptor()
+}
+
+func (RangeRequest_LeaseFilter) Type() protoreflect.EnumType {
+	return &file_rpc_proto_enumTypes[3]
+}
+
+func (x RangeRequest_LeaseFilter) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RangeRequest_LeaseFilter.Descriptor instead.
+func (RangeRequest_LeaseFilter) EnumDescriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{1, 2}
+}
+
+type IncrementRequest_Encoding int32
+
+const (
+	IncrementRequest_DECIMAL    IncrementRequest_Encoding = 0 // default, the counter is stored as a base 10 string, e.g. "42"
+	IncrementRequest_BIG_ENDIAN IncrementRequest_Encoding = 1 // the counter is stored as an 8 byte big-endian integer
+)
+
+// Enum value maps for IncrementRequest_Encoding.
+var (
+	IncrementRequest_Encoding_name = map[int32]string{
+		0: "DECIMAL",
+		1: "BIG_ENDIAN",
+	}
+	IncrementRequest_Encoding_value = map[string]int32{
+		"DECIMAL":    0,
+		"BIG_ENDIAN": 1,
+	}
+)
+
+func (x IncrementRequest_Encoding) Enum() *IncrementRequest_Encoding {
+	p := new(IncrementRequest_Encoding)
+	*p = x
+	return p
+}
+
+func (x IncrementRequest_Encoding) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (IncrementRequest_Encoding) Descriptor() protoreflect.EnumDescriptor {
+	return file_rpc_proto_enumTypes[4].Descriptor()
+}
+
+func (IncrementRequest_Encoding) Type() protoreflect.EnumType {
+	return &file_rpc_proto_enumTypes[4]
+}
+
+func (x IncrementRequest_Encoding) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use IncrementRequest_Encoding.Descriptor instead.
+func (IncrementRequest_Encoding) EnumDescriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{5, 0}
+}
+
 type Compare_CompareResult int32
 
 const (
@@ -216,11 +311,11 @@ func (x Compare_CompareResult) String() string {
 }
 
 func (Compare_CompareResult) Descriptor() protoreflect.EnumDescriptor {
-	return file_rpc_proto_enumTypes[3].Descriptor()
+	return file_rpc_proto_enumTypes[5].Descriptor()
 }
 
 func (Compare_CompareResult) Type() protoreflect.EnumType {
-	return &file_rpc_proto_enumTypes[3]
+	return &file_rpc_proto_enumTypes[5]
 }
 
 func (x Compare_CompareResult) Number() protoreflect.EnumNumber {
@@ -229,17 +324,20 @@ func (x Compare_CompareResult) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Compare_CompareResult.Descriptor instead.
 func (Compare_CompareResult) EnumDescriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{9, 0}
+	return file_rpc_proto_rawDescGZIP(), []int{11, 0}
 }
 
 type Compare_CompareTarget int32
 
 const (
-	Compare_VERSION Compare_CompareTarget = 0
-	Compare_CREATE  Compare_CompareTarget = 1
-	Compare_MOD     Compare_CompareTarget = 2
-	Compare_VALUE   Compare_CompareTarget = 3
-	Compare_LEASE   Compare_CompareTarget = 4
+	Compare_VERSION    Compare_CompareTarget = 0
+	Compare_CREATE     Compare_CompareTarget = 1
+	Compare_MOD        Compare_CompareTarget = 2
+	Compare_VALUE      Compare_CompareTarget = 3
+	Compare_LEASE      Compare_CompareTarget = 4
+	Compare_COUNT      Compare_CompareTarget = 5
+	Compare_MAX_CREATE Compare_CompareTarget = 6
+	Compare_MAX_MOD    Compare_CompareTarget = 7
 )
 
 // Enum value maps for Compare_CompareTarget.
@@ -250,13 +348,19 @@ var (
 		2: "MOD",
 		3: "VALUE",
 		4: "LEASE",
+		5: "COUNT",
+		6: "MAX_CREATE",
+		7: "MAX_MOD",
 	}
 	Compare_CompareTarget_value = map[string]int32{
-		"VERSION": 0,
-		"CREATE":  1,
-		"MOD":     2,
-		"VALUE":   3,
-		"LEASE":   4,
+		"VERSION":    0,
+		"CREATE":     1,
+		"MOD":        2,
+		"VALUE":      3,
+		"LEASE":      4,
+		"COUNT":      5,
+		"MAX_CREATE": 6,
+		"MAX_MOD":    7,
 	}
 )
 
@@ -271,11 +375,11 @@ func (x Compare_CompareTarget) String() string {
 }
 
 func (Compare_CompareTarget) Descriptor() protoreflect.EnumDescriptor {
-	return file_rpc_proto_enumTypes[4].Descriptor()
+	return file_rpc_proto_enumTypes[6].Descriptor()
 }
 
 func (Compare_CompareTarget) Type() protoreflect.EnumType {
-	return &file_rpc_proto_enumTypes[4]
+	return &file_rpc_proto_enumTypes[6]
 }
 
 func (x Compare_CompareTarget) Number() protoreflect.EnumNumber {
@@ -284,7 +388,7 @@ func (x Compare_CompareTarget) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Compare_CompareTarget.Descriptor instead.
 func (Compare_CompareTarget) EnumDescriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{9, 1}
+	return file_rpc_proto_rawDescGZIP(), []int{11, 1}
 }
 
 type WatchCreateRequest_FilterType int32
@@ -294,6 +398,12 @@ const (
 	WatchCreateRequest_NOPUT WatchCreateRequest_FilterType = 0
 	// filter out delete event.
 	WatchCreateRequest_NODELETE WatchCreateRequest_FilterType = 1
+	// filter out put event leaving the value of the key unchanged. The previous
+	// value of the key is read from the backend for each put event, and the event
+	// is kept if it is compacted.
+	WatchCreateRequest_NOUNCHANGED WatchCreateRequest_FilterType = 2
+	// filter out put event updating an existing key, keeping the ones creating a key.
+	WatchCreateRequest_NOUPDATE WatchCreateRequest_FilterType = 3
 )
 
 // Enum value maps for WatchCreateRequest_FilterType.
@@ -301,10 +411,14 @@ var (
 	WatchCreateRequest_FilterType_name = map[int32]string{
 		0: "NOPUT",
 		1: "NODELETE",
+		2: "NOUNCHANGED",
+		3: "NOUPDATE",
 	}
 	WatchCreateRequest_FilterType_value = map[string]int32{
-		"NOPUT":    0,
-		"NODELETE": 1,
+		"NOPUT":       0,
+		"NODELETE":    1,
+		"NOUNCHANGED": 2,
+		"NOUPDATE":    3,
 	}
 )
 
@@ -319,11 +433,11 @@ func (x WatchCreateRequest_FilterType) String() string {
 }
 
 func (WatchCreateRequest_FilterType) Descriptor() protoreflect.EnumDescriptor {
-	return file_rpc_proto_enumTypes[5].Descriptor()
+	return file_rpc_proto_enumTypes[7].Descriptor()
 }
 
 func (WatchCreateRequest_FilterType) Type() protoreflect.EnumType {
-	return &file_rpc_proto_enumTypes[5]
+	return &file_rpc_proto_enumTypes[7]
 }
 
 func (x WatchCreateRequest_FilterType) Number() protoreflect.EnumNumber {
@@ -332,7 +446,108 @@ func (x WatchCreateRequest_FilterType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use WatchCreateRequest_FilterType.Descriptor instead.
 func (WatchCreateRequest_FilterType) EnumDescriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{21, 0}
+	return file_rpc_proto_rawDescGZIP(), []int{24, 0}
+}
+
+type SubscriptionRequest_SubscriptionAction int32
+
+const (
+	SubscriptionRequest_LIST   SubscriptionRequest_SubscriptionAction = 0
+	SubscriptionRequest_ACK    SubscriptionRequest_SubscriptionAction = 1
+	SubscriptionRequest_DELETE SubscriptionRequest_SubscriptionAction = 2
+)
+
+// Enum value maps for SubscriptionRequest_SubscriptionAction.
+var (
+	SubscriptionRequest_SubscriptionAction_name = map[int32]string{
+		0: "LIST",
+		1: "ACK",
+		2: "DELETE",
+	}
+	SubscriptionRequest_SubscriptionAction_value = map[string]int32{
+		"LIST":   0,
+		"ACK":    1,
+		"DELETE": 2,
+	}
+)
+
+func (x SubscriptionRequest_SubscriptionAction) Enum() *SubscriptionRequest_SubscriptionAction {
+	p := new(SubscriptionRequest_SubscriptionAction)
+	*p = x
+	return p
+}
+
+func (x SubscriptionRequest_SubscriptionAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SubscriptionRequest_SubscriptionAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_rpc_proto_enumTypes[8].Descriptor()
+}
+
+func (SubscriptionRequest_SubscriptionAction) Type() protoreflect.EnumType {
+	return &file_rpc_proto_enumTypes[8]
+}
+
+func (x SubscriptionRequest_SubscriptionAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SubscriptionRequest_SubscriptionAction.Descriptor instead.
+func (SubscriptionRequest_SubscriptionAction) EnumDescriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{29, 0}
+}
+
+type LeaseEvent_EventType int32
+
+const (
+	LeaseEvent_GRANT      LeaseEvent_EventType = 0 // the lease was granted
+	LeaseEvent_CHECKPOINT LeaseEvent_EventType = 1 // the remaining TTL of the lease was checkpointed
+	LeaseEvent_REVOKE     LeaseEvent_EventType = 2 // the lease was revoked
+	LeaseEvent_EXPIRE     LeaseEvent_EventType = 3 // the lease expired and was revoked
+)
+
+// Enum value maps for LeaseEvent_EventType.
+var (
+	LeaseEvent_EventType_name = map[int32]string{
+		0: "GRANT",
+		1: "CHECKPOINT",
+		2: "REVOKE",
+		3: "EXPIRE",
+	}
+	LeaseEvent_EventType_value = map[string]int32{
+		"GRANT":      0,
+		"CHECKPOINT": 1,
+		"REVOKE":     2,
+		"EXPIRE":     3,
+	}
+)
+
+func (x LeaseEvent_EventType) Enum() *LeaseEvent_EventType {
+	p := new(LeaseEvent_EventType)
+	*p = x
+	return p
+}
+
+func (x LeaseEvent_EventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LeaseEvent_EventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_rpc_proto_enumTypes[9].Descriptor()
+}
+
+func (LeaseEvent_EventType) Type() protoreflect.EnumType {
+	return &file_rpc_proto_enumTypes[9]
+}
+
+func (x LeaseEvent_EventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LeaseEvent_EventType.Descriptor instead.
+func (LeaseEvent_EventType) EnumDescriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{51, 0}
 }
 
 type AlarmRequest_AlarmAction int32
@@ -368,11 +583,11 @@ func (x AlarmRequest_AlarmAction) String() string {
 }
 
 func (AlarmRequest_AlarmAction) Descriptor() protoreflect.EnumDescriptor {
-	return file_rpc_proto_enumTypes[6].Descriptor()
+	return file_rpc_proto_enumTypes[10].Descriptor()
 }
 
 func (AlarmRequest_AlarmAction) Type() protoreflect.EnumType {
-	return &file_rpc_proto_enumTypes[6]
+	return &file_rpc_proto_enumTypes[10]
 }
 
 func (x AlarmRequest_AlarmAction) Number() protoreflect.EnumNumber {
@@ -381,7 +596,56 @@ func (x AlarmRequest_AlarmAction) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use AlarmRequest_AlarmAction.Descriptor instead.
 func (AlarmRequest_AlarmAction) EnumDescriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{54, 0}
+	return file_rpc_proto_rawDescGZIP(), []int{68, 0}
+}
+
+type RevisionHoldRequest_RevisionHoldAction int32
+
+const (
+	RevisionHoldRequest_LIST    RevisionHoldRequest_RevisionHoldAction = 0
+	RevisionHoldRequest_CREATE  RevisionHoldRequest_RevisionHoldAction = 1
+	RevisionHoldRequest_RELEASE RevisionHoldRequest_RevisionHoldAction = 2
+)
+
+// Enum value maps for RevisionHoldRequest_RevisionHoldAction.
+var (
+	RevisionHoldRequest_RevisionHoldAction_name = map[int32]string{
+		0: "LIST",
+		1: "CREATE",
+		2: "RELEASE",
+	}
+	RevisionHoldRequest_RevisionHoldAction_value = map[string]int32{
+		"LIST":    0,
+		"CREATE":  1,
+		"RELEASE": 2,
+	}
+)
+
+func (x RevisionHoldRequest_RevisionHoldAction) Enum() *RevisionHoldRequest_RevisionHoldAction {
+	p := new(RevisionHoldRequest_RevisionHoldAction)
+	*p = x
+	return p
+}
+
+func (x RevisionHoldRequest_RevisionHoldAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RevisionHoldRequest_RevisionHoldAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_rpc_proto_enumTypes[11].Descriptor()
+}
+
+func (RevisionHoldRequest_RevisionHoldAction) Type() protoreflect.EnumType {
+	return &file_rpc_proto_enumTypes[11]
+}
+
+func (x RevisionHoldRequest_RevisionHoldAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RevisionHoldRequest_RevisionHoldAction.Descriptor instead.
+func (RevisionHoldRequest_RevisionHoldAction) EnumDescriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{71, 0}
 }
 
 type DowngradeRequest_DowngradeAction int32
@@ -417,11 +681,11 @@ func (x DowngradeRequest_DowngradeAction) String() string {
 }
 
 func (DowngradeRequest_DowngradeAction) Descriptor() protoreflect.EnumDescriptor {
-	return file_rpc_proto_enumTypes[7].Descriptor()
+	return file_rpc_proto_enumTypes[12].Descriptor()
 }
 
 func (DowngradeRequest_DowngradeAction) Type() protoreflect.EnumType {
-	return &file_rpc_proto_enumTypes[7]
+	return &file_rpc_proto_enumTypes[12]
 }
 
 func (x DowngradeRequest_DowngradeAction) Number() protoreflect.EnumNumber {
@@ -430,7 +694,7 @@ func (x DowngradeRequest_DowngradeAction) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use DowngradeRequest_DowngradeAction.Descriptor instead.
 func (DowngradeRequest_DowngradeAction) EnumDescriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{57, 0}
+	return file_rpc_proto_rawDescGZIP(), []int{80, 0}
 }
 
 type ResponseHeader struct {
@@ -553,8 +817,25 @@ type RangeRequest struct {
 	// max_create_revision is the upper bound for returned key create revisions; all keys with
 	// greater create revisions will be filtered away.
 	MaxCreateRevision int64 `protobuf:"varint,13,opt,name=max_create_revision,json=maxCreateRevision,proto3" json:"max_create_revision,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	// value_prefix is the prefix that returned values must start with; all keys whose
+	// values do not start with value_prefix will be filtered away.
+	ValuePrefix []byte `protobuf:"bytes,14,opt,name=value_prefix,json=valuePrefix,proto3" json:"value_prefix,omitempty"`
+	// min_value_size is the lower bound, in bytes, for returned value sizes; all keys with
+	// smaller values will be filtered away.
+	MinValueSize int64 `protobuf:"varint,15,opt,name=min_value_size,json=minValueSize,proto3" json:"min_value_size,omitempty"`
+	// max_value_size is the upper bound, in bytes, for returned value sizes; all keys with
+	// larger values will be filtered away. When max_value_size is set to 0, it is treated
+	// as no upper bound.
+	MaxValueSize int64 `protobuf:"varint,16,opt,name=max_value_size,json=maxValueSize,proto3" json:"max_value_size,omitempty"`
+	// lease_filter filters away keys based on whether they are attached to a lease.
+	LeaseFilter RangeRequest_LeaseFilter `protobuf:"varint,17,opt,name=lease_filter,json=leaseFilter,proto3,enum=etcdserverpb.RangeRequest_LeaseFilter" json:"lease_filter,omitempty"`
+	// continue_token is the continue_token of a previous range response. When set,
+	// the range resumes after the last key-value pair returned by that response,
+	// at the same revision and in the same sort order. The other fields of the
+	// request must be the same as for the previous request, except for limit.
+	ContinueToken []byte `protobuf:"bytes,18,opt,name=continue_token,json=continueToken,proto3" json:"continue_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *RangeRequest) Reset() {
@@ -678,6 +959,41 @@ func (x *RangeRequest) GetMaxCreateRevision() int64 {
 	return 0
 }
 
+func (x *RangeRequest) GetValuePrefix() []byte {
+	if x != nil {
+		return x.ValuePrefix
+	}
+	return nil
+}
+
+func (x *RangeRequest) GetMinValueSize() int64 {
+	if x != nil {
+		return x.MinValueSize
+	}
+	return 0
+}
+
+func (x *RangeRequest) GetMaxValueSize() int64 {
+	if x != nil {
+		return x.MaxValueSize
+	}
+	return 0
+}
+
+func (x *RangeRequest) GetLeaseFilter() RangeRequest_LeaseFilter {
+	if x != nil {
+		return x.LeaseFilter
+	}
+	return RangeRequest_ANY
+}
+
+func (x *RangeRequest) GetContinueToken() []byte {
+	if x != nil {
+		return x.ContinueToken
+	}
+	return nil
+}
+
 type RangeResponse struct {
 	state  protoimpl.MessageState `protogen:"open.v1"`
 	Header *ResponseHeader        `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
@@ -687,9 +1003,14 @@ type RangeResponse struct {
 	// more indicates if there are more keys to return in the requested range.
 	More bool `protobuf:"varint,3,opt,name=more,proto3" json:"more,omitempty"`
 	// count is set to the actual number of keys within the range when requested.
-	// Unlike Kvs, it is unaffected by limits and filters (e.g., Min/Max, Create/Modify, Revisions)
-	// and reflects the full count within the specified range.
-	Count         int64 `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	// Unlike Kvs, it is unaffected by limits and filters (e.g., Min/Max, Create/Modify, Revisions,
+	// value and lease filters) and reflects the full count within the specified range.
+	// If continue_token is set in the request, only the keys after the position it
+	// resumes from are counted.
+	Count int64 `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	// continue_token is set when more is true and the range has a limit. Passing it
+	// in the continue_token of the next request returns the next page of the range.
+	ContinueToken []byte `protobuf:"bytes,5,opt,name=continue_token,json=continueToken,proto3" json:"continue_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -752,6 +1073,13 @@ func (x *RangeResponse) GetCount() int64 {
 	return 0
 }
 
+func (x *RangeResponse) GetContinueToken() []byte {
+	if x != nil {
+		return x.ContinueToken
+	}
+	return nil
+}
+
 type PutRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// key is the key, in bytes, to put into the key-value store.
@@ -769,7 +1097,12 @@ type PutRequest struct {
 	IgnoreValue bool `protobuf:"varint,5,opt,name=ignore_value,json=ignoreValue,proto3" json:"ignore_value,omitempty"`
 	// If ignore_lease is set, etcd updates the key using its current lease.
 	// Returns an error if the key does not exist.
-	IgnoreLease   bool `protobuf:"varint,6,opt,name=ignore_lease,json=ignoreLease,proto3" json:"ignore_lease,omitempty"`
+	IgnoreLease bool `protobuf:"varint,6,opt,name=ignore_lease,json=ignoreLease,proto3" json:"ignore_lease,omitempty"`
+	// ttl is the time to live of the key in seconds. The server attaches the key
+	// to a lease shared by all keys expiring within the same second, so no lease
+	// needs to be granted by the client. A ttl cannot be combined with lease or
+	// ignore_lease.
+	Ttl           int64 `protobuf:"varint,7,opt,name=ttl,proto3" json:"ttl,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -846,11 +1179,21 @@ func (x *PutRequest) GetIgnoreLease() bool {
 	return false
 }
 
+func (x *PutRequest) GetTtl() int64 {
+	if x != nil {
+		return x.Ttl
+	}
+	return 0
+}
+
 type PutResponse struct {
 	state  protoimpl.MessageState `protogen:"open.v1"`
 	Header *ResponseHeader        `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
 	// if prev_kv is set in the request, the previous key-value pair will be returned.
-	PrevKv        *mvccpb.KeyValue `protobuf:"bytes,2,opt,name=prev_kv,json=prevKv,proto3" json:"prev_kv,omitempty"`
+	PrevKv *mvccpb.KeyValue `protobuf:"bytes,2,opt,name=prev_kv,json=prevKv,proto3" json:"prev_kv,omitempty"`
+	// if ttl is set in the request, expiry is the unix time in seconds from which
+	// the key may expire.
+	Expiry        int64 `protobuf:"varint,3,opt,name=expiry,proto3" json:"expiry,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -899,37 +1242,49 @@ func (x *PutResponse) GetPrevKv() *mvccpb.KeyValue {
 	return nil
 }
 
-type DeleteRangeRequest struct {
+func (x *PutResponse) GetExpiry() int64 {
+	if x != nil {
+		return x.Expiry
+	}
+	return 0
+}
+
+type IncrementRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// key is the first key to delete in the range.
+	// key is the key of the counter to increment. A key that does not exist
+	// is treated as a counter with value 0.
 	Key []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	// range_end is the key following the last key to delete for the range [key, range_end).
-	// If range_end is not given, the range is defined to contain only the key argument.
-	// If range_end is one bit larger than the given key, then the range is all the keys
-	// with the prefix (the given key).
-	// If range_end is '\0', the range is all keys greater than or equal to the key argument.
-	RangeEnd []byte `protobuf:"bytes,2,opt,name=range_end,json=rangeEnd,proto3" json:"range_end,omitempty"`
-	// If prev_kv is set, etcd gets the previous key-value pairs before deleting it.
-	// The previous key-value pairs will be returned in the delete response.
-	PrevKv        bool `protobuf:"varint,3,opt,name=prev_kv,json=prevKv,proto3" json:"prev_kv,omitempty"`
+	// delta is added to the current value of the counter. It may be negative.
+	Delta int64 `protobuf:"varint,2,opt,name=delta,proto3" json:"delta,omitempty"`
+	// encoding is how the counter value is stored in the key-value store.
+	Encoding IncrementRequest_Encoding `protobuf:"varint,3,opt,name=encoding,proto3,enum=etcdserverpb.IncrementRequest_Encoding" json:"encoding,omitempty"`
+	// lease is the lease ID to associate with the key in the key-value store. A lease
+	// value of 0 indicates no lease.
+	Lease int64 `protobuf:"varint,4,opt,name=lease,proto3" json:"lease,omitempty"`
+	// If ignore_lease is set, etcd updates the key using its current lease.
+	// Returns an error if the key does not exist.
+	IgnoreLease bool `protobuf:"varint,5,opt,name=ignore_lease,json=ignoreLease,proto3" json:"ignore_lease,omitempty"`
+	// If prev_kv is set, etcd gets the previous key-value pair before changing it.
+	// The previous key-value pair will be returned in the increment response.
+	PrevKv        bool `protobuf:"varint,6,opt,name=prev_kv,json=prevKv,proto3" json:"prev_kv,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteRangeRequest) Reset() {
-	*x = DeleteRangeRequest{}
+func (x *IncrementRequest) Reset() {
+	*x = IncrementRequest{}
 	mi := &file_rpc_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteRangeRequest) String() string {
+func (x *IncrementRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteRangeRequest) ProtoMessage() {}
+func (*IncrementRequest) ProtoMessage() {}
 
-func (x *DeleteRangeRequest) ProtoReflect() protoreflect.Message {
+func (x *IncrementRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -941,57 +1296,78 @@ func (x *DeleteRangeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteRangeRequest.ProtoReflect.Descriptor instead.
-func (*DeleteRangeRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use IncrementRequest.ProtoReflect.Descriptor instead.
+func (*IncrementRequest) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *DeleteRangeRequest) GetKey() []byte {
+func (x *IncrementRequest) GetKey() []byte {
 	if x != nil {
 		return x.Key
 	}
 	return nil
 }
 
-func (x *DeleteRangeRequest) GetRangeEnd() []byte {
+func (x *IncrementRequest) GetDelta() int64 {
 	if x != nil {
-		return x.RangeEnd
+		return x.Delta
 	}
-	return nil
+	return 0
 }
 
-func (x *DeleteRangeRequest) GetPrevKv() bool {
+func (x *IncrementRequest) GetEncoding() IncrementRequest_Encoding {
+	if x != nil {
+		return x.Encoding
+	}
+	return IncrementRequest_DECIMAL
+}
+
+func (x *IncrementRequest) GetLease() int64 {
+	if x != nil {
+		return x.Lease
+	}
+	return 0
+}
+
+func (x *IncrementRequest) GetIgnoreLease() bool {
+	if x != nil {
+		return x.IgnoreLease
+	}
+	return false
+}
+
+func (x *IncrementRequest) GetPrevKv() bool {
 	if x != nil {
 		return x.PrevKv
 	}
 	return false
 }
 
-type DeleteRangeResponse struct {
+type IncrementResponse struct {
 	state  protoimpl.MessageState `protogen:"open.v1"`
 	Header *ResponseHeader        `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
-	// deleted is the number of keys deleted by the delete range request.
-	Deleted int64 `protobuf:"varint,2,opt,name=deleted,proto3" json:"deleted,omitempty"`
-	// if prev_kv is set in the request, the previous key-value pairs will be returned.
-	PrevKvs       []*mvccpb.KeyValue `protobuf:"bytes,3,rep,name=prev_kvs,json=prevKvs,proto3" json:"prev_kvs,omitempty"`
+	// value is the value of the counter after the increment.
+	Value int64 `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
+	// if prev_kv is set in the request, the previous key-value pair will be returned.
+	PrevKv        *mvccpb.KeyValue `protobuf:"bytes,3,opt,name=prev_kv,json=prevKv,proto3" json:"prev_kv,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteRangeResponse) Reset() {
-	*x = DeleteRangeResponse{}
+func (x *IncrementResponse) Reset() {
+	*x = IncrementResponse{}
 	mi := &file_rpc_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteRangeResponse) String() string {
+func (x *IncrementResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteRangeResponse) ProtoMessage() {}
+func (*IncrementResponse) ProtoMessage() {}
 
-func (x *DeleteRangeResponse) ProtoReflect() protoreflect.Message {
+func (x *IncrementResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1003,42 +1379,220 @@ func (x *DeleteRangeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteRangeResponse.ProtoReflect.Descriptor instead.
-func (*DeleteRangeResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use IncrementResponse.ProtoReflect.Descriptor instead.
+func (*IncrementResponse) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *DeleteRangeResponse) GetHeader() *ResponseHeader {
+func (x *IncrementResponse) GetHeader() *ResponseHeader {
 	if x != nil {
 		return x.Header
 	}
 	return nil
 }
 
-func (x *DeleteRangeResponse) GetDeleted() int64 {
+func (x *IncrementResponse) GetValue() int64 {
 	if x != nil {
-		return x.Deleted
+		return x.Value
 	}
 	return 0
 }
 
-func (x *DeleteRangeResponse) GetPrevKvs() []*mvccpb.KeyValue {
+func (x *IncrementResponse) GetPrevKv() *mvccpb.KeyValue {
 	if x != nil {
-		return x.PrevKvs
+		return x.PrevKv
 	}
 	return nil
 }
 
-type RequestOp struct {
+type DeleteRangeRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// request is a union of request types accepted by a transaction.
-	//
+	// key is the first key to delete in the range.
+	Key []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// range_end is the key following the last key to delete for the range [key, range_end).
+	// If range_end is not given, the range is defined to contain only the key argument.
+	// If range_end is one bit larger than the given key, then the range is all the keys
+	// with the prefix (the given key).
+	// If range_end is '\0', the range is all keys greater than or equal to the key argument.
+	RangeEnd []byte `protobuf:"bytes,2,opt,name=range_end,json=rangeEnd,proto3" json:"range_end,omitempty"`
+	// If prev_kv is set, etcd gets the previous key-value pairs before deleting it.
+	// The previous key-value pairs will be returned in the delete response.
+	PrevKv bool `protobuf:"varint,3,opt,name=prev_kv,json=prevKv,proto3" json:"prev_kv,omitempty"`
+	// batch_size, if positive, deletes the range in batches of at most batch_size keys,
+	// each applied in its own raft entry at its own revision. A batch also stops before
+	// its previous key-value pairs, which it writes to the backend and to watchers,
+	// exceed the request size limit of the server. Until the last batch is
+	// applied, other requests may observe the range partially deleted. A batched delete
+	// cannot return the previous key-value pairs and is not allowed in a txn.
+	BatchSize int64 `protobuf:"varint,4,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+	// atomic, together with batch_size, deletes the range in a single raft entry at a
+	// single revision, so that no other request observes it partially deleted. The
+	// entry deletes every key in the range when applied, including keys created after
+	// the range was measured. The request fails without deleting any key if the range
+	// does not fit in one batch.
+	Atomic bool `protobuf:"varint,5,opt,name=atomic,proto3" json:"atomic,omitempty"`
+	// max_batches, if positive, stops a batched delete after max_batches batches. The
+	// response then carries the key to resume the delete from in next_key.
+	MaxBatches    int64 `protobuf:"varint,6,opt,name=max_batches,json=maxBatches,proto3" json:"max_batches,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRangeRequest) Reset() {
+	*x = DeleteRangeRequest{}
+	mi := &file_rpc_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRangeRequest) ProtoMessage() {}
+
+func (x *DeleteRangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRangeRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRangeRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DeleteRangeRequest) GetKey() []byte {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *DeleteRangeRequest) GetRangeEnd() []byte {
+	if x != nil {
+		return x.RangeEnd
+	}
+	return nil
+}
+
+func (x *DeleteRangeRequest) GetPrevKv() bool {
+	if x != nil {
+		return x.PrevKv
+	}
+	return false
+}
+
+func (x *DeleteRangeRequest) GetBatchSize() int64 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+func (x *DeleteRangeRequest) GetAtomic() bool {
+	if x != nil {
+		return x.Atomic
+	}
+	return false
+}
+
+func (x *DeleteRangeRequest) GetMaxBatches() int64 {
+	if x != nil {
+		return x.MaxBatches
+	}
+	return 0
+}
+
+type DeleteRangeResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Header *ResponseHeader        `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	// deleted is the number of keys deleted by the delete range request.
+	Deleted int64 `protobuf:"varint,2,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	// if prev_kv is set in the request, the previous key-value pairs will be returned.
+	PrevKvs []*mvccpb.KeyValue `protobuf:"bytes,3,rep,name=prev_kvs,json=prevKvs,proto3" json:"prev_kvs,omitempty"`
+	// next_key, if set, is the start of the part of the range a batched delete
+	// stopped before. Deleting from next_key to the same range end resumes the delete.
+	NextKey       []byte `protobuf:"bytes,4,opt,name=next_key,json=nextKey,proto3" json:"next_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRangeResponse) Reset() {
+	*x = DeleteRangeResponse{}
+	mi := &file_rpc_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRangeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRangeResponse) ProtoMessage() {}
+
+func (x *DeleteRangeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRangeResponse.ProtoReflect.Descriptor instead.
+func (*DeleteRangeResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DeleteRangeResponse) GetHeader() *ResponseHeader {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *DeleteRangeResponse) GetDeleted() int64 {
+	if x != nil {
+		return x.Deleted
+	}
+	return 0
+}
+
+func (x *DeleteRangeResponse) GetPrevKvs() []*mvccpb.KeyValue {
+	if x != nil {
+		return x.PrevKvs
+	}
+	return nil
+}
+
+func (x *DeleteRangeResponse) GetNextKey() []byte {
+	if x != nil {
+		return x.NextKey
+	}
+	return nil
+}
+
+type RequestOp struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// request is a union of request types accepted by a transaction.
+	//
 	// Types that are valid to be assigned to Request:
 	//
 	//	*RequestOp_RequestRange
 	//	*RequestOp_RequestPut
 	//	*RequestOp_RequestDeleteRange
 	//	*RequestOp_RequestTxn
+	//	*RequestOp_RequestIncrement
 	Request       isRequestOp_Request `protobuf_oneof:"request"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -1046,7 +1600,7 @@ type RequestOp struct {
 
 func (x *RequestOp) Reset() {
 	*x = RequestOp{}
-	mi := &file_rpc_proto_msgTypes[7]
+	mi := &file_rpc_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1058,7 +1612,7 @@ func (x *RequestOp) String() string {
 func (*RequestOp) ProtoMessage() {}
 
 func (x *RequestOp) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[7]
+	mi := &file_rpc_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1071,7 +1625,7 @@ func (x *RequestOp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RequestOp.ProtoReflect.Descriptor instead.
 func (*RequestOp) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{7}
+	return file_rpc_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *RequestOp) GetRequest() isRequestOp_Request {
@@ -1117,6 +1671,15 @@ func (x *RequestOp) GetRequestTxn() *TxnRequest {
 	return nil
 }
 
+func (x *RequestOp) GetRequestIncrement() *IncrementRequest {
+	if x != nil {
+		if x, ok := x.Request.(*RequestOp_RequestIncrement); ok {
+			return x.RequestIncrement
+		}
+	}
+	return nil
+}
+
 type isRequestOp_Request interface {
 	isRequestOp_Request()
 }
@@ -1137,6 +1700,10 @@ type RequestOp_RequestTxn struct {
 	RequestTxn *TxnRequest `protobuf:"bytes,4,opt,name=request_txn,json=requestTxn,proto3,oneof"`
 }
 
+type RequestOp_RequestIncrement struct {
+	RequestIncrement *IncrementRequest `protobuf:"bytes,5,opt,name=request_increment,json=requestIncrement,proto3,oneof"`
+}
+
 func (*RequestOp_RequestRange) isRequestOp_Request() {}
 
 func (*RequestOp_RequestPut) isRequestOp_Request() {}
@@ -1145,6 +1712,8 @@ func (*RequestOp_RequestDeleteRange) isRequestOp_Request() {}
 
 func (*RequestOp_RequestTxn) isRequestOp_Request() {}
 
+func (*RequestOp_RequestIncrement) isRequestOp_Request() {}
+
 type ResponseOp struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// response is a union of response types returned by a transaction.
@@ -1155,6 +1724,7 @@ type ResponseOp struct {
 	//	*ResponseOp_ResponsePut
 	//	*ResponseOp_ResponseDeleteRange
 	//	*ResponseOp_ResponseTxn
+	//	*ResponseOp_ResponseIncrement
 	Response      isResponseOp_Response `protobuf_oneof:"response"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -1162,7 +1732,7 @@ type ResponseOp struct {
 
 func (x *ResponseOp) Reset() {
 	*x = ResponseOp{}
-	mi := &file_rpc_proto_msgTypes[8]
+	mi := &file_rpc_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1174,7 +1744,7 @@ func (x *ResponseOp) String() string {
 func (*ResponseOp) ProtoMessage() {}
 
 func (x *ResponseOp) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[8]
+	mi := &file_rpc_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1187,7 +1757,7 @@ func (x *ResponseOp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResponseOp.ProtoReflect.Descriptor instead.
 func (*ResponseOp) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{8}
+	return file_rpc_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *ResponseOp) GetResponse() isResponseOp_Response {
@@ -1233,6 +1803,15 @@ func (x *ResponseOp) GetResponseTxn() *TxnResponse {
 	return nil
 }
 
+func (x *ResponseOp) GetResponseIncrement() *IncrementResponse {
+	if x != nil {
+		if x, ok := x.Response.(*ResponseOp_ResponseIncrement); ok {
+			return x.ResponseIncrement
+		}
+	}
+	return nil
+}
+
 type isResponseOp_Response interface {
 	isResponseOp_Response()
 }
@@ -1253,6 +1832,10 @@ type ResponseOp_ResponseTxn struct {
 	ResponseTxn *TxnResponse `protobuf:"bytes,4,opt,name=response_txn,json=responseTxn,proto3,oneof"`
 }
 
+type ResponseOp_ResponseIncrement struct {
+	ResponseIncrement *IncrementResponse `protobuf:"bytes,5,opt,name=response_increment,json=responseIncrement,proto3,oneof"`
+}
+
 func (*ResponseOp_ResponseRange) isResponseOp_Response() {}
 
 func (*ResponseOp_ResponsePut) isResponseOp_Response() {}
@@ -1261,6 +1844,8 @@ func (*ResponseOp_ResponseDeleteRange) isResponseOp_Response() {}
 
 func (*ResponseOp_ResponseTxn) isResponseOp_Response() {}
 
+func (*ResponseOp_ResponseIncrement) isResponseOp_Response() {}
+
 type Compare struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// result is logical comparison operation for this comparison.
@@ -1276,9 +1861,14 @@ type Compare struct {
 	//	*Compare_ModRevision
 	//	*Compare_Value
 	//	*Compare_Lease
+	//	*Compare_Count
+	//	*Compare_MaxCreateRevision
+	//	*Compare_MaxModRevision
 	TargetUnion isCompare_TargetUnion `protobuf_oneof:"target_union"`
 	// range_end compares the given target to all keys in the range [key, range_end).
 	// See RangeRequest for more details on key ranges.
+	// The COUNT, MAX_CREATE and MAX_MOD targets are aggregated over all keys in the
+	// range and compared once; an empty range aggregates to 0.
 	RangeEnd      []byte `protobuf:"bytes,64,opt,name=range_end,json=rangeEnd,proto3" json:"range_end,omitempty"` // TODO: fill out with most of the rest of RangeRequest fields when needed.
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -1286,7 +1876,7 @@ type Compare struct {
 
 func (x *Compare) Reset() {
 	*x = Compare{}
-	mi := &file_rpc_proto_msgTypes[9]
+	mi := &file_rpc_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1298,7 +1888,7 @@ func (x *Compare) String() string {
 func (*Compare) ProtoMessage() {}
 
 func (x *Compare) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[9]
+	mi := &file_rpc_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1311,7 +1901,7 @@ func (x *Compare) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Compare.ProtoReflect.Descriptor instead.
 func (*Compare) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{9}
+	return file_rpc_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *Compare) GetResult() Compare_CompareResult {
@@ -1387,6 +1977,33 @@ func (x *Compare) GetLease() int64 {
 	return 0
 }
 
+func (x *Compare) GetCount() int64 {
+	if x != nil {
+		if x, ok := x.TargetUnion.(*Compare_Count); ok {
+			return x.Count
+		}
+	}
+	return 0
+}
+
+func (x *Compare) GetMaxCreateRevision() int64 {
+	if x != nil {
+		if x, ok := x.TargetUnion.(*Compare_MaxCreateRevision); ok {
+			return x.MaxCreateRevision
+		}
+	}
+	return 0
+}
+
+func (x *Compare) GetMaxModRevision() int64 {
+	if x != nil {
+		if x, ok := x.TargetUnion.(*Compare_MaxModRevision); ok {
+			return x.MaxModRevision
+		}
+	}
+	return 0
+}
+
 func (x *Compare) GetRangeEnd() []byte {
 	if x != nil {
 		return x.RangeEnd
@@ -1420,7 +2037,22 @@ type Compare_Value struct {
 
 type Compare_Lease struct {
 	// lease is the lease id of the given key.
-	Lease int64 `protobuf:"varint,8,opt,name=lease,proto3,oneof"` // leave room for more target_union field tags, jump to 64
+	Lease int64 `protobuf:"varint,8,opt,name=lease,proto3,oneof"`
+}
+
+type Compare_Count struct {
+	// count is the number of keys in the given range.
+	Count int64 `protobuf:"varint,9,opt,name=count,proto3,oneof"`
+}
+
+type Compare_MaxCreateRevision struct {
+	// max_create_revision is the greatest creation revision of the keys in the given range.
+	MaxCreateRevision int64 `protobuf:"varint,10,opt,name=max_create_revision,json=maxCreateRevision,proto3,oneof"`
+}
+
+type Compare_MaxModRevision struct {
+	// max_mod_revision is the greatest last modified revision of the keys in the given range.
+	MaxModRevision int64 `protobuf:"varint,11,opt,name=max_mod_revision,json=maxModRevision,proto3,oneof"` // leave room for more target_union field tags, jump to 64
 }
 
 func (*Compare_Version) isCompare_TargetUnion() {}
@@ -1433,6 +2065,12 @@ func (*Compare_Value) isCompare_TargetUnion() {}
 
 func (*Compare_Lease) isCompare_TargetUnion() {}
 
+func (*Compare_Count) isCompare_TargetUnion() {}
+
+func (*Compare_MaxCreateRevision) isCompare_TargetUnion() {}
+
+func (*Compare_MaxModRevision) isCompare_TargetUnion() {}
+
 // From google paxosdb paper:
 // Our implementation hinges around a powerful primitive which we call MultiOp. All other database
 // operations except for iteration are implemented as a single call to MultiOp. A MultiOp is applied atomically
@@ -1466,7 +2104,7 @@ type TxnRequest struct {
 
 func (x *TxnRequest) Reset() {
 	*x = TxnRequest{}
-	mi := &file_rpc_proto_msgTypes[10]
+	mi := &file_rpc_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1478,7 +2116,7 @@ func (x *TxnRequest) String() string {
 func (*TxnRequest) ProtoMessage() {}
 
 func (x *TxnRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[10]
+	mi := &file_rpc_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1491,7 +2129,7 @@ func (x *TxnRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TxnRequest.ProtoReflect.Descriptor instead.
 func (*TxnRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{10}
+	return file_rpc_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *TxnRequest) GetCompare() []*Compare {
@@ -1529,7 +2167,7 @@ type TxnResponse struct {
 
 func (x *TxnResponse) Reset() {
 	*x = TxnResponse{}
-	mi := &file_rpc_proto_msgTypes[11]
+	mi := &file_rpc_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1541,7 +2179,7 @@ func (x *TxnResponse) String() string {
 func (*TxnResponse) ProtoMessage() {}
 
 func (x *TxnResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[11]
+	mi := &file_rpc_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1554,7 +2192,7 @@ func (x *TxnResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TxnResponse.ProtoReflect.Descriptor instead.
 func (*TxnResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{11}
+	return file_rpc_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *TxnResponse) GetHeader() *ResponseHeader {
@@ -1587,14 +2225,19 @@ type CompactionRequest struct {
 	// physical is set so the RPC will wait until the compaction is physically
 	// applied to the local database such that compacted entries are totally
 	// removed from the backend database.
-	Physical      bool `protobuf:"varint,2,opt,name=physical,proto3" json:"physical,omitempty"`
+	Physical bool `protobuf:"varint,2,opt,name=physical,proto3" json:"physical,omitempty"`
+	// prefixes compacts the keys under each prefix up to the revision of the
+	// longest prefix they match instead of revision. Prefixes compacted past
+	// revision must be listed on every compaction to keep their own revision;
+	// keys under prefixes left out are compacted like any other key.
+	Prefixes      []*PrefixCompaction `protobuf:"bytes,3,rep,name=prefixes,proto3" json:"prefixes,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CompactionRequest) Reset() {
 	*x = CompactionRequest{}
-	mi := &file_rpc_proto_msgTypes[12]
+	mi := &file_rpc_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1606,7 +2249,7 @@ func (x *CompactionRequest) String() string {
 func (*CompactionRequest) ProtoMessage() {}
 
 func (x *CompactionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[12]
+	mi := &file_rpc_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1619,7 +2262,7 @@ func (x *CompactionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CompactionRequest.ProtoReflect.Descriptor instead.
 func (*CompactionRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{12}
+	return file_rpc_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *CompactionRequest) GetRevision() int64 {
@@ -1636,28 +2279,40 @@ func (x *CompactionRequest) GetPhysical() bool {
 	return false
 }
 
-type CompactionResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Header        *ResponseHeader        `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+func (x *CompactionRequest) GetPrefixes() []*PrefixCompaction {
+	if x != nil {
+		return x.Prefixes
+	}
+	return nil
+}
+
+type PrefixCompaction struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// prefix is the key prefix to compact. An empty prefix matches every key
+	// not under a longer listed prefix.
+	Prefix []byte `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	// revision is the revision to compact the keys under the prefix up to.
+	// If revision is 0, the keys keep their current compaction revision.
+	Revision      int64 `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CompactionResponse) Reset() {
-	*x = CompactionResponse{}
-	mi := &file_rpc_proto_msgTypes[13]
+func (x *PrefixCompaction) Reset() {
+	*x = PrefixCompaction{}
+	mi := &file_rpc_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CompactionResponse) String() string {
+func (x *PrefixCompaction) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CompactionResponse) ProtoMessage() {}
+func (*PrefixCompaction) ProtoMessage() {}
 
-func (x *CompactionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[13]
+func (x *PrefixCompaction) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1668,19 +2323,70 @@ func (x *CompactionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CompactionResponse.ProtoReflect.Descriptor instead.
-func (*CompactionResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use PrefixCompaction.ProtoReflect.Descriptor instead.
+func (*PrefixCompaction) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *CompactionResponse) GetHeader() *ResponseHeader {
+func (x *PrefixCompaction) GetPrefix() []byte {
 	if x != nil {
-		return x.Header
+		return x.Prefix
 	}
 	return nil
 }
 
-type HashRequest struct {
+func (x *PrefixCompaction) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+type CompactionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Header        *ResponseHeader        `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompactionResponse) Reset() {
+	*x = CompactionResponse{}
+	mi := &file_rpc_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompactionResponse) ProtoMessage() {}
+
+func (x *CompactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompactionResponse.ProtoReflect.Descriptor instead.
+func (*CompactionResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CompactionResponse) GetHeader() *ResponseHeader {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+type HashRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -1688,7 +2394,7 @@ type HashRequest struct {
 
 func (x *HashRequest) Reset() {
 	*x = HashRequest{}
-	mi := &file_rpc_proto_msgTypes[14]
+	mi := &file_rpc_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1700,7 +2406,7 @@ func (x *HashRequest) String() string {
 func (*HashRequest) ProtoMessage() {}
 
 func (x *HashRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[14]
+	mi := &file_rpc_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1713,7 +2419,7 @@ func (x *HashRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HashRequest.ProtoReflect.Descriptor instead.
 func (*HashRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{14}
+	return file_rpc_proto_rawDescGZIP(), []int{17}
 }
 
 type HashKVRequest struct {
@@ -1726,7 +2432,7 @@ type HashKVRequest struct {
 
 func (x *HashKVRequest) Reset() {
 	*x = HashKVRequest{}
-	mi := &file_rpc_proto_msgTypes[15]
+	mi := &file_rpc_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1738,7 +2444,7 @@ func (x *HashKVRequest) String() string {
 func (*HashKVRequest) ProtoMessage() {}
 
 func (x *HashKVRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[15]
+	mi := &file_rpc_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1751,7 +2457,7 @@ func (x *HashKVRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HashKVRequest.ProtoReflect.Descriptor instead.
 func (*HashKVRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{15}
+	return file_rpc_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *HashKVRequest) GetRevision() int64 {
@@ -1776,7 +2482,7 @@ type HashKVResponse struct {
 
 func (x *HashKVResponse) Reset() {
 	*x = HashKVResponse{}
-	mi := &file_rpc_proto_msgTypes[16]
+	mi := &file_rpc_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1788,7 +2494,7 @@ func (x *HashKVResponse) String() string {
 func (*HashKVResponse) ProtoMessage() {}
 
 func (x *HashKVResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[16]
+	mi := &file_rpc_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1801,7 +2507,7 @@ func (x *HashKVResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HashKVResponse.ProtoReflect.Descriptor instead.
 func (*HashKVResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{16}
+	return file_rpc_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *HashKVResponse) GetHeader() *ResponseHeader {
@@ -1843,7 +2549,7 @@ type HashResponse struct {
 
 func (x *HashResponse) Reset() {
 	*x = HashResponse{}
-	mi := &file_rpc_proto_msgTypes[17]
+	mi := &file_rpc_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1855,7 +2561,7 @@ func (x *HashResponse) String() string {
 func (*HashResponse) ProtoMessage() {}
 
 func (x *HashResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[17]
+	mi := &file_rpc_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1868,7 +2574,7 @@ func (x *HashResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HashResponse.ProtoReflect.Descriptor instead.
 func (*HashResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{17}
+	return file_rpc_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *HashResponse) GetHeader() *ResponseHeader {
@@ -1893,7 +2599,7 @@ type SnapshotRequest struct {
 
 func (x *SnapshotRequest) Reset() {
 	*x = SnapshotRequest{}
-	mi := &file_rpc_proto_msgTypes[18]
+	mi := &file_rpc_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1905,7 +2611,7 @@ func (x *SnapshotRequest) String() string {
 func (*SnapshotRequest) ProtoMessage() {}
 
 func (x *SnapshotRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[18]
+	mi := &file_rpc_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1918,7 +2624,7 @@ func (x *SnapshotRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SnapshotRequest.ProtoReflect.Descriptor instead.
 func (*SnapshotRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{18}
+	return file_rpc_proto_rawDescGZIP(), []int{21}
 }
 
 type SnapshotResponse struct {
@@ -1940,7 +2646,7 @@ type SnapshotResponse struct {
 
 func (x *SnapshotResponse) Reset() {
 	*x = SnapshotResponse{}
-	mi := &file_rpc_proto_msgTypes[19]
+	mi := &file_rpc_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1952,7 +2658,7 @@ func (x *SnapshotResponse) String() string {
 func (*SnapshotResponse) ProtoMessage() {}
 
 func (x *SnapshotResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[19]
+	mi := &file_rpc_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1965,7 +2671,7 @@ func (x *SnapshotResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SnapshotResponse.ProtoReflect.Descriptor instead.
 func (*SnapshotResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{19}
+	return file_rpc_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *SnapshotResponse) GetHeader() *ResponseHeader {
@@ -2012,7 +2718,7 @@ type WatchRequest struct {
 
 func (x *WatchRequest) Reset() {
 	*x = WatchRequest{}
-	mi := &file_rpc_proto_msgTypes[20]
+	mi := &file_rpc_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2024,7 +2730,7 @@ func (x *WatchRequest) String() string {
 func (*WatchRequest) ProtoMessage() {}
 
 func (x *WatchRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[20]
+	mi := &file_rpc_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2037,7 +2743,7 @@ func (x *WatchRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WatchRequest.ProtoReflect.Descriptor instead.
 func (*WatchRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{20}
+	return file_rpc_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *WatchRequest) GetRequestUnion() isWatchRequest_RequestUnion {
@@ -2125,14 +2831,34 @@ type WatchCreateRequest struct {
 	// use on the stream will cause an error to be returned.
 	WatchId int64 `protobuf:"varint,7,opt,name=watch_id,json=watchId,proto3" json:"watch_id,omitempty"`
 	// fragment enables splitting large revisions into multiple watch responses.
-	Fragment      bool `protobuf:"varint,8,opt,name=fragment,proto3" json:"fragment,omitempty"`
+	Fragment bool `protobuf:"varint,8,opt,name=fragment,proto3" json:"fragment,omitempty"`
+	// ranges are more keys or ranges of keys to watch in addition to the one given by
+	// key and range_end. The events on all of them are sent under the same watch ID,
+	// from the same start revision, in a single stream ordered by revision.
+	Ranges []*KeyRange `protobuf:"bytes,9,rep,name=ranges,proto3" json:"ranges,omitempty"`
+	// value_prefix, if set, filters out the put events whose value does not start with
+	// value_prefix.
+	ValuePrefix []byte `protobuf:"bytes,10,opt,name=value_prefix,json=valuePrefix,proto3" json:"value_prefix,omitempty"`
+	// lease, if set, filters out the events on keys not attached to the lease. A delete
+	// event is kept if the key was attached to the lease before being deleted, which is
+	// read from the backend for each delete event, or if that is compacted.
+	Lease int64 `protobuf:"varint,11,opt,name=lease,proto3" json:"lease,omitempty"`
+	// coalesce is set so that a watcher falling behind the key-value store only receives
+	// the latest event of each key among the events it catches up on. The responses
+	// leaving out events are marked coalesced.
+	Coalesce bool `protobuf:"varint,12,opt,name=coalesce,proto3" json:"coalesce,omitempty"`
+	// subscription is the name of the durable subscription the watcher resumes. If
+	// start_revision is not set, the watch starts right after the revision last
+	// acknowledged for the subscription, or at the current revision if nothing was
+	// acknowledged yet. Progress is acknowledged with the Subscription RPC.
+	Subscription  string `protobuf:"bytes,13,opt,name=subscription,proto3" json:"subscription,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *WatchCreateRequest) Reset() {
 	*x = WatchCreateRequest{}
-	mi := &file_rpc_proto_msgTypes[21]
+	mi := &file_rpc_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2144,7 +2870,7 @@ func (x *WatchCreateRequest) String() string {
 func (*WatchCreateRequest) ProtoMessage() {}
 
 func (x *WatchCreateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[21]
+	mi := &file_rpc_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2157,7 +2883,7 @@ func (x *WatchCreateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WatchCreateRequest.ProtoReflect.Descriptor instead.
 func (*WatchCreateRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{21}
+	return file_rpc_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *WatchCreateRequest) GetKey() []byte {
@@ -2216,6 +2942,96 @@ func (x *WatchCreateRequest) GetFragment() bool {
 	return false
 }
 
+func (x *WatchCreateRequest) GetRanges() []*KeyRange {
+	if x != nil {
+		return x.Ranges
+	}
+	return nil
+}
+
+func (x *WatchCreateRequest) GetValuePrefix() []byte {
+	if x != nil {
+		return x.ValuePrefix
+	}
+	return nil
+}
+
+func (x *WatchCreateRequest) GetLease() int64 {
+	if x != nil {
+		return x.Lease
+	}
+	return 0
+}
+
+func (x *WatchCreateRequest) GetCoalesce() bool {
+	if x != nil {
+		return x.Coalesce
+	}
+	return false
+}
+
+func (x *WatchCreateRequest) GetSubscription() string {
+	if x != nil {
+		return x.Subscription
+	}
+	return ""
+}
+
+// KeyRange is a key, or the range of keys [key, range_end) if range_end is given. Like for
+// a watch create request, a range_end of '\0' is the range of all keys greater than or
+// equal to the key.
+type KeyRange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           []byte                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	RangeEnd      []byte                 `protobuf:"bytes,2,opt,name=range_end,json=rangeEnd,proto3" json:"range_end,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KeyRange) Reset() {
+	*x = KeyRange{}
+	mi := &file_rpc_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KeyRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeyRange) ProtoMessage() {}
+
+func (x *KeyRange) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KeyRange.ProtoReflect.Descriptor instead.
+func (*KeyRange) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *KeyRange) GetKey() []byte {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *KeyRange) GetRangeEnd() []byte {
+	if x != nil {
+		return x.RangeEnd
+	}
+	return nil
+}
+
 type WatchCancelRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// watch_id is the watcher id to cancel so that no more events are transmitted.
@@ -2226,7 +3042,7 @@ type WatchCancelRequest struct {
 
 func (x *WatchCancelRequest) Reset() {
 	*x = WatchCancelRequest{}
-	mi := &file_rpc_proto_msgTypes[22]
+	mi := &file_rpc_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2238,7 +3054,7 @@ func (x *WatchCancelRequest) String() string {
 func (*WatchCancelRequest) ProtoMessage() {}
 
 func (x *WatchCancelRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[22]
+	mi := &file_rpc_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2251,7 +3067,7 @@ func (x *WatchCancelRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WatchCancelRequest.ProtoReflect.Descriptor instead.
 func (*WatchCancelRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{22}
+	return file_rpc_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *WatchCancelRequest) GetWatchId() int64 {
@@ -2264,14 +3080,19 @@ func (x *WatchCancelRequest) GetWatchId() int64 {
 // Requests the a watch stream progress status be sent in the watch response stream as soon as
 // possible.
 type WatchProgressRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// linearizable makes the member wait until it applied all entries committed in the
+	// cluster when the request was received before sending the progress notification,
+	// and until all watchers of the stream are synced. The revision of the notification
+	// is then at least the revision of any write completed before the request.
+	Linearizable  bool `protobuf:"varint,1,opt,name=linearizable,proto3" json:"linearizable,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *WatchProgressRequest) Reset() {
 	*x = WatchProgressRequest{}
-	mi := &file_rpc_proto_msgTypes[23]
+	mi := &file_rpc_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2283,7 +3104,7 @@ func (x *WatchProgressRequest) String() string {
 func (*WatchProgressRequest) ProtoMessage() {}
 
 func (x *WatchProgressRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[23]
+	mi := &file_rpc_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2296,7 +3117,14 @@ func (x *WatchProgressRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WatchProgressRequest.ProtoReflect.Descriptor instead.
 func (*WatchProgressRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{23}
+	return file_rpc_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *WatchProgressRequest) GetLinearizable() bool {
+	if x != nil {
+		return x.Linearizable
+	}
+	return false
 }
 
 type WatchResponse struct {
@@ -2325,15 +3153,23 @@ type WatchResponse struct {
 	// cancel_reason indicates the reason for canceling the watcher.
 	CancelReason string `protobuf:"bytes,6,opt,name=cancel_reason,json=cancelReason,proto3" json:"cancel_reason,omitempty"`
 	// framgment is true if large watch response was split over multiple responses.
-	Fragment      bool            `protobuf:"varint,7,opt,name=fragment,proto3" json:"fragment,omitempty"`
-	Events        []*mvccpb.Event `protobuf:"bytes,11,rep,name=events,proto3" json:"events,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	Fragment bool `protobuf:"varint,7,opt,name=fragment,proto3" json:"fragment,omitempty"`
+	// coalesced is true if the events of the response are the latest events of their keys
+	// and earlier events of the same keys were left out. See coalesce of the watch create
+	// request.
+	Coalesced bool `protobuf:"varint,8,opt,name=coalesced,proto3" json:"coalesced,omitempty"`
+	// partial_revision is true if the events of the last revision in the response
+	// continue in the next response of the watcher. Events of one revision are only
+	// split over several responses if they do not fit into a single fragment.
+	PartialRevision bool            `protobuf:"varint,9,opt,name=partial_revision,json=partialRevision,proto3" json:"partial_revision,omitempty"`
+	Events          []*mvccpb.Event `protobuf:"bytes,11,rep,name=events,proto3" json:"events,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *WatchResponse) Reset() {
 	*x = WatchResponse{}
-	mi := &file_rpc_proto_msgTypes[24]
+	mi := &file_rpc_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2345,7 +3181,7 @@ func (x *WatchResponse) String() string {
 func (*WatchResponse) ProtoMessage() {}
 
 func (x *WatchResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[24]
+	mi := &file_rpc_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2358,7 +3194,7 @@ func (x *WatchResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WatchResponse.ProtoReflect.Descriptor instead.
 func (*WatchResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{24}
+	return file_rpc_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *WatchResponse) GetHeader() *ResponseHeader {
@@ -2410,6 +3246,20 @@ func (x *WatchResponse) GetFragment() bool {
 	return false
 }
 
+func (x *WatchResponse) GetCoalesced() bool {
+	if x != nil {
+		return x.Coalesced
+	}
+	return false
+}
+
+func (x *WatchResponse) GetPartialRevision() bool {
+	if x != nil {
+		return x.PartialRevision
+	}
+	return false
+}
+
 func (x *WatchResponse) GetEvents() []*mvccpb.Event {
 	if x != nil {
 		return x.Events
@@ -2417,31 +3267,42 @@ func (x *WatchResponse) GetEvents() []*mvccpb.Event {
 	return nil
 }
 
-type LeaseGrantRequest struct {
+type SubscriptionRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// TTL is the advisory time-to-live in seconds. Expired lease will return -1.
-	TTL int64 `protobuf:"varint,1,opt,name=TTL,proto3" json:"TTL,omitempty"`
-	// ID is the requested ID for the lease. If ID is set to 0, the lessor chooses an ID.
-	ID            int64 `protobuf:"varint,2,opt,name=ID,proto3" json:"ID,omitempty"`
+	// action is the kind of subscription request to issue. The action may LIST the
+	// subscriptions, ACK the progress of a subscription, which creates it if it does
+	// not exist, or DELETE a subscription.
+	Action SubscriptionRequest_SubscriptionAction `protobuf:"varint,1,opt,name=action,proto3,enum=etcdserverpb.SubscriptionRequest_SubscriptionAction" json:"action,omitempty"`
+	// name is the name of the subscription to acknowledge or delete. If name is set on
+	// LIST, only the subscription with that name is listed.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// revision is the revision acknowledged on ACK, meaning all events up to and including
+	// it were processed. If revision is 0, the current revision is acknowledged. A
+	// subscription never moves back to an earlier revision.
+	Revision int64 `protobuf:"varint,3,opt,name=revision,proto3" json:"revision,omitempty"`
+	// max_lag is the number of revisions the subscription may lag behind the current
+	// revision while it holds compaction back. If max_lag is 0 on ACK, the server
+	// default is used.
+	MaxLag        int64 `protobuf:"varint,4,opt,name=max_lag,json=maxLag,proto3" json:"max_lag,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LeaseGrantRequest) Reset() {
-	*x = LeaseGrantRequest{}
-	mi := &file_rpc_proto_msgTypes[25]
+func (x *SubscriptionRequest) Reset() {
+	*x = SubscriptionRequest{}
+	mi := &file_rpc_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LeaseGrantRequest) String() string {
+func (x *SubscriptionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LeaseGrantRequest) ProtoMessage() {}
+func (*SubscriptionRequest) ProtoMessage() {}
 
-func (x *LeaseGrantRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[25]
+func (x *SubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2452,52 +3313,68 @@ func (x *LeaseGrantRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LeaseGrantRequest.ProtoReflect.Descriptor instead.
-func (*LeaseGrantRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use SubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*SubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *LeaseGrantRequest) GetTTL() int64 {
+func (x *SubscriptionRequest) GetAction() SubscriptionRequest_SubscriptionAction {
 	if x != nil {
-		return x.TTL
+		return x.Action
+	}
+	return SubscriptionRequest_LIST
+}
+
+func (x *SubscriptionRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SubscriptionRequest) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
 	}
 	return 0
 }
 
-func (x *LeaseGrantRequest) GetID() int64 {
+func (x *SubscriptionRequest) GetMaxLag() int64 {
 	if x != nil {
-		return x.ID
+		return x.MaxLag
 	}
 	return 0
 }
 
-type LeaseGrantResponse struct {
-	state  protoimpl.MessageState `protogen:"open.v1"`
-	Header *ResponseHeader        `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
-	// ID is the lease ID for the granted lease.
-	ID int64 `protobuf:"varint,2,opt,name=ID,proto3" json:"ID,omitempty"`
-	// TTL is the server chosen lease time-to-live in seconds.
-	TTL           int64  `protobuf:"varint,3,opt,name=TTL,proto3" json:"TTL,omitempty"`
-	Error         string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+type Subscription struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name is the name of the subscription.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// revision is the last revision acknowledged for the subscription.
+	Revision int64 `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	// max_lag is the number of revisions the subscription may lag behind the current
+	// revision while it holds compaction back. A subscription with a max_lag of 0 never
+	// holds compaction back.
+	MaxLag        int64 `protobuf:"varint,3,opt,name=max_lag,json=maxLag,proto3" json:"max_lag,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LeaseGrantResponse) Reset() {
-	*x = LeaseGrantResponse{}
-	mi := &file_rpc_proto_msgTypes[26]
+func (x *Subscription) Reset() {
+	*x = Subscription{}
+	mi := &file_rpc_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LeaseGrantResponse) String() string {
+func (x *Subscription) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LeaseGrantResponse) ProtoMessage() {}
+func (*Subscription) ProtoMessage() {}
 
-func (x *LeaseGrantResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[26]
+func (x *Subscription) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2508,62 +3385,56 @@ func (x *LeaseGrantResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LeaseGrantResponse.ProtoReflect.Descriptor instead.
-func (*LeaseGrantResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use Subscription.ProtoReflect.Descriptor instead.
+func (*Subscription) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *LeaseGrantResponse) GetHeader() *ResponseHeader {
+func (x *Subscription) GetName() string {
 	if x != nil {
-		return x.Header
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *LeaseGrantResponse) GetID() int64 {
+func (x *Subscription) GetRevision() int64 {
 	if x != nil {
-		return x.ID
+		return x.Revision
 	}
 	return 0
 }
 
-func (x *LeaseGrantResponse) GetTTL() int64 {
+func (x *Subscription) GetMaxLag() int64 {
 	if x != nil {
-		return x.TTL
+		return x.MaxLag
 	}
 	return 0
 }
 
-func (x *LeaseGrantResponse) GetError() string {
-	if x != nil {
-		return x.Error
-	}
-	return ""
-}
-
-type LeaseRevokeRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// ID is the lease ID to revoke. When the ID is revoked, all associated keys will be deleted.
-	ID            int64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+type SubscriptionResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Header *ResponseHeader        `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	// subscriptions is the list of subscriptions acknowledged, deleted or listed by the request.
+	Subscriptions []*Subscription `protobuf:"bytes,2,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LeaseRevokeRequest) Reset() {
-	*x = LeaseRevokeRequest{}
-	mi := &file_rpc_proto_msgTypes[27]
+func (x *SubscriptionResponse) Reset() {
+	*x = SubscriptionResponse{}
+	mi := &file_rpc_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LeaseRevokeRequest) String() string {
+func (x *SubscriptionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LeaseRevokeRequest) ProtoMessage() {}
+func (*SubscriptionResponse) ProtoMessage() {}
 
-func (x *LeaseRevokeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[27]
+func (x *SubscriptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2574,40 +3445,55 @@ func (x *LeaseRevokeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LeaseRevokeRequest.ProtoReflect.Descriptor instead.
-func (*LeaseRevokeRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use SubscriptionResponse.ProtoReflect.Descriptor instead.
+func (*SubscriptionResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *LeaseRevokeRequest) GetID() int64 {
+func (x *SubscriptionResponse) GetHeader() *ResponseHeader {
 	if x != nil {
-		return x.ID
+		return x.Header
 	}
-	return 0
+	return nil
 }
 
-type LeaseRevokeResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Header        *ResponseHeader        `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+func (x *SubscriptionResponse) GetSubscriptions() []*Subscription {
+	if x != nil {
+		return x.Subscriptions
+	}
+	return nil
+}
+
+type LeaseGrantRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// TTL is the advisory time-to-live in seconds. Expired lease will return -1.
+	TTL int64 `protobuf:"varint,1,opt,name=TTL,proto3" json:"TTL,omitempty"`
+	// ID is the requested ID for the lease. If ID is set to 0, the lessor chooses an ID.
+	ID int64 `protobuf:"varint,2,opt,name=ID,proto3" json:"ID,omitempty"`
+	// parent is the ID of the lease to grant the lease as a child of. A child lease is
+	// revoked along with its parent, whether the parent is revoked or expires. If parent
+	// is set to 0, the lease has no parent. With auth enabled, granting a child lease
+	// requires write permission on the keys attached to the parent.
+	Parent        int64 `protobuf:"varint,3,opt,name=parent,proto3" json:"parent,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LeaseRevokeResponse) Reset() {
-	*x = LeaseRevokeResponse{}
-	mi := &file_rpc_proto_msgTypes[28]
+func (x *LeaseGrantRequest) Reset() {
+	*x = LeaseGrantRequest{}
+	mi := &file_rpc_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LeaseRevokeResponse) String() string {
+func (x *LeaseGrantRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LeaseRevokeResponse) ProtoMessage() {}
+func (*LeaseGrantRequest) ProtoMessage() {}
 
-func (x *LeaseRevokeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[28]
+func (x *LeaseGrantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2618,43 +3504,59 @@ func (x *LeaseRevokeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LeaseRevokeResponse.ProtoReflect.Descriptor instead.
-func (*LeaseRevokeResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use LeaseGrantRequest.ProtoReflect.Descriptor instead.
+func (*LeaseGrantRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *LeaseRevokeResponse) GetHeader() *ResponseHeader {
+func (x *LeaseGrantRequest) GetTTL() int64 {
 	if x != nil {
-		return x.Header
+		return x.TTL
 	}
-	return nil
+	return 0
 }
 
-type LeaseCheckpoint struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// ID is the lease ID to checkpoint.
-	ID int64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	// Remaining_TTL is the remaining time until expiry of the lease.
-	Remaining_TTL int64 `protobuf:"varint,2,opt,name=remaining_TTL,json=remainingTTL,proto3" json:"remaining_TTL,omitempty"`
+func (x *LeaseGrantRequest) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *LeaseGrantRequest) GetParent() int64 {
+	if x != nil {
+		return x.Parent
+	}
+	return 0
+}
+
+type LeaseGrantResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Header *ResponseHeader        `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	// ID is the lease ID for the granted lease.
+	ID int64 `protobuf:"varint,2,opt,name=ID,proto3" json:"ID,omitempty"`
+	// TTL is the server chosen lease time-to-live in seconds.
+	TTL           int64  `protobuf:"varint,3,opt,name=TTL,proto3" json:"TTL,omitempty"`
+	Error         string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LeaseCheckpoint) Reset() {
-	*x = LeaseCheckpoint{}
-	mi := &file_rpc_proto_msgTypes[29]
+func (x *LeaseGrantResponse) Reset() {
+	*x = LeaseGrantResponse{}
+	mi := &file_rpc_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LeaseCheckpoint) String() string {
+func (x *LeaseGrantResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LeaseCheckpoint) ProtoMessage() {}
+func (*LeaseGrantResponse) ProtoMessage() {}
 
-func (x *LeaseCheckpoint) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[29]
+func (x *LeaseGrantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2665,47 +3567,62 @@ func (x *LeaseCheckpoint) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LeaseCheckpoint.ProtoReflect.Descriptor instead.
-func (*LeaseCheckpoint) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use LeaseGrantResponse.ProtoReflect.Descriptor instead.
+func (*LeaseGrantResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *LeaseCheckpoint) GetID() int64 {
+func (x *LeaseGrantResponse) GetHeader() *ResponseHeader {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *LeaseGrantResponse) GetID() int64 {
 	if x != nil {
 		return x.ID
 	}
 	return 0
 }
 
-func (x *LeaseCheckpoint) GetRemaining_TTL() int64 {
+func (x *LeaseGrantResponse) GetTTL() int64 {
 	if x != nil {
-		return x.Remaining_TTL
+		return x.TTL
 	}
 	return 0
 }
 
-type LeaseCheckpointRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Checkpoints   []*LeaseCheckpoint     `protobuf:"bytes,1,rep,name=checkpoints,proto3" json:"checkpoints,omitempty"`
+func (x *LeaseGrantResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type LeaseRevokeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID is the lease ID to revoke. When the ID is revoked, all associated keys will be deleted.
+	ID            int64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LeaseCheckpointRequest) Reset() {
-	*x = LeaseCheckpointRequest{}
-	mi := &file_rpc_proto_msgTypes[30]
+func (x *LeaseRevokeRequest) Reset() {
+	*x = LeaseRevokeRequest{}
+	mi := &file_rpc_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LeaseCheckpointRequest) String() string {
+func (x *LeaseRevokeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LeaseCheckpointRequest) ProtoMessage() {}
+func (*LeaseRevokeRequest) ProtoMessage() {}
 
-func (x *LeaseCheckpointRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[30]
+func (x *LeaseRevokeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2716,40 +3633,40 @@ func (x *LeaseCheckpointRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LeaseCheckpointRequest.ProtoReflect.Descriptor instead.
-func (*LeaseCheckpointRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use LeaseRevokeRequest.ProtoReflect.Descriptor instead.
+func (*LeaseRevokeRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *LeaseCheckpointRequest) GetCheckpoints() []*LeaseCheckpoint {
+func (x *LeaseRevokeRequest) GetID() int64 {
 	if x != nil {
-		return x.Checkpoints
+		return x.ID
 	}
-	return nil
+	return 0
 }
 
-type LeaseCheckpointResponse struct {
+type LeaseRevokeResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Header        *ResponseHeader        `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LeaseCheckpointResponse) Reset() {
-	*x = LeaseCheckpointResponse{}
-	mi := &file_rpc_proto_msgTypes[31]
+func (x *LeaseRevokeResponse) Reset() {
+	*x = LeaseRevokeResponse{}
+	mi := &file_rpc_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LeaseCheckpointResponse) String() string {
+func (x *LeaseRevokeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LeaseCheckpointResponse) ProtoMessage() {}
+func (*LeaseRevokeResponse) ProtoMessage() {}
 
-func (x *LeaseCheckpointResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[31]
+func (x *LeaseRevokeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2760,41 +3677,41 @@ func (x *LeaseCheckpointResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LeaseCheckpointResponse.ProtoReflect.Descriptor instead.
-func (*LeaseCheckpointResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use LeaseRevokeResponse.ProtoReflect.Descriptor instead.
+func (*LeaseRevokeResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *LeaseCheckpointResponse) GetHeader() *ResponseHeader {
+func (x *LeaseRevokeResponse) GetHeader() *ResponseHeader {
 	if x != nil {
 		return x.Header
 	}
 	return nil
 }
 
-type LeaseKeepAliveRequest struct {
+type LeaseRevokeBatchRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// ID is the lease ID for the lease to keep alive.
-	ID            int64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	// IDs are the lease IDs to revoke.
+	IDs           []int64 `protobuf:"varint,1,rep,packed,name=IDs,proto3" json:"IDs,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LeaseKeepAliveRequest) Reset() {
-	*x = LeaseKeepAliveRequest{}
-	mi := &file_rpc_proto_msgTypes[32]
+func (x *LeaseRevokeBatchRequest) Reset() {
+	*x = LeaseRevokeBatchRequest{}
+	mi := &file_rpc_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LeaseKeepAliveRequest) String() string {
+func (x *LeaseRevokeBatchRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LeaseKeepAliveRequest) ProtoMessage() {}
+func (*LeaseRevokeBatchRequest) ProtoMessage() {}
 
-func (x *LeaseKeepAliveRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[32]
+func (x *LeaseRevokeBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2805,44 +3722,43 @@ func (x *LeaseKeepAliveRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LeaseKeepAliveRequest.ProtoReflect.Descriptor instead.
-func (*LeaseKeepAliveRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use LeaseRevokeBatchRequest.ProtoReflect.Descriptor instead.
+func (*LeaseRevokeBatchRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *LeaseKeepAliveRequest) GetID() int64 {
+func (x *LeaseRevokeBatchRequest) GetIDs() []int64 {
 	if x != nil {
-		return x.ID
+		return x.IDs
 	}
-	return 0
+	return nil
 }
 
-type LeaseKeepAliveResponse struct {
+type LeaseRevokeBatchResponse struct {
 	state  protoimpl.MessageState `protogen:"open.v1"`
 	Header *ResponseHeader        `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
-	// ID is the lease ID from the keep alive request.
-	ID int64 `protobuf:"varint,2,opt,name=ID,proto3" json:"ID,omitempty"`
-	// TTL is the new time-to-live for the lease.
-	TTL           int64 `protobuf:"varint,3,opt,name=TTL,proto3" json:"TTL,omitempty"`
+	// revoked are the IDs of the revoked leases. Requested IDs missing from it did not
+	// exist.
+	Revoked       []int64 `protobuf:"varint,2,rep,packed,name=revoked,proto3" json:"revoked,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LeaseKeepAliveResponse) Reset() {
-	*x = LeaseKeepAliveResponse{}
-	mi := &file_rpc_proto_msgTypes[33]
+func (x *LeaseRevokeBatchResponse) Reset() {
+	*x = LeaseRevokeBatchResponse{}
+	mi := &file_rpc_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LeaseKeepAliveResponse) String() string {
+func (x *LeaseRevokeBatchResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LeaseKeepAliveResponse) ProtoMessage() {}
+func (*LeaseRevokeBatchResponse) ProtoMessage() {}
 
-func (x *LeaseKeepAliveResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[33]
+func (x *LeaseRevokeBatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2853,57 +3769,50 @@ func (x *LeaseKeepAliveResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LeaseKeepAliveResponse.ProtoReflect.Descriptor instead.
-func (*LeaseKeepAliveResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use LeaseRevokeBatchResponse.ProtoReflect.Descriptor instead.
+func (*LeaseRevokeBatchResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *LeaseKeepAliveResponse) GetHeader() *ResponseHeader {
+func (x *LeaseRevokeBatchResponse) GetHeader() *ResponseHeader {
 	if x != nil {
 		return x.Header
 	}
 	return nil
 }
 
-func (x *LeaseKeepAliveResponse) GetID() int64 {
+func (x *LeaseRevokeBatchResponse) GetRevoked() []int64 {
 	if x != nil {
-		return x.ID
+		return x.Revoked
 	}
-	return 0
+	return nil
 }
 
-func (x *LeaseKeepAliveResponse) GetTTL() int64 {
-	if x != nil {
-		return x.TTL
-	}
-	return 0
-}
-
-type LeaseTimeToLiveRequest struct {
+type LeaseUpdateTTLRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// ID is the lease ID for the lease.
+	// ID is the lease ID of the lease to update.
 	ID int64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	// keys is true to query all the keys attached to this lease.
-	Keys          bool `protobuf:"varint,2,opt,name=keys,proto3" json:"keys,omitempty"`
+	// TTL is the new advisory time-to-live in seconds of the lease.
+	TTL           int64 `protobuf:"varint,2,opt,name=TTL,proto3" json:"TTL,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LeaseTimeToLiveRequest) Reset() {
-	*x = LeaseTimeToLiveRequest{}
-	mi := &file_rpc_proto_msgTypes[34]
+func (x *LeaseUpdateTTLRequest) Reset() {
+	*x = LeaseUpdateTTLRequest{}
+	mi := &file_rpc_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LeaseTimeToLiveRequest) String() string {
+func (x *LeaseUpdateTTLRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LeaseTimeToLiveRequest) ProtoMessage() {}
+func (*LeaseUpdateTTLRequest) ProtoMessage() {}
 
-func (x *LeaseTimeToLiveRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[34]
+func (x *LeaseUpdateTTLRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2914,55 +3823,51 @@ func (x *LeaseTimeToLiveRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LeaseTimeToLiveRequest.ProtoReflect.Descriptor instead.
-func (*LeaseTimeToLiveRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use LeaseUpdateTTLRequest.ProtoReflect.Descriptor instead.
+func (*LeaseUpdateTTLRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *LeaseTimeToLiveRequest) GetID() int64 {
+func (x *LeaseUpdateTTLRequest) GetID() int64 {
 	if x != nil {
 		return x.ID
 	}
 	return 0
 }
 
-func (x *LeaseTimeToLiveRequest) GetKeys() bool {
+func (x *LeaseUpdateTTLRequest) GetTTL() int64 {
 	if x != nil {
-		return x.Keys
+		return x.TTL
 	}
-	return false
+	return 0
 }
 
-type LeaseTimeToLiveResponse struct {
+type LeaseUpdateTTLResponse struct {
 	state  protoimpl.MessageState `protogen:"open.v1"`
 	Header *ResponseHeader        `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
-	// ID is the lease ID from the keep alive request.
+	// ID is the lease ID of the updated lease.
 	ID int64 `protobuf:"varint,2,opt,name=ID,proto3" json:"ID,omitempty"`
-	// TTL is the remaining TTL in seconds for the lease; the lease will expire in under TTL+1 seconds.
-	TTL int64 `protobuf:"varint,3,opt,name=TTL,proto3" json:"TTL,omitempty"`
-	// GrantedTTL is the initial granted time in seconds upon lease creation/renewal.
-	GrantedTTL int64 `protobuf:"varint,4,opt,name=grantedTTL,proto3" json:"grantedTTL,omitempty"`
-	// Keys is the list of keys attached to this lease.
-	Keys          [][]byte `protobuf:"bytes,5,rep,name=keys,proto3" json:"keys,omitempty"`
+	// TTL is the server chosen lease time-to-live in seconds.
+	TTL           int64 `protobuf:"varint,3,opt,name=TTL,proto3" json:"TTL,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LeaseTimeToLiveResponse) Reset() {
-	*x = LeaseTimeToLiveResponse{}
-	mi := &file_rpc_proto_msgTypes[35]
+func (x *LeaseUpdateTTLResponse) Reset() {
+	*x = LeaseUpdateTTLResponse{}
+	mi := &file_rpc_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LeaseTimeToLiveResponse) String() string {
+func (x *LeaseUpdateTTLResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LeaseTimeToLiveResponse) ProtoMessage() {}
+func (*LeaseUpdateTTLResponse) ProtoMessage() {}
 
-func (x *LeaseTimeToLiveResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[35]
+func (x *LeaseUpdateTTLResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2973,67 +3878,57 @@ func (x *LeaseTimeToLiveResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LeaseTimeToLiveResponse.ProtoReflect.Descriptor instead.
-func (*LeaseTimeToLiveResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use LeaseUpdateTTLResponse.ProtoReflect.Descriptor instead.
+func (*LeaseUpdateTTLResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *LeaseTimeToLiveResponse) GetHeader() *ResponseHeader {
+func (x *LeaseUpdateTTLResponse) GetHeader() *ResponseHeader {
 	if x != nil {
 		return x.Header
 	}
 	return nil
 }
 
-func (x *LeaseTimeToLiveResponse) GetID() int64 {
+func (x *LeaseUpdateTTLResponse) GetID() int64 {
 	if x != nil {
 		return x.ID
 	}
 	return 0
 }
 
-func (x *LeaseTimeToLiveResponse) GetTTL() int64 {
+func (x *LeaseUpdateTTLResponse) GetTTL() int64 {
 	if x != nil {
 		return x.TTL
 	}
 	return 0
 }
 
-func (x *LeaseTimeToLiveResponse) GetGrantedTTL() int64 {
-	if x != nil {
-		return x.GrantedTTL
-	}
-	return 0
-}
-
-func (x *LeaseTimeToLiveResponse) GetKeys() [][]byte {
-	if x != nil {
-		return x.Keys
-	}
-	return nil
-}
-
-type LeaseLeasesRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+type LeaseCheckpoint struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID is the lease ID to checkpoint.
+	ID int64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	// Remaining_TTL is the remaining time until expiry of the lease.
+	Remaining_TTL int64 `protobuf:"varint,2,opt,name=remaining_TTL,json=remainingTTL,proto3" json:"remaining_TTL,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LeaseLeasesRequest) Reset() {
-	*x = LeaseLeasesRequest{}
-	mi := &file_rpc_proto_msgTypes[36]
+func (x *LeaseCheckpoint) Reset() {
+	*x = LeaseCheckpoint{}
+	mi := &file_rpc_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LeaseLeasesRequest) String() string {
+func (x *LeaseCheckpoint) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LeaseLeasesRequest) ProtoMessage() {}
+func (*LeaseCheckpoint) ProtoMessage() {}
 
-func (x *LeaseLeasesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[36]
+func (x *LeaseCheckpoint) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3044,33 +3939,47 @@ func (x *LeaseLeasesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LeaseLeasesRequest.ProtoReflect.Descriptor instead.
-func (*LeaseLeasesRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use LeaseCheckpoint.ProtoReflect.Descriptor instead.
+func (*LeaseCheckpoint) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{40}
 }
 
-type LeaseStatus struct {
+func (x *LeaseCheckpoint) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *LeaseCheckpoint) GetRemaining_TTL() int64 {
+	if x != nil {
+		return x.Remaining_TTL
+	}
+	return 0
+}
+
+type LeaseCheckpointRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ID            int64                  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"` // TODO: int64 TTL = 2;
+	Checkpoints   []*LeaseCheckpoint     `protobuf:"bytes,1,rep,name=checkpoints,proto3" json:"checkpoints,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LeaseStatus) Reset() {
-	*x = LeaseStatus{}
-	mi := &file_rpc_proto_msgTypes[37]
+func (x *LeaseCheckpointRequest) Reset() {
+	*x = LeaseCheckpointRequest{}
+	mi := &file_rpc_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LeaseStatus) String() string {
+func (x *LeaseCheckpointRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LeaseStatus) ProtoMessage() {}
+func (*LeaseCheckpointRequest) ProtoMessage() {}
 
-func (x *LeaseStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[37]
+func (x *LeaseCheckpointRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3081,41 +3990,40 @@ func (x *LeaseStatus) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LeaseStatus.ProtoReflect.Descriptor instead.
-func (*LeaseStatus) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use LeaseCheckpointRequest.ProtoReflect.Descriptor instead.
+func (*LeaseCheckpointRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *LeaseStatus) GetID() int64 {
+func (x *LeaseCheckpointRequest) GetCheckpoints() []*LeaseCheckpoint {
 	if x != nil {
-		return x.ID
+		return x.Checkpoints
 	}
-	return 0
+	return nil
 }
 
-type LeaseLeasesResponse struct {
+type LeaseCheckpointResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Header        *ResponseHeader        `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
-	Leases        []*LeaseStatus         `protobuf:"bytes,2,rep,name=leases,proto3" json:"leases,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LeaseLeasesResponse) Reset() {
-	*x = LeaseLeasesResponse{}
-	mi := &file_rpc_proto_msgTypes[38]
+func (x *LeaseCheckpointResponse) Reset() {
+	*x = LeaseCheckpointResponse{}
+	mi := &file_rpc_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LeaseLeasesResponse) String() string {
+func (x *LeaseCheckpointResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LeaseLeasesResponse) ProtoMessage() {}
+func (*LeaseCheckpointResponse) ProtoMessage() {}
 
-func (x *LeaseLeasesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[38]
+func (x *LeaseCheckpointResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3126,56 +4034,41 @@ func (x *LeaseLeasesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LeaseLeasesResponse.ProtoReflect.Descriptor instead.
-func (*LeaseLeasesResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use LeaseCheckpointResponse.ProtoReflect.Descriptor instead.
+func (*LeaseCheckpointResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *LeaseLeasesResponse) GetHeader() *ResponseHeader {
+func (x *LeaseCheckpointResponse) GetHeader() *ResponseHeader {
 	if x != nil {
 		return x.Header
 	}
 	return nil
 }
 
-func (x *LeaseLeasesResponse) GetLeases() []*LeaseStatus {
-	if x != nil {
-		return x.Leases
-	}
-	return nil
-}
-
-type Member struct {
+type LeaseKeepAliveRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// ID is the member ID for this member.
-	ID uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	// name is the human-readable name of the member. If the member is not started, the name will be an empty string.
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	// peerURLs is the list of URLs the member exposes to the cluster for communication.
-	PeerURLs []string `protobuf:"bytes,3,rep,name=peerURLs,proto3" json:"peerURLs,omitempty"`
-	// clientURLs is the list of URLs the member exposes to clients for communication. If the member is not started, clientURLs will be empty.
-	ClientURLs []string `protobuf:"bytes,4,rep,name=clientURLs,proto3" json:"clientURLs,omitempty"`
-	// isLearner indicates if the member is raft learner.
-	IsLearner     bool `protobuf:"varint,5,opt,name=isLearner,proto3" json:"isLearner,omitempty"`
+	// ID is the lease ID for the lease to keep alive.
+	ID            int64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Member) Reset() {
-	*x = Member{}
-	mi := &file_rpc_proto_msgTypes[39]
+func (x *LeaseKeepAliveRequest) Reset() {
+	*x = LeaseKeepAliveRequest{}
+	mi := &file_rpc_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Member) String() string {
+func (x *LeaseKeepAliveRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Member) ProtoMessage() {}
+func (*LeaseKeepAliveRequest) ProtoMessage() {}
 
-func (x *Member) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[39]
+func (x *LeaseKeepAliveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3186,71 +4079,111 @@ func (x *Member) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Member.ProtoReflect.Descriptor instead.
-func (*Member) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{39}
+// Deprecated: Use LeaseKeepAliveRequest.ProtoReflect.Descriptor instead.
+func (*LeaseKeepAliveRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *Member) GetID() uint64 {
+func (x *LeaseKeepAliveRequest) GetID() int64 {
 	if x != nil {
 		return x.ID
 	}
 	return 0
 }
 
-func (x *Member) GetName() string {
+type LeaseKeepAliveResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Header *ResponseHeader        `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	// ID is the lease ID from the keep alive request.
+	ID int64 `protobuf:"varint,2,opt,name=ID,proto3" json:"ID,omitempty"`
+	// TTL is the new time-to-live for the lease.
+	TTL           int64 `protobuf:"varint,3,opt,name=TTL,proto3" json:"TTL,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaseKeepAliveResponse) Reset() {
+	*x = LeaseKeepAliveResponse{}
+	mi := &file_rpc_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaseKeepAliveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaseKeepAliveResponse) ProtoMessage() {}
+
+func (x *LeaseKeepAliveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[44]
 	if x != nil {
-		return x.Name
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Member) GetPeerURLs() []string {
+// Deprecated: Use LeaseKeepAliveResponse.ProtoReflect.Descriptor instead.
+func (*LeaseKeepAliveResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *LeaseKeepAliveResponse) GetHeader() *ResponseHeader {
 	if x != nil {
-		return x.PeerURLs
+		return x.Header
 	}
 	return nil
 }
 
-func (x *Member) GetClientURLs() []string {
+func (x *LeaseKeepAliveResponse) GetID() int64 {
 	if x != nil {
-		return x.ClientURLs
+		return x.ID
 	}
-	return nil
+	return 0
 }
 
-func (x *Member) GetIsLearner() bool {
+func (x *LeaseKeepAliveResponse) GetTTL() int64 {
 	if x != nil {
-		return x.IsLearner
+		return x.TTL
 	}
-	return false
+	return 0
 }
 
-type MemberAddRequest struct {
+type LeaseTimeToLiveRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// peerURLs is the list of URLs the added member will use to communicate with the cluster.
-	PeerURLs []string `protobuf:"bytes,1,rep,name=peerURLs,proto3" json:"peerURLs,omitempty"`
-	// isLearner indicates if the added member is raft learner.
-	IsLearner     bool `protobuf:"varint,2,opt,name=isLearner,proto3" json:"isLearner,omitempty"`
+	// ID is the lease ID for the lease.
+	ID int64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	// keys is true to query all the keys attached to this lease.
+	Keys bool `protobuf:"varint,2,opt,name=keys,proto3" json:"keys,omitempty"`
+	// keys_limit is the maximum number of attached keys returned when keys is true. The keys
+	// are returned in ascending order. If keys_limit is not positive, all keys are returned.
+	KeysLimit int64 `protobuf:"varint,3,opt,name=keys_limit,json=keysLimit,proto3" json:"keys_limit,omitempty"`
+	// keys_from is the first attached key to return when keys is true. To fetch the next page,
+	// set it to the last key of the previous page followed by a zero byte.
+	KeysFrom      []byte `protobuf:"bytes,4,opt,name=keys_from,json=keysFrom,proto3" json:"keys_from,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MemberAddRequest) Reset() {
-	*x = MemberAddRequest{}
-	mi := &file_rpc_proto_msgTypes[40]
+func (x *LeaseTimeToLiveRequest) Reset() {
+	*x = LeaseTimeToLiveRequest{}
+	mi := &file_rpc_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MemberAddRequest) String() string {
+func (x *LeaseTimeToLiveRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MemberAddRequest) ProtoMessage() {}
+func (*LeaseTimeToLiveRequest) ProtoMessage() {}
 
-func (x *MemberAddRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[40]
+func (x *LeaseTimeToLiveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3261,51 +4194,71 @@ func (x *MemberAddRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MemberAddRequest.ProtoReflect.Descriptor instead.
-func (*MemberAddRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{40}
+// Deprecated: Use LeaseTimeToLiveRequest.ProtoReflect.Descriptor instead.
+func (*LeaseTimeToLiveRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *MemberAddRequest) GetPeerURLs() []string {
+func (x *LeaseTimeToLiveRequest) GetID() int64 {
 	if x != nil {
-		return x.PeerURLs
+		return x.ID
 	}
-	return nil
+	return 0
 }
 
-func (x *MemberAddRequest) GetIsLearner() bool {
+func (x *LeaseTimeToLiveRequest) GetKeys() bool {
 	if x != nil {
-		return x.IsLearner
+		return x.Keys
 	}
 	return false
 }
 
-type MemberAddResponse struct {
+func (x *LeaseTimeToLiveRequest) GetKeysLimit() int64 {
+	if x != nil {
+		return x.KeysLimit
+	}
+	return 0
+}
+
+func (x *LeaseTimeToLiveRequest) GetKeysFrom() []byte {
+	if x != nil {
+		return x.KeysFrom
+	}
+	return nil
+}
+
+type LeaseTimeToLiveResponse struct {
 	state  protoimpl.MessageState `protogen:"open.v1"`
 	Header *ResponseHeader        `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
-	// member is the member information for the added member.
-	Member *Member `protobuf:"bytes,2,opt,name=member,proto3" json:"member,omitempty"`
-	// members is a list of all members after adding the new member.
-	Members       []*Member `protobuf:"bytes,3,rep,name=members,proto3" json:"members,omitempty"`
+	// ID is the lease ID from the keep alive request.
+	ID int64 `protobuf:"varint,2,opt,name=ID,proto3" json:"ID,omitempty"`
+	// TTL is the remaining TTL in seconds for the lease; the lease will expire in under TTL+1 seconds.
+	TTL int64 `protobuf:"varint,3,opt,name=TTL,proto3" json:"TTL,omitempty"`
+	// GrantedTTL is the initial granted time in seconds upon lease creation/renewal.
+	GrantedTTL int64 `protobuf:"varint,4,opt,name=grantedTTL,proto3" json:"grantedTTL,omitempty"`
+	// Keys is the list of keys attached to this lease.
+	Keys [][]byte `protobuf:"bytes,5,rep,name=keys,proto3" json:"keys,omitempty"`
+	// more_keys indicates if there are more attached keys past the ones returned.
+	MoreKeys      bool `protobuf:"varint,6,opt,name=more_keys,json=moreKeys,proto3" json:"more_keys,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MemberAddResponse) Reset() {
-	*x = MemberAddResponse{}
-	mi := &file_rpc_proto_msgTypes[41]
+func (x *LeaseTimeToLiveResponse) Reset() {
+	*x = LeaseTimeToLiveResponse{}
+	mi := &file_rpc_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MemberAddResponse) String() string {
+func (x *LeaseTimeToLiveResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MemberAddResponse) ProtoMessage() {}
+func (*LeaseTimeToLiveResponse) ProtoMessage() {}
 
-func (x *MemberAddResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[41]
+func (x *LeaseTimeToLiveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3316,55 +4269,154 @@ func (x *MemberAddResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MemberAddResponse.ProtoReflect.Descriptor instead.
-func (*MemberAddResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{41}
+// Deprecated: Use LeaseTimeToLiveResponse.ProtoReflect.Descriptor instead.
+func (*LeaseTimeToLiveResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *MemberAddResponse) GetHeader() *ResponseHeader {
+func (x *LeaseTimeToLiveResponse) GetHeader() *ResponseHeader {
 	if x != nil {
 		return x.Header
 	}
 	return nil
 }
 
-func (x *MemberAddResponse) GetMember() *Member {
+func (x *LeaseTimeToLiveResponse) GetID() int64 {
 	if x != nil {
-		return x.Member
+		return x.ID
+	}
+	return 0
+}
+
+func (x *LeaseTimeToLiveResponse) GetTTL() int64 {
+	if x != nil {
+		return x.TTL
+	}
+	return 0
+}
+
+func (x *LeaseTimeToLiveResponse) GetGrantedTTL() int64 {
+	if x != nil {
+		return x.GrantedTTL
+	}
+	return 0
+}
+
+func (x *LeaseTimeToLiveResponse) GetKeys() [][]byte {
+	if x != nil {
+		return x.Keys
 	}
 	return nil
 }
 
-func (x *MemberAddResponse) GetMembers() []*Member {
+func (x *LeaseTimeToLiveResponse) GetMoreKeys() bool {
 	if x != nil {
-		return x.Members
+		return x.MoreKeys
+	}
+	return false
+}
+
+type LeaseLeasesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// key and range_end select the leases with at least one attached key in the range
+	// [key, range_end), following the conventions of RangeRequest. If key is empty, all
+	// leases are listed.
+	Key      []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	RangeEnd []byte `protobuf:"bytes,2,opt,name=range_end,json=rangeEnd,proto3" json:"range_end,omitempty"`
+	// keys is true to list the keys attached to each lease.
+	Keys bool `protobuf:"varint,3,opt,name=keys,proto3" json:"keys,omitempty"`
+	// keys_limit is the maximum number of attached keys listed per lease when keys is true.
+	// If keys_limit is not positive or above the server maximum of 1000, the server maximum
+	// is used. The remaining keys of a lease are fetched with LeaseTimeToLive.
+	KeysLimit     int64 `protobuf:"varint,4,opt,name=keys_limit,json=keysLimit,proto3" json:"keys_limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaseLeasesRequest) Reset() {
+	*x = LeaseLeasesRequest{}
+	mi := &file_rpc_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaseLeasesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaseLeasesRequest) ProtoMessage() {}
+
+func (x *LeaseLeasesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaseLeasesRequest.ProtoReflect.Descriptor instead.
+func (*LeaseLeasesRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *LeaseLeasesRequest) GetKey() []byte {
+	if x != nil {
+		return x.Key
 	}
 	return nil
 }
 
-type MemberRemoveRequest struct {
+func (x *LeaseLeasesRequest) GetRangeEnd() []byte {
+	if x != nil {
+		return x.RangeEnd
+	}
+	return nil
+}
+
+func (x *LeaseLeasesRequest) GetKeys() bool {
+	if x != nil {
+		return x.Keys
+	}
+	return false
+}
+
+func (x *LeaseLeasesRequest) GetKeysLimit() int64 {
+	if x != nil {
+		return x.KeysLimit
+	}
+	return 0
+}
+
+type LeaseStatus struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// ID is the member ID of the member to remove.
-	ID            uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	ID    int64                  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"` // TODO: int64 TTL = 2;
+	// keys are the keys attached to the lease, in ascending order, if requested.
+	Keys [][]byte `protobuf:"bytes,3,rep,name=keys,proto3" json:"keys,omitempty"`
+	// more_keys indicates if there are more attached keys past the ones listed.
+	MoreKeys      bool `protobuf:"varint,4,opt,name=more_keys,json=moreKeys,proto3" json:"more_keys,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MemberRemoveRequest) Reset() {
-	*x = MemberRemoveRequest{}
-	mi := &file_rpc_proto_msgTypes[42]
+func (x *LeaseStatus) Reset() {
+	*x = LeaseStatus{}
+	mi := &file_rpc_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MemberRemoveRequest) String() string {
+func (x *LeaseStatus) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MemberRemoveRequest) ProtoMessage() {}
+func (*LeaseStatus) ProtoMessage() {}
 
-func (x *MemberRemoveRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[42]
+func (x *LeaseStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3375,42 +4427,55 @@ func (x *MemberRemoveRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MemberRemoveRequest.ProtoReflect.Descriptor instead.
-func (*MemberRemoveRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{42}
+// Deprecated: Use LeaseStatus.ProtoReflect.Descriptor instead.
+func (*LeaseStatus) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *MemberRemoveRequest) GetID() uint64 {
+func (x *LeaseStatus) GetID() int64 {
 	if x != nil {
 		return x.ID
 	}
 	return 0
 }
 
-type MemberRemoveResponse struct {
-	state  protoimpl.MessageState `protogen:"open.v1"`
-	Header *ResponseHeader        `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
-	// members is a list of all members after removing the member.
-	Members       []*Member `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty"`
+func (x *LeaseStatus) GetKeys() [][]byte {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+func (x *LeaseStatus) GetMoreKeys() bool {
+	if x != nil {
+		return x.MoreKeys
+	}
+	return false
+}
+
+type LeaseLeasesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Header        *ResponseHeader        `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Leases        []*LeaseStatus         `protobuf:"bytes,2,rep,name=leases,proto3" json:"leases,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MemberRemoveResponse) Reset() {
-	*x = MemberRemoveResponse{}
-	mi := &file_rpc_proto_msgTypes[43]
+func (x *LeaseLeasesResponse) Reset() {
+	*x = LeaseLeasesResponse{}
+	mi := &file_rpc_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MemberRemoveResponse) String() string {
+func (x *LeaseLeasesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MemberRemoveResponse) ProtoMessage() {}
+func (*LeaseLeasesResponse) ProtoMessage() {}
 
-func (x *MemberRemoveResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[43]
+func (x *LeaseLeasesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3421,50 +4486,1082 @@ func (x *MemberRemoveResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MemberRemoveResponse.ProtoReflect.Descriptor instead.
-func (*MemberRemoveResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{43}
+// Deprecated: Use LeaseLeasesResponse.ProtoReflect.Descriptor instead.
+func (*LeaseLeasesResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *LeaseLeasesResponse) GetHeader() *ResponseHeader {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *LeaseLeasesResponse) GetLeases() []*LeaseStatus {
+	if x != nil {
+		return x.Leases
+	}
+	return nil
+}
+
+type LeaseWatchRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID is the ID of the lease to watch the events of. If ID is set to 0, the events of all
+	// leases are streamed.
+	ID int64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	// keys is true to list the keys deleted along with revoked and expired leases.
+	Keys          bool `protobuf:"varint,2,opt,name=keys,proto3" json:"keys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaseWatchRequest) Reset() {
+	*x = LeaseWatchRequest{}
+	mi := &file_rpc_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaseWatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaseWatchRequest) ProtoMessage() {}
+
+func (x *LeaseWatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaseWatchRequest.ProtoReflect.Descriptor instead.
+func (*LeaseWatchRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *LeaseWatchRequest) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *LeaseWatchRequest) GetKeys() bool {
+	if x != nil {
+		return x.Keys
+	}
+	return false
+}
+
+type LeaseEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// type is the kind of event.
+	Type LeaseEvent_EventType `protobuf:"varint,1,opt,name=type,proto3,enum=etcdserverpb.LeaseEvent_EventType" json:"type,omitempty"`
+	// ID is the ID of the lease.
+	ID int64 `protobuf:"varint,2,opt,name=ID,proto3" json:"ID,omitempty"`
+	// TTL is the granted TTL of the lease in seconds for GRANT events, and the remaining TTL
+	// for CHECKPOINT events.
+	TTL int64 `protobuf:"varint,3,opt,name=TTL,proto3" json:"TTL,omitempty"`
+	// parent is the ID of the parent lease, if the lease is a child lease. A child lease is
+	// revoked or expires along with its parent.
+	Parent int64 `protobuf:"varint,4,opt,name=parent,proto3" json:"parent,omitempty"`
+	// revision is the revision of the key-value store when the event was applied. The keys
+	// attached to a revoked or expired lease are deleted at this revision.
+	Revision int64 `protobuf:"varint,5,opt,name=revision,proto3" json:"revision,omitempty"`
+	// keys are the keys deleted along with a revoked or expired lease, if requested.
+	Keys          [][]byte `protobuf:"bytes,6,rep,name=keys,proto3" json:"keys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaseEvent) Reset() {
+	*x = LeaseEvent{}
+	mi := &file_rpc_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaseEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaseEvent) ProtoMessage() {}
+
+func (x *LeaseEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaseEvent.ProtoReflect.Descriptor instead.
+func (*LeaseEvent) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *LeaseEvent) GetType() LeaseEvent_EventType {
+	if x != nil {
+		return x.Type
+	}
+	return LeaseEvent_GRANT
+}
+
+func (x *LeaseEvent) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *LeaseEvent) GetTTL() int64 {
+	if x != nil {
+		return x.TTL
+	}
+	return 0
+}
+
+func (x *LeaseEvent) GetParent() int64 {
+	if x != nil {
+		return x.Parent
+	}
+	return 0
+}
+
+func (x *LeaseEvent) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *LeaseEvent) GetKeys() [][]byte {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+type LeaseWatchResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Header        *ResponseHeader        `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Events        []*LeaseEvent          `protobuf:"bytes,2,rep,name=events,proto3" json:"events,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaseWatchResponse) Reset() {
+	*x = LeaseWatchResponse{}
+	mi := &file_rpc_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaseWatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaseWatchResponse) ProtoMessage() {}
+
+func (x *LeaseWatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaseWatchResponse.ProtoReflect.Descriptor instead.
+func (*LeaseWatchResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *LeaseWatchResponse) GetHeader() *ResponseHeader {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *LeaseWatchResponse) GetEvents() []*LeaseEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type Member struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID is the member ID for this member.
+	ID uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	// name is the human-readable name of the member. If the member is not started, the name will be an empty string.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// peerURLs is the list of URLs the member exposes to the cluster for communication.
+	PeerURLs []string `protobuf:"bytes,3,rep,name=peerURLs,proto3" json:"peerURLs,omitempty"`
+	// clientURLs is the list of URLs the member exposes to clients for communication. If the member is not started, clientURLs will be empty.
+	ClientURLs []string `protobuf:"bytes,4,rep,name=clientURLs,proto3" json:"clientURLs,omitempty"`
+	// isLearner indicates if the member is raft learner.
+	IsLearner     bool `protobuf:"varint,5,opt,name=isLearner,proto3" json:"isLearner,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Member) Reset() {
+	*x = Member{}
+	mi := &file_rpc_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Member) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Member) ProtoMessage() {}
+
+func (x *Member) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Member.ProtoReflect.Descriptor instead.
+func (*Member) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *Member) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *Member) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Member) GetPeerURLs() []string {
+	if x != nil {
+		return x.PeerURLs
+	}
+	return nil
+}
+
+func (x *Member) GetClientURLs() []string {
+	if x != nil {
+		return x.ClientURLs
+	}
+	return nil
+}
+
+func (x *Member) GetIsLearner() bool {
+	if x != nil {
+		return x.IsLearner
+	}
+	return false
+}
+
+type MemberAddRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// peerURLs is the list of URLs the added member will use to communicate with the cluster.
+	PeerURLs []string `protobuf:"bytes,1,rep,name=peerURLs,proto3" json:"peerURLs,omitempty"`
+	// isLearner indicates if the added member is raft learner.
+	IsLearner     bool `protobuf:"varint,2,opt,name=isLearner,proto3" json:"isLearner,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemberAddRequest) Reset() {
+	*x = MemberAddRequest{}
+	mi := &file_rpc_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemberAddRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemberAddRequest) ProtoMessage() {}
+
+func (x *MemberAddRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemberAddRequest.ProtoReflect.Descriptor instead.
+func (*MemberAddRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *MemberAddRequest) GetPeerURLs() []string {
+	if x != nil {
+		return x.PeerURLs
+	}
+	return nil
+}
+
+func (x *MemberAddRequest) GetIsLearner() bool {
+	if x != nil {
+		return x.IsLearner
+	}
+	return false
+}
+
+type MemberAddResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Header *ResponseHeader        `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	// member is the member information for the added member.
+	Member *Member `protobuf:"bytes,2,opt,name=member,proto3" json:"member,omitempty"`
+	// members is a list of all members after adding the new member.
+	Members       []*Member `protobuf:"bytes,3,rep,name=members,proto3" json:"members,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemberAddResponse) Reset() {
+	*x = MemberAddResponse{}
+	mi := &file_rpc_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemberAddResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemberAddResponse) ProtoMessage() {}
+
+func (x *MemberAddResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemberAddResponse.ProtoReflect.Descriptor instead.
+func (*MemberAddResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *MemberAddResponse) GetHeader() *ResponseHeader {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *MemberAddResponse) GetMember() *Member {
+	if x != nil {
+		return x.Member
+	}
+	return nil
+}
+
+func (x *MemberAddResponse) GetMembers() []*Member {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type MemberRemoveRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID is the member ID of the member to remove.
+	ID            uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemberRemoveRequest) Reset() {
+	*x = MemberRemoveRequest{}
+	mi := &file_rpc_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemberRemoveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemberRemoveRequest) ProtoMessage() {}
+
+func (x *MemberRemoveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemberRemoveRequest.ProtoReflect.Descriptor instead.
+func (*MemberRemoveRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *MemberRemoveRequest) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+type MemberRemoveResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Header *ResponseHeader        `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	// members is a list of all members after removing the member.
+	Members       []*Member `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemberRemoveResponse) Reset() {
+	*x = MemberRemoveResponse{}
+	mi := &file_rpc_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemberRemoveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemberRemoveResponse) ProtoMessage() {}
+
+func (x *MemberRemoveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemberRemoveResponse.ProtoReflect.Descriptor instead.
+func (*MemberRemoveResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *MemberRemoveResponse) GetHeader() *ResponseHeader {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *MemberRemoveResponse) GetMembers() []*Member {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type MemberUpdateRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID is the member ID of the member to update.
+	ID uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	// peerURLs is the new list of URLs the member will use to communicate with the cluster.
+	PeerURLs      []string `protobuf:"bytes,2,rep,name=peerURLs,proto3" json:"peerURLs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemberUpdateRequest) Reset() {
+	*x = MemberUpdateRequest{}
+	mi := &file_rpc_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemberUpdateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemberUpdateRequest) ProtoMessage() {}
+
+func (x *MemberUpdateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemberUpdateRequest.ProtoReflect.Descriptor instead.
+func (*MemberUpdateRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *MemberUpdateRequest) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *MemberUpdateRequest) GetPeerURLs() []string {
+	if x != nil {
+		return x.PeerURLs
+	}
+	return nil
+}
+
+type MemberUpdateResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Header *ResponseHeader        `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	// members is a list of all members after updating the member.
+	Members       []*Member `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemberUpdateResponse) Reset() {
+	*x = MemberUpdateResponse{}
+	mi := &file_rpc_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemberUpdateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemberUpdateResponse) ProtoMessage() {}
+
+func (x *MemberUpdateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemberUpdateResponse.ProtoReflect.Descriptor instead.
+func (*MemberUpdateResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *MemberUpdateResponse) GetHeader() *ResponseHeader {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *MemberUpdateResponse) GetMembers() []*Member {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type MemberListRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Linearizable  bool                   `protobuf:"varint,1,opt,name=linearizable,proto3" json:"linearizable,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemberListRequest) Reset() {
+	*x = MemberListRequest{}
+	mi := &file_rpc_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemberListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemberListRequest) ProtoMessage() {}
+
+func (x *MemberListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemberListRequest.ProtoReflect.Descriptor instead.
+func (*MemberListRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *MemberListRequest) GetLinearizable() bool {
+	if x != nil {
+		return x.Linearizable
+	}
+	return false
+}
+
+type MemberListResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Header *ResponseHeader        `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	// members is a list of all members associated with the cluster.
+	Members       []*Member `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemberListResponse) Reset() {
+	*x = MemberListResponse{}
+	mi := &file_rpc_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemberListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemberListResponse) ProtoMessage() {}
+
+func (x *MemberListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemberListResponse.ProtoReflect.Descriptor instead.
+func (*MemberListResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *MemberListResponse) GetHeader() *ResponseHeader {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *MemberListResponse) GetMembers() []*Member {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type MemberPromoteRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID is the member ID of the member to promote.
+	ID            uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemberPromoteRequest) Reset() {
+	*x = MemberPromoteRequest{}
+	mi := &file_rpc_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemberPromoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemberPromoteRequest) ProtoMessage() {}
+
+func (x *MemberPromoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemberPromoteRequest.ProtoReflect.Descriptor instead.
+func (*MemberPromoteRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *MemberPromoteRequest) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+type MemberPromoteResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Header *ResponseHeader        `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	// members is a list of all members after promoting the member.
+	Members       []*Member `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemberPromoteResponse) Reset() {
+	*x = MemberPromoteResponse{}
+	mi := &file_rpc_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemberPromoteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemberPromoteResponse) ProtoMessage() {}
+
+func (x *MemberPromoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemberPromoteResponse.ProtoReflect.Descriptor instead.
+func (*MemberPromoteResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *MemberPromoteResponse) GetHeader() *ResponseHeader {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *MemberPromoteResponse) GetMembers() []*Member {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type DefragmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DefragmentRequest) Reset() {
+	*x = DefragmentRequest{}
+	mi := &file_rpc_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DefragmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DefragmentRequest) ProtoMessage() {}
+
+func (x *DefragmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DefragmentRequest.ProtoReflect.Descriptor instead.
+func (*DefragmentRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{64}
+}
+
+type DefragmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Header        *ResponseHeader        `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DefragmentResponse) Reset() {
+	*x = DefragmentResponse{}
+	mi := &file_rpc_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DefragmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DefragmentResponse) ProtoMessage() {}
+
+func (x *DefragmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DefragmentResponse.ProtoReflect.Descriptor instead.
+func (*DefragmentResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *DefragmentResponse) GetHeader() *ResponseHeader {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+type MoveLeaderRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// targetID is the node ID for the new leader.
+	TargetID      uint64 `protobuf:"varint,1,opt,name=targetID,proto3" json:"targetID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveLeaderRequest) Reset() {
+	*x = MoveLeaderRequest{}
+	mi := &file_rpc_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveLeaderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveLeaderRequest) ProtoMessage() {}
+
+func (x *MoveLeaderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveLeaderRequest.ProtoReflect.Descriptor instead.
+func (*MoveLeaderRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *MoveLeaderRequest) GetTargetID() uint64 {
+	if x != nil {
+		return x.TargetID
+	}
+	return 0
+}
+
+type MoveLeaderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Header        *ResponseHeader        `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveLeaderResponse) Reset() {
+	*x = MoveLeaderResponse{}
+	mi := &file_rpc_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveLeaderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveLeaderResponse) ProtoMessage() {}
+
+func (x *MoveLeaderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveLeaderResponse.ProtoReflect.Descriptor instead.
+func (*MoveLeaderResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *MoveLeaderResponse) GetHeader() *ResponseHeader {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+type AlarmRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// action is the kind of alarm request to issue. The action
+	// may GET alarm statuses, ACTIVATE an alarm, or DEACTIVATE a
+	// raised alarm.
+	Action AlarmRequest_AlarmAction `protobuf:"varint,1,opt,name=action,proto3,enum=etcdserverpb.AlarmRequest_AlarmAction" json:"action,omitempty"`
+	// memberID is the ID of the member associated with the alarm. If memberID is 0, the
+	// alarm request covers all members.
+	MemberID uint64 `protobuf:"varint,2,opt,name=memberID,proto3" json:"memberID,omitempty"`
+	// alarm is the type of alarm to consider for this request.
+	Alarm         AlarmType `protobuf:"varint,3,opt,name=alarm,proto3,enum=etcdserverpb.AlarmType" json:"alarm,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AlarmRequest) Reset() {
+	*x = AlarmRequest{}
+	mi := &file_rpc_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AlarmRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AlarmRequest) ProtoMessage() {}
+
+func (x *AlarmRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AlarmRequest.ProtoReflect.Descriptor instead.
+func (*AlarmRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *AlarmRequest) GetAction() AlarmRequest_AlarmAction {
+	if x != nil {
+		return x.Action
+	}
+	return AlarmRequest_GET
 }
 
-func (x *MemberRemoveResponse) GetHeader() *ResponseHeader {
+func (x *AlarmRequest) GetMemberID() uint64 {
 	if x != nil {
-		return x.Header
+		return x.MemberID
 	}
-	return nil
+	return 0
 }
 
-func (x *MemberRemoveResponse) GetMembers() []*Member {
+func (x *AlarmRequest) GetAlarm() AlarmType {
 	if x != nil {
-		return x.Members
+		return x.Alarm
 	}
-	return nil
+	return AlarmType_NONE
 }
 
-type MemberUpdateRequest struct {
+type AlarmMember struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// ID is the member ID of the member to update.
-	ID uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	// peerURLs is the new list of URLs the member will use to communicate with the cluster.
-	PeerURLs      []string `protobuf:"bytes,2,rep,name=peerURLs,proto3" json:"peerURLs,omitempty"`
+	// memberID is the ID of the member associated with the raised alarm.
+	MemberID uint64 `protobuf:"varint,1,opt,name=memberID,proto3" json:"memberID,omitempty"`
+	// alarm is the type of alarm which has been raised.
+	Alarm         AlarmType `protobuf:"varint,2,opt,name=alarm,proto3,enum=etcdserverpb.AlarmType" json:"alarm,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MemberUpdateRequest) Reset() {
-	*x = MemberUpdateRequest{}
-	mi := &file_rpc_proto_msgTypes[44]
+func (x *AlarmMember) Reset() {
+	*x = AlarmMember{}
+	mi := &file_rpc_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MemberUpdateRequest) String() string {
+func (x *AlarmMember) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MemberUpdateRequest) ProtoMessage() {}
+func (*AlarmMember) ProtoMessage() {}
 
-func (x *MemberUpdateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[44]
+func (x *AlarmMember) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3475,49 +5572,49 @@ func (x *MemberUpdateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MemberUpdateRequest.ProtoReflect.Descriptor instead.
-func (*MemberUpdateRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{44}
+// Deprecated: Use AlarmMember.ProtoReflect.Descriptor instead.
+func (*AlarmMember) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *MemberUpdateRequest) GetID() uint64 {
+func (x *AlarmMember) GetMemberID() uint64 {
 	if x != nil {
-		return x.ID
+		return x.MemberID
 	}
 	return 0
 }
 
-func (x *MemberUpdateRequest) GetPeerURLs() []string {
+func (x *AlarmMember) GetAlarm() AlarmType {
 	if x != nil {
-		return x.PeerURLs
+		return x.Alarm
 	}
-	return nil
+	return AlarmType_NONE
 }
 
-type MemberUpdateResponse struct {
+type AlarmResponse struct {
 	state  protoimpl.MessageState `protogen:"open.v1"`
 	Header *ResponseHeader        `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
-	// members is a list of all members after updating the member.
-	Members       []*Member `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty"`
+	// alarms is a list of alarms associated with the alarm request.
+	Alarms        []*AlarmMember `protobuf:"bytes,2,rep,name=alarms,proto3" json:"alarms,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MemberUpdateResponse) Reset() {
-	*x = MemberUpdateResponse{}
-	mi := &file_rpc_proto_msgTypes[45]
+func (x *AlarmResponse) Reset() {
+	*x = AlarmResponse{}
+	mi := &file_rpc_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MemberUpdateResponse) String() string {
+func (x *AlarmResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MemberUpdateResponse) ProtoMessage() {}
+func (*AlarmResponse) ProtoMessage() {}
 
-func (x *MemberUpdateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[45]
+func (x *AlarmResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3528,47 +5625,59 @@ func (x *MemberUpdateResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MemberUpdateResponse.ProtoReflect.Descriptor instead.
-func (*MemberUpdateResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{45}
+// Deprecated: Use AlarmResponse.ProtoReflect.Descriptor instead.
+func (*AlarmResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *MemberUpdateResponse) GetHeader() *ResponseHeader {
+func (x *AlarmResponse) GetHeader() *ResponseHeader {
 	if x != nil {
 		return x.Header
 	}
 	return nil
 }
 
-func (x *MemberUpdateResponse) GetMembers() []*Member {
+func (x *AlarmResponse) GetAlarms() []*AlarmMember {
 	if x != nil {
-		return x.Members
+		return x.Alarms
 	}
 	return nil
 }
 
-type MemberListRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Linearizable  bool                   `protobuf:"varint,1,opt,name=linearizable,proto3" json:"linearizable,omitempty"`
+type RevisionHoldRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// action is the kind of revision hold request to issue. The action
+	// may LIST all holds, CREATE a new hold, or RELEASE an existing hold.
+	Action RevisionHoldRequest_RevisionHoldAction `protobuf:"varint,1,opt,name=action,proto3,enum=etcdserverpb.RevisionHoldRequest_RevisionHoldAction" json:"action,omitempty"`
+	// ID is the ID of the hold to create or release. If ID is 0 on CREATE,
+	// the server chooses an ID.
+	ID int64 `protobuf:"varint,2,opt,name=ID,proto3" json:"ID,omitempty"`
+	// revision is the revision to hold on CREATE. If revision is 0, the current
+	// revision is held. The revision must not be compacted yet.
+	Revision int64 `protobuf:"varint,3,opt,name=revision,proto3" json:"revision,omitempty"`
+	// lease is the ID of the lease to attach the hold to on CREATE. The hold is
+	// released when the lease is revoked or expires. If lease is 0, the hold
+	// lives until it is released.
+	Lease         int64 `protobuf:"varint,4,opt,name=lease,proto3" json:"lease,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MemberListRequest) Reset() {
-	*x = MemberListRequest{}
-	mi := &file_rpc_proto_msgTypes[46]
+func (x *RevisionHoldRequest) Reset() {
+	*x = RevisionHoldRequest{}
+	mi := &file_rpc_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MemberListRequest) String() string {
+func (x *RevisionHoldRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MemberListRequest) ProtoMessage() {}
+func (*RevisionHoldRequest) ProtoMessage() {}
 
-func (x *MemberListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[46]
+func (x *RevisionHoldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3579,94 +5688,68 @@ func (x *MemberListRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MemberListRequest.ProtoReflect.Descriptor instead.
-func (*MemberListRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{46}
+// Deprecated: Use RevisionHoldRequest.ProtoReflect.Descriptor instead.
+func (*RevisionHoldRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{71}
 }
 
-func (x *MemberListRequest) GetLinearizable() bool {
+func (x *RevisionHoldRequest) GetAction() RevisionHoldRequest_RevisionHoldAction {
 	if x != nil {
-		return x.Linearizable
+		return x.Action
 	}
-	return false
-}
-
-type MemberListResponse struct {
-	state  protoimpl.MessageState `protogen:"open.v1"`
-	Header *ResponseHeader        `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
-	// members is a list of all members associated with the cluster.
-	Members       []*Member `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *MemberListResponse) Reset() {
-	*x = MemberListResponse{}
-	mi := &file_rpc_proto_msgTypes[47]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *MemberListResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return RevisionHoldRequest_LIST
 }
 
-func (*MemberListResponse) ProtoMessage() {}
-
-func (x *MemberListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[47]
+func (x *RevisionHoldRequest) GetID() int64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.ID
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use MemberListResponse.ProtoReflect.Descriptor instead.
-func (*MemberListResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{47}
+	return 0
 }
 
-func (x *MemberListResponse) GetHeader() *ResponseHeader {
+func (x *RevisionHoldRequest) GetRevision() int64 {
 	if x != nil {
-		return x.Header
+		return x.Revision
 	}
-	return nil
+	return 0
 }
 
-func (x *MemberListResponse) GetMembers() []*Member {
+func (x *RevisionHoldRequest) GetLease() int64 {
 	if x != nil {
-		return x.Members
+		return x.Lease
 	}
-	return nil
+	return 0
 }
 
-type MemberPromoteRequest struct {
+type RevisionHold struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// ID is the member ID of the member to promote.
-	ID            uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	// ID is the ID of the hold.
+	ID int64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	// revision is the revision protected from compaction by the hold.
+	Revision int64 `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	// lease is the ID of the lease the hold is attached to.
+	Lease int64 `protobuf:"varint,3,opt,name=lease,proto3" json:"lease,omitempty"`
+	// create_time is the time the hold was created at, in unix seconds.
+	CreateTime    int64 `protobuf:"varint,4,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MemberPromoteRequest) Reset() {
-	*x = MemberPromoteRequest{}
-	mi := &file_rpc_proto_msgTypes[48]
+func (x *RevisionHold) Reset() {
+	*x = RevisionHold{}
+	mi := &file_rpc_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MemberPromoteRequest) String() string {
+func (x *RevisionHold) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MemberPromoteRequest) ProtoMessage() {}
+func (*RevisionHold) ProtoMessage() {}
 
-func (x *MemberPromoteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[48]
+func (x *RevisionHold) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3677,42 +5760,63 @@ func (x *MemberPromoteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MemberPromoteRequest.ProtoReflect.Descriptor instead.
-func (*MemberPromoteRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{48}
+// Deprecated: Use RevisionHold.ProtoReflect.Descriptor instead.
+func (*RevisionHold) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{72}
 }
 
-func (x *MemberPromoteRequest) GetID() uint64 {
+func (x *RevisionHold) GetID() int64 {
 	if x != nil {
 		return x.ID
 	}
 	return 0
 }
 
-type MemberPromoteResponse struct {
+func (x *RevisionHold) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *RevisionHold) GetLease() int64 {
+	if x != nil {
+		return x.Lease
+	}
+	return 0
+}
+
+func (x *RevisionHold) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+type RevisionHoldResponse struct {
 	state  protoimpl.MessageState `protogen:"open.v1"`
 	Header *ResponseHeader        `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
-	// members is a list of all members after promoting the member.
-	Members       []*Member `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty"`
+	// holds is the list of holds created, released or listed by the request.
+	Holds         []*RevisionHold `protobuf:"bytes,2,rep,name=holds,proto3" json:"holds,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MemberPromoteResponse) Reset() {
-	*x = MemberPromoteResponse{}
-	mi := &file_rpc_proto_msgTypes[49]
+func (x *RevisionHoldResponse) Reset() {
+	*x = RevisionHoldResponse{}
+	mi := &file_rpc_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MemberPromoteResponse) String() string {
+func (x *RevisionHoldResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MemberPromoteResponse) ProtoMessage() {}
+func (*RevisionHoldResponse) ProtoMessage() {}
 
-func (x *MemberPromoteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[49]
+func (x *RevisionHoldResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3723,46 +5827,46 @@ func (x *MemberPromoteResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MemberPromoteResponse.ProtoReflect.Descriptor instead.
-func (*MemberPromoteResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{49}
+// Deprecated: Use RevisionHoldResponse.ProtoReflect.Descriptor instead.
+func (*RevisionHoldResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{73}
 }
 
-func (x *MemberPromoteResponse) GetHeader() *ResponseHeader {
+func (x *RevisionHoldResponse) GetHeader() *ResponseHeader {
 	if x != nil {
 		return x.Header
 	}
 	return nil
 }
 
-func (x *MemberPromoteResponse) GetMembers() []*Member {
+func (x *RevisionHoldResponse) GetHolds() []*RevisionHold {
 	if x != nil {
-		return x.Members
+		return x.Holds
 	}
 	return nil
 }
 
-type DefragmentRequest struct {
+type CompactionPolicyRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DefragmentRequest) Reset() {
-	*x = DefragmentRequest{}
-	mi := &file_rpc_proto_msgTypes[50]
+func (x *CompactionPolicyRequest) Reset() {
+	*x = CompactionPolicyRequest{}
+	mi := &file_rpc_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DefragmentRequest) String() string {
+func (x *CompactionPolicyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DefragmentRequest) ProtoMessage() {}
+func (*CompactionPolicyRequest) ProtoMessage() {}
 
-func (x *DefragmentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[50]
+func (x *CompactionPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3773,33 +5877,42 @@ func (x *DefragmentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DefragmentRequest.ProtoReflect.Descriptor instead.
-func (*DefragmentRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{50}
+// Deprecated: Use CompactionPolicyRequest.ProtoReflect.Descriptor instead.
+func (*CompactionPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{74}
 }
 
-type DefragmentResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Header        *ResponseHeader        `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type CompactionRetention struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// prefix is the key prefix the rule applies to. The rule with an empty
+	// prefix applies to every key not under another rule.
+	Prefix []byte `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	// retention is the history kept for the keys under the prefix, in seconds
+	// in periodic mode and in revisions in revision mode. A retention of 0
+	// means the keys are not compacted automatically.
+	Retention int64 `protobuf:"varint,2,opt,name=retention,proto3" json:"retention,omitempty"`
+	// compact_revision is the revision the keys under the prefix are
+	// compacted up to.
+	CompactRevision int64 `protobuf:"varint,3,opt,name=compact_revision,json=compactRevision,proto3" json:"compact_revision,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *DefragmentResponse) Reset() {
-	*x = DefragmentResponse{}
-	mi := &file_rpc_proto_msgTypes[51]
+func (x *CompactionRetention) Reset() {
+	*x = CompactionRetention{}
+	mi := &file_rpc_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DefragmentResponse) String() string {
+func (x *CompactionRetention) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DefragmentResponse) ProtoMessage() {}
+func (*CompactionRetention) ProtoMessage() {}
 
-func (x *DefragmentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[51]
+func (x *CompactionRetention) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3810,41 +5923,62 @@ func (x *DefragmentResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DefragmentResponse.ProtoReflect.Descriptor instead.
-func (*DefragmentResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{51}
+// Deprecated: Use CompactionRetention.ProtoReflect.Descriptor instead.
+func (*CompactionRetention) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{75}
 }
 
-func (x *DefragmentResponse) GetHeader() *ResponseHeader {
+func (x *CompactionRetention) GetPrefix() []byte {
 	if x != nil {
-		return x.Header
+		return x.Prefix
 	}
 	return nil
 }
 
-type MoveLeaderRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// targetID is the node ID for the new leader.
-	TargetID      uint64 `protobuf:"varint,1,opt,name=targetID,proto3" json:"targetID,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *CompactionRetention) GetRetention() int64 {
+	if x != nil {
+		return x.Retention
+	}
+	return 0
+}
+
+func (x *CompactionRetention) GetCompactRevision() int64 {
+	if x != nil {
+		return x.CompactRevision
+	}
+	return 0
 }
 
-func (x *MoveLeaderRequest) Reset() {
-	*x = MoveLeaderRequest{}
-	mi := &file_rpc_proto_msgTypes[52]
+type CompactionPolicyResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Header *ResponseHeader        `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	// mode is the auto compaction mode of the member, either "periodic" or
+	// "revision".
+	Mode string `protobuf:"bytes,2,opt,name=mode,proto3" json:"mode,omitempty"`
+	// retentions is the list of retention rules of the member, starting with
+	// the rule for the keys not under any prefix.
+	Retentions []*CompactionRetention `protobuf:"bytes,3,rep,name=retentions,proto3" json:"retentions,omitempty"`
+	// compact_revision is the revision all keys are compacted up to.
+	CompactRevision int64 `protobuf:"varint,4,opt,name=compact_revision,json=compactRevision,proto3" json:"compact_revision,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CompactionPolicyResponse) Reset() {
+	*x = CompactionPolicyResponse{}
+	mi := &file_rpc_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MoveLeaderRequest) String() string {
+func (x *CompactionPolicyResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MoveLeaderRequest) ProtoMessage() {}
+func (*CompactionPolicyResponse) ProtoMessage() {}
 
-func (x *MoveLeaderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[52]
+func (x *CompactionPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3855,40 +5989,60 @@ func (x *MoveLeaderRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MoveLeaderRequest.ProtoReflect.Descriptor instead.
-func (*MoveLeaderRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{52}
+// Deprecated: Use CompactionPolicyResponse.ProtoReflect.Descriptor instead.
+func (*CompactionPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{76}
 }
 
-func (x *MoveLeaderRequest) GetTargetID() uint64 {
+func (x *CompactionPolicyResponse) GetHeader() *ResponseHeader {
 	if x != nil {
-		return x.TargetID
+		return x.Header
+	}
+	return nil
+}
+
+func (x *CompactionPolicyResponse) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *CompactionPolicyResponse) GetRetentions() []*CompactionRetention {
+	if x != nil {
+		return x.Retentions
+	}
+	return nil
+}
+
+func (x *CompactionPolicyResponse) GetCompactRevision() int64 {
+	if x != nil {
+		return x.CompactRevision
 	}
 	return 0
 }
 
-type MoveLeaderResponse struct {
+type WatchersRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Header        *ResponseHeader        `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MoveLeaderResponse) Reset() {
-	*x = MoveLeaderResponse{}
-	mi := &file_rpc_proto_msgTypes[53]
+func (x *WatchersRequest) Reset() {
+	*x = WatchersRequest{}
+	mi := &file_rpc_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MoveLeaderResponse) String() string {
+func (x *WatchersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MoveLeaderResponse) ProtoMessage() {}
+func (*WatchersRequest) ProtoMessage() {}
 
-func (x *MoveLeaderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[53]
+func (x *WatchersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3899,48 +6053,58 @@ func (x *MoveLeaderResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MoveLeaderResponse.ProtoReflect.Descriptor instead.
-func (*MoveLeaderResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{53}
-}
-
-func (x *MoveLeaderResponse) GetHeader() *ResponseHeader {
-	if x != nil {
-		return x.Header
-	}
-	return nil
+// Deprecated: Use WatchersRequest.ProtoReflect.Descriptor instead.
+func (*WatchersRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{77}
 }
 
-type AlarmRequest struct {
+type WatcherInfo struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// action is the kind of alarm request to issue. The action
-	// may GET alarm statuses, ACTIVATE an alarm, or DEACTIVATE a
-	// raised alarm.
-	Action AlarmRequest_AlarmAction `protobuf:"varint,1,opt,name=action,proto3,enum=etcdserverpb.AlarmRequest_AlarmAction" json:"action,omitempty"`
-	// memberID is the ID of the member associated with the alarm. If memberID is 0, the
-	// alarm request covers all members.
-	MemberID uint64 `protobuf:"varint,2,opt,name=memberID,proto3" json:"memberID,omitempty"`
-	// alarm is the type of alarm to consider for this request.
-	Alarm         AlarmType `protobuf:"varint,3,opt,name=alarm,proto3,enum=etcdserverpb.AlarmType" json:"alarm,omitempty"`
+	// watch_id is the ID of the watcher on its watch stream.
+	WatchId int64 `protobuf:"varint,1,opt,name=watch_id,json=watchId,proto3" json:"watch_id,omitempty"`
+	// ranges are the keys and ranges of keys the watcher watches.
+	Ranges []*KeyRange `protobuf:"bytes,2,rep,name=ranges,proto3" json:"ranges,omitempty"`
+	// start_revision is the revision the watcher started watching from, or 0 if
+	// it started at the revision of the store when it was created.
+	StartRevision int64 `protobuf:"varint,3,opt,name=start_revision,json=startRevision,proto3" json:"start_revision,omitempty"`
+	// revision is the revision the watcher caught up with. The events of the
+	// watcher up to the revision were sent to its watch stream or are pending.
+	Revision int64 `protobuf:"varint,4,opt,name=revision,proto3" json:"revision,omitempty"`
+	// synced is true if the watcher caught up with the store, so it gets the
+	// events of new revisions as they are committed.
+	Synced bool `protobuf:"varint,5,opt,name=synced,proto3" json:"synced,omitempty"`
+	// pending_events is the number of events held back from the watcher while
+	// its watch stream is blocked.
+	PendingEvents int64 `protobuf:"varint,6,opt,name=pending_events,json=pendingEvents,proto3" json:"pending_events,omitempty"`
+	// victim is true if the watcher is blocked on its watch stream.
+	Victim bool `protobuf:"varint,7,opt,name=victim,proto3" json:"victim,omitempty"`
+	// victim_duration is the total time the watcher was blocked on its watch
+	// stream, in milliseconds.
+	VictimDuration int64 `protobuf:"varint,8,opt,name=victim_duration,json=victimDuration,proto3" json:"victim_duration,omitempty"`
+	// user is the user of the watch stream, or empty if the client did not
+	// authenticate.
+	User string `protobuf:"bytes,9,opt,name=user,proto3" json:"user,omitempty"`
+	// remote_address is the address of the client of the watch stream.
+	RemoteAddress string `protobuf:"bytes,10,opt,name=remote_address,json=remoteAddress,proto3" json:"remote_address,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AlarmRequest) Reset() {
-	*x = AlarmRequest{}
-	mi := &file_rpc_proto_msgTypes[54]
+func (x *WatcherInfo) Reset() {
+	*x = WatcherInfo{}
+	mi := &file_rpc_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AlarmRequest) String() string {
+func (x *WatcherInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AlarmRequest) ProtoMessage() {}
+func (*WatcherInfo) ProtoMessage() {}
 
-func (x *AlarmRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[54]
+func (x *WatcherInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3951,110 +6115,105 @@ func (x *AlarmRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AlarmRequest.ProtoReflect.Descriptor instead.
-func (*AlarmRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{54}
+// Deprecated: Use WatcherInfo.ProtoReflect.Descriptor instead.
+func (*WatcherInfo) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{78}
 }
 
-func (x *AlarmRequest) GetAction() AlarmRequest_AlarmAction {
+func (x *WatcherInfo) GetWatchId() int64 {
 	if x != nil {
-		return x.Action
+		return x.WatchId
 	}
-	return AlarmRequest_GET
+	return 0
 }
 
-func (x *AlarmRequest) GetMemberID() uint64 {
+func (x *WatcherInfo) GetRanges() []*KeyRange {
 	if x != nil {
-		return x.MemberID
+		return x.Ranges
 	}
-	return 0
+	return nil
 }
 
-func (x *AlarmRequest) GetAlarm() AlarmType {
+func (x *WatcherInfo) GetStartRevision() int64 {
 	if x != nil {
-		return x.Alarm
+		return x.StartRevision
 	}
-	return AlarmType_NONE
+	return 0
 }
 
-type AlarmMember struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// memberID is the ID of the member associated with the raised alarm.
-	MemberID uint64 `protobuf:"varint,1,opt,name=memberID,proto3" json:"memberID,omitempty"`
-	// alarm is the type of alarm which has been raised.
-	Alarm         AlarmType `protobuf:"varint,2,opt,name=alarm,proto3,enum=etcdserverpb.AlarmType" json:"alarm,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *WatcherInfo) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
 }
 
-func (x *AlarmMember) Reset() {
-	*x = AlarmMember{}
-	mi := &file_rpc_proto_msgTypes[55]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *WatcherInfo) GetSynced() bool {
+	if x != nil {
+		return x.Synced
+	}
+	return false
 }
 
-func (x *AlarmMember) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *WatcherInfo) GetPendingEvents() int64 {
+	if x != nil {
+		return x.PendingEvents
+	}
+	return 0
 }
 
-func (*AlarmMember) ProtoMessage() {}
-
-func (x *AlarmMember) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[55]
+func (x *WatcherInfo) GetVictim() bool {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Victim
 	}
-	return mi.MessageOf(x)
+	return false
 }
 
-// Deprecated: Use AlarmMember.ProtoReflect.Descriptor instead.
-func (*AlarmMember) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{55}
+func (x *WatcherInfo) GetVictimDuration() int64 {
+	if x != nil {
+		return x.VictimDuration
+	}
+	return 0
 }
 
-func (x *AlarmMember) GetMemberID() uint64 {
+func (x *WatcherInfo) GetUser() string {
 	if x != nil {
-		return x.MemberID
+		return x.User
 	}
-	return 0
+	return ""
 }
 
-func (x *AlarmMember) GetAlarm() AlarmType {
+func (x *WatcherInfo) GetRemoteAddress() string {
 	if x != nil {
-		return x.Alarm
+		return x.RemoteAddress
 	}
-	return AlarmType_NONE
+	return ""
 }
 
-type AlarmResponse struct {
+type WatchersResponse struct {
 	state  protoimpl.MessageState `protogen:"open.v1"`
 	Header *ResponseHeader        `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
-	// alarms is a list of alarms associated with the alarm request.
-	Alarms        []*AlarmMember `protobuf:"bytes,2,rep,name=alarms,proto3" json:"alarms,omitempty"`
+	// watchers is the list of watchers of the member.
+	Watchers      []*WatcherInfo `protobuf:"bytes,2,rep,name=watchers,proto3" json:"watchers,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AlarmResponse) Reset() {
-	*x = AlarmResponse{}
-	mi := &file_rpc_proto_msgTypes[56]
+func (x *WatchersResponse) Reset() {
+	*x = WatchersResponse{}
+	mi := &file_rpc_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AlarmResponse) String() string {
+func (x *WatchersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AlarmResponse) ProtoMessage() {}
+func (*WatchersResponse) ProtoMessage() {}
 
-func (x *AlarmResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[56]
+func (x *WatchersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4065,21 +6224,21 @@ func (x *AlarmResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AlarmResponse.ProtoReflect.Descriptor instead.
-func (*AlarmResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{56}
+// Deprecated: Use WatchersResponse.ProtoReflect.Descriptor instead.
+func (*WatchersResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{79}
 }
 
-func (x *AlarmResponse) GetHeader() *ResponseHeader {
+func (x *WatchersResponse) GetHeader() *ResponseHeader {
 	if x != nil {
 		return x.Header
 	}
 	return nil
 }
 
-func (x *AlarmResponse) GetAlarms() []*AlarmMember {
+func (x *WatchersResponse) GetWatchers() []*WatcherInfo {
 	if x != nil {
-		return x.Alarms
+		return x.Watchers
 	}
 	return nil
 }
@@ -4098,7 +6257,7 @@ type DowngradeRequest struct {
 
 func (x *DowngradeRequest) Reset() {
 	*x = DowngradeRequest{}
-	mi := &file_rpc_proto_msgTypes[57]
+	mi := &file_rpc_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4110,7 +6269,7 @@ func (x *DowngradeRequest) String() string {
 func (*DowngradeRequest) ProtoMessage() {}
 
 func (x *DowngradeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[57]
+	mi := &file_rpc_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4123,7 +6282,7 @@ func (x *DowngradeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DowngradeRequest.ProtoReflect.Descriptor instead.
 func (*DowngradeRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{57}
+	return file_rpc_proto_rawDescGZIP(), []int{80}
 }
 
 func (x *DowngradeRequest) GetAction() DowngradeRequest_DowngradeAction {
@@ -4151,7 +6310,7 @@ type DowngradeResponse struct {
 
 func (x *DowngradeResponse) Reset() {
 	*x = DowngradeResponse{}
-	mi := &file_rpc_proto_msgTypes[58]
+	mi := &file_rpc_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4163,7 +6322,7 @@ func (x *DowngradeResponse) String() string {
 func (*DowngradeResponse) ProtoMessage() {}
 
 func (x *DowngradeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[58]
+	mi := &file_rpc_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4176,7 +6335,7 @@ func (x *DowngradeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DowngradeResponse.ProtoReflect.Descriptor instead.
 func (*DowngradeResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{58}
+	return file_rpc_proto_rawDescGZIP(), []int{81}
 }
 
 func (x *DowngradeResponse) GetHeader() *ResponseHeader {
@@ -4206,7 +6365,7 @@ type DowngradeVersionTestRequest struct {
 
 func (x *DowngradeVersionTestRequest) Reset() {
 	*x = DowngradeVersionTestRequest{}
-	mi := &file_rpc_proto_msgTypes[59]
+	mi := &file_rpc_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4218,7 +6377,7 @@ func (x *DowngradeVersionTestRequest) String() string {
 func (*DowngradeVersionTestRequest) ProtoMessage() {}
 
 func (x *DowngradeVersionTestRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[59]
+	mi := &file_rpc_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4231,7 +6390,7 @@ func (x *DowngradeVersionTestRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DowngradeVersionTestRequest.ProtoReflect.Descriptor instead.
 func (*DowngradeVersionTestRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{59}
+	return file_rpc_proto_rawDescGZIP(), []int{82}
 }
 
 func (x *DowngradeVersionTestRequest) GetVer() string {
@@ -4249,7 +6408,7 @@ type StatusRequest struct {
 
 func (x *StatusRequest) Reset() {
 	*x = StatusRequest{}
-	mi := &file_rpc_proto_msgTypes[60]
+	mi := &file_rpc_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4261,7 +6420,7 @@ func (x *StatusRequest) String() string {
 func (*StatusRequest) ProtoMessage() {}
 
 func (x *StatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[60]
+	mi := &file_rpc_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4274,7 +6433,7 @@ func (x *StatusRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StatusRequest.ProtoReflect.Descriptor instead.
 func (*StatusRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{60}
+	return file_rpc_proto_rawDescGZIP(), []int{83}
 }
 
 type StatusResponse struct {
@@ -4310,7 +6469,7 @@ type StatusResponse struct {
 
 func (x *StatusResponse) Reset() {
 	*x = StatusResponse{}
-	mi := &file_rpc_proto_msgTypes[61]
+	mi := &file_rpc_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4322,7 +6481,7 @@ func (x *StatusResponse) String() string {
 func (*StatusResponse) ProtoMessage() {}
 
 func (x *StatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[61]
+	mi := &file_rpc_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4335,7 +6494,7 @@ func (x *StatusResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StatusResponse.ProtoReflect.Descriptor instead.
 func (*StatusResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{61}
+	return file_rpc_proto_rawDescGZIP(), []int{84}
 }
 
 func (x *StatusResponse) GetHeader() *ResponseHeader {
@@ -4441,7 +6600,7 @@ type DowngradeInfo struct {
 
 func (x *DowngradeInfo) Reset() {
 	*x = DowngradeInfo{}
-	mi := &file_rpc_proto_msgTypes[62]
+	mi := &file_rpc_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4453,7 +6612,7 @@ func (x *DowngradeInfo) String() string {
 func (*DowngradeInfo) ProtoMessage() {}
 
 func (x *DowngradeInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[62]
+	mi := &file_rpc_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4466,7 +6625,7 @@ func (x *DowngradeInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DowngradeInfo.ProtoReflect.Descriptor instead.
 func (*DowngradeInfo) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{62}
+	return file_rpc_proto_rawDescGZIP(), []int{85}
 }
 
 func (x *DowngradeInfo) GetEnabled() bool {
@@ -4491,7 +6650,7 @@ type AuthEnableRequest struct {
 
 func (x *AuthEnableRequest) Reset() {
 	*x = AuthEnableRequest{}
-	mi := &file_rpc_proto_msgTypes[63]
+	mi := &file_rpc_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4503,7 +6662,7 @@ func (x *AuthEnableRequest) String() string {
 func (*AuthEnableRequest) ProtoMessage() {}
 
 func (x *AuthEnableRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[63]
+	mi := &file_rpc_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4516,7 +6675,7 @@ func (x *AuthEnableRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuthEnableRequest.ProtoReflect.Descriptor instead.
 func (*AuthEnableRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{63}
+	return file_rpc_proto_rawDescGZIP(), []int{86}
 }
 
 type AuthDisableRequest struct {
@@ -4527,7 +6686,7 @@ type AuthDisableRequest struct {
 
 func (x *AuthDisableRequest) Reset() {
 	*x = AuthDisableRequest{}
-	mi := &file_rpc_proto_msgTypes[64]
+	mi := &file_rpc_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4539,7 +6698,7 @@ func (x *AuthDisableRequest) String() string {
 func (*AuthDisableRequest) ProtoMessage() {}
 
 func (x *AuthDisableRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[64]
+	mi := &file_rpc_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4552,7 +6711,7 @@ func (x *AuthDisableRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuthDisableRequest.ProtoReflect.Descriptor instead.
 func (*AuthDisableRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{64}
+	return file_rpc_proto_rawDescGZIP(), []int{87}
 }
 
 type AuthStatusRequest struct {
@@ -4563,7 +6722,7 @@ type AuthStatusRequest struct {
 
 func (x *AuthStatusRequest) Reset() {
 	*x = AuthStatusRequest{}
-	mi := &file_rpc_proto_msgTypes[65]
+	mi := &file_rpc_proto_msgTypes[88]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4575,7 +6734,7 @@ func (x *AuthStatusRequest) String() string {
 func (*AuthStatusRequest) ProtoMessage() {}
 
 func (x *AuthStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[65]
+	mi := &file_rpc_proto_msgTypes[88]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4588,7 +6747,7 @@ func (x *AuthStatusRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuthStatusRequest.ProtoReflect.Descriptor instead.
 func (*AuthStatusRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{65}
+	return file_rpc_proto_rawDescGZIP(), []int{88}
 }
 
 type AuthenticateRequest struct {
@@ -4601,7 +6760,7 @@ type AuthenticateRequest struct {
 
 func (x *AuthenticateRequest) Reset() {
 	*x = AuthenticateRequest{}
-	mi := &file_rpc_proto_msgTypes[66]
+	mi := &file_rpc_proto_msgTypes[89]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4613,7 +6772,7 @@ func (x *AuthenticateRequest) String() string {
 func (*AuthenticateRequest) ProtoMessage() {}
 
 func (x *AuthenticateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[66]
+	mi := &file_rpc_proto_msgTypes[89]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4626,7 +6785,7 @@ func (x *AuthenticateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuthenticateRequest.ProtoReflect.Descriptor instead.
 func (*AuthenticateRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{66}
+	return file_rpc_proto_rawDescGZIP(), []int{89}
 }
 
 func (x *AuthenticateRequest) GetName() string {
@@ -4655,7 +6814,7 @@ type AuthUserAddRequest struct {
 
 func (x *AuthUserAddRequest) Reset() {
 	*x = AuthUserAddRequest{}
-	mi := &file_rpc_proto_msgTypes[67]
+	mi := &file_rpc_proto_msgTypes[90]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4667,7 +6826,7 @@ func (x *AuthUserAddRequest) String() string {
 func (*AuthUserAddRequest) ProtoMessage() {}
 
 func (x *AuthUserAddRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[67]
+	mi := &file_rpc_proto_msgTypes[90]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4680,7 +6839,7 @@ func (x *AuthUserAddRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuthUserAddRequest.ProtoReflect.Descriptor instead.
 func (*AuthUserAddRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{67}
+	return file_rpc_proto_rawDescGZIP(), []int{90}
 }
 
 func (x *AuthUserAddRequest) GetName() string {
@@ -4720,7 +6879,7 @@ type AuthUserGetRequest struct {
 
 func (x *AuthUserGetRequest) Reset() {
 	*x = AuthUserGetRequest{}
-	mi := &file_rpc_proto_msgTypes[68]
+	mi := &file_rpc_proto_msgTypes[91]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4732,7 +6891,7 @@ func (x *AuthUserGetRequest) String() string {
 func (*AuthUserGetRequest) ProtoMessage() {}
 
 func (x *AuthUserGetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[68]
+	mi := &file_rpc_proto_msgTypes[91]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4745,7 +6904,7 @@ func (x *AuthUserGetRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuthUserGetRequest.ProtoReflect.Descriptor instead.
 func (*AuthUserGetRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{68}
+	return file_rpc_proto_rawDescGZIP(), []int{91}
 }
 
 func (x *AuthUserGetRequest) GetName() string {
@@ -4765,7 +6924,7 @@ type AuthUserDeleteRequest struct {
 
 func (x *AuthUserDeleteRequest) Reset() {
 	*x = AuthUserDeleteRequest{}
-	mi := &file_rpc_proto_msgTypes[69]
+	mi := &file_rpc_proto_msgTypes[92]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4777,7 +6936,7 @@ func (x *AuthUserDeleteRequest) String() string {
 func (*AuthUserDeleteRequest) ProtoMessage() {}
 
 func (x *AuthUserDeleteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[69]
+	mi := &file_rpc_proto_msgTypes[92]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4790,7 +6949,7 @@ func (x *AuthUserDeleteRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuthUserDeleteRequest.ProtoReflect.Descriptor instead.
 func (*AuthUserDeleteRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{69}
+	return file_rpc_proto_rawDescGZIP(), []int{92}
 }
 
 func (x *AuthUserDeleteRequest) GetName() string {
@@ -4814,7 +6973,7 @@ type AuthUserChangePasswordRequest struct {
 
 func (x *AuthUserChangePasswordRequest) Reset() {
 	*x = AuthUserChangePasswordRequest{}
-	mi := &file_rpc_proto_msgTypes[70]
+	mi := &file_rpc_proto_msgTypes[93]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4826,7 +6985,7 @@ func (x *AuthUserChangePasswordRequest) String() string {
 func (*AuthUserChangePasswordRequest) ProtoMessage() {}
 
 func (x *AuthUserChangePasswordRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[70]
+	mi := &file_rpc_proto_msgTypes[93]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4839,7 +6998,7 @@ func (x *AuthUserChangePasswordRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuthUserChangePasswordRequest.ProtoReflect.Descriptor instead.
 func (*AuthUserChangePasswordRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{70}
+	return file_rpc_proto_rawDescGZIP(), []int{93}
 }
 
 func (x *AuthUserChangePasswordRequest) GetName() string {
@@ -4875,7 +7034,7 @@ type AuthUserGrantRoleRequest struct {
 
 func (x *AuthUserGrantRoleRequest) Reset() {
 	*x = AuthUserGrantRoleRequest{}
-	mi := &file_rpc_proto_msgTypes[71]
+	mi := &file_rpc_proto_msgTypes[94]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4887,7 +7046,7 @@ func (x *AuthUserGrantRoleRequest) String() string {
 func (*AuthUserGrantRoleRequest) ProtoMessage() {}
 
 func (x *AuthUserGrantRoleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[71]
+	mi := &file_rpc_proto_msgTypes[94]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4900,7 +7059,7 @@ func (x *AuthUserGrantRoleRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuthUserGrantRoleRequest.ProtoReflect.Descriptor instead.
 func (*AuthUserGrantRoleRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{71}
+	return file_rpc_proto_rawDescGZIP(), []int{94}
 }
 
 func (x *AuthUserGrantRoleRequest) GetUser() string {
@@ -4927,7 +7086,7 @@ type AuthUserRevokeRoleRequest struct {
 
 func (x *AuthUserRevokeRoleRequest) Reset() {
 	*x = AuthUserRevokeRoleRequest{}
-	mi := &file_rpc_proto_msgTypes[72]
+	mi := &file_rpc_proto_msgTypes[95]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4939,7 +7098,7 @@ func (x *AuthUserRevokeRoleRequest) String() string {
 func (*AuthUserRevokeRoleRequest) ProtoMessage() {}
 
 func (x *AuthUserRevokeRoleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[72]
+	mi := &file_rpc_proto_msgTypes[95]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4952,7 +7111,7 @@ func (x *AuthUserRevokeRoleRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuthUserRevokeRoleRequest.ProtoReflect.Descriptor instead.
 func (*AuthUserRevokeRoleRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{72}
+	return file_rpc_proto_rawDescGZIP(), []int{95}
 }
 
 func (x *AuthUserRevokeRoleRequest) GetName() string {
@@ -4979,7 +7138,7 @@ type AuthRoleAddRequest struct {
 
 func (x *AuthRoleAddRequest) Reset() {
 	*x = AuthRoleAddRequest{}
-	mi := &file_rpc_proto_msgTypes[73]
+	mi := &file_rpc_proto_msgTypes[96]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4991,7 +7150,7 @@ func (x *AuthRoleAddRequest) String() string {
 func (*AuthRoleAddRequest) ProtoMessage() {}
 
 func (x *AuthRoleAddRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[73]
+	mi := &file_rpc_proto_msgTypes[96]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5004,7 +7163,7 @@ func (x *AuthRoleAddRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuthRoleAddRequest.ProtoReflect.Descriptor instead.
 func (*AuthRoleAddRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{73}
+	return file_rpc_proto_rawDescGZIP(), []int{96}
 }
 
 func (x *AuthRoleAddRequest) GetName() string {
@@ -5023,7 +7182,7 @@ type AuthRoleGetRequest struct {
 
 func (x *AuthRoleGetRequest) Reset() {
 	*x = AuthRoleGetRequest{}
-	mi := &file_rpc_proto_msgTypes[74]
+	mi := &file_rpc_proto_msgTypes[97]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5035,7 +7194,7 @@ func (x *AuthRoleGetRequest) String() string {
 func (*AuthRoleGetRequest) ProtoMessage() {}
 
 func (x *AuthRoleGetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[74]
+	mi := &file_rpc_proto_msgTypes[97]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5048,7 +7207,7 @@ func (x *AuthRoleGetRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuthRoleGetRequest.ProtoReflect.Descriptor instead.
 func (*AuthRoleGetRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{74}
+	return file_rpc_proto_rawDescGZIP(), []int{97}
 }
 
 func (x *AuthRoleGetRequest) GetRole() string {
@@ -5066,7 +7225,7 @@ type AuthUserListRequest struct {
 
 func (x *AuthUserListRequest) Reset() {
 	*x = AuthUserListRequest{}
-	mi := &file_rpc_proto_msgTypes[75]
+	mi := &file_rpc_proto_msgTypes[98]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5078,7 +7237,7 @@ func (x *AuthUserListRequest) String() string {
 func (*AuthUserListRequest) ProtoMessage() {}
 
 func (x *AuthUserListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[75]
+	mi := &file_rpc_proto_msgTypes[98]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5091,7 +7250,7 @@ func (x *AuthUserListRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuthUserListRequest.ProtoReflect.Descriptor instead.
 func (*AuthUserListRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{75}
+	return file_rpc_proto_rawDescGZIP(), []int{98}
 }
 
 type AuthRoleListRequest struct {
@@ -5102,7 +7261,7 @@ type AuthRoleListRequest struct {
 
 func (x *AuthRoleListRequest) Reset() {
 	*x = AuthRoleListRequest{}
-	mi := &file_rpc_proto_msgTypes[76]
+	mi := &file_rpc_proto_msgTypes[99]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5114,7 +7273,7 @@ func (x *AuthRoleListRequest) String() string {
 func (*AuthRoleListRequest) ProtoMessage() {}
 
 func (x *AuthRoleListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[76]
+	mi := &file_rpc_proto_msgTypes[99]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5127,7 +7286,7 @@ func (x *AuthRoleListRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuthRoleListRequest.ProtoReflect.Descriptor instead.
 func (*AuthRoleListRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{76}
+	return file_rpc_proto_rawDescGZIP(), []int{99}
 }
 
 type AuthRoleDeleteRequest struct {
@@ -5139,7 +7298,7 @@ type AuthRoleDeleteRequest struct {
 
 func (x *AuthRoleDeleteRequest) Reset() {
 	*x = AuthRoleDeleteRequest{}
-	mi := &file_rpc_proto_msgTypes[77]
+	mi := &file_rpc_proto_msgTypes[100]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5151,7 +7310,7 @@ func (x *AuthRoleDeleteRequest) String() string {
 func (*AuthRoleDeleteRequest) ProtoMessage() {}
 
 func (x *AuthRoleDeleteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[77]
+	mi := &file_rpc_proto_msgTypes[100]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5164,7 +7323,7 @@ func (x *AuthRoleDeleteRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuthRoleDeleteRequest.ProtoReflect.Descriptor instead.
 func (*AuthRoleDeleteRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{77}
+	return file_rpc_proto_rawDescGZIP(), []int{100}
 }
 
 func (x *AuthRoleDeleteRequest) GetRole() string {
@@ -5186,7 +7345,7 @@ type AuthRoleGrantPermissionRequest struct {
 
 func (x *AuthRoleGrantPermissionRequest) Reset() {
 	*x = AuthRoleGrantPermissionRequest{}
-	mi := &file_rpc_proto_msgTypes[78]
+	mi := &file_rpc_proto_msgTypes[101]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5198,7 +7357,7 @@ func (x *AuthRoleGrantPermissionRequest) String() string {
 func (*AuthRoleGrantPermissionRequest) ProtoMessage() {}
 
 func (x *AuthRoleGrantPermissionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[78]
+	mi := &file_rpc_proto_msgTypes[101]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5211,7 +7370,7 @@ func (x *AuthRoleGrantPermissionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuthRoleGrantPermissionRequest.ProtoReflect.Descriptor instead.
 func (*AuthRoleGrantPermissionRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{78}
+	return file_rpc_proto_rawDescGZIP(), []int{101}
 }
 
 func (x *AuthRoleGrantPermissionRequest) GetName() string {
@@ -5239,7 +7398,7 @@ type AuthRoleRevokePermissionRequest struct {
 
 func (x *AuthRoleRevokePermissionRequest) Reset() {
 	*x = AuthRoleRevokePermissionRequest{}
-	mi := &file_rpc_proto_msgTypes[79]
+	mi := &file_rpc_proto_msgTypes[102]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5251,7 +7410,7 @@ func (x *AuthRoleRevokePermissionRequest) String() string {
 func (*AuthRoleRevokePermissionRequest) ProtoMessage() {}
 
 func (x *AuthRoleRevokePermissionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[79]
+	mi := &file_rpc_proto_msgTypes[102]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5264,7 +7423,7 @@ func (x *AuthRoleRevokePermissionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuthRoleRevokePermissionRequest.ProtoReflect.Descriptor instead.
 func (*AuthRoleRevokePermissionRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{79}
+	return file_rpc_proto_rawDescGZIP(), []int{102}
 }
 
 func (x *AuthRoleRevokePermissionRequest) GetRole() string {
@@ -5297,7 +7456,7 @@ type AuthEnableResponse struct {
 
 func (x *AuthEnableResponse) Reset() {
 	*x = AuthEnableResponse{}
-	mi := &file_rpc_proto_msgTypes[80]
+	mi := &file_rpc_proto_msgTypes[103]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5309,7 +7468,7 @@ func (x *AuthEnableResponse) String() string {
 func (*AuthEnableResponse) ProtoMessage() {}
 
 func (x *AuthEnableResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[80]
+	mi := &file_rpc_proto_msgTypes[103]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5322,7 +7481,7 @@ func (x *AuthEnableResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuthEnableResponse.ProtoReflect.Descriptor instead.
 func (*AuthEnableResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{80}
+	return file_rpc_proto_rawDescGZIP(), []int{103}
 }
 
 func (x *AuthEnableResponse) GetHeader() *ResponseHeader {
@@ -5341,7 +7500,7 @@ type AuthDisableResponse struct {
 
 func (x *AuthDisableResponse) Reset() {
 	*x = AuthDisableResponse{}
-	mi := &file_rpc_proto_msgTypes[81]
+	mi := &file_rpc_proto_msgTypes[104]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5353,7 +7512,7 @@ func (x *AuthDisableResponse) String() string {
 func (*AuthDisableResponse) ProtoMessage() {}
 
 func (x *AuthDisableResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[81]
+	mi := &file_rpc_proto_msgTypes[104]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5366,7 +7525,7 @@ func (x *AuthDisableResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuthDisableResponse.ProtoReflect.Descriptor instead.
 func (*AuthDisableResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{81}
+	return file_rpc_proto_rawDescGZIP(), []int{104}
 }
 
 func (x *AuthDisableResponse) GetHeader() *ResponseHeader {
@@ -5388,7 +7547,7 @@ type AuthStatusResponse struct {
 
 func (x *AuthStatusResponse) Reset() {
 	*x = AuthStatusResponse{}
-	mi := &file_rpc_proto_msgTypes[82]
+	mi := &file_rpc_proto_msgTypes[105]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5400,7 +7559,7 @@ func (x *AuthStatusResponse) String() string {
 func (*AuthStatusResponse) ProtoMessage() {}
 
 func (x *AuthStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[82]
+	mi := &file_rpc_proto_msgTypes[105]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5413,7 +7572,7 @@ func (x *AuthStatusResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuthStatusResponse.ProtoReflect.Descriptor instead.
 func (*AuthStatusResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{82}
+	return file_rpc_proto_rawDescGZIP(), []int{105}
 }
 
 func (x *AuthStatusResponse) GetHeader() *ResponseHeader {
@@ -5448,7 +7607,7 @@ type AuthenticateResponse struct {
 
 func (x *AuthenticateResponse) Reset() {
 	*x = AuthenticateResponse{}
-	mi := &file_rpc_proto_msgTypes[83]
+	mi := &file_rpc_proto_msgTypes[106]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5460,7 +7619,7 @@ func (x *AuthenticateResponse) String() string {
 func (*AuthenticateResponse) ProtoMessage() {}
 
 func (x *AuthenticateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[83]
+	mi := &file_rpc_proto_msgTypes[106]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5473,7 +7632,7 @@ func (x *AuthenticateResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuthenticateResponse.ProtoReflect.Descriptor instead.
 func (*AuthenticateResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{83}
+	return file_rpc_proto_rawDescGZIP(), []int{106}
 }
 
 func (x *AuthenticateResponse) GetHeader() *ResponseHeader {
@@ -5499,7 +7658,7 @@ type AuthUserAddResponse struct {
 
 func (x *AuthUserAddResponse) Reset() {
 	*x = AuthUserAddResponse{}
-	mi := &file_rpc_proto_msgTypes[84]
+	mi := &file_rpc_proto_msgTypes[107]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5511,7 +7670,7 @@ func (x *AuthUserAddResponse) String() string {
 func (*AuthUserAddResponse) ProtoMessage() {}
 
 func (x *AuthUserAddResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[84]
+	mi := &file_rpc_proto_msgTypes[107]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5524,7 +7683,7 @@ func (x *AuthUserAddResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuthUserAddResponse.ProtoReflect.Descriptor instead.
 func (*AuthUserAddResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{84}
+	return file_rpc_proto_rawDescGZIP(), []int{107}
 }
 
 func (x *AuthUserAddResponse) GetHeader() *ResponseHeader {
@@ -5544,7 +7703,7 @@ type AuthUserGetResponse struct {
 
 func (x *AuthUserGetResponse) Reset() {
 	*x = AuthUserGetResponse{}
-	mi := &file_rpc_proto_msgTypes[85]
+	mi := &file_rpc_proto_msgTypes[108]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5556,7 +7715,7 @@ func (x *AuthUserGetResponse) String() string {
 func (*AuthUserGetResponse) ProtoMessage() {}
 
 func (x *AuthUserGetResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[85]
+	mi := &file_rpc_proto_msgTypes[108]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5569,7 +7728,7 @@ func (x *AuthUserGetResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuthUserGetResponse.ProtoReflect.Descriptor instead.
 func (*AuthUserGetResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{85}
+	return file_rpc_proto_rawDescGZIP(), []int{108}
 }
 
 func (x *AuthUserGetResponse) GetHeader() *ResponseHeader {
@@ -5595,7 +7754,7 @@ type AuthUserDeleteResponse struct {
 
 func (x *AuthUserDeleteResponse) Reset() {
 	*x = AuthUserDeleteResponse{}
-	mi := &file_rpc_proto_msgTypes[86]
+	mi := &file_rpc_proto_msgTypes[109]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5607,7 +7766,7 @@ func (x *AuthUserDeleteResponse) String() string {
 func (*AuthUserDeleteResponse) ProtoMessage() {}
 
 func (x *AuthUserDeleteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[86]
+	mi := &file_rpc_proto_msgTypes[109]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5620,7 +7779,7 @@ func (x *AuthUserDeleteResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuthUserDeleteResponse.ProtoReflect.Descriptor instead.
 func (*AuthUserDeleteResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{86}
+	return file_rpc_proto_rawDescGZIP(), []int{109}
 }
 
 func (x *AuthUserDeleteResponse) GetHeader() *ResponseHeader {
@@ -5639,7 +7798,7 @@ type AuthUserChangePasswordResponse struct {
 
 func (x *AuthUserChangePasswordResponse) Reset() {
 	*x = AuthUserChangePasswordResponse{}
-	mi := &file_rpc_proto_msgTypes[87]
+	mi := &file_rpc_proto_msgTypes[110]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5651,7 +7810,7 @@ func (x *AuthUserChangePasswordResponse) String() string {
 func (*AuthUserChangePasswordResponse) ProtoMessage() {}
 
 func (x *AuthUserChangePasswordResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[87]
+	mi := &file_rpc_proto_msgTypes[110]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5664,7 +7823,7 @@ func (x *AuthUserChangePasswordResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuthUserChangePasswordResponse.ProtoReflect.Descriptor instead.
 func (*AuthUserChangePasswordResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{87}
+	return file_rpc_proto_rawDescGZIP(), []int{110}
 }
 
 func (x *AuthUserChangePasswordResponse) GetHeader() *ResponseHeader {
@@ -5683,7 +7842,7 @@ type AuthUserGrantRoleResponse struct {
 
 func (x *AuthUserGrantRoleResponse) Reset() {
 	*x = AuthUserGrantRoleResponse{}
-	mi := &file_rpc_proto_msgTypes[88]
+	mi := &file_rpc_proto_msgTypes[111]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5695,7 +7854,7 @@ func (x *AuthUserGrantRoleResponse) String() string {
 func (*AuthUserGrantRoleResponse) ProtoMessage() {}
 
 func (x *AuthUserGrantRoleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[88]
+	mi := &file_rpc_proto_msgTypes[111]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5708,7 +7867,7 @@ func (x *AuthUserGrantRoleResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuthUserGrantRoleResponse.ProtoReflect.Descriptor instead.
 func (*AuthUserGrantRoleResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{88}
+	return file_rpc_proto_rawDescGZIP(), []int{111}
 }
 
 func (x *AuthUserGrantRoleResponse) GetHeader() *ResponseHeader {
@@ -5727,7 +7886,7 @@ type AuthUserRevokeRoleResponse struct {
 
 func (x *AuthUserRevokeRoleResponse) Reset() {
 	*x = AuthUserRevokeRoleResponse{}
-	mi := &file_rpc_proto_msgTypes[89]
+	mi := &file_rpc_proto_msgTypes[112]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5739,7 +7898,7 @@ func (x *AuthUserRevokeRoleResponse) String() string {
 func (*AuthUserRevokeRoleResponse) ProtoMessage() {}
 
 func (x *AuthUserRevokeRoleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[89]
+	mi := &file_rpc_proto_msgTypes[112]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5752,7 +7911,7 @@ func (x *AuthUserRevokeRoleResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuthUserRevokeRoleResponse.ProtoReflect.Descriptor instead.
 func (*AuthUserRevokeRoleResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{89}
+	return file_rpc_proto_rawDescGZIP(), []int{112}
 }
 
 func (x *AuthUserRevokeRoleResponse) GetHeader() *ResponseHeader {
@@ -5771,7 +7930,7 @@ type AuthRoleAddResponse struct {
 
 func (x *AuthRoleAddResponse) Reset() {
 	*x = AuthRoleAddResponse{}
-	mi := &file_rpc_proto_msgTypes[90]
+	mi := &file_rpc_proto_msgTypes[113]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5783,7 +7942,7 @@ func (x *AuthRoleAddResponse) String() string {
 func (*AuthRoleAddResponse) ProtoMessage() {}
 
 func (x *AuthRoleAddResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[90]
+	mi := &file_rpc_proto_msgTypes[113]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5796,7 +7955,7 @@ func (x *AuthRoleAddResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuthRoleAddResponse.ProtoReflect.Descriptor instead.
 func (*AuthRoleAddResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{90}
+	return file_rpc_proto_rawDescGZIP(), []int{113}
 }
 
 func (x *AuthRoleAddResponse) GetHeader() *ResponseHeader {
@@ -5816,7 +7975,7 @@ type AuthRoleGetResponse struct {
 
 func (x *AuthRoleGetResponse) Reset() {
 	*x = AuthRoleGetResponse{}
-	mi := &file_rpc_proto_msgTypes[91]
+	mi := &file_rpc_proto_msgTypes[114]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5828,7 +7987,7 @@ func (x *AuthRoleGetResponse) String() string {
 func (*AuthRoleGetResponse) ProtoMessage() {}
 
 func (x *AuthRoleGetResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[91]
+	mi := &file_rpc_proto_msgTypes[114]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5841,7 +8000,7 @@ func (x *AuthRoleGetResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuthRoleGetResponse.ProtoReflect.Descriptor instead.
 func (*AuthRoleGetResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{91}
+	return file_rpc_proto_rawDescGZIP(), []int{114}
 }
 
 func (x *AuthRoleGetResponse) GetHeader() *ResponseHeader {
@@ -5868,7 +8027,7 @@ type AuthRoleListResponse struct {
 
 func (x *AuthRoleListResponse) Reset() {
 	*x = AuthRoleListResponse{}
-	mi := &file_rpc_proto_msgTypes[92]
+	mi := &file_rpc_proto_msgTypes[115]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5880,7 +8039,7 @@ func (x *AuthRoleListResponse) String() string {
 func (*AuthRoleListResponse) ProtoMessage() {}
 
 func (x *AuthRoleListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[92]
+	mi := &file_rpc_proto_msgTypes[115]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5893,7 +8052,7 @@ func (x *AuthRoleListResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuthRoleListResponse.ProtoReflect.Descriptor instead.
 func (*AuthRoleListResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{92}
+	return file_rpc_proto_rawDescGZIP(), []int{115}
 }
 
 func (x *AuthRoleListResponse) GetHeader() *ResponseHeader {
@@ -5920,7 +8079,7 @@ type AuthUserListResponse struct {
 
 func (x *AuthUserListResponse) Reset() {
 	*x = AuthUserListResponse{}
-	mi := &file_rpc_proto_msgTypes[93]
+	mi := &file_rpc_proto_msgTypes[116]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5932,7 +8091,7 @@ func (x *AuthUserListResponse) String() string {
 func (*AuthUserListResponse) ProtoMessage() {}
 
 func (x *AuthUserListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[93]
+	mi := &file_rpc_proto_msgTypes[116]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5945,7 +8104,7 @@ func (x *AuthUserListResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuthUserListResponse.ProtoReflect.Descriptor instead.
 func (*AuthUserListResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{93}
+	return file_rpc_proto_rawDescGZIP(), []int{116}
 }
 
 func (x *AuthUserListResponse) GetHeader() *ResponseHeader {
@@ -5971,7 +8130,7 @@ type AuthRoleDeleteResponse struct {
 
 func (x *AuthRoleDeleteResponse) Reset() {
 	*x = AuthRoleDeleteResponse{}
-	mi := &file_rpc_proto_msgTypes[94]
+	mi := &file_rpc_proto_msgTypes[117]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5983,7 +8142,7 @@ func (x *AuthRoleDeleteResponse) String() string {
 func (*AuthRoleDeleteResponse) ProtoMessage() {}
 
 func (x *AuthRoleDeleteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[94]
+	mi := &file_rpc_proto_msgTypes[117]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5996,7 +8155,7 @@ func (x *AuthRoleDeleteResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuthRoleDeleteResponse.ProtoReflect.Descriptor instead.
 func (*AuthRoleDeleteResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{94}
+	return file_rpc_proto_rawDescGZIP(), []int{117}
 }
 
 func (x *AuthRoleDeleteResponse) GetHeader() *ResponseHeader {
@@ -6015,7 +8174,7 @@ type AuthRoleGrantPermissionResponse struct {
 
 func (x *AuthRoleGrantPermissionResponse) Reset() {
 	*x = AuthRoleGrantPermissionResponse{}
-	mi := &file_rpc_proto_msgTypes[95]
+	mi := &file_rpc_proto_msgTypes[118]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6027,7 +8186,7 @@ func (x *AuthRoleGrantPermissionResponse) String() string {
 func (*AuthRoleGrantPermissionResponse) ProtoMessage() {}
 
 func (x *AuthRoleGrantPermissionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[95]
+	mi := &file_rpc_proto_msgTypes[118]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6040,7 +8199,7 @@ func (x *AuthRoleGrantPermissionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuthRoleGrantPermissionResponse.ProtoReflect.Descriptor instead.
 func (*AuthRoleGrantPermissionResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{95}
+	return file_rpc_proto_rawDescGZIP(), []int{118}
 }
 
 func (x *AuthRoleGrantPermissionResponse) GetHeader() *ResponseHeader {
@@ -6059,7 +8218,7 @@ type AuthRoleRevokePermissionResponse struct {
 
 func (x *AuthRoleRevokePermissionResponse) Reset() {
 	*x = AuthRoleRevokePermissionResponse{}
-	mi := &file_rpc_proto_msgTypes[96]
+	mi := &file_rpc_proto_msgTypes[119]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6071,7 +8230,7 @@ func (x *AuthRoleRevokePermissionResponse) String() string {
 func (*AuthRoleRevokePermissionResponse) ProtoMessage() {}
 
 func (x *AuthRoleRevokePermissionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[96]
+	mi := &file_rpc_proto_msgTypes[119]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6084,7 +8243,7 @@ func (x *AuthRoleRevokePermissionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuthRoleRevokePermissionResponse.ProtoReflect.Descriptor instead.
 func (*AuthRoleRevokePermissionResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{96}
+	return file_rpc_proto_rawDescGZIP(), []int{119}
 }
 
 func (x *AuthRoleRevokePermissionResponse) GetHeader() *ResponseHeader {
@@ -6109,13 +8268,19 @@ type RangeStreamResponse struct {
 	//     fields are provided for clients that merge all responses into a
 	//     single RangeResponse.
 	RangeResponse *RangeResponse `protobuf:"bytes,1,opt,name=range_response,json=rangeResponse,proto3" json:"range_response,omitempty"`
+	// resume_token is set on every chunk but the final one. If the stream breaks,
+	// a new RangeStream request with the same parameters and continue_token set to
+	// the last received resume_token streams the remaining key-value pairs at the
+	// same revision. The count of the resumed stream only includes the keys after
+	// the resume token.
+	ResumeToken   []byte `protobuf:"bytes,2,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *RangeStreamResponse) Reset() {
 	*x = RangeStreamResponse{}
-	mi := &file_rpc_proto_msgTypes[97]
+	mi := &file_rpc_proto_msgTypes[120]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6127,7 +8292,7 @@ func (x *RangeStreamResponse) String() string {
 func (*RangeStreamResponse) ProtoMessage() {}
 
 func (x *RangeStreamResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[97]
+	mi := &file_rpc_proto_msgTypes[120]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6140,7 +8305,7 @@ func (x *RangeStreamResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RangeStreamResponse.ProtoReflect.Descriptor instead.
 func (*RangeStreamResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{97}
+	return file_rpc_proto_rawDescGZIP(), []int{120}
 }
 
 func (x *RangeStreamResponse) GetRangeResponse() *RangeResponse {
@@ -6150,6 +8315,200 @@ func (x *RangeStreamResponse) GetRangeResponse() *RangeResponse {
 	return nil
 }
 
+func (x *RangeStreamResponse) GetResumeToken() []byte {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return nil
+}
+
+type KeyHistoryRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// key is the key to get the history of.
+	Key []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// revision is the point-in-time of the key-value store to read the history at;
+	// revisions of the key after it are not returned. If revision is less or equal
+	// to zero, the history is read at the newest key-value store. If the revision
+	// has been compacted, ErrCompacted is returned as a response.
+	Revision int64 `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	// min_mod_revision is the lower bound for returned revisions; all revisions of
+	// the key before it are filtered away.
+	MinModRevision int64 `protobuf:"varint,3,opt,name=min_mod_revision,json=minModRevision,proto3" json:"min_mod_revision,omitempty"`
+	// max_mod_revision is the upper bound for returned revisions; all revisions of
+	// the key after it are filtered away.
+	MaxModRevision int64 `protobuf:"varint,4,opt,name=max_mod_revision,json=maxModRevision,proto3" json:"max_mod_revision,omitempty"`
+	// limit is a limit on the number of revisions returned for the request. When
+	// limit is set to 0, it is treated as no limit.
+	Limit int64 `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	// sort_order is the order of the returned revisions. Revisions are returned
+	// oldest first unless sort_order is DESCEND.
+	SortOrder RangeRequest_SortOrder `protobuf:"varint,6,opt,name=sort_order,json=sortOrder,proto3,enum=etcdserverpb.RangeRequest_SortOrder" json:"sort_order,omitempty"`
+	// serializable sets the request to use serializable member-local reads.
+	Serializable bool `protobuf:"varint,7,opt,name=serializable,proto3" json:"serializable,omitempty"`
+	// keys_only when set returns the revisions without their values.
+	KeysOnly      bool `protobuf:"varint,8,opt,name=keys_only,json=keysOnly,proto3" json:"keys_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KeyHistoryRequest) Reset() {
+	*x = KeyHistoryRequest{}
+	mi := &file_rpc_proto_msgTypes[121]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KeyHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeyHistoryRequest) ProtoMessage() {}
+
+func (x *KeyHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[121]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KeyHistoryRequest.ProtoReflect.Descriptor instead.
+func (*KeyHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *KeyHistoryRequest) GetKey() []byte {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *KeyHistoryRequest) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *KeyHistoryRequest) GetMinModRevision() int64 {
+	if x != nil {
+		return x.MinModRevision
+	}
+	return 0
+}
+
+func (x *KeyHistoryRequest) GetMaxModRevision() int64 {
+	if x != nil {
+		return x.MaxModRevision
+	}
+	return 0
+}
+
+func (x *KeyHistoryRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *KeyHistoryRequest) GetSortOrder() RangeRequest_SortOrder {
+	if x != nil {
+		return x.SortOrder
+	}
+	return RangeRequest_NONE
+}
+
+func (x *KeyHistoryRequest) GetSerializable() bool {
+	if x != nil {
+		return x.Serializable
+	}
+	return false
+}
+
+func (x *KeyHistoryRequest) GetKeysOnly() bool {
+	if x != nil {
+		return x.KeysOnly
+	}
+	return false
+}
+
+type KeyHistoryResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Header *ResponseHeader        `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	// kvs holds the key-value pair of every returned revision of the key. A
+	// revision deleting the key is returned with version 0 and no value.
+	Kvs []*mvccpb.KeyValue `protobuf:"bytes,2,rep,name=kvs,proto3" json:"kvs,omitempty"`
+	// more indicates if there are more revisions to return in the requested bounds.
+	More bool `protobuf:"varint,3,opt,name=more,proto3" json:"more,omitempty"`
+	// count is the number of revisions of the key retained in the requested bounds.
+	Count         int64 `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KeyHistoryResponse) Reset() {
+	*x = KeyHistoryResponse{}
+	mi := &file_rpc_proto_msgTypes[122]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KeyHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeyHistoryResponse) ProtoMessage() {}
+
+func (x *KeyHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[122]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KeyHistoryResponse.ProtoReflect.Descriptor instead.
+func (*KeyHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *KeyHistoryResponse) GetHeader() *ResponseHeader {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *KeyHistoryResponse) GetKvs() []*mvccpb.KeyValue {
+	if x != nil {
+		return x.Kvs
+	}
+	return nil
+}
+
+func (x *KeyHistoryResponse) GetMore() bool {
+	if x != nil {
+		return x.More
+	}
+	return false
+}
+
+func (x *KeyHistoryResponse) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
 var File_rpc_proto protoreflect.FileDescriptor
 
 const file_rpc_proto_rawDesc = "" +
@@ -6160,7 +8519,7 @@ const file_rpc_proto_rawDesc = "" +
 	"cluster_id\x18\x01 \x01(\x04R\tclusterId\x12\x1b\n" +
 	"\tmember_id\x18\x02 \x01(\x04R\bmemberId\x12\x1a\n" +
 	"\brevision\x18\x03 \x01(\x03R\brevision\x12\x1b\n" +
-	"\traft_term\x18\x04 \x01(\x04R\braftTerm:\a\x82\xb5\x18\x033.0\"\xc3\x05\n" +
+	"\traft_term\x18\x04 \x01(\x04R\braftTerm:\a\x82\xb5\x18\x033.0\"\x8e\b\n" +
 	"\fRangeRequest\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\fR\x03key\x12\x1b\n" +
 	"\trange_end\x18\x02 \x01(\fR\brangeEnd\x12\x14\n" +
@@ -6178,7 +8537,12 @@ const file_rpc_proto_rawDesc = "" +
 	" \x01(\x03B\a\x8a\xb5\x18\x033.1R\x0eminModRevision\x121\n" +
 	"\x10max_mod_revision\x18\v \x01(\x03B\a\x8a\xb5\x18\x033.1R\x0emaxModRevision\x127\n" +
 	"\x13min_create_revision\x18\f \x01(\x03B\a\x8a\xb5\x18\x033.1R\x11minCreateRevision\x127\n" +
-	"\x13max_create_revision\x18\r \x01(\x03B\a\x8a\xb5\x18\x033.1R\x11maxCreateRevision\"7\n" +
+	"\x13max_create_revision\x18\r \x01(\x03B\a\x8a\xb5\x18\x033.1R\x11maxCreateRevision\x12*\n" +
+	"\fvalue_prefix\x18\x0e \x01(\fB\a\x8a\xb5\x18\x033.8R\vvaluePrefix\x12-\n" +
+	"\x0emin_value_size\x18\x0f \x01(\x03B\a\x8a\xb5\x18\x033.8R\fminValueSize\x12-\n" +
+	"\x0emax_value_size\x18\x10 \x01(\x03B\a\x8a\xb5\x18\x033.8R\fmaxValueSize\x12R\n" +
+	"\flease_filter\x18\x11 \x01(\x0e2&.etcdserverpb.RangeRequest.LeaseFilterB\a\x8a\xb5\x18\x033.8R\vleaseFilter\x12.\n" +
+	"\x0econtinue_token\x18\x12 \x01(\fB\a\x8a\xb5\x18\x033.8R\rcontinueToken\"7\n" +
 	"\tSortOrder\x12\b\n" +
 	"\x04NONE\x10\x00\x12\n" +
 	"\n" +
@@ -6191,12 +8555,17 @@ const file_rpc_proto_rawDesc = "" +
 	"\n" +
 	"\x06CREATE\x10\x02\x12\a\n" +
 	"\x03MOD\x10\x03\x12\t\n" +
-	"\x05VALUE\x10\x04\x1a\a\x92\xb5\x18\x033.0:\a\x82\xb5\x18\x033.0\"\x9c\x01\n" +
+	"\x05VALUE\x10\x04\x1a\a\x92\xb5\x18\x033.0\";\n" +
+	"\vLeaseFilter\x12\a\n" +
+	"\x03ANY\x10\x00\x12\f\n" +
+	"\bATTACHED\x10\x01\x12\f\n" +
+	"\bDETACHED\x10\x02\x1a\a\x92\xb5\x18\x033.8:\a\x82\xb5\x18\x033.0\"\xcc\x01\n" +
 	"\rRangeResponse\x124\n" +
 	"\x06header\x18\x01 \x01(\v2\x1c.etcdserverpb.ResponseHeaderR\x06header\x12\"\n" +
 	"\x03kvs\x18\x02 \x03(\v2\x10.mvccpb.KeyValueR\x03kvs\x12\x12\n" +
 	"\x04more\x18\x03 \x01(\bR\x04more\x12\x14\n" +
-	"\x05count\x18\x04 \x01(\x03R\x05count:\a\x82\xb5\x18\x033.0\"\xcd\x01\n" +
+	"\x05count\x18\x04 \x01(\x03R\x05count\x12.\n" +
+	"\x0econtinue_token\x18\x05 \x01(\fB\a\x8a\xb5\x18\x033.8R\rcontinueToken:\a\x82\xb5\x18\x033.0\"\xe8\x01\n" +
 	"\n" +
 	"PutRequest\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\fR\x03key\x12\x14\n" +
@@ -6204,34 +8573,59 @@ const file_rpc_proto_rawDesc = "" +
 	"\x05lease\x18\x03 \x01(\x03R\x05lease\x12 \n" +
 	"\aprev_kv\x18\x04 \x01(\bB\a\x8a\xb5\x18\x033.1R\x06prevKv\x12*\n" +
 	"\fignore_value\x18\x05 \x01(\bB\a\x8a\xb5\x18\x033.2R\vignoreValue\x12*\n" +
-	"\fignore_lease\x18\x06 \x01(\bB\a\x8a\xb5\x18\x033.2R\vignoreLease:\a\x82\xb5\x18\x033.0\"\x80\x01\n" +
+	"\fignore_lease\x18\x06 \x01(\bB\a\x8a\xb5\x18\x033.2R\vignoreLease\x12\x19\n" +
+	"\x03ttl\x18\a \x01(\x03B\a\x8a\xb5\x18\x033.8R\x03ttl:\a\x82\xb5\x18\x033.0\"\xa1\x01\n" +
 	"\vPutResponse\x124\n" +
 	"\x06header\x18\x01 \x01(\v2\x1c.etcdserverpb.ResponseHeaderR\x06header\x122\n" +
-	"\aprev_kv\x18\x02 \x01(\v2\x10.mvccpb.KeyValueB\a\x8a\xb5\x18\x033.1R\x06prevKv:\a\x82\xb5\x18\x033.0\"n\n" +
+	"\aprev_kv\x18\x02 \x01(\v2\x10.mvccpb.KeyValueB\a\x8a\xb5\x18\x033.1R\x06prevKv\x12\x1f\n" +
+	"\x06expiry\x18\x03 \x01(\x03B\a\x8a\xb5\x18\x033.8R\x06expiry:\a\x82\xb5\x18\x033.0\"\x8c\x02\n" +
+	"\x10IncrementRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\fR\x03key\x12\x14\n" +
+	"\x05delta\x18\x02 \x01(\x03R\x05delta\x12C\n" +
+	"\bencoding\x18\x03 \x01(\x0e2'.etcdserverpb.IncrementRequest.EncodingR\bencoding\x12\x14\n" +
+	"\x05lease\x18\x04 \x01(\x03R\x05lease\x12!\n" +
+	"\fignore_lease\x18\x05 \x01(\bR\vignoreLease\x12\x17\n" +
+	"\aprev_kv\x18\x06 \x01(\bR\x06prevKv\"0\n" +
+	"\bEncoding\x12\v\n" +
+	"\aDECIMAL\x10\x00\x12\x0e\n" +
+	"\n" +
+	"BIG_ENDIAN\x10\x01\x1a\a\x92\xb5\x18\x033.8:\a\x82\xb5\x18\x033.8\"\x93\x01\n" +
+	"\x11IncrementResponse\x124\n" +
+	"\x06header\x18\x01 \x01(\v2\x1c.etcdserverpb.ResponseHeaderR\x06header\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value\x12)\n" +
+	"\aprev_kv\x18\x03 \x01(\v2\x10.mvccpb.KeyValueR\x06prevKv:\a\x82\xb5\x18\x033.8\"\xe1\x01\n" +
 	"\x12DeleteRangeRequest\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\fR\x03key\x12\x1b\n" +
 	"\trange_end\x18\x02 \x01(\fR\brangeEnd\x12 \n" +
-	"\aprev_kv\x18\x03 \x01(\bB\a\x8a\xb5\x18\x033.1R\x06prevKv:\a\x82\xb5\x18\x033.0\"\xa4\x01\n" +
+	"\aprev_kv\x18\x03 \x01(\bB\a\x8a\xb5\x18\x033.1R\x06prevKv\x12&\n" +
+	"\n" +
+	"batch_size\x18\x04 \x01(\x03B\a\x8a\xb5\x18\x033.8R\tbatchSize\x12\x1f\n" +
+	"\x06atomic\x18\x05 \x01(\bB\a\x8a\xb5\x18\x033.8R\x06atomic\x12(\n" +
+	"\vmax_batches\x18\x06 \x01(\x03B\a\x8a\xb5\x18\x033.8R\n" +
+	"maxBatches:\a\x82\xb5\x18\x033.0\"\xc8\x01\n" +
 	"\x13DeleteRangeResponse\x124\n" +
 	"\x06header\x18\x01 \x01(\v2\x1c.etcdserverpb.ResponseHeaderR\x06header\x12\x18\n" +
 	"\adeleted\x18\x02 \x01(\x03R\adeleted\x124\n" +
-	"\bprev_kvs\x18\x03 \x03(\v2\x10.mvccpb.KeyValueB\a\x8a\xb5\x18\x033.1R\aprevKvs:\a\x82\xb5\x18\x033.0\"\xbb\x02\n" +
+	"\bprev_kvs\x18\x03 \x03(\v2\x10.mvccpb.KeyValueB\a\x8a\xb5\x18\x033.1R\aprevKvs\x12\"\n" +
+	"\bnext_key\x18\x04 \x01(\fB\a\x8a\xb5\x18\x033.8R\anextKey:\a\x82\xb5\x18\x033.0\"\x93\x03\n" +
 	"\tRequestOp\x12A\n" +
 	"\rrequest_range\x18\x01 \x01(\v2\x1a.etcdserverpb.RangeRequestH\x00R\frequestRange\x12;\n" +
 	"\vrequest_put\x18\x02 \x01(\v2\x18.etcdserverpb.PutRequestH\x00R\n" +
 	"requestPut\x12T\n" +
 	"\x14request_delete_range\x18\x03 \x01(\v2 .etcdserverpb.DeleteRangeRequestH\x00R\x12requestDeleteRange\x12D\n" +
 	"\vrequest_txn\x18\x04 \x01(\v2\x18.etcdserverpb.TxnRequestB\a\x8a\xb5\x18\x033.3H\x00R\n" +
-	"requestTxn:\a\x82\xb5\x18\x033.0B\t\n" +
-	"\arequest\"\xc9\x02\n" +
+	"requestTxn\x12V\n" +
+	"\x11request_increment\x18\x05 \x01(\v2\x1e.etcdserverpb.IncrementRequestB\a\x8a\xb5\x18\x033.8H\x00R\x10requestIncrement:\a\x82\xb5\x18\x033.0B\t\n" +
+	"\arequest\"\xa4\x03\n" +
 	"\n" +
 	"ResponseOp\x12D\n" +
 	"\x0eresponse_range\x18\x01 \x01(\v2\x1b.etcdserverpb.RangeResponseH\x00R\rresponseRange\x12>\n" +
 	"\fresponse_put\x18\x02 \x01(\v2\x19.etcdserverpb.PutResponseH\x00R\vresponsePut\x12W\n" +
 	"\x15response_delete_range\x18\x03 \x01(\v2!.etcdserverpb.DeleteRangeResponseH\x00R\x13responseDeleteRange\x12G\n" +
-	"\fresponse_txn\x18\x04 \x01(\v2\x19.etcdserverpb.TxnResponseB\a\x8a\xb5\x18\x033.3H\x00R\vresponseTxn:\a\x82\xb5\x18\x033.0B\n" +
+	"\fresponse_txn\x18\x04 \x01(\v2\x19.etcdserverpb.TxnResponseB\a\x8a\xb5\x18\x033.3H\x00R\vresponseTxn\x12Y\n" +
+	"\x12response_increment\x18\x05 \x01(\v2\x1f.etcdserverpb.IncrementResponseB\a\x8a\xb5\x18\x033.8H\x00R\x11responseIncrement:\a\x82\xb5\x18\x033.0B\n" +
 	"\n" +
-	"\bresponse\"\xa8\x04\n" +
+	"\bresponse\"\xfd\x05\n" +
 	"\aCompare\x12;\n" +
 	"\x06result\x18\x01 \x01(\x0e2#.etcdserverpb.Compare.CompareResultR\x06result\x12;\n" +
 	"\x06target\x18\x02 \x01(\x0e2#.etcdserverpb.Compare.CompareTargetR\x06target\x12\x10\n" +
@@ -6240,20 +8634,28 @@ const file_rpc_proto_rawDesc = "" +
 	"\x0fcreate_revision\x18\x05 \x01(\x03H\x00R\x0ecreateRevision\x12#\n" +
 	"\fmod_revision\x18\x06 \x01(\x03H\x00R\vmodRevision\x12\x16\n" +
 	"\x05value\x18\a \x01(\fH\x00R\x05value\x12\x1f\n" +
-	"\x05lease\x18\b \x01(\x03B\a\x8a\xb5\x18\x033.3H\x00R\x05lease\x12$\n" +
+	"\x05lease\x18\b \x01(\x03B\a\x8a\xb5\x18\x033.3H\x00R\x05lease\x12\x1f\n" +
+	"\x05count\x18\t \x01(\x03B\a\x8a\xb5\x18\x033.8H\x00R\x05count\x129\n" +
+	"\x13max_create_revision\x18\n" +
+	" \x01(\x03B\a\x8a\xb5\x18\x033.8H\x00R\x11maxCreateRevision\x123\n" +
+	"\x10max_mod_revision\x18\v \x01(\x03B\a\x8a\xb5\x18\x033.8H\x00R\x0emaxModRevision\x12$\n" +
 	"\trange_end\x18@ \x01(\fB\a\x8a\xb5\x18\x033.3R\brangeEnd\"R\n" +
 	"\rCompareResult\x12\t\n" +
 	"\x05EQUAL\x10\x00\x12\v\n" +
 	"\aGREATER\x10\x01\x12\b\n" +
 	"\x04LESS\x10\x02\x12\x16\n" +
-	"\tNOT_EQUAL\x10\x03\x1a\a\x9a\xb5\x18\x033.1\x1a\a\x92\xb5\x18\x033.0\"Y\n" +
+	"\tNOT_EQUAL\x10\x03\x1a\a\x9a\xb5\x18\x033.1\x1a\a\x92\xb5\x18\x033.0\"\x9c\x01\n" +
 	"\rCompareTarget\x12\v\n" +
 	"\aVERSION\x10\x00\x12\n" +
 	"\n" +
 	"\x06CREATE\x10\x01\x12\a\n" +
 	"\x03MOD\x10\x02\x12\t\n" +
 	"\x05VALUE\x10\x03\x12\x12\n" +
-	"\x05LEASE\x10\x04\x1a\a\x9a\xb5\x18\x033.3\x1a\a\x92\xb5\x18\x033.0:\a\x82\xb5\x18\x033.0B\x0e\n" +
+	"\x05LEASE\x10\x04\x1a\a\x9a\xb5\x18\x033.3\x12\x12\n" +
+	"\x05COUNT\x10\x05\x1a\a\x9a\xb5\x18\x033.8\x12\x17\n" +
+	"\n" +
+	"MAX_CREATE\x10\x06\x1a\a\x9a\xb5\x18\x033.8\x12\x14\n" +
+	"\aMAX_MOD\x10\a\x1a\a\x9a\xb5\x18\x033.8\x1a\a\x92\xb5\x18\x033.0:\a\x82\xb5\x18\x033.0B\x0e\n" +
 	"\ftarget_union\"\xac\x01\n" +
 	"\n" +
 	"TxnRequest\x12/\n" +
@@ -6263,10 +8665,14 @@ const file_rpc_proto_rawDesc = "" +
 	"\vTxnResponse\x124\n" +
 	"\x06header\x18\x01 \x01(\v2\x1c.etcdserverpb.ResponseHeaderR\x06header\x12\x1c\n" +
 	"\tsucceeded\x18\x02 \x01(\bR\tsucceeded\x126\n" +
-	"\tresponses\x18\x03 \x03(\v2\x18.etcdserverpb.ResponseOpR\tresponses:\a\x82\xb5\x18\x033.0\"T\n" +
+	"\tresponses\x18\x03 \x03(\v2\x18.etcdserverpb.ResponseOpR\tresponses:\a\x82\xb5\x18\x033.0\"\x99\x01\n" +
 	"\x11CompactionRequest\x12\x1a\n" +
 	"\brevision\x18\x01 \x01(\x03R\brevision\x12\x1a\n" +
-	"\bphysical\x18\x02 \x01(\bR\bphysical:\a\x82\xb5\x18\x033.0\"S\n" +
+	"\bphysical\x18\x02 \x01(\bR\bphysical\x12C\n" +
+	"\bprefixes\x18\x03 \x03(\v2\x1e.etcdserverpb.PrefixCompactionB\a\x8a\xb5\x18\x033.8R\bprefixes:\a\x82\xb5\x18\x033.0\"O\n" +
+	"\x10PrefixCompaction\x12\x16\n" +
+	"\x06prefix\x18\x01 \x01(\fR\x06prefix\x12\x1a\n" +
+	"\brevision\x18\x02 \x01(\x03R\brevision:\a\x82\xb5\x18\x033.8\"S\n" +
 	"\x12CompactionResponse\x124\n" +
 	"\x06header\x18\x01 \x01(\v2\x1c.etcdserverpb.ResponseHeaderR\x06header:\a\x82\xb5\x18\x033.0\"\x16\n" +
 	"\vHashRequest:\a\x82\xb5\x18\x033.0\"4\n" +
@@ -6290,7 +8696,7 @@ const file_rpc_proto_rawDesc = "" +
 	"\x0ecreate_request\x18\x01 \x01(\v2 .etcdserverpb.WatchCreateRequestH\x00R\rcreateRequest\x12I\n" +
 	"\x0ecancel_request\x18\x02 \x01(\v2 .etcdserverpb.WatchCancelRequestH\x00R\rcancelRequest\x12X\n" +
 	"\x10progress_request\x18\x03 \x01(\v2\".etcdserverpb.WatchProgressRequestB\a\x8a\xb5\x18\x033.4H\x00R\x0fprogressRequest:\a\x82\xb5\x18\x033.0B\x0f\n" +
-	"\rrequest_union\"\x87\x03\n" +
+	"\rrequest_union\"\x8e\x05\n" +
 	"\x12WatchCreateRequest\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\fR\x03key\x12\x1b\n" +
 	"\trange_end\x18\x02 \x01(\fR\brangeEnd\x12%\n" +
@@ -6299,14 +8705,26 @@ const file_rpc_proto_rawDesc = "" +
 	"\afilters\x18\x05 \x03(\x0e2+.etcdserverpb.WatchCreateRequest.FilterTypeB\a\x8a\xb5\x18\x033.1R\afilters\x12 \n" +
 	"\aprev_kv\x18\x06 \x01(\bB\a\x8a\xb5\x18\x033.1R\x06prevKv\x12\"\n" +
 	"\bwatch_id\x18\a \x01(\x03B\a\x8a\xb5\x18\x033.4R\awatchId\x12#\n" +
-	"\bfragment\x18\b \x01(\bB\a\x8a\xb5\x18\x033.4R\bfragment\".\n" +
+	"\bfragment\x18\b \x01(\bB\a\x8a\xb5\x18\x033.4R\bfragment\x127\n" +
+	"\x06ranges\x18\t \x03(\v2\x16.etcdserverpb.KeyRangeB\a\x8a\xb5\x18\x033.8R\x06ranges\x12*\n" +
+	"\fvalue_prefix\x18\n" +
+	" \x01(\fB\a\x8a\xb5\x18\x033.8R\vvaluePrefix\x12\x1d\n" +
+	"\x05lease\x18\v \x01(\x03B\a\x8a\xb5\x18\x033.8R\x05lease\x12#\n" +
+	"\bcoalesce\x18\f \x01(\bB\a\x8a\xb5\x18\x033.8R\bcoalesce\x12+\n" +
+	"\fsubscription\x18\r \x01(\tB\a\x8a\xb5\x18\x033.8R\fsubscription\"_\n" +
 	"\n" +
 	"FilterType\x12\t\n" +
 	"\x05NOPUT\x10\x00\x12\f\n" +
-	"\bNODELETE\x10\x01\x1a\a\x92\xb5\x18\x033.1:\a\x82\xb5\x18\x033.0\"A\n" +
+	"\bNODELETE\x10\x01\x12\x18\n" +
+	"\vNOUNCHANGED\x10\x02\x1a\a\x9a\xb5\x18\x033.8\x12\x15\n" +
+	"\bNOUPDATE\x10\x03\x1a\a\x9a\xb5\x18\x033.8\x1a\a\x92\xb5\x18\x033.1:\a\x82\xb5\x18\x033.0\"B\n" +
+	"\bKeyRange\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\fR\x03key\x12\x1b\n" +
+	"\trange_end\x18\x02 \x01(\fR\brangeEnd:\a\x82\xb5\x18\x033.8\"A\n" +
 	"\x12WatchCancelRequest\x12\"\n" +
-	"\bwatch_id\x18\x01 \x01(\x03B\a\x8a\xb5\x18\x033.1R\awatchId:\a\x82\xb5\x18\x033.1\"\x1f\n" +
-	"\x14WatchProgressRequest:\a\x82\xb5\x18\x033.4\"\xc4\x02\n" +
+	"\bwatch_id\x18\x01 \x01(\x03B\a\x8a\xb5\x18\x033.1R\awatchId:\a\x82\xb5\x18\x033.1\"L\n" +
+	"\x14WatchProgressRequest\x12+\n" +
+	"\flinearizable\x18\x01 \x01(\bB\a\x8a\xb5\x18\x033.8R\flinearizable:\a\x82\xb5\x18\x033.4\"\x9f\x03\n" +
 	"\rWatchResponse\x124\n" +
 	"\x06header\x18\x01 \x01(\v2\x1c.etcdserverpb.ResponseHeaderR\x06header\x12\x19\n" +
 	"\bwatch_id\x18\x02 \x01(\x03R\awatchId\x12\x18\n" +
@@ -6315,10 +8733,30 @@ const file_rpc_proto_rawDesc = "" +
 	"\x10compact_revision\x18\x05 \x01(\x03R\x0fcompactRevision\x12,\n" +
 	"\rcancel_reason\x18\x06 \x01(\tB\a\x8a\xb5\x18\x033.4R\fcancelReason\x12#\n" +
 	"\bfragment\x18\a \x01(\bB\a\x8a\xb5\x18\x033.4R\bfragment\x12%\n" +
-	"\x06events\x18\v \x03(\v2\r.mvccpb.EventR\x06events:\a\x82\xb5\x18\x033.0\">\n" +
+	"\tcoalesced\x18\b \x01(\bB\a\x8a\xb5\x18\x033.8R\tcoalesced\x122\n" +
+	"\x10partial_revision\x18\t \x01(\bB\a\x8a\xb5\x18\x033.8R\x0fpartialRevision\x12%\n" +
+	"\x06events\x18\v \x03(\v2\r.mvccpb.EventR\x06events:\a\x82\xb5\x18\x033.0\"\xf3\x01\n" +
+	"\x13SubscriptionRequest\x12L\n" +
+	"\x06action\x18\x01 \x01(\x0e24.etcdserverpb.SubscriptionRequest.SubscriptionActionR\x06action\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
+	"\brevision\x18\x03 \x01(\x03R\brevision\x12\x17\n" +
+	"\amax_lag\x18\x04 \x01(\x03R\x06maxLag\"<\n" +
+	"\x12SubscriptionAction\x12\b\n" +
+	"\x04LIST\x10\x00\x12\a\n" +
+	"\x03ACK\x10\x01\x12\n" +
+	"\n" +
+	"\x06DELETE\x10\x02\x1a\a\x92\xb5\x18\x033.8:\a\x82\xb5\x18\x033.8\"`\n" +
+	"\fSubscription\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1a\n" +
+	"\brevision\x18\x02 \x01(\x03R\brevision\x12\x17\n" +
+	"\amax_lag\x18\x03 \x01(\x03R\x06maxLag:\a\x82\xb5\x18\x033.8\"\x97\x01\n" +
+	"\x14SubscriptionResponse\x124\n" +
+	"\x06header\x18\x01 \x01(\v2\x1c.etcdserverpb.ResponseHeaderR\x06header\x12@\n" +
+	"\rsubscriptions\x18\x02 \x03(\v2\x1a.etcdserverpb.SubscriptionR\rsubscriptions:\a\x82\xb5\x18\x033.8\"_\n" +
 	"\x11LeaseGrantRequest\x12\x10\n" +
 	"\x03TTL\x18\x01 \x01(\x03R\x03TTL\x12\x0e\n" +
-	"\x02ID\x18\x02 \x01(\x03R\x02ID:\a\x82\xb5\x18\x033.0\"\x8b\x01\n" +
+	"\x02ID\x18\x02 \x01(\x03R\x02ID\x12\x1f\n" +
+	"\x06parent\x18\x03 \x01(\x03B\a\x8a\xb5\x18\x033.8R\x06parent:\a\x82\xb5\x18\x033.0\"\x8b\x01\n" +
 	"\x12LeaseGrantResponse\x124\n" +
 	"\x06header\x18\x01 \x01(\v2\x1c.etcdserverpb.ResponseHeaderR\x06header\x12\x0e\n" +
 	"\x02ID\x18\x02 \x01(\x03R\x02ID\x12\x10\n" +
@@ -6327,7 +8765,19 @@ const file_rpc_proto_rawDesc = "" +
 	"\x12LeaseRevokeRequest\x12\x0e\n" +
 	"\x02ID\x18\x01 \x01(\x03R\x02ID:\a\x82\xb5\x18\x033.0\"T\n" +
 	"\x13LeaseRevokeResponse\x124\n" +
-	"\x06header\x18\x01 \x01(\v2\x1c.etcdserverpb.ResponseHeaderR\x06header:\a\x82\xb5\x18\x033.0\"O\n" +
+	"\x06header\x18\x01 \x01(\v2\x1c.etcdserverpb.ResponseHeaderR\x06header:\a\x82\xb5\x18\x033.0\"4\n" +
+	"\x17LeaseRevokeBatchRequest\x12\x10\n" +
+	"\x03IDs\x18\x01 \x03(\x03R\x03IDs:\a\x82\xb5\x18\x033.8\"s\n" +
+	"\x18LeaseRevokeBatchResponse\x124\n" +
+	"\x06header\x18\x01 \x01(\v2\x1c.etcdserverpb.ResponseHeaderR\x06header\x12\x18\n" +
+	"\arevoked\x18\x02 \x03(\x03R\arevoked:\a\x82\xb5\x18\x033.8\"B\n" +
+	"\x15LeaseUpdateTTLRequest\x12\x0e\n" +
+	"\x02ID\x18\x01 \x01(\x03R\x02ID\x12\x10\n" +
+	"\x03TTL\x18\x02 \x01(\x03R\x03TTL:\a\x82\xb5\x18\x033.8\"y\n" +
+	"\x16LeaseUpdateTTLResponse\x124\n" +
+	"\x06header\x18\x01 \x01(\v2\x1c.etcdserverpb.ResponseHeaderR\x06header\x12\x0e\n" +
+	"\x02ID\x18\x02 \x01(\x03R\x02ID\x12\x10\n" +
+	"\x03TTL\x18\x03 \x01(\x03R\x03TTL:\a\x82\xb5\x18\x033.8\"O\n" +
 	"\x0fLeaseCheckpoint\x12\x0e\n" +
 	"\x02ID\x18\x01 \x01(\x03R\x02ID\x12#\n" +
 	"\rremaining_TTL\x18\x02 \x01(\x03R\fremainingTTL:\a\x82\xb5\x18\x033.4\"b\n" +
@@ -6340,10 +8790,13 @@ const file_rpc_proto_rawDesc = "" +
 	"\x16LeaseKeepAliveResponse\x124\n" +
 	"\x06header\x18\x01 \x01(\v2\x1c.etcdserverpb.ResponseHeaderR\x06header\x12\x0e\n" +
 	"\x02ID\x18\x02 \x01(\x03R\x02ID\x12\x10\n" +
-	"\x03TTL\x18\x03 \x01(\x03R\x03TTL:\a\x82\xb5\x18\x033.0\"E\n" +
+	"\x03TTL\x18\x03 \x01(\x03R\x03TTL:\a\x82\xb5\x18\x033.0\"\x93\x01\n" +
 	"\x16LeaseTimeToLiveRequest\x12\x0e\n" +
 	"\x02ID\x18\x01 \x01(\x03R\x02ID\x12\x12\n" +
-	"\x04keys\x18\x02 \x01(\bR\x04keys:\a\x82\xb5\x18\x033.1\"\xae\x01\n" +
+	"\x04keys\x18\x02 \x01(\bR\x04keys\x12&\n" +
+	"\n" +
+	"keys_limit\x18\x03 \x01(\x03B\a\x8a\xb5\x18\x033.8R\tkeysLimit\x12$\n" +
+	"\tkeys_from\x18\x04 \x01(\fB\a\x8a\xb5\x18\x033.8R\bkeysFrom:\a\x82\xb5\x18\x033.1\"\xd4\x01\n" +
 	"\x17LeaseTimeToLiveResponse\x124\n" +
 	"\x06header\x18\x01 \x01(\v2\x1c.etcdserverpb.ResponseHeaderR\x06header\x12\x0e\n" +
 	"\x02ID\x18\x02 \x01(\x03R\x02ID\x12\x10\n" +
@@ -6351,13 +8804,43 @@ const file_rpc_proto_rawDesc = "" +
 	"\n" +
 	"grantedTTL\x18\x04 \x01(\x03R\n" +
 	"grantedTTL\x12\x12\n" +
-	"\x04keys\x18\x05 \x03(\fR\x04keys:\a\x82\xb5\x18\x033.1\"\x1d\n" +
-	"\x12LeaseLeasesRequest:\a\x82\xb5\x18\x033.3\"&\n" +
+	"\x04keys\x18\x05 \x03(\fR\x04keys\x12$\n" +
+	"\tmore_keys\x18\x06 \x01(\bB\a\x8a\xb5\x18\x033.8R\bmoreKeys:\a\x82\xb5\x18\x033.1\"\xa3\x01\n" +
+	"\x12LeaseLeasesRequest\x12\x19\n" +
+	"\x03key\x18\x01 \x01(\fB\a\x8a\xb5\x18\x033.8R\x03key\x12$\n" +
+	"\trange_end\x18\x02 \x01(\fB\a\x8a\xb5\x18\x033.8R\brangeEnd\x12\x1b\n" +
+	"\x04keys\x18\x03 \x01(\bB\a\x8a\xb5\x18\x033.8R\x04keys\x12&\n" +
+	"\n" +
+	"keys_limit\x18\x04 \x01(\x03B\a\x8a\xb5\x18\x033.8R\tkeysLimit:\a\x82\xb5\x18\x033.3\"i\n" +
 	"\vLeaseStatus\x12\x0e\n" +
-	"\x02ID\x18\x01 \x01(\x03R\x02ID:\a\x82\xb5\x18\x033.3\"\x87\x01\n" +
+	"\x02ID\x18\x01 \x01(\x03R\x02ID\x12\x1b\n" +
+	"\x04keys\x18\x03 \x03(\fB\a\x8a\xb5\x18\x033.8R\x04keys\x12$\n" +
+	"\tmore_keys\x18\x04 \x01(\bB\a\x8a\xb5\x18\x033.8R\bmoreKeys:\a\x82\xb5\x18\x033.3\"\x87\x01\n" +
 	"\x13LeaseLeasesResponse\x124\n" +
 	"\x06header\x18\x01 \x01(\v2\x1c.etcdserverpb.ResponseHeaderR\x06header\x121\n" +
-	"\x06leases\x18\x02 \x03(\v2\x19.etcdserverpb.LeaseStatusR\x06leases:\a\x82\xb5\x18\x033.3\"\x98\x01\n" +
+	"\x06leases\x18\x02 \x03(\v2\x19.etcdserverpb.LeaseStatusR\x06leases:\a\x82\xb5\x18\x033.3\"@\n" +
+	"\x11LeaseWatchRequest\x12\x0e\n" +
+	"\x02ID\x18\x01 \x01(\x03R\x02ID\x12\x12\n" +
+	"\x04keys\x18\x02 \x01(\bR\x04keys:\a\x82\xb5\x18\x033.8\"\x80\x02\n" +
+	"\n" +
+	"LeaseEvent\x126\n" +
+	"\x04type\x18\x01 \x01(\x0e2\".etcdserverpb.LeaseEvent.EventTypeR\x04type\x12\x0e\n" +
+	"\x02ID\x18\x02 \x01(\x03R\x02ID\x12\x10\n" +
+	"\x03TTL\x18\x03 \x01(\x03R\x03TTL\x12\x16\n" +
+	"\x06parent\x18\x04 \x01(\x03R\x06parent\x12\x1a\n" +
+	"\brevision\x18\x05 \x01(\x03R\brevision\x12\x12\n" +
+	"\x04keys\x18\x06 \x03(\fR\x04keys\"G\n" +
+	"\tEventType\x12\t\n" +
+	"\x05GRANT\x10\x00\x12\x0e\n" +
+	"\n" +
+	"CHECKPOINT\x10\x01\x12\n" +
+	"\n" +
+	"\x06REVOKE\x10\x02\x12\n" +
+	"\n" +
+	"\x06EXPIRE\x10\x03\x1a\a\x92\xb5\x18\x033.8:\a\x82\xb5\x18\x033.8\"\x85\x01\n" +
+	"\x12LeaseWatchResponse\x124\n" +
+	"\x06header\x18\x01 \x01(\v2\x1c.etcdserverpb.ResponseHeaderR\x06header\x120\n" +
+	"\x06events\x18\x02 \x03(\v2\x18.etcdserverpb.LeaseEventR\x06events:\a\x82\xb5\x18\x033.8\"\x98\x01\n" +
 	"\x06Member\x12\x0e\n" +
 	"\x02ID\x18\x01 \x01(\x04R\x02ID\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
@@ -6415,7 +8898,54 @@ const file_rpc_proto_rawDesc = "" +
 	"\x05alarm\x18\x02 \x01(\x0e2\x17.etcdserverpb.AlarmTypeR\x05alarm:\a\x82\xb5\x18\x033.0\"\x81\x01\n" +
 	"\rAlarmResponse\x124\n" +
 	"\x06header\x18\x01 \x01(\v2\x1c.etcdserverpb.ResponseHeaderR\x06header\x121\n" +
-	"\x06alarms\x18\x02 \x03(\v2\x19.etcdserverpb.AlarmMemberR\x06alarms:\a\x82\xb5\x18\x033.0\"\xbf\x01\n" +
+	"\x06alarms\x18\x02 \x03(\v2\x19.etcdserverpb.AlarmMemberR\x06alarms:\a\x82\xb5\x18\x033.0\"\xf0\x01\n" +
+	"\x13RevisionHoldRequest\x12L\n" +
+	"\x06action\x18\x01 \x01(\x0e24.etcdserverpb.RevisionHoldRequest.RevisionHoldActionR\x06action\x12\x0e\n" +
+	"\x02ID\x18\x02 \x01(\x03R\x02ID\x12\x1a\n" +
+	"\brevision\x18\x03 \x01(\x03R\brevision\x12\x14\n" +
+	"\x05lease\x18\x04 \x01(\x03R\x05lease\"@\n" +
+	"\x12RevisionHoldAction\x12\b\n" +
+	"\x04LIST\x10\x00\x12\n" +
+	"\n" +
+	"\x06CREATE\x10\x01\x12\v\n" +
+	"\aRELEASE\x10\x02\x1a\a\x92\xb5\x18\x033.8:\a\x82\xb5\x18\x033.8\"z\n" +
+	"\fRevisionHold\x12\x0e\n" +
+	"\x02ID\x18\x01 \x01(\x03R\x02ID\x12\x1a\n" +
+	"\brevision\x18\x02 \x01(\x03R\brevision\x12\x14\n" +
+	"\x05lease\x18\x03 \x01(\x03R\x05lease\x12\x1f\n" +
+	"\vcreate_time\x18\x04 \x01(\x03R\n" +
+	"createTime:\a\x82\xb5\x18\x033.8\"\x87\x01\n" +
+	"\x14RevisionHoldResponse\x124\n" +
+	"\x06header\x18\x01 \x01(\v2\x1c.etcdserverpb.ResponseHeaderR\x06header\x120\n" +
+	"\x05holds\x18\x02 \x03(\v2\x1a.etcdserverpb.RevisionHoldR\x05holds:\a\x82\xb5\x18\x033.8\"\"\n" +
+	"\x17CompactionPolicyRequest:\a\x82\xb5\x18\x033.8\"\x7f\n" +
+	"\x13CompactionRetention\x12\x16\n" +
+	"\x06prefix\x18\x01 \x01(\fR\x06prefix\x12\x1c\n" +
+	"\tretention\x18\x02 \x01(\x03R\tretention\x12)\n" +
+	"\x10compact_revision\x18\x03 \x01(\x03R\x0fcompactRevision:\a\x82\xb5\x18\x033.8\"\xdb\x01\n" +
+	"\x18CompactionPolicyResponse\x124\n" +
+	"\x06header\x18\x01 \x01(\v2\x1c.etcdserverpb.ResponseHeaderR\x06header\x12\x12\n" +
+	"\x04mode\x18\x02 \x01(\tR\x04mode\x12A\n" +
+	"\n" +
+	"retentions\x18\x03 \x03(\v2!.etcdserverpb.CompactionRetentionR\n" +
+	"retentions\x12)\n" +
+	"\x10compact_revision\x18\x04 \x01(\x03R\x0fcompactRevision:\a\x82\xb5\x18\x033.8\"\x1a\n" +
+	"\x0fWatchersRequest:\a\x82\xb5\x18\x033.8\"\xdf\x02\n" +
+	"\vWatcherInfo\x12\x19\n" +
+	"\bwatch_id\x18\x01 \x01(\x03R\awatchId\x12.\n" +
+	"\x06ranges\x18\x02 \x03(\v2\x16.etcdserverpb.KeyRangeR\x06ranges\x12%\n" +
+	"\x0estart_revision\x18\x03 \x01(\x03R\rstartRevision\x12\x1a\n" +
+	"\brevision\x18\x04 \x01(\x03R\brevision\x12\x16\n" +
+	"\x06synced\x18\x05 \x01(\bR\x06synced\x12%\n" +
+	"\x0epending_events\x18\x06 \x01(\x03R\rpendingEvents\x12\x16\n" +
+	"\x06victim\x18\a \x01(\bR\x06victim\x12'\n" +
+	"\x0fvictim_duration\x18\b \x01(\x03R\x0evictimDuration\x12\x12\n" +
+	"\x04user\x18\t \x01(\tR\x04user\x12%\n" +
+	"\x0eremote_address\x18\n" +
+	" \x01(\tR\rremoteAddress:\a\x82\xb5\x18\x033.8\"\x88\x01\n" +
+	"\x10WatchersResponse\x124\n" +
+	"\x06header\x18\x01 \x01(\v2\x1c.etcdserverpb.ResponseHeaderR\x06header\x125\n" +
+	"\bwatchers\x18\x02 \x03(\v2\x19.etcdserverpb.WatcherInfoR\bwatchers:\a\x82\xb5\x18\x033.8\"\xbf\x01\n" +
 	"\x10DowngradeRequest\x12F\n" +
 	"\x06action\x18\x01 \x01(\x0e2..etcdserverpb.DowngradeRequest.DowngradeActionR\x06action\x12\x18\n" +
 	"\aversion\x18\x02 \x01(\tR\aversion\"@\n" +
@@ -6529,38 +9059,62 @@ const file_rpc_proto_rawDesc = "" +
 	"\x1fAuthRoleGrantPermissionResponse\x124\n" +
 	"\x06header\x18\x01 \x01(\v2\x1c.etcdserverpb.ResponseHeaderR\x06header:\a\x82\xb5\x18\x033.0\"a\n" +
 	" AuthRoleRevokePermissionResponse\x124\n" +
-	"\x06header\x18\x01 \x01(\v2\x1c.etcdserverpb.ResponseHeaderR\x06header:\a\x82\xb5\x18\x033.0\"b\n" +
+	"\x06header\x18\x01 \x01(\v2\x1c.etcdserverpb.ResponseHeaderR\x06header:\a\x82\xb5\x18\x033.0\"\x8e\x01\n" +
 	"\x13RangeStreamResponse\x12B\n" +
-	"\x0erange_response\x18\x01 \x01(\v2\x1b.etcdserverpb.RangeResponseR\rrangeResponse:\a\x82\xb5\x18\x033.7*A\n" +
+	"\x0erange_response\x18\x01 \x01(\v2\x1b.etcdserverpb.RangeResponseR\rrangeResponse\x12*\n" +
+	"\fresume_token\x18\x02 \x01(\fB\a\x8a\xb5\x18\x033.8R\vresumeToken:\a\x82\xb5\x18\x033.7\"\xba\x02\n" +
+	"\x11KeyHistoryRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\fR\x03key\x12\x1a\n" +
+	"\brevision\x18\x02 \x01(\x03R\brevision\x12(\n" +
+	"\x10min_mod_revision\x18\x03 \x01(\x03R\x0eminModRevision\x12(\n" +
+	"\x10max_mod_revision\x18\x04 \x01(\x03R\x0emaxModRevision\x12\x14\n" +
+	"\x05limit\x18\x05 \x01(\x03R\x05limit\x12C\n" +
+	"\n" +
+	"sort_order\x18\x06 \x01(\x0e2$.etcdserverpb.RangeRequest.SortOrderR\tsortOrder\x12\"\n" +
+	"\fserializable\x18\a \x01(\bR\fserializable\x12\x1b\n" +
+	"\tkeys_only\x18\b \x01(\bR\bkeysOnly:\a\x82\xb5\x18\x033.8\"\xa1\x01\n" +
+	"\x12KeyHistoryResponse\x124\n" +
+	"\x06header\x18\x01 \x01(\v2\x1c.etcdserverpb.ResponseHeaderR\x06header\x12\"\n" +
+	"\x03kvs\x18\x02 \x03(\v2\x10.mvccpb.KeyValueR\x03kvs\x12\x12\n" +
+	"\x04more\x18\x03 \x01(\bR\x04more\x12\x14\n" +
+	"\x05count\x18\x04 \x01(\x03R\x05count:\a\x82\xb5\x18\x033.8*A\n" +
 	"\tAlarmType\x12\b\n" +
 	"\x04NONE\x10\x00\x12\v\n" +
 	"\aNOSPACE\x10\x01\x12\x14\n" +
-	"\aCORRUPT\x10\x02\x1a\a\x9a\xb5\x18\x033.3\x1a\a\x92\xb5\x18\x033.02\xb6\x04\n" +
+	"\aCORRUPT\x10\x02\x1a\a\x9a\xb5\x18\x033.3\x1a\a\x92\xb5\x18\x033.02\xa2\x05\n" +
 	"\x02KV\x12Y\n" +
 	"\x05Range\x12\x1a.etcdserverpb.RangeRequest\x1a\x1b.etcdserverpb.RangeResponse\"\x17\x82\xd3\xe4\x93\x02\x11:\x01*\"\f/v3/kv/range\x12P\n" +
-	"\vRangeStream\x12\x1a.etcdserverpb.RangeRequest\x1a!.etcdserverpb.RangeStreamResponse\"\x000\x01\x12Q\n" +
+	"\vRangeStream\x12\x1a.etcdserverpb.RangeRequest\x1a!.etcdserverpb.RangeStreamResponse\"\x000\x01\x12j\n" +
+	"\n" +
+	"KeyHistory\x12\x1f.etcdserverpb.KeyHistoryRequest\x1a .etcdserverpb.KeyHistoryResponse\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/v3/kv/history\x12Q\n" +
 	"\x03Put\x12\x18.etcdserverpb.PutRequest\x1a\x19.etcdserverpb.PutResponse\"\x15\x82\xd3\xe4\x93\x02\x0f:\x01*\"\n" +
 	"/v3/kv/put\x12q\n" +
 	"\vDeleteRange\x12 .etcdserverpb.DeleteRangeRequest\x1a!.etcdserverpb.DeleteRangeResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/v3/kv/deleterange\x12Q\n" +
 	"\x03Txn\x12\x18.etcdserverpb.TxnRequest\x1a\x19.etcdserverpb.TxnResponse\"\x15\x82\xd3\xe4\x93\x02\x0f:\x01*\"\n" +
 	"/v3/kv/txn\x12j\n" +
-	"\aCompact\x12\x1f.etcdserverpb.CompactionRequest\x1a .etcdserverpb.CompactionResponse\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/v3/kv/compaction2c\n" +
+	"\aCompact\x12\x1f.etcdserverpb.CompactionRequest\x1a .etcdserverpb.CompactionResponse\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/v3/kv/compaction2\xdd\x01\n" +
 	"\x05Watch\x12Z\n" +
-	"\x05Watch\x12\x1a.etcdserverpb.WatchRequest\x1a\x1b.etcdserverpb.WatchResponse\"\x14\x82\xd3\xe4\x93\x02\x0e:\x01*\"\t/v3/watch(\x010\x012\xad\x05\n" +
+	"\x05Watch\x12\x1a.etcdserverpb.WatchRequest\x1a\x1b.etcdserverpb.WatchResponse\"\x14\x82\xd3\xe4\x93\x02\x0e:\x01*\"\t/v3/watch(\x010\x01\x12x\n" +
+	"\fSubscription\x12!.etcdserverpb.SubscriptionRequest\x1a\".etcdserverpb.SubscriptionResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/v3/watch/subscription2\x85\b\n" +
 	"\x05Lease\x12k\n" +
 	"\n" +
 	"LeaseGrant\x12\x1f.etcdserverpb.LeaseGrantRequest\x1a .etcdserverpb.LeaseGrantResponse\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/v3/lease/grant\x12\x89\x01\n" +
-	"\vLeaseRevoke\x12 .etcdserverpb.LeaseRevokeRequest\x1a!.etcdserverpb.LeaseRevokeResponse\"5\x82\xd3\xe4\x93\x02/:\x01*Z\x18:\x01*\"\x13/v3/kv/lease/revoke\"\x10/v3/lease/revoke\x12\x7f\n" +
+	"\vLeaseRevoke\x12 .etcdserverpb.LeaseRevokeRequest\x1a!.etcdserverpb.LeaseRevokeResponse\"5\x82\xd3\xe4\x93\x02/:\x01*Z\x18:\x01*\"\x13/v3/kv/lease/revoke\"\x10/v3/lease/revoke\x12\x83\x01\n" +
+	"\x10LeaseRevokeBatch\x12%.etcdserverpb.LeaseRevokeBatchRequest\x1a&.etcdserverpb.LeaseRevokeBatchResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/v3/lease/revokebatch\x12{\n" +
+	"\x0eLeaseUpdateTTL\x12#.etcdserverpb.LeaseUpdateTTLRequest\x1a$.etcdserverpb.LeaseUpdateTTLResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/v3/lease/updatettl\x12\x7f\n" +
 	"\x0eLeaseKeepAlive\x12#.etcdserverpb.LeaseKeepAliveRequest\x1a$.etcdserverpb.LeaseKeepAliveResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/v3/lease/keepalive(\x010\x01\x12\x9d\x01\n" +
 	"\x0fLeaseTimeToLive\x12$.etcdserverpb.LeaseTimeToLiveRequest\x1a%.etcdserverpb.LeaseTimeToLiveResponse\"=\x82\xd3\xe4\x93\x027:\x01*Z\x1c:\x01*\"\x17/v3/kv/lease/timetolive\"\x14/v3/lease/timetolive\x12\x89\x01\n" +
-	"\vLeaseLeases\x12 .etcdserverpb.LeaseLeasesRequest\x1a!.etcdserverpb.LeaseLeasesResponse\"5\x82\xd3\xe4\x93\x02/:\x01*Z\x18:\x01*\"\x13/v3/kv/lease/leases\"\x10/v3/lease/leases2\xea\x04\n" +
+	"\vLeaseLeases\x12 .etcdserverpb.LeaseLeasesRequest\x1a!.etcdserverpb.LeaseLeasesResponse\"5\x82\xd3\xe4\x93\x02/:\x01*Z\x18:\x01*\"\x13/v3/kv/lease/leases\"\x10/v3/lease/leases\x12S\n" +
+	"\n" +
+	"LeaseWatch\x12\x1f.etcdserverpb.LeaseWatchRequest\x1a .etcdserverpb.LeaseWatchResponse\"\x000\x012\xea\x04\n" +
 	"\aCluster\x12o\n" +
 	"\tMemberAdd\x12\x1e.etcdserverpb.MemberAddRequest\x1a\x1f.etcdserverpb.MemberAddResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/v3/cluster/member/add\x12{\n" +
 	"\fMemberRemove\x12!.etcdserverpb.MemberRemoveRequest\x1a\".etcdserverpb.MemberRemoveResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/v3/cluster/member/remove\x12{\n" +
 	"\fMemberUpdate\x12!.etcdserverpb.MemberUpdateRequest\x1a\".etcdserverpb.MemberUpdateResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/v3/cluster/member/update\x12s\n" +
 	"\n" +
 	"MemberList\x12\x1f.etcdserverpb.MemberListRequest\x1a .etcdserverpb.MemberListResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/v3/cluster/member/list\x12\x7f\n" +
-	"\rMemberPromote\x12\".etcdserverpb.MemberPromoteRequest\x1a#.etcdserverpb.MemberPromoteResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/v3/cluster/member/promote2\x80\a\n" +
+	"\rMemberPromote\x12\".etcdserverpb.MemberPromoteRequest\x1a#.etcdserverpb.MemberPromoteResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/v3/cluster/member/promote2\x81\n" +
+	"\n" +
 	"\vMaintenance\x12b\n" +
 	"\x05Alarm\x12\x1a.etcdserverpb.AlarmRequest\x1a\x1b.etcdserverpb.AlarmResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/v3/maintenance/alarm\x12f\n" +
 	"\x06Status\x12\x1b.etcdserverpb.StatusRequest\x1a\x1c.etcdserverpb.StatusResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/v3/maintenance/status\x12v\n" +
@@ -6571,7 +9125,10 @@ const file_rpc_proto_rawDesc = "" +
 	"\bSnapshot\x12\x1d.etcdserverpb.SnapshotRequest\x1a\x1e.etcdserverpb.SnapshotResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v3/maintenance/snapshot0\x01\x12\x7f\n" +
 	"\n" +
 	"MoveLeader\x12\x1f.etcdserverpb.MoveLeaderRequest\x1a .etcdserverpb.MoveLeaderResponse\".\x82\xd3\xe4\x93\x02(:\x01*\"#/v3/maintenance/transfer-leadership\x12r\n" +
-	"\tDowngrade\x12\x1e.etcdserverpb.DowngradeRequest\x1a\x1f.etcdserverpb.DowngradeResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/v3/maintenance/downgrade2\xa7\x10\n" +
+	"\tDowngrade\x12\x1e.etcdserverpb.DowngradeRequest\x1a\x1f.etcdserverpb.DowngradeResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/v3/maintenance/downgrade\x12~\n" +
+	"\fRevisionHold\x12!.etcdserverpb.RevisionHoldRequest\x1a\".etcdserverpb.RevisionHoldResponse\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/v3/maintenance/revisionhold\x12\x8e\x01\n" +
+	"\x10CompactionPolicy\x12%.etcdserverpb.CompactionPolicyRequest\x1a&.etcdserverpb.CompactionPolicyResponse\"+\x82\xd3\xe4\x93\x02%:\x01*\" /v3/maintenance/compactionpolicy\x12n\n" +
+	"\bWatchers\x12\x1d.etcdserverpb.WatchersRequest\x1a\x1e.etcdserverpb.WatchersResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v3/maintenance/watchers2\xa7\x10\n" +
 	"\x04Auth\x12k\n" +
 	"\n" +
 	"AuthEnable\x12\x1f.etcdserverpb.AuthEnableRequest\x1a .etcdserverpb.AuthEnableResponse\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/v3/auth/enable\x12o\n" +
@@ -6612,294 +9169,367 @@ func file_rpc_proto_rawDescGZIP() []byte {
 	return file_rpc_proto_rawDescData
 }
 
-var file_rpc_proto_enumTypes = make([]protoimpl.EnumInfo, 8)
-var file_rpc_proto_msgTypes = make([]protoimpl.MessageInfo, 98)
+var file_rpc_proto_enumTypes = make([]protoimpl.EnumInfo, 13)
+var file_rpc_proto_msgTypes = make([]protoimpl.MessageInfo, 123)
 var file_rpc_proto_goTypes = []any{
-	(AlarmType)(0),                           // 0: etcdserverpb.AlarmType
-	(RangeRequest_SortOrder)(0),              // 1: etcdserverpb.RangeRequest.SortOrder
-	(RangeRequest_SortTarget)(0),             // 2: etcdserverpb.RangeRequest.SortTarget
-	(Compare_CompareResult)(0),               // 3: etcdserverpb.Compare.CompareResult
-	(Compare_CompareTarget)(0),               // 4: etcdserverpb.Compare.CompareTarget
-	(WatchCreateRequest_FilterType)(0),       // 5: etcdserverpb.WatchCreateRequest.FilterType
-	(AlarmRequest_AlarmAction)(0),            // 6: etcdserverpb.AlarmRequest.AlarmAction
-	(DowngradeRequest_DowngradeAction)(0),    // 7: etcdserverpb.DowngradeRequest.DowngradeAction
-	(*ResponseHeader)(nil),                   // 8: etcdserverpb.ResponseHeader
-	(*RangeRequest)(nil),                     // 9: etcdserverpb.RangeRequest
-	(*RangeResponse)(nil),                    // 10: etcdserverpb.RangeResponse
-	(*PutRequest)(nil),                       // 11: etcdserverpb.PutRequest
-	(*PutResponse)(nil),                      // 12: etcdserverpb.PutResponse
-	(*DeleteRangeRequest)(nil),               // 13: etcdserverpb.DeleteRangeRequest
-	(*DeleteRangeResponse)(nil),              // 14: etcdserverpb.DeleteRangeResponse
-	(*RequestOp)(nil),                        // 15: etcdserverpb.RequestOp
-	(*ResponseOp)(nil),                       // 16: etcdserverpb.ResponseOp
-	(*Compare)(nil),                          // 17: etcdserverpb.Compare
-	(*TxnRequest)(nil),                       // 18: etcdserverpb.TxnRequest
-	(*TxnResponse)(nil),                      // 19: etcdserverpb.TxnResponse
-	(*CompactionRequest)(nil),                // 20: etcdserverpb.CompactionRequest
-	(*CompactionResponse)(nil),               // 21: etcdserverpb.CompactionResponse
-	(*HashRequest)(nil),                      // 22: etcdserverpb.HashRequest
-	(*HashKVRequest)(nil),                    // 23: etcdserverpb.HashKVRequest
-	(*HashKVResponse)(nil),                   // 24: etcdserverpb.HashKVResponse
-	(*HashResponse)(nil),                     // 25: etcdserverpb.HashResponse
-	(*SnapshotRequest)(nil),                  // 26: etcdserverpb.SnapshotRequest
-	(*SnapshotResponse)(nil),                 // 27: etcdserverpb.SnapshotResponse
-	(*WatchRequest)(nil),                     // 28: etcdserverpb.WatchRequest
-	(*WatchCreateRequest)(nil),               // 29: etcdserverpb.WatchCreateRequest
-	(*WatchCancelRequest)(nil),               // 30: etcdserverpb.WatchCancelRequest
-	(*WatchProgressRequest)(nil),             // 31: etcdserverpb.WatchProgressRequest
-	(*WatchResponse)(nil),                    // 32: etcdserverpb.WatchResponse
-	(*LeaseGrantRequest)(nil),                // 33: etcdserverpb.LeaseGrantRequest
-	(*LeaseGrantResponse)(nil),               // 34: etcdserverpb.LeaseGrantResponse
-	(*LeaseRevokeRequest)(nil),               // 35: etcdserverpb.LeaseRevokeRequest
-	(*LeaseRevokeResponse)(nil),              // 36: etcdserverpb.LeaseRevokeResponse
-	(*LeaseCheckpoint)(nil),                  // 37: etcdserverpb.LeaseCheckpoint
-	(*LeaseCheckpointRequest)(nil),           // 38: etcdserverpb.LeaseCheckpointRequest
-	(*LeaseCheckpointResponse)(nil),          // 39: etcdserverpb.LeaseCheckpointResponse
-	(*LeaseKeepAliveRequest)(nil),            // 40: etcdserverpb.LeaseKeepAliveRequest
-	(*LeaseKeepAliveResponse)(nil),           // 41: etcdserverpb.LeaseKeepAliveResponse
-	(*LeaseTimeToLiveRequest)(nil),           // 42: etcdserverpb.LeaseTimeToLiveRequest
-	(*LeaseTimeToLiveResponse)(nil),          // 43: etcdserverpb.LeaseTimeToLiveResponse
-	(*LeaseLeasesRequest)(nil),               // 44: etcdserverpb.LeaseLeasesRequest
-	(*LeaseStatus)(nil),                      // 45: etcdserverpb.LeaseStatus
-	(*LeaseLeasesResponse)(nil),              // 46: etcdserverpb.LeaseLeasesResponse
-	(*Member)(nil),                           // 47: etcdserverpb.Member
-	(*MemberAddRequest)(nil),                 // 48: etcdserverpb.MemberAddRequest
-	(*MemberAddResponse)(nil),                // 49: etcdserverpb.MemberAddResponse
-	(*MemberRemoveRequest)(nil),              // 50: etcdserverpb.MemberRemoveRequest
-	(*MemberRemoveResponse)(nil),             // 51: etcdserverpb.MemberRemoveResponse
-	(*MemberUpdateRequest)(nil),              // 52: etcdserverpb.MemberUpdateRequest
-	(*MemberUpdateResponse)(nil),             // 53: etcdserverpb.MemberUpdateResponse
-	(*MemberListRequest)(nil),                // 54: etcdserverpb.MemberListRequest
-	(*MemberListResponse)(nil),               // 55: etcdserverpb.MemberListResponse
-	(*MemberPromoteRequest)(nil),             // 56: etcdserverpb.MemberPromoteRequest
-	(*MemberPromoteResponse)(nil),            // 57: etcdserverpb.MemberPromoteResponse
-	(*DefragmentRequest)(nil),                // 58: etcdserverpb.DefragmentRequest
-	(*DefragmentResponse)(nil),               // 59: etcdserverpb.DefragmentResponse
-	(*MoveLeaderRequest)(nil),                // 60: etcdserverpb.MoveLeaderRequest
-	(*MoveLeaderResponse)(nil),               // 61: etcdserverpb.MoveLeaderResponse
-	(*AlarmRequest)(nil),                     // 62: etcdserverpb.AlarmRequest
-	(*AlarmMember)(nil),                      // 63: etcdserverpb.AlarmMember
-	(*AlarmResponse)(nil),                    // 64: etcdserverpb.AlarmResponse
-	(*DowngradeRequest)(nil),                 // 65: etcdserverpb.DowngradeRequest
-	(*DowngradeResponse)(nil),                // 66: etcdserverpb.DowngradeResponse
-	(*DowngradeVersionTestRequest)(nil),      // 67: etcdserverpb.DowngradeVersionTestRequest
-	(*StatusRequest)(nil),                    // 68: etcdserverpb.StatusRequest
-	(*StatusResponse)(nil),                   // 69: etcdserverpb.StatusResponse
-	(*DowngradeInfo)(nil),                    // 70: etcdserverpb.DowngradeInfo
-	(*AuthEnableRequest)(nil),                // 71: etcdserverpb.AuthEnableRequest
-	(*AuthDisableRequest)(nil),               // 72: etcdserverpb.AuthDisableRequest
-	(*AuthStatusRequest)(nil),                // 73: etcdserverpb.AuthStatusRequest
-	(*AuthenticateRequest)(nil),              // 74: etcdserverpb.AuthenticateRequest
-	(*AuthUserAddRequest)(nil),               // 75: etcdserverpb.AuthUserAddRequest
-	(*AuthUserGetRequest)(nil),               // 76: etcdserverpb.AuthUserGetRequest
-	(*AuthUserDeleteRequest)(nil),            // 77: etcdserverpb.AuthUserDeleteRequest
-	(*AuthUserChangePasswordRequest)(nil),    // 78: etcdserverpb.AuthUserChangePasswordRequest
-	(*AuthUserGrantRoleRequest)(nil),         // 79: etcdserverpb.AuthUserGrantRoleRequest
-	(*AuthUserRevokeRoleRequest)(nil),        // 80: etcdserverpb.AuthUserRevokeRoleRequest
-	(*AuthRoleAddRequest)(nil),               // 81: etcdserverpb.AuthRoleAddRequest
-	(*AuthRoleGetRequest)(nil),               // 82: etcdserverpb.AuthRoleGetRequest
-	(*AuthUserListRequest)(nil),              // 83: etcdserverpb.AuthUserListRequest
-	(*AuthRoleListRequest)(nil),              // 84: etcdserverpb.AuthRoleListRequest
-	(*AuthRoleDeleteRequest)(nil),            // 85: etcdserverpb.AuthRoleDeleteRequest
-	(*AuthRoleGrantPermissionRequest)(nil),   // 86: etcdserverpb.AuthRoleGrantPermissionRequest
-	(*AuthRoleRevokePermissionRequest)(nil),  // 87: etcdserverpb.AuthRoleRevokePermissionRequest
-	(*AuthEnableResponse)(nil),               // 88: etcdserverpb.AuthEnableResponse
-	(*AuthDisableResponse)(nil),              // 89: etcdserverpb.AuthDisableResponse
-	(*AuthStatusResponse)(nil),               // 90: etcdserverpb.AuthStatusResponse
-	(*AuthenticateResponse)(nil),             // 91: etcdserverpb.AuthenticateResponse
-	(*AuthUserAddResponse)(nil),              // 92: etcdserverpb.AuthUserAddResponse
-	(*AuthUserGetResponse)(nil),              // 93: etcdserverpb.AuthUserGetResponse
-	(*AuthUserDeleteResponse)(nil),           // 94: etcdserverpb.AuthUserDeleteResponse
-	(*AuthUserChangePasswordResponse)(nil),   // 95: etcdserverpb.AuthUserChangePasswordResponse
-	(*AuthUserGrantRoleResponse)(nil),        // 96: etcdserverpb.AuthUserGrantRoleResponse
-	(*AuthUserRevokeRoleResponse)(nil),       // 97: etcdserverpb.AuthUserRevokeRoleResponse
-	(*AuthRoleAddResponse)(nil),              // 98: etcdserverpb.AuthRoleAddResponse
-	(*AuthRoleGetResponse)(nil),              // 99: etcdserverpb.AuthRoleGetResponse
-	(*AuthRoleListResponse)(nil),             // 100: etcdserverpb.AuthRoleListResponse
-	(*AuthUserListResponse)(nil),             // 101: etcdserverpb.AuthUserListResponse
-	(*AuthRoleDeleteResponse)(nil),           // 102: etcdserverpb.AuthRoleDeleteResponse
-	(*AuthRoleGrantPermissionResponse)(nil),  // 103: etcdserverpb.AuthRoleGrantPermissionResponse
-	(*AuthRoleRevokePermissionResponse)(nil), // 104: etcdserverpb.AuthRoleRevokePermissionResponse
-	(*RangeStreamResponse)(nil),              // 105: etcdserverpb.RangeStreamResponse
-	(*mvccpb.KeyValue)(nil),                  // 106: mvccpb.KeyValue
-	(*mvccpb.Event)(nil),                     // 107: mvccpb.Event
-	(*authpb.UserAddOptions)(nil),            // 108: authpb.UserAddOptions
-	(*authpb.Permission)(nil),                // 109: authpb.Permission
+	(AlarmType)(0),                              // 0: etcdserverpb.AlarmType
+	(RangeRequest_SortOrder)(0),                 // 1: etcdserverpb.RangeRequest.SortOrder
+	(RangeRequest_SortTarget)(0),                // 2: etcdserverpb.RangeRequest.SortTarget
+	(RangeRequest_LeaseFilter)(0),               // 3: etcdserverpb.RangeRequest.LeaseFilter
+	(IncrementRequest_Encoding)(0),              // 4: etcdserverpb.IncrementRequest.Encoding
+	(Compare_CompareResult)(0),                  // 5: etcdserverpb.Compare.CompareResult
+	(Compare_CompareTarget)(0),                  // 6: etcdserverpb.Compare.CompareTarget
+	(WatchCreateRequest_FilterType)(0),          // 7: etcdserverpb.WatchCreateRequest.FilterType
+	(SubscriptionRequest_SubscriptionAction)(0), // 8: etcdserverpb.SubscriptionRequest.SubscriptionAction
+	(LeaseEvent_EventType)(0),                   // 9: etcdserverpb.LeaseEvent.EventType
+	(AlarmRequest_AlarmAction)(0),               // 10: etcdserverpb.AlarmRequest.AlarmAction
+	(RevisionHoldRequest_RevisionHoldAction)(0), // 11: etcdserverpb.RevisionHoldRequest.RevisionHoldAction
+	(DowngradeRequest_DowngradeAction)(0),       // 12: etcdserverpb.DowngradeRequest.DowngradeAction
+	(*ResponseHeader)(nil),                      // 13: etcdserverpb.ResponseHeader
+	(*RangeRequest)(nil),                        // 14: etcdserverpb.RangeRequest
+	(*RangeResponse)(nil),                       // 15: etcdserverpb.RangeResponse
+	(*PutRequest)(nil),                          // 16: etcdserverpb.PutRequest
+	(*PutResponse)(nil),                         // 17: etcdserverpb.PutResponse
+	(*IncrementRequest)(nil),                    // 18: etcdserverpb.IncrementRequest
+	(*IncrementResponse)(nil),                   // 19: etcdserverpb.IncrementResponse
+	(*DeleteRangeRequest)(nil),                  // 20: etcdserverpb.DeleteRangeRequest
+	(*DeleteRangeResponse)(nil),                 // 21: etcdserverpb.DeleteRangeResponse
+	(*RequestOp)(nil),                           // 22: etcdserverpb.RequestOp
+	(*ResponseOp)(nil),                          // 23: etcdserverpb.ResponseOp
+	(*Compare)(nil),                             // 24: etcdserverpb.Compare
+	(*TxnRequest)(nil),                          // 25: etcdserverpb.TxnRequest
+	(*TxnResponse)(nil),                         // 26: etcdserverpb.TxnResponse
+	(*CompactionRequest)(nil),                   // 27: etcdserverpb.CompactionRequest
+	(*PrefixCompaction)(nil),                    // 28: etcdserverpb.PrefixCompaction
+	(*CompactionResponse)(nil),                  // 29: etcdserverpb.CompactionResponse
+	(*HashRequest)(nil),                         // 30: etcdserverpb.HashRequest
+	(*HashKVRequest)(nil),                       // 31: etcdserverpb.HashKVRequest
+	(*HashKVResponse)(nil),                      // 32: etcdserverpb.HashKVResponse
+	(*HashResponse)(nil),                        // 33: etcdserverpb.HashResponse
+	(*SnapshotRequest)(nil),                     // 34: etcdserverpb.SnapshotRequest
+	(*SnapshotResponse)(nil),                    // 35: etcdserverpb.SnapshotResponse
+	(*WatchRequest)(nil),                        // 36: etcdserverpb.WatchRequest
+	(*WatchCreateRequest)(nil),                  // 37: etcdserverpb.WatchCreateRequest
+	(*KeyRange)(nil),                            // 38: etcdserverpb.KeyRange
+	(*WatchCancelRequest)(nil),                  // 39: etcdserverpb.WatchCancelRequest
+	(*WatchProgressRequest)(nil),                // 40: etcdserverpb.WatchProgressRequest
+	(*WatchResponse)(nil),                       // 41: etcdserverpb.WatchResponse
+	(*SubscriptionRequest)(nil),                 // 42: etcdserverpb.SubscriptionRequest
+	(*Subscription)(nil),                        // 43: etcdserverpb.Subscription
+	(*SubscriptionResponse)(nil),                // 44: etcdserverpb.SubscriptionResponse
+	(*LeaseGrantRequest)(nil),                   // 45: etcdserverpb.LeaseGrantRequest
+	(*LeaseGrantResponse)(nil),                  // 46: etcdserverpb.LeaseGrantResponse
+	(*LeaseRevokeRequest)(nil),                  // 47: etcdserverpb.LeaseRevokeRequest
+	(*LeaseRevokeResponse)(nil),                 // 48: etcdserverpb.LeaseRevokeResponse
+	(*LeaseRevokeBatchRequest)(nil),             // 49: etcdserverpb.LeaseRevokeBatchRequest
+	(*LeaseRevokeBatchResponse)(nil),            // 50: etcdserverpb.LeaseRevokeBatchResponse
+	(*LeaseUpdateTTLRequest)(nil),               // 51: etcdserverpb.LeaseUpdateTTLRequest
+	(*LeaseUpdateTTLResponse)(nil),              // 52: etcdserverpb.LeaseUpdateTTLResponse
+	(*LeaseCheckpoint)(nil),                     // 53: etcdserverpb.LeaseCheckpoint
+	(*LeaseCheckpointRequest)(nil),              // 54: etcdserverpb.LeaseCheckpointRequest
+	(*LeaseCheckpointResponse)(nil),             // 55: etcdserverpb.LeaseCheckpointResponse
+	(*LeaseKeepAliveRequest)(nil),               // 56: etcdserverpb.LeaseKeepAliveRequest
+	(*LeaseKeepAliveResponse)(nil),              // 57: etcdserverpb.LeaseKeepAliveResponse
+	(*LeaseTimeToLiveRequest)(nil),              // 58: etcdserverpb.LeaseTimeToLiveRequest
+	(*LeaseTimeToLiveResponse)(nil),             // 59: etcdserverpb.LeaseTimeToLiveResponse
+	(*LeaseLeasesRequest)(nil),                  // 60: etcdserverpb.LeaseLeasesRequest
+	(*LeaseStatus)(nil),                         // 61: etcdserverpb.LeaseStatus
+	(*LeaseLeasesResponse)(nil),                 // 62: etcdserverpb.LeaseLeasesResponse
+	(*LeaseWatchRequest)(nil),                   // 63: etcdserverpb.LeaseWatchRequest
+	(*LeaseEvent)(nil),                          // 64: etcdserverpb.LeaseEvent
+	(*LeaseWatchResponse)(nil),                  // 65: etcdserverpb.LeaseWatchResponse
+	(*Member)(nil),                              // 66: etcdserverpb.Member
+	(*MemberAddRequest)(nil),                    // 67: etcdserverpb.MemberAddRequest
+	(*MemberAddResponse)(nil),                   // 68: etcdserverpb.MemberAddResponse
+	(*MemberRemoveRequest)(nil),                 // 69: etcdserverpb.MemberRemoveRequest
+	(*MemberRemoveResponse)(nil),                // 70: etcdserverpb.MemberRemoveResponse
+	(*MemberUpdateRequest)(nil),                 // 71: etcdserverpb.MemberUpdateRequest
+	(*MemberUpdateResponse)(nil),                // 72: etcdserverpb.MemberUpdateResponse
+	(*MemberListRequest)(nil),                   // 73: etcdserverpb.MemberListRequest
+	(*MemberListResponse)(nil),                  // 74: etcdserverpb.MemberListResponse
+	(*MemberPromoteRequest)(nil),                // 75: etcdserverpb.MemberPromoteRequest
+	(*MemberPromoteResponse)(nil),               // 76: etcdserverpb.MemberPromoteResponse
+	(*DefragmentRequest)(nil),                   // 77: etcdserverpb.DefragmentRequest
+	(*DefragmentResponse)(nil),                  // 78: etcdserverpb.DefragmentResponse
+	(*MoveLeaderRequest)(nil),                   // 79: etcdserverpb.MoveLeaderRequest
+	(*MoveLeaderResponse)(nil),                  // 80: etcdserverpb.MoveLeaderResponse
+	(*AlarmRequest)(nil),                        // 81: etcdserverpb.AlarmRequest
+	(*AlarmMember)(nil),                         // 82: etcdserverpb.AlarmMember
+	(*AlarmResponse)(nil),                       // 83: etcdserverpb.AlarmResponse
+	(*RevisionHoldRequest)(nil),                 // 84: etcdserverpb.RevisionHoldRequest
+	(*RevisionHold)(nil),                        // 85: etcdserverpb.RevisionHold
+	(*RevisionHoldResponse)(nil),                // 86: etcdserverpb.RevisionHoldResponse
+	(*CompactionPolicyRequest)(nil),             // 87: etcdserverpb.CompactionPolicyRequest
+	(*CompactionRetention)(nil),                 // 88: etcdserverpb.CompactionRetention
+	(*CompactionPolicyResponse)(nil),            // 89: etcdserverpb.CompactionPolicyResponse
+	(*WatchersRequest)(nil),                     // 90: etcdserverpb.WatchersRequest
+	(*WatcherInfo)(nil),                         // 91: etcdserverpb.WatcherInfo
+	(*WatchersResponse)(nil),                    // 92: etcdserverpb.WatchersResponse
+	(*DowngradeRequest)(nil),                    // 93: etcdserverpb.DowngradeRequest
+	(*DowngradeResponse)(nil),                   // 94: etcdserverpb.DowngradeResponse
+	(*DowngradeVersionTestRequest)(nil),         // 95: etcdserverpb.DowngradeVersionTestRequest
+	(*StatusRequest)(nil),                       // 96: etcdserverpb.StatusRequest
+	(*StatusResponse)(nil),                      // 97: etcdserverpb.StatusResponse
+	(*DowngradeInfo)(nil),                       // 98: etcdserverpb.DowngradeInfo
+	(*AuthEnableRequest)(nil),                   // 99: etcdserverpb.AuthEnableRequest
+	(*AuthDisableRequest)(nil),                  // 100: etcdserverpb.AuthDisableRequest
+	(*AuthStatusRequest)(nil),                   // 101: etcdserverpb.AuthStatusRequest
+	(*AuthenticateRequest)(nil),                 // 102: etcdserverpb.AuthenticateRequest
+	(*AuthUserAddRequest)(nil),                  // 103: etcdserverpb.AuthUserAddRequest
+	(*AuthUserGetRequest)(nil),                  // 104: etcdserverpb.AuthUserGetRequest
+	(*AuthUserDeleteRequest)(nil),               // 105: etcdserverpb.AuthUserDeleteRequest
+	(*AuthUserChangePasswordRequest)(nil),       // 106: etcdserverpb.AuthUserChangePasswordRequest
+	(*AuthUserGrantRoleRequest)(nil),            // 107: etcdserverpb.AuthUserGrantRoleRequest
+	(*AuthUserRevokeRoleRequest)(nil),           // 108: etcdserverpb.AuthUserRevokeRoleRequest
+	(*AuthRoleAddRequest)(nil),                  // 109: etcdserverpb.AuthRoleAddRequest
+	(*AuthRoleGetRequest)(nil),                  // 110: etcdserverpb.AuthRoleGetRequest
+	(*AuthUserListRequest)(nil),                 // 111: etcdserverpb.AuthUserListRequest
+	(*AuthRoleListRequest)(nil),                 // 112: etcdserverpb.AuthRoleListRequest
+	(*AuthRoleDeleteRequest)(nil),               // 113: etcdserverpb.AuthRoleDeleteRequest
+	(*AuthRoleGrantPermissionRequest)(nil),      // 114: etcdserverpb.AuthRoleGrantPermissionRequest
+	(*AuthRoleRevokePermissionRequest)(nil),     // 115: etcdserverpb.AuthRoleRevokePermissionRequest
+	(*AuthEnableResponse)(nil),                  // 116: etcdserverpb.AuthEnableResponse
+	(*AuthDisableResponse)(nil),                 // 117: etcdserverpb.AuthDisableResponse
+	(*AuthStatusResponse)(nil),                  // 118: etcdserverpb.AuthStatusResponse
+	(*AuthenticateResponse)(nil),                // 119: etcdserverpb.AuthenticateResponse
+	(*AuthUserAddResponse)(nil),                 // 120: etcdserverpb.AuthUserAddResponse
+	(*AuthUserGetResponse)(nil),                 // 121: etcdserverpb.AuthUserGetResponse
+	(*AuthUserDeleteResponse)(nil),              // 122: etcdserverpb.AuthUserDeleteResponse
+	(*AuthUserChangePasswordResponse)(nil),      // 123: etcdserverpb.AuthUserChangePasswordResponse
+	(*AuthUserGrantRoleResponse)(nil),           // 124: etcdserverpb.AuthUserGrantRoleResponse
+	(*AuthUserRevokeRoleResponse)(nil),          // 125: etcdserverpb.AuthUserRevokeRoleResponse
+	(*AuthRoleAddResponse)(nil),                 // 126: etcdserverpb.AuthRoleAddResponse
+	(*AuthRoleGetResponse)(nil),                 // 127: etcdserverpb.AuthRoleGetResponse
+	(*AuthRoleListResponse)(nil),                // 128: etcdserverpb.AuthRoleListResponse
+	(*AuthUserListResponse)(nil),                // 129: etcdserverpb.AuthUserListResponse
+	(*AuthRoleDeleteResponse)(nil),              // 130: etcdserverpb.AuthRoleDeleteResponse
+	(*AuthRoleGrantPermissionResponse)(nil),     // 131: etcdserverpb.AuthRoleGrantPermissionResponse
+	(*AuthRoleRevokePermissionResponse)(nil),    // 132: etcdserverpb.AuthRoleRevokePermissionResponse
+	(*RangeStreamResponse)(nil),                 // 133: etcdserverpb.RangeStreamResponse
+	(*KeyHistoryRequest)(nil),                   // 134: etcdserverpb.KeyHistoryRequest
+	(*KeyHistoryResponse)(nil),                  // 135: etcdserverpb.KeyHistoryResponse
+	(*mvccpb.KeyValue)(nil),                     // 136: mvccpb.KeyValue
+	(*mvccpb.Event)(nil),                        // 137: mvccpb.Event
+	(*authpb.UserAddOptions)(nil),               // 138: authpb.UserAddOptions
+	(*authpb.Permission)(nil),                   // 139: authpb.Permission
 }
 var file_rpc_proto_depIdxs = []int32{
 	1,   // 0: etcdserverpb.RangeRequest.sort_order:type_name -> etcdserverpb.RangeRequest.SortOrder
 	2,   // 1: etcdserverpb.RangeRequest.sort_target:type_name -> etcdserverpb.RangeRequest.SortTarget
-	8,   // 2: etcdserverpb.RangeResponse.header:type_name -> etcdserverpb.ResponseHeader
-	106, // 3: etcdserverpb.RangeResponse.kvs:type_name -> mvccpb.KeyValue
-	8,   // 4: etcdserverpb.PutResponse.header:type_name -> etcdserverpb.ResponseHeader
-	106, // 5: etcdserverpb.PutResponse.prev_kv:type_name -> mvccpb.KeyValue
-	8,   // 6: etcdserverpb.DeleteRangeResponse.header:type_name -> etcdserverpb.ResponseHeader
-	106, // 7: etcdserverpb.DeleteRangeResponse.prev_kvs:type_name -> mvccpb.KeyValue
-	9,   // 8: etcdserverpb.RequestOp.request_range:type_name -> etcdserverpb.RangeRequest
-	11,  // 9: etcdserverpb.RequestOp.request_put:type_name -> etcdserverpb.PutRequest
-	13,  // 10: etcdserverpb.RequestOp.request_delete_range:type_name -> etcdserverpb.DeleteRangeRequest
-	18,  // 11: etcdserverpb.RequestOp.request_txn:type_name -> etcdserverpb.TxnRequest
-	10,  // 12: etcdserverpb.ResponseOp.response_range:type_name -> etcdserverpb.RangeResponse
-	12,  // 13: etcdserverpb.ResponseOp.response_put:type_name -> etcdserverpb.PutResponse
-	14,  // 14: etcdserverpb.ResponseOp.response_delete_range:type_name -> etcdserverpb.DeleteRangeResponse
-	19,  // 15: etcdserverpb.ResponseOp.response_txn:type_name -> etcdserverpb.TxnResponse
-	3,   // 16: etcdserverpb.Compare.result:type_name -> etcdserverpb.Compare.CompareResult
-	4,   // 17: etcdserverpb.Compare.target:type_name -> etcdserverpb.Compare.CompareTarget
-	17,  // 18: etcdserverpb.TxnRequest.compare:type_name -> etcdserverpb.Compare
-	15,  // 19: etcdserverpb.TxnRequest.success:type_name -> etcdserverpb.RequestOp
-	15,  // 20: etcdserverpb.TxnRequest.failure:type_name -> etcdserverpb.RequestOp
-	8,   // 21: etcdserverpb.TxnResponse.header:type_name -> etcdserverpb.ResponseHeader
-	16,  // 22: etcdserverpb.TxnResponse.responses:type_name -> etcdserverpb.ResponseOp
-	8,   // 23: etcdserverpb.CompactionResponse.header:type_name -> etcdserverpb.ResponseHeader
-	8,   // 24: etcdserverpb.HashKVResponse.header:type_name -> etcdserverpb.ResponseHeader
-	8,   // 25: etcdserverpb.HashResponse.header:type_name -> etcdserverpb.ResponseHeader
-	8,   // 26: etcdserverpb.SnapshotResponse.header:type_name -> etcdserverpb.ResponseHeader
-	29,  // 27: etcdserverpb.WatchRequest.create_request:type_name -> etcdserverpb.WatchCreateRequest
-	30,  // 28: etcdserverpb.WatchRequest.cancel_request:type_name -> etcdserverpb.WatchCancelRequest
-	31,  // 29: etcdserverpb.WatchRequest.progress_request:type_name -> etcdserverpb.WatchProgressRequest
-	5,   // 30: etcdserverpb.WatchCreateRequest.filters:type_name -> etcdserverpb.WatchCreateRequest.FilterType
-	8,   // 31: etcdserverpb.WatchResponse.header:type_name -> etcdserverpb.ResponseHeader
-	107, // 32: etcdserverpb.WatchResponse.events:type_name -> mvccpb.Event
-	8,   // 33: etcdserverpb.LeaseGrantResponse.header:type_name -> etcdserverpb.ResponseHeader
-	8,   // 34: etcdserverpb.LeaseRevokeResponse.header:type_name -> etcdserverpb.ResponseHeader
-	37,  // 35: etcdserverpb.LeaseCheckpointRequest.checkpoints:type_name -> etcdserverpb.LeaseCheckpoint
-	8,   // 36: etcdserverpb.LeaseCheckpointResponse.header:type_name -> etcdserverpb.ResponseHeader
-	8,   // 37: etcdserverpb.LeaseKeepAliveResponse.header:type_name -> etcdserverpb.ResponseHeader
-	8,   // 38: etcdserverpb.LeaseTimeToLiveResponse.header:type_name -> etcdserverpb.ResponseHeader
-	8,   // 39: etcdserverpb.LeaseLeasesResponse.header:type_name -> etcdserverpb.ResponseHeader
-	45,  // 40: etcdserverpb.LeaseLeasesResponse.leases:type_name -> etcdserverpb.LeaseStatus
-	8,   // 41: etcdserverpb.MemberAddResponse.header:type_name -> etcdserverpb.ResponseHeader
-	47,  // 42: etcdserverpb.MemberAddResponse.member:type_name -> etcdserverpb.Member
-	47,  // 43: etcdserverpb.MemberAddResponse.members:type_name -> etcdserverpb.Member
-	8,   // 44: etcdserverpb.MemberRemoveResponse.header:type_name -> etcdserverpb.ResponseHeader
-	47,  // 45: etcdserverpb.MemberRemoveResponse.members:type_name -> etcdserverpb.Member
-	8,   // 46: etcdserverpb.MemberUpdateResponse.header:type_name -> etcdserverpb.ResponseHeader
-	47,  // 47: etcdserverpb.MemberUpdateResponse.members:type_name -> etcdserverpb.Member
-	8,   // 48: etcdserverpb.MemberListResponse.header:type_name -> etcdserverpb.ResponseHeader
-	47,  // 49: etcdserverpb.MemberListResponse.members:type_name -> etcdserverpb.Member
-	8,   // 50: etcdserverpb.MemberPromoteResponse.header:type_name -> etcdserverpb.ResponseHeader
-	47,  // 51: etcdserverpb.MemberPromoteResponse.members:type_name -> etcdserverpb.Member
-	8,   // 52: etcdserverpb.DefragmentResponse.header:type_name -> etcdserverpb.ResponseHeader
-	8,   // 53: etcdserverpb.MoveLeaderResponse.header:type_name -> etcdserverpb.ResponseHeader
-	6,   // 54: etcdserverpb.AlarmRequest.action:type_name -> etcdserverpb.AlarmRequest.AlarmAction
-	0,   // 55: etcdserverpb.AlarmRequest.alarm:type_name -> etcdserverpb.AlarmType
-	0,   // 56: etcdserverpb.AlarmMember.alarm:type_name -> etcdserverpb.AlarmType
-	8,   // 57: etcdserverpb.AlarmResponse.header:type_name -> etcdserverpb.ResponseHeader
-	63,  // 58: etcdserverpb.AlarmResponse.alarms:type_name -> etcdserverpb.AlarmMember
-	7,   // 59: etcdserverpb.DowngradeRequest.action:type_name -> etcdserverpb.DowngradeRequest.DowngradeAction
-	8,   // 60: etcdserverpb.DowngradeResponse.header:type_name -> etcdserverpb.ResponseHeader
-	8,   // 61: etcdserverpb.StatusResponse.header:type_name -> etcdserverpb.ResponseHeader
-	70,  // 62: etcdserverpb.StatusResponse.downgradeInfo:type_name -> etcdserverpb.DowngradeInfo
-	108, // 63: etcdserverpb.AuthUserAddRequest.options:type_name -> authpb.UserAddOptions
-	109, // 64: etcdserverpb.AuthRoleGrantPermissionRequest.perm:type_name -> authpb.Permission
-	8,   // 65: etcdserverpb.AuthEnableResponse.header:type_name -> etcdserverpb.ResponseHeader
-	8,   // 66: etcdserverpb.AuthDisableResponse.header:type_name -> etcdserverpb.ResponseHeader
-	8,   // 67: etcdserverpb.AuthStatusResponse.header:type_name -> etcdserverpb.ResponseHeader
-	8,   // 68: etcdserverpb.AuthenticateResponse.header:type_name -> etcdserverpb.ResponseHeader
-	8,   // 69: etcdserverpb.AuthUserAddResponse.header:type_name -> etcdserverpb.ResponseHeader
-	8,   // 70: etcdserverpb.AuthUserGetResponse.header:type_name -> etcdserverpb.ResponseHeader
-	8,   // 71: etcdserverpb.AuthUserDeleteResponse.header:type_name -> etcdserverpb.ResponseHeader
-	8,   // 72: etcdserverpb.AuthUserChangePasswordResponse.header:type_name -> etcdserverpb.ResponseHeader
-	8,   // 73: etcdserverpb.AuthUserGrantRoleResponse.header:type_name -> etcdserverpb.ResponseHeader
-	8,   // 74: etcdserverpb.AuthUserRevokeRoleResponse.header:type_name -> etcdserverpb.ResponseHeader
-	8,   // 75: etcdserverpb.AuthRoleAddResponse.header:type_name -> etcdserverpb.ResponseHeader
-	8,   // 76: etcdserverpb.AuthRoleGetResponse.header:type_name -> etcdserverpb.ResponseHeader
-	109, // 77: etcdserverpb.AuthRoleGetResponse.perm:type_name -> authpb.Permission
-	8,   // 78: etcdserverpb.AuthRoleListResponse.header:type_name -> etcdserverpb.ResponseHeader
-	8,   // 79: etcdserverpb.AuthUserListResponse.header:type_name -> etcdserverpb.ResponseHeader
-	8,   // 80: etcdserverpb.AuthRoleDeleteResponse.header:type_name -> etcdserverpb.ResponseHeader
-	8,   // 81: etcdserverpb.AuthRoleGrantPermissionResponse.header:type_name -> etcdserverpb.ResponseHeader
-	8,   // 82: etcdserverpb.AuthRoleRevokePermissionResponse.header:type_name -> etcdserverpb.ResponseHeader
-	10,  // 83: etcdserverpb.RangeStreamResponse.range_response:type_name -> etcdserverpb.RangeResponse
-	9,   // 84: etcdserverpb.KV.Range:input_type -> etcdserverpb.RangeRequest
-	9,   // 85: etcdserverpb.KV.RangeStream:input_type -> etcdserverpb.RangeRequest
-	11,  // 86: etcdserverpb.KV.Put:input_type -> etcdserverpb.PutRequest
-	13,  // 87: etcdserverpb.KV.DeleteRange:input_type -> etcdserverpb.DeleteRangeRequest
-	18,  // 88: etcdserverpb.KV.Txn:input_type -> etcdserverpb.TxnRequest
-	20,  // 89: etcdserverpb.KV.Compact:input_type -> etcdserverpb.CompactionRequest
-	28,  // 90: etcdserverpb.Watch.Watch:input_type -> etcdserverpb.WatchRequest
-	33,  // 91: etcdserverpb.Lease.LeaseGrant:input_type -> etcdserverpb.LeaseGrantRequest
-	35,  // 92: etcdserverpb.Lease.LeaseRevoke:input_type -> etcdserverpb.LeaseRevokeRequest
-	40,  // 93: etcdserverpb.Lease.LeaseKeepAlive:input_type -> etcdserverpb.LeaseKeepAliveRequest
-	42,  // 94: etcdserverpb.Lease.LeaseTimeToLive:input_type -> etcdserverpb.LeaseTimeToLiveRequest
-	44,  // 95: etcdserverpb.Lease.LeaseLeases:input_type -> etcdserverpb.LeaseLeasesRequest
-	48,  // 96: etcdserverpb.Cluster.MemberAdd:input_type -> etcdserverpb.MemberAddRequest
-	50,  // 97: etcdserverpb.Cluster.MemberRemove:input_type -> etcdserverpb.MemberRemoveRequest
-	52,  // 98: etcdserverpb.Cluster.MemberUpdate:input_type -> etcdserverpb.MemberUpdateRequest
-	54,  // 99: etcdserverpb.Cluster.MemberList:input_type -> etcdserverpb.MemberListRequest
-	56,  // 100: etcdserverpb.Cluster.MemberPromote:input_type -> etcdserverpb.MemberPromoteRequest
-	62,  // 101: etcdserverpb.Maintenance.Alarm:input_type -> etcdserverpb.AlarmRequest
-	68,  // 102: etcdserverpb.Maintenance.Status:input_type -> etcdserverpb.StatusRequest
-	58,  // 103: etcdserverpb.Maintenance.Defragment:input_type -> etcdserverpb.DefragmentRequest
-	22,  // 104: etcdserverpb.Maintenance.Hash:input_type -> etcdserverpb.HashRequest
-	23,  // 105: etcdserverpb.Maintenance.HashKV:input_type -> etcdserverpb.HashKVRequest
-	26,  // 106: etcdserverpb.Maintenance.Snapshot:input_type -> etcdserverpb.SnapshotRequest
-	60,  // 107: etcdserverpb.Maintenance.MoveLeader:input_type -> etcdserverpb.MoveLeaderRequest
-	65,  // 108: etcdserverpb.Maintenance.Downgrade:input_type -> etcdserverpb.DowngradeRequest
-	71,  // 109: etcdserverpb.Auth.AuthEnable:input_type -> etcdserverpb.AuthEnableRequest
-	72,  // 110: etcdserverpb.Auth.AuthDisable:input_type -> etcdserverpb.AuthDisableRequest
-	73,  // 111: etcdserverpb.Auth.AuthStatus:input_type -> etcdserverpb.AuthStatusRequest
-	74,  // 112: etcdserverpb.Auth.Authenticate:input_type -> etcdserverpb.AuthenticateRequest
-	75,  // 113: etcdserverpb.Auth.UserAdd:input_type -> etcdserverpb.AuthUserAddRequest
-	76,  // 114: etcdserverpb.Auth.UserGet:input_type -> etcdserverpb.AuthUserGetRequest
-	83,  // 115: etcdserverpb.Auth.UserList:input_type -> etcdserverpb.AuthUserListRequest
-	77,  // 116: etcdserverpb.Auth.UserDelete:input_type -> etcdserverpb.AuthUserDeleteRequest
-	78,  // 117: etcdserverpb.Auth.UserChangePassword:input_type -> etcdserverpb.AuthUserChangePasswordRequest
-	79,  // 118: etcdserverpb.Auth.UserGrantRole:input_type -> etcdserverpb.AuthUserGrantRoleRequest
-	80,  // 119: etcdserverpb.Auth.UserRevokeRole:input_type -> etcdserverpb.AuthUserRevokeRoleRequest
-	81,  // 120: etcdserverpb.Auth.RoleAdd:input_type -> etcdserverpb.AuthRoleAddRequest
-	82,  // 121: etcdserverpb.Auth.RoleGet:input_type -> etcdserverpb.AuthRoleGetRequest
-	84,  // 122: etcdserverpb.Auth.RoleList:input_type -> etcdserverpb.AuthRoleListRequest
-	85,  // 123: etcdserverpb.Auth.RoleDelete:input_type -> etcdserverpb.AuthRoleDeleteRequest
-	86,  // 124: etcdserverpb.Auth.RoleGrantPermission:input_type -> etcdserverpb.AuthRoleGrantPermissionRequest
-	87,  // 125: etcdserverpb.Auth.RoleRevokePermission:input_type -> etcdserverpb.AuthRoleRevokePermissionRequest
-	10,  // 126: etcdserverpb.KV.Range:output_type -> etcdserverpb.RangeResponse
-	105, // 127: etcdserverpb.KV.RangeStream:output_type -> etcdserverpb.RangeStreamResponse
-	12,  // 128: etcdserverpb.KV.Put:output_type -> etcdserverpb.PutResponse
-	14,  // 129: etcdserverpb.KV.DeleteRange:output_type -> etcdserverpb.DeleteRangeResponse
-	19,  // 130: etcdserverpb.KV.Txn:output_type -> etcdserverpb.TxnResponse
-	21,  // 131: etcdserverpb.KV.Compact:output_type -> etcdserverpb.CompactionResponse
-	32,  // 132: etcdserverpb.Watch.Watch:output_type -> etcdserverpb.WatchResponse
-	34,  // 133: etcdserverpb.Lease.LeaseGrant:output_type -> etcdserverpb.LeaseGrantResponse
-	36,  // 134: etcdserverpb.Lease.LeaseRevoke:output_type -> etcdserverpb.LeaseRevokeResponse
-	41,  // 135: etcdserverpb.Lease.LeaseKeepAlive:output_type -> etcdserverpb.LeaseKeepAliveResponse
-	43,  // 136: etcdserverpb.Lease.LeaseTimeToLive:output_type -> etcdserverpb.LeaseTimeToLiveResponse
-	46,  // 137: etcdserverpb.Lease.LeaseLeases:output_type -> etcdserverpb.LeaseLeasesResponse
-	49,  // 138: etcdserverpb.Cluster.MemberAdd:output_type -> etcdserverpb.MemberAddResponse
-	51,  // 139: etcdserverpb.Cluster.MemberRemove:output_type -> etcdserverpb.MemberRemoveResponse
-	53,  // 140: etcdserverpb.Cluster.MemberUpdate:output_type -> etcdserverpb.MemberUpdateResponse
-	55,  // 141: etcdserverpb.Cluster.MemberList:output_type -> etcdserverpb.MemberListResponse
-	57,  // 142: etcdserverpb.Cluster.MemberPromote:output_type -> etcdserverpb.MemberPromoteResponse
-	64,  // 143: etcdserverpb.Maintenance.Alarm:output_type -> etcdserverpb.AlarmResponse
-	69,  // 144: etcdserverpb.Maintenance.Status:output_type -> etcdserverpb.StatusResponse
-	59,  // 145: etcdserverpb.Maintenance.Defragment:output_type -> etcdserverpb.DefragmentResponse
-	25,  // 146: etcdserverpb.Maintenance.Hash:output_type -> etcdserverpb.HashResponse
-	24,  // 147: etcdserverpb.Maintenance.HashKV:output_type -> etcdserverpb.HashKVResponse
-	27,  // 148: etcdserverpb.Maintenance.Snapshot:output_type -> etcdserverpb.SnapshotResponse
-	61,  // 149: etcdserverpb.Maintenance.MoveLeader:output_type -> etcdserverpb.MoveLeaderResponse
-	66,  // 150: etcdserverpb.Maintenance.Downgrade:output_type -> etcdserverpb.DowngradeResponse
-	88,  // 151: etcdserverpb.Auth.AuthEnable:output_type -> etcdserverpb.AuthEnableResponse
-	89,  // 152: etcdserverpb.Auth.AuthDisable:output_type -> etcdserverpb.AuthDisableResponse
-	90,  // 153: etcdserverpb.Auth.AuthStatus:output_type -> etcdserverpb.AuthStatusResponse
-	91,  // 154: etcdserverpb.Auth.Authenticate:output_type -> etcdserverpb.AuthenticateResponse
-	92,  // 155: etcdserverpb.Auth.UserAdd:output_type -> etcdserverpb.AuthUserAddResponse
-	93,  // 156: etcdserverpb.Auth.UserGet:output_type -> etcdserverpb.AuthUserGetResponse
-	101, // 157: etcdserverpb.Auth.UserList:output_type -> etcdserverpb.AuthUserListResponse
-	94,  // 158: etcdserverpb.Auth.UserDelete:output_type -> etcdserverpb.AuthUserDeleteResponse
-	95,  // 159: etcdserverpb.Auth.UserChangePassword:output_type -> etcdserverpb.AuthUserChangePasswordResponse
-	96,  // 160: etcdserverpb.Auth.UserGrantRole:output_type -> etcdserverpb.AuthUserGrantRoleResponse
-	97,  // 161: etcdserverpb.Auth.UserRevokeRole:output_type -> etcdserverpb.AuthUserRevokeRoleResponse
-	98,  // 162: etcdserverpb.Auth.RoleAdd:output_type -> etcdserverpb.AuthRoleAddResponse
-	99,  // 163: etcdserverpb.Auth.RoleGet:output_type -> etcdserverpb.AuthRoleGetResponse
-	100, // 164: etcdserverpb.Auth.RoleList:output_type -> etcdserverpb.AuthRoleListResponse
-	102, // 165: etcdserverpb.Auth.RoleDelete:output_type -> etcdserverpb.AuthRoleDeleteResponse
-	103, // 166: etcdserverpb.Auth.RoleGrantPermission:output_type -> etcdserverpb.AuthRoleGrantPermissionResponse
-	104, // 167: etcdserverpb.Auth.RoleRevokePermission:output_type -> etcdserverpb.AuthRoleRevokePermissionResponse
-	126, // [126:168] is the sub-list for method output_type
-	84,  // [84:126] is the sub-list for method input_type
-	84,  // [84:84] is the sub-list for extension type_name
-	84,  // [84:84] is the sub-list for extension extendee
-	0,   // [0:84] is the sub-list for field type_name
+	3,   // 2: etcdserverpb.RangeRequest.lease_filter:type_name -> etcdserverpb.RangeRequest.LeaseFilter
+	13,  // 3: etcdserverpb.RangeResponse.header:type_name -> etcdserverpb.ResponseHeader
+	136, // 4: etcdserverpb.RangeResponse.kvs:type_name -> mvccpb.KeyValue
+	13,  // 5: etcdserverpb.PutResponse.header:type_name -> etcdserverpb.ResponseHeader
+	136, // 6: etcdserverpb.PutResponse.prev_kv:type_name -> mvccpb.KeyValue
+	4,   // 7: etcdserverpb.IncrementRequest.encoding:type_name -> etcdserverpb.IncrementRequest.Encoding
+	13,  // 8: etcdserverpb.IncrementResponse.header:type_name -> etcdserverpb.ResponseHeader
+	136, // 9: etcdserverpb.IncrementResponse.prev_kv:type_name -> mvccpb.KeyValue
+	13,  // 10: etcdserverpb.DeleteRangeResponse.header:type_name -> etcdserverpb.ResponseHeader
+	136, // 11: etcdserverpb.DeleteRangeResponse.prev_kvs:type_name -> mvccpb.KeyValue
+	14,  // 12: etcdserverpb.RequestOp.request_range:type_name -> etcdserverpb.RangeRequest
+	16,  // 13: etcdserverpb.RequestOp.request_put:type_name -> etcdserverpb.PutRequest
+	20,  // 14: etcdserverpb.RequestOp.request_delete_range:type_name -> etcdserverpb.DeleteRangeRequest
+	25,  // 15: etcdserverpb.RequestOp.request_txn:type_name -> etcdserverpb.TxnRequest
+	18,  // 16: etcdserverpb.RequestOp.request_increment:type_name -> etcdserverpb.IncrementRequest
+	15,  // 17: etcdserverpb.ResponseOp.response_range:type_name -> etcdserverpb.RangeResponse
+	17,  // 18: etcdserverpb.ResponseOp.response_put:type_name -> etcdserverpb.PutResponse
+	21,  // 19: etcdserverpb.ResponseOp.response_delete_range:type_name -> etcdserverpb.DeleteRangeResponse
+	26,  // 20: etcdserverpb.ResponseOp.response_txn:type_name -> etcdserverpb.TxnResponse
+	19,  // 21: etcdserverpb.ResponseOp.response_increment:type_name -> etcdserverpb.IncrementResponse
+	5,   // 22: etcdserverpb.Compare.result:type_name -> etcdserverpb.Compare.CompareResult
+	6,   // 23: etcdserverpb.Compare.target:type_name -> etcdserverpb.Compare.CompareTarget
+	24,  // 24: etcdserverpb.TxnRequest.compare:type_name -> etcdserverpb.Compare
+	22,  // 25: etcdserverpb.TxnRequest.success:type_name -> etcdserverpb.RequestOp
+	22,  // 26: etcdserverpb.TxnRequest.failure:type_name -> etcdserverpb.RequestOp
+	13,  // 27: etcdserverpb.TxnResponse.header:type_name -> etcdserverpb.ResponseHeader
+	23,  // 28: etcdserverpb.TxnResponse.responses:type_name -> etcdserverpb.ResponseOp
+	28,  // 29: etcdserverpb.CompactionRequest.prefixes:type_name -> etcdserverpb.PrefixCompaction
+	13,  // 30: etcdserverpb.CompactionResponse.header:type_name -> etcdserverpb.ResponseHeader
+	13,  // 31: etcdserverpb.HashKVResponse.header:type_name -> etcdserverpb.ResponseHeader
+	13,  // 32: etcdserverpb.HashResponse.header:type_name -> etcdserverpb.ResponseHeader
+	13,  // 33: etcdserverpb.SnapshotResponse.header:type_name -> etcdserverpb.ResponseHeader
+	37,  // 34: etcdserverpb.WatchRequest.create_request:type_name -> etcdserverpb.WatchCreateRequest
+	39,  // 35: etcdserverpb.WatchRequest.cancel_request:type_name -> etcdserverpb.WatchCancelRequest
+	40,  // 36: etcdserverpb.WatchRequest.progress_request:type_name -> etcdserverpb.WatchProgressRequest
+	7,   // 37: etcdserverpb.WatchCreateRequest.filters:type_name -> etcdserverpb.WatchCreateRequest.FilterType
+	38,  // 38: etcdserverpb.WatchCreateRequest.ranges:type_name -> etcdserverpb.KeyRange
+	13,  // 39: etcdserverpb.WatchResponse.header:type_name -> etcdserverpb.ResponseHeader
+	137, // 40: etcdserverpb.WatchResponse.events:type_name -> mvccpb.Event
+	8,   // 41: etcdserverpb.SubscriptionRequest.action:type_name -> etcdserverpb.SubscriptionRequest.SubscriptionAction
+	13,  // 42: etcdserverpb.SubscriptionResponse.header:type_name -> etcdserverpb.ResponseHeader
+	43,  // 43: etcdserverpb.SubscriptionResponse.subscriptions:type_name -> etcdserverpb.Subscription
+	13,  // 44: etcdserverpb.LeaseGrantResponse.header:type_name -> etcdserverpb.ResponseHeader
+	13,  // 45: etcdserverpb.LeaseRevokeResponse.header:type_name -> etcdserverpb.ResponseHeader
+	13,  // 46: etcdserverpb.LeaseRevokeBatchResponse.header:type_name -> etcdserverpb.ResponseHeader
+	13,  // 47: etcdserverpb.LeaseUpdateTTLResponse.header:type_name -> etcdserverpb.ResponseHeader
+	53,  // 48: etcdserverpb.LeaseCheckpointRequest.checkpoints:type_name -> etcdserverpb.LeaseCheckpoint
+	13,  // 49: etcdserverpb.LeaseCheckpointResponse.header:type_name -> etcdserverpb.ResponseHeader
+	13,  // 50: etcdserverpb.LeaseKeepAliveResponse.header:type_name -> etcdserverpb.ResponseHeader
+	13,  // 51: etcdserverpb.LeaseTimeToLiveResponse.header:type_name -> etcdserverpb.ResponseHeader
+	13,  // 52: etcdserverpb.LeaseLeasesResponse.header:type_name -> etcdserverpb.ResponseHeader
+	61,  // 53: etcdserverpb.LeaseLeasesResponse.leases:type_name -> etcdserverpb.LeaseStatus
+	9,   // 54: etcdserverpb.LeaseEvent.type:type_name -> etcdserverpb.LeaseEvent.EventType
+	13,  // 55: etcdserverpb.LeaseWatchResponse.header:type_name -> etcdserverpb.ResponseHeader
+	64,  // 56: etcdserverpb.LeaseWatchResponse.events:type_name -> etcdserverpb.LeaseEvent
+	13,  // 57: etcdserverpb.MemberAddResponse.header:type_name -> etcdserverpb.ResponseHeader
+	66,  // 58: etcdserverpb.MemberAddResponse.member:type_name -> etcdserverpb.Member
+	66,  // 59: etcdserverpb.MemberAddResponse.members:type_name -> etcdserverpb.Member
+	13,  // 60: etcdserverpb.MemberRemoveResponse.header:type_name -> etcdserverpb.ResponseHeader
+	66,  // 61: etcdserverpb.MemberRemoveResponse.members:type_name -> etcdserverpb.Member
+	13,  // 62: etcdserverpb.MemberUpdateResponse.header:type_name -> etcdserverpb.ResponseHeader
+	66,  // 63: etcdserverpb.MemberUpdateResponse.members:type_name -> etcdserverpb.Member
+	13,  // 64: etcdserverpb.MemberListResponse.header:type_name -> etcdserverpb.ResponseHeader
+	66,  // 65: etcdserverpb.MemberListResponse.members:type_name -> etcdserverpb.Member
+	13,  // 66: etcdserverpb.MemberPromoteResponse.header:type_name -> etcdserverpb.ResponseHeader
+	66,  // 67: etcdserverpb.MemberPromoteResponse.members:type_name -> etcdserverpb.Member
+	13,  // 68: etcdserverpb.DefragmentResponse.header:type_name -> etcdserverpb.ResponseHeader
+	13,  // 69: etcdserverpb.MoveLeaderResponse.header:type_name -> etcdserverpb.ResponseHeader
+	10,  // 70: etcdserverpb.AlarmRequest.action:type_name -> etcdserverpb.AlarmRequest.AlarmAction
+	0,   // 71: etcdserverpb.AlarmRequest.alarm:type_name -> etcdserverpb.AlarmType
+	0,   // 72: etcdserverpb.AlarmMember.alarm:type_name -> etcdserverpb.AlarmType
+	13,  // 73: etcdserverpb.AlarmResponse.header:type_name -> etcdserverpb.ResponseHeader
+	82,  // 74: etcdserverpb.AlarmResponse.alarms:type_name -> etcdserverpb.AlarmMember
+	11,  // 75: etcdserverpb.RevisionHoldRequest.action:type_name -> etcdserverpb.RevisionHoldRequest.RevisionHoldAction
+	13,  // 76: etcdserverpb.RevisionHoldResponse.header:type_name -> etcdserverpb.ResponseHeader
+	85,  // 77: etcdserverpb.RevisionHoldResponse.holds:type_name -> etcdserverpb.RevisionHold
+	13,  // 78: etcdserverpb.CompactionPolicyResponse.header:type_name -> etcdserverpb.ResponseHeader
+	88,  // 79: etcdserverpb.CompactionPolicyResponse.retentions:type_name -> etcdserverpb.CompactionRetention
+	38,  // 80: etcdserverpb.WatcherInfo.ranges:type_name -> etcdserverpb.KeyRange
+	13,  // 81: etcdserverpb.WatchersResponse.header:type_name -> etcdserverpb.ResponseHeader
+	91,  // 82: etcdserverpb.WatchersResponse.watchers:type_name -> etcdserverpb.WatcherInfo
+	12,  // 83: etcdserverpb.DowngradeRequest.action:type_name -> etcdserverpb.DowngradeRequest.DowngradeAction
+	13,  // 84: etcdserverpb.DowngradeResponse.header:type_name -> etcdserverpb.ResponseHeader
+	13,  // 85: etcdserverpb.StatusResponse.header:type_name -> etcdserverpb.ResponseHeader
+	98,  // 86: etcdserverpb.StatusResponse.downgradeInfo:type_name -> etcdserverpb.DowngradeInfo
+	138, // 87: etcdserverpb.AuthUserAddRequest.options:type_name -> authpb.UserAddOptions
+	139, // 88: etcdserverpb.AuthRoleGrantPermissionRequest.perm:type_name -> authpb.Permission
+	13,  // 89: etcdserverpb.AuthEnableResponse.header:type_name -> etcdserverpb.ResponseHeader
+	13,  // 90: etcdserverpb.AuthDisableResponse.header:type_name -> etcdserverpb.ResponseHeader
+	13,  // 91: etcdserverpb.AuthStatusResponse.header:type_name -> etcdserverpb.ResponseHeader
+	13,  // 92: etcdserverpb.AuthenticateResponse.header:type_name -> etcdserverpb.ResponseHeader
+	13,  // 93: etcdserverpb.AuthUserAddResponse.header:type_name -> etcdserverpb.ResponseHeader
+	13,  // 94: etcdserverpb.AuthUserGetResponse.header:type_name -> etcdserverpb.ResponseHeader
+	13,  // 95: etcdserverpb.AuthUserDeleteResponse.header:type_name -> etcdserverpb.ResponseHeader
+	13,  // 96: etcdserverpb.AuthUserChangePasswordResponse.header:type_name -> etcdserverpb.ResponseHeader
+	13,  // 97: etcdserverpb.AuthUserGrantRoleResponse.header:type_name -> etcdserverpb.ResponseHeader
+	13,  // 98: etcdserverpb.AuthUserRevokeRoleResponse.header:type_name -> etcdserverpb.ResponseHeader
+	13,  // 99: etcdserverpb.AuthRoleAddResponse.header:type_name -> etcdserverpb.ResponseHeader
+	13,  // 100: etcdserverpb.AuthRoleGetResponse.header:type_name -> etcdserverpb.ResponseHeader
+	139, // 101: etcdserverpb.AuthRoleGetResponse.perm:type_name -> authpb.Permission
+	13,  // 102: etcdserverpb.AuthRoleListResponse.header:type_name -> etcdserverpb.ResponseHeader
+	13,  // 103: etcdserverpb.AuthUserListResponse.header:type_name -> etcdserverpb.ResponseHeader
+	13,  // 104: etcdserverpb.AuthRoleDeleteResponse.header:type_name -> etcdserverpb.ResponseHeader
+	13,  // 105: etcdserverpb.AuthRoleGrantPermissionResponse.header:type_name -> etcdserverpb.ResponseHeader
+	13,  // 106: etcdserverpb.AuthRoleRevokePermissionResponse.header:type_name -> etcdserverpb.ResponseHeader
+	15,  // 107: etcdserverpb.RangeStreamResponse.range_response:type_name -> etcdserverpb.RangeResponse
+	1,   // 108: etcdserverpb.KeyHistoryRequest.sort_order:type_name -> etcdserverpb.RangeRequest.SortOrder
+	13,  // 109: etcdserverpb.KeyHistoryResponse.header:type_name -> etcdserverpb.ResponseHeader
+	136, // 110: etcdserverpb.KeyHistoryResponse.kvs:type_name -> mvccpb.KeyValue
+	14,  // 111: etcdserverpb.KV.Range:input_type -> etcdserverpb.RangeRequest
+	14,  // 112: etcdserverpb.KV.RangeStream:input_type -> etcdserverpb.RangeRequest
+	134, // 113: etcdserverpb.KV.KeyHistory:input_type -> etcdserverpb.KeyHistoryRequest
+	16,  // 114: etcdserverpb.KV.Put:input_type -> etcdserverpb.PutRequest
+	20,  // 115: etcdserverpb.KV.DeleteRange:input_type -> etcdserverpb.DeleteRangeRequest
+	25,  // 116: etcdserverpb.KV.Txn:input_type -> etcdserverpb.TxnRequest
+	27,  // 117: etcdserverpb.KV.Compact:input_type -> etcdserverpb.CompactionRequest
+	36,  // 118: etcdserverpb.Watch.Watch:input_type -> etcdserverpb.WatchRequest
+	42,  // 119: etcdserverpb.Watch.Subscription:input_type -> etcdserverpb.SubscriptionRequest
+	45,  // 120: etcdserverpb.Lease.LeaseGrant:input_type -> etcdserverpb.LeaseGrantRequest
+	47,  // 121: etcdserverpb.Lease.LeaseRevoke:input_type -> etcdserverpb.LeaseRevokeRequest
+	49,  // 122: etcdserverpb.Lease.LeaseRevokeBatch:input_type -> etcdserverpb.LeaseRevokeBatchRequest
+	51,  // 123: etcdserverpb.Lease.LeaseUpdateTTL:input_type -> etcdserverpb.LeaseUpdateTTLRequest
+	56,  // 124: etcdserverpb.Lease.LeaseKeepAlive:input_type -> etcdserverpb.LeaseKeepAliveRequest
+	58,  // 125: etcdserverpb.Lease.LeaseTimeToLive:input_type -> etcdserverpb.LeaseTimeToLiveRequest
+	60,  // 126: etcdserverpb.Lease.LeaseLeases:input_type -> etcdserverpb.LeaseLeasesRequest
+	63,  // 127: etcdserverpb.Lease.LeaseWatch:input_type -> etcdserverpb.LeaseWatchRequest
+	67,  // 128: etcdserverpb.Cluster.MemberAdd:input_type -> etcdserverpb.MemberAddRequest
+	69,  // 129: etcdserverpb.Cluster.MemberRemove:input_type -> etcdserverpb.MemberRemoveRequest
+	71,  // 130: etcdserverpb.Cluster.MemberUpdate:input_type -> etcdserverpb.MemberUpdateRequest
+	73,  // 131: etcdserverpb.Cluster.MemberList:input_type -> etcdserverpb.MemberListRequest
+	75,  // 132: etcdserverpb.Cluster.MemberPromote:input_type -> etcdserverpb.MemberPromoteRequest
+	81,  // 133: etcdserverpb.Maintenance.Alarm:input_type -> etcdserverpb.AlarmRequest
+	96,  // 134: etcdserverpb.Maintenance.Status:input_type -> etcdserverpb.StatusRequest
+	77,  // 135: etcdserverpb.Maintenance.Defragment:input_type -> etcdserverpb.DefragmentRequest
+	30,  // 136: etcdserverpb.Maintenance.Hash:input_type -> etcdserverpb.HashRequest
+	31,  // 137: etcdserverpb.Maintenance.HashKV:input_type -> etcdserverpb.HashKVRequest
+	34,  // 138: etcdserverpb.Maintenance.Snapshot:input_type -> etcdserverpb.SnapshotRequest
+	79,  // 139: etcdserverpb.Maintenance.MoveLeader:input_type -> etcdserverpb.MoveLeaderRequest
+	93,  // 140: etcdserverpb.Maintenance.Downgrade:input_type -> etcdserverpb.DowngradeRequest
+	84,  // 141: etcdserverpb.Maintenance.RevisionHold:input_type -> etcdserverpb.RevisionHoldRequest
+	87,  // 142: etcdserverpb.Maintenance.CompactionPolicy:input_type -> etcdserverpb.CompactionPolicyRequest
+	90,  // 143: etcdserverpb.Maintenance.Watchers:input_type -> etcdserverpb.WatchersRequest
+	99,  // 144: etcdserverpb.Auth.AuthEnable:input_type -> etcdserverpb.AuthEnableRequest
+	100, // 145: etcdserverpb.Auth.AuthDisable:input_type -> etcdserverpb.AuthDisableRequest
+	101, // 146: etcdserverpb.Auth.AuthStatus:input_type -> etcdserverpb.AuthStatusRequest
+	102, // 147: etcdserverpb.Auth.Authenticate:input_type -> etcdserverpb.AuthenticateRequest
+	103, // 148: etcdserverpb.Auth.UserAdd:input_type -> etcdserverpb.AuthUserAddRequest
+	104, // 149: etcdserverpb.Auth.UserGet:input_type -> etcdserverpb.AuthUserGetRequest
+	111, // 150: etcdserverpb.Auth.UserList:input_type -> etcdserverpb.AuthUserListRequest
+	105, // 151: etcdserverpb.Auth.UserDelete:input_type -> etcdserverpb.AuthUserDeleteRequest
+	106, // 152: etcdserverpb.Auth.UserChangePassword:input_type -> etcdserverpb.AuthUserChangePasswordRequest
+	107, // 153: etcdserverpb.Auth.UserGrantRole:input_type -> etcdserverpb.AuthUserGrantRoleRequest
+	108, // 154: etcdserverpb.Auth.UserRevokeRole:input_type -> etcdserverpb.AuthUserRevokeRoleRequest
+	109, // 155: etcdserverpb.Auth.RoleAdd:input_type -> etcdserverpb.AuthRoleAddRequest
+	110, // 156: etcdserverpb.Auth.RoleGet:input_type -> etcdserverpb.AuthRoleGetRequest
+	112, // 157: etcdserverpb.Auth.RoleList:input_type -> etcdserverpb.AuthRoleListRequest
+	113, // 158: etcdserverpb.Auth.RoleDelete:input_type -> etcdserverpb.AuthRoleDeleteRequest
+	114, // 159: etcdserverpb.Auth.RoleGrantPermission:input_type -> etcdserverpb.AuthRoleGrantPermissionRequest
+	115, // 160: etcdserverpb.Auth.RoleRevokePermission:input_type -> etcdserverpb.AuthRoleRevokePermissionRequest
+	15,  // 161: etcdserverpb.KV.Range:output_type -> etcdserverpb.RangeResponse
+	133, // 162: etcdserverpb.KV.RangeStream:output_type -> etcdserverpb.RangeStreamResponse
+	135, // 163: etcdserverpb.KV.KeyHistory:output_type -> etcdserverpb.KeyHistoryResponse
+	17,  // 164: etcdserverpb.KV.Put:output_type -> etcdserverpb.PutResponse
+	21,  // 165: etcdserverpb.KV.DeleteRange:output_type -> etcdserverpb.DeleteRangeResponse
+	26,  // 166: etcdserverpb.KV.Txn:output_type -> etcdserverpb.TxnResponse
+	29,  // 167: etcdserverpb.KV.Compact:output_type -> etcdserverpb.CompactionResponse
+	41,  // 168: etcdserverpb.Watch.Watch:output_type -> etcdserverpb.WatchResponse
+	44,  // 169: etcdserverpb.Watch.Subscription:output_type -> etcdserverpb.SubscriptionResponse
+	46,  // 170: etcdserverpb.Lease.LeaseGrant:output_type -> etcdserverpb.LeaseGrantResponse
+	48,  // 171: etcdserverpb.Lease.LeaseRevoke:output_type -> etcdserverpb.LeaseRevokeResponse
+	50,  // 172: etcdserverpb.Lease.LeaseRevokeBatch:output_type -> etcdserverpb.LeaseRevokeBatchResponse
+	52,  // 173: etcdserverpb.Lease.LeaseUpdateTTL:output_type -> etcdserverpb.LeaseUpdateTTLResponse
+	57,  // 174: etcdserverpb.Lease.LeaseKeepAlive:output_type -> etcdserverpb.LeaseKeepAliveResponse
+	59,  // 175: etcdserverpb.Lease.LeaseTimeToLive:output_type -> etcdserverpb.LeaseTimeToLiveResponse
+	62,  // 176: etcdserverpb.Lease.LeaseLeases:output_type -> etcdserverpb.LeaseLeasesResponse
+	65,  // 177: etcdserverpb.Lease.LeaseWatch:output_type -> etcdserverpb.LeaseWatchResponse
+	68,  // 178: etcdserverpb.Cluster.MemberAdd:output_type -> etcdserverpb.MemberAddResponse
+	70,  // 179: etcdserverpb.Cluster.MemberRemove:output_type -> etcdserverpb.MemberRemoveResponse
+	72,  // 180: etcdserverpb.Cluster.MemberUpdate:output_type -> etcdserverpb.MemberUpdateResponse
+	74,  // 181: etcdserverpb.Cluster.MemberList:output_type -> etcdserverpb.MemberListResponse
+	76,  // 182: etcdserverpb.Cluster.MemberPromote:output_type -> etcdserverpb.MemberPromoteResponse
+	83,  // 183: etcdserverpb.Maintenance.Alarm:output_type -> etcdserverpb.AlarmResponse
+	97,  // 184: etcdserverpb.Maintenance.Status:output_type -> etcdserverpb.StatusResponse
+	78,  // 185: etcdserverpb.Maintenance.Defragment:output_type -> etcdserverpb.DefragmentResponse
+	33,  // 186: etcdserverpb.Maintenance.Hash:output_type -> etcdserverpb.HashResponse
+	32,  // 187: etcdserverpb.Maintenance.HashKV:output_type -> etcdserverpb.HashKVResponse
+	35,  // 188: etcdserverpb.Maintenance.Snapshot:output_type -> etcdserverpb.SnapshotResponse
+	80,  // 189: etcdserverpb.Maintenance.MoveLeader:output_type -> etcdserverpb.MoveLeaderResponse
+	94,  // 190: etcdserverpb.Maintenance.Downgrade:output_type -> etcdserverpb.DowngradeResponse
+	86,  // 191: etcdserverpb.Maintenance.RevisionHold:output_type -> etcdserverpb.RevisionHoldResponse
+	89,  // 192: etcdserverpb.Maintenance.CompactionPolicy:output_type -> etcdserverpb.CompactionPolicyResponse
+	92,  // 193: etcdserverpb.Maintenance.Watchers:output_type -> etcdserverpb.WatchersResponse
+	116, // 194: etcdserverpb.Auth.AuthEnable:output_type -> etcdserverpb.AuthEnableResponse
+	117, // 195: etcdserverpb.Auth.AuthDisable:output_type -> etcdserverpb.AuthDisableResponse
+	118, // 196: etcdserverpb.Auth.AuthStatus:output_type -> etcdserverpb.AuthStatusResponse
+	119, // 197: etcdserverpb.Auth.Authenticate:output_type -> etcdserverpb.AuthenticateResponse
+	120, // 198: etcdserverpb.Auth.UserAdd:output_type -> etcdserverpb.AuthUserAddResponse
+	121, // 199: etcdserverpb.Auth.UserGet:output_type -> etcdserverpb.AuthUserGetResponse
+	129, // 200: etcdserverpb.Auth.UserList:output_type -> etcdserverpb.AuthUserListResponse
+	122, // 201: etcdserverpb.Auth.UserDelete:output_type -> etcdserverpb.AuthUserDeleteResponse
+	123, // 202: etcdserverpb.Auth.UserChangePassword:output_type -> etcdserverpb.AuthUserChangePasswordResponse
+	124, // 203: etcdserverpb.Auth.UserGrantRole:output_type -> etcdserverpb.AuthUserGrantRoleResponse
+	125, // 204: etcdserverpb.Auth.UserRevokeRole:output_type -> etcdserverpb.AuthUserRevokeRoleResponse
+	126, // 205: etcdserverpb.Auth.RoleAdd:output_type -> etcdserverpb.AuthRoleAddResponse
+	127, // 206: etcdserverpb.Auth.RoleGet:output_type -> etcdserverpb.AuthRoleGetResponse
+	128, // 207: etcdserverpb.Auth.RoleList:output_type -> etcdserverpb.AuthRoleListResponse
+	130, // 208: etcdserverpb.Auth.RoleDelete:output_type -> etcdserverpb.AuthRoleDeleteResponse
+	131, // 209: etcdserverpb.Auth.RoleGrantPermission:output_type -> etcdserverpb.AuthRoleGrantPermissionResponse
+	132, // 210: etcdserverpb.Auth.RoleRevokePermission:output_type -> etcdserverpb.AuthRoleRevokePermissionResponse
+	161, // [161:211] is the sub-list for method output_type
+	111, // [111:161] is the sub-list for method input_type
+	111, // [111:111] is the sub-list for extension type_name
+	111, // [111:111] is the sub-list for extension extendee
+	0,   // [0:111] is the sub-list for field type_name
 }
 
 func init() { file_rpc_proto_init() }
@@ -6907,26 +9537,31 @@ func file_rpc_proto_init() {
 	if File_rpc_proto != nil {
 		return
 	}
-	file_rpc_proto_msgTypes[7].OneofWrappers = []any{
+	file_rpc_proto_msgTypes[9].OneofWrappers = []any{
 		(*RequestOp_RequestRange)(nil),
 		(*RequestOp_RequestPut)(nil),
 		(*RequestOp_RequestDeleteRange)(nil),
 		(*RequestOp_RequestTxn)(nil),
+		(*RequestOp_RequestIncrement)(nil),
 	}
-	file_rpc_proto_msgTypes[8].OneofWrappers = []any{
+	file_rpc_proto_msgTypes[10].OneofWrappers = []any{
 		(*ResponseOp_ResponseRange)(nil),
 		(*ResponseOp_ResponsePut)(nil),
 		(*ResponseOp_ResponseDeleteRange)(nil),
 		(*ResponseOp_ResponseTxn)(nil),
+		(*ResponseOp_ResponseIncrement)(nil),
 	}
-	file_rpc_proto_msgTypes[9].OneofWrappers = []any{
+	file_rpc_proto_msgTypes[11].OneofWrappers = []any{
 		(*Compare_Version)(nil),
 		(*Compare_CreateRevision)(nil),
 		(*Compare_ModRevision)(nil),
 		(*Compare_Value)(nil),
 		(*Compare_Lease)(nil),
+		(*Compare_Count)(nil),
+		(*Compare_MaxCreateRevision)(nil),
+		(*Compare_MaxModRevision)(nil),
 	}
-	file_rpc_proto_msgTypes[20].OneofWrappers = []any{
+	file_rpc_proto_msgTypes[23].OneofWrappers = []any{
 		(*WatchRequest_CreateRequest)(nil),
 		(*WatchRequest_CancelRequest)(nil),
 		(*WatchRequest_ProgressRequest)(nil),
@@ -6936,8 +9571,8 @@ func file_rpc_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_proto_rawDesc), len(file_rpc_proto_rawDesc)),
-			NumEnums:      8,
-			NumMessages:   98,
+			NumEnums:      13,
+			NumMessages:   123,
 			NumExtensions: 0,
 			NumServices:   6,
 		},
@@ -22,6 +22,7 @@ const _ = grpc.SupportPackageIsVersion9
 const (
 	KV_Range_FullMethodName       = "/etcdserverpb.KV/Range"
 	KV_RangeStream_FullMethodName = "/etcdserverpb.KV/RangeStream"
+	KV_KeyHistory_FullMethodName  = "/etcdserverpb.KV/KeyHistory"
 	KV_Put_FullMethodName         = "/etcdserverpb.KV/Put"
 	KV_DeleteRange_FullMethodName = "/etcdserverpb.KV/DeleteRange"
 	KV_Txn_FullMethodName         = "/etcdserverpb.KV/Txn"
@@ -40,6 +41,9 @@ type KVClient interface {
 	// grpc-gateway REST mapping, because streaming chunked responses
 	// are not a good fit for standard JSON/REST semantics.
 	RangeStream(ctx context.Context, in *RangeRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[RangeStreamResponse], error)
+	// KeyHistory gets every revision of a key retained in the key-value store.
+	// Supported since etcd 3.8.
+	KeyHistory(ctx context.Context, in *KeyHistoryRequest, opts ...grpc.CallOption) (*KeyHistoryResponse, error)
 	// Put puts the given key into the key-value store.
 	// A put request increments the revision of the key-value store
 	// and generates one event in the event history.
@@ -96,6 +100,16 @@ func (c *kVClient) RangeStream(ctx context.Context, in *RangeRequest, opts ...gr
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type KV_RangeStreamClient = grpc.ServerStreamingClient[RangeStreamResponse]
 
+func (c *kVClient) KeyHistory(ctx context.Context, in *KeyHistoryRequest, opts ...grpc.CallOption) (*KeyHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(KeyHistoryResponse)
+	err := c.cc.Invoke(ctx, KV_KeyHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *kVClient) Put(ctx context.Context, in *PutRequest, opts ...grpc.CallOption) (*PutResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(PutResponse)
@@ -148,6 +162,9 @@ type KVServer interface {
 	// grpc-gateway REST mapping, because streaming chunked responses
 	// are not a good fit for standard JSON/REST semantics.
 	RangeStream(*RangeRequest, grpc.ServerStreamingServer[RangeStreamResponse]) error
+	// KeyHistory gets every revision of a key retained in the key-value store.
+	// Supported since etcd 3.8.
+	KeyHistory(context.Context, *KeyHistoryRequest) (*KeyHistoryResponse, error)
 	// Put puts the given key into the key-value store.
 	// A put request increments the revision of the key-value store
 	// and generates one event in the event history.
@@ -181,6 +198,9 @@ func (UnimplementedKVServer) Range(context.Context, *RangeRequest) (*RangeRespon
 func (UnimplementedKVServer) RangeStream(*RangeRequest, grpc.ServerStreamingServer[RangeStreamResponse]) error {
 	return status.Error(codes.Unimplemented, "method RangeStream not implemented")
 }
+func (UnimplementedKVServer) KeyHistory(context.Context, *KeyHistoryRequest) (*KeyHistoryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method KeyHistory not implemented")
+}
 func (UnimplementedKVServer) Put(context.Context, *PutRequest) (*PutResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method Put not implemented")
 }
@@ -243,6 +263,24 @@ func _KV_RangeStream_Handler(srv interface{}, stream grpc.ServerStream) error {
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type KV_RangeStreamServer = grpc.ServerStreamingServer[RangeStreamResponse]
 
+func _KV_KeyHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KeyHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KVServer).KeyHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KV_KeyHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KVServer).KeyHistory(ctx, req.(*KeyHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _KV_Put_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(PutRequest)
 	if err := dec(in); err != nil {
@@ -326,6 +364,10 @@ var KV_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Range",
 			Handler:    _KV_Range_Handler,
 		},
+		{
+			MethodName: "KeyHistory",
+			Handler:    _KV_KeyHistory_Handler,
+		},
 		{
 			MethodName: "Put",
 			Handler:    _KV_Put_Handler,
@@ -354,7 +396,8 @@ var KV_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	Watch_Watch_FullMethodName = "/etcdserverpb.Watch/Watch"
+	Watch_Watch_FullMethodName        = "/etcdserverpb.Watch/Watch"
+	Watch_Subscription_FullMethodName = "/etcdserverpb.Watch/Subscription"
 )
 
 // WatchClient is the client API for Watch service.
@@ -367,6 +410,12 @@ type WatchClient interface {
 	// for several watches at once. The entire event history can be watched starting from the
 	// last compaction revision.
 	Watch(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[WatchRequest, WatchResponse], error)
+	// Subscription acknowledges, deletes and lists durable watch subscriptions. The
+	// server stores the last revision acknowledged for each subscription, and holds
+	// compaction back at it while the subscription lags behind by no more than its
+	// max_lag, so that a watcher resuming the subscription gets every event after it.
+	// Supported since etcd 3.8.
+	Subscription(ctx context.Context, in *SubscriptionRequest, opts ...grpc.CallOption) (*SubscriptionResponse, error)
 }
 
 type watchClient struct {
@@ -390,6 +439,16 @@ func (c *watchClient) Watch(ctx context.Context, opts ...grpc.CallOption) (grpc.
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type Watch_WatchClient = grpc.BidiStreamingClient[WatchRequest, WatchResponse]
 
+func (c *watchClient) Subscription(ctx context.Context, in *SubscriptionRequest, opts ...grpc.CallOption) (*SubscriptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubscriptionResponse)
+	err := c.cc.Invoke(ctx, Watch_Subscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // WatchServer is the server API for Watch service.
 // All implementations must embed UnimplementedWatchServer
 // for forward compatibility.
@@ -400,6 +459,12 @@ type WatchServer interface {
 	// for several watches at once. The entire event history can be watched starting from the
 	// last compaction revision.
 	Watch(grpc.BidiStreamingServer[WatchRequest, WatchResponse]) error
+	// Subscription acknowledges, deletes and lists durable watch subscriptions. The
+	// server stores the last revision acknowledged for each subscription, and holds
+	// compaction back at it while the subscription lags behind by no more than its
+	// max_lag, so that a watcher resuming the subscription gets every event after it.
+	// Supported since etcd 3.8.
+	Subscription(context.Context, *SubscriptionRequest) (*SubscriptionResponse, error)
 	mustEmbedUnimplementedWatchServer()
 }
 
@@ -413,6 +478,9 @@ type UnimplementedWatchServer struct{}
 func (UnimplementedWatchServer) Watch(grpc.BidiStreamingServer[WatchRequest, WatchResponse]) error {
 	return status.Error(codes.Unimplemented, "method Watch not implemented")
 }
+func (UnimplementedWatchServer) Subscription(context.Context, *SubscriptionRequest) (*SubscriptionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Subscription not implemented")
+}
 func (UnimplementedWatchServer) mustEmbedUnimplementedWatchServer() {}
 func (UnimplementedWatchServer) testEmbeddedByValue()               {}
 
@@ -441,13 +509,36 @@ func _Watch_Watch_Handler(srv interface{}, stream grpc.ServerStream) error {
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type Watch_WatchServer = grpc.BidiStreamingServer[WatchRequest, WatchResponse]
 
+func _Watch_Subscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WatchServer).Subscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Watch_Subscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WatchServer).Subscription(ctx, req.(*SubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Watch_ServiceDesc is the grpc.ServiceDesc for Watch service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
 var Watch_ServiceDesc = grpc.ServiceDesc{
 	ServiceName: "etcdserverpb.Watch",
 	HandlerType: (*WatchServer)(nil),
-	Methods:     []grpc.MethodDesc{},
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Subscription",
+			Handler:    _Watch_Subscription_Handler,
+		},
+	},
 	Streams: []grpc.StreamDesc{
 		{
 			StreamName:    "Watch",
@@ -460,11 +551,14 @@ var Watch_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	Lease_LeaseGrant_FullMethodName      = "/etcdserverpb.Lease/LeaseGrant"
-	Lease_LeaseRevoke_FullMethodName     = "/etcdserverpb.Lease/LeaseRevoke"
-	Lease_LeaseKeepAlive_FullMethodName  = "/etcdserverpb.Lease/LeaseKeepAlive"
-	Lease_LeaseTimeToLive_FullMethodName = "/etcdserverpb.Lease/LeaseTimeToLive"
-	Lease_LeaseLeases_FullMethodName     = "/etcdserverpb.Lease/LeaseLeases"
+	Lease_LeaseGrant_FullMethodName       = "/etcdserverpb.Lease/LeaseGrant"
+	Lease_LeaseRevoke_FullMethodName      = "/etcdserverpb.Lease/LeaseRevoke"
+	Lease_LeaseRevokeBatch_FullMethodName = "/etcdserverpb.Lease/LeaseRevokeBatch"
+	Lease_LeaseUpdateTTL_FullMethodName   = "/etcdserverpb.Lease/LeaseUpdateTTL"
+	Lease_LeaseKeepAlive_FullMethodName   = "/etcdserverpb.Lease/LeaseKeepAlive"
+	Lease_LeaseTimeToLive_FullMethodName  = "/etcdserverpb.Lease/LeaseTimeToLive"
+	Lease_LeaseLeases_FullMethodName      = "/etcdserverpb.Lease/LeaseLeases"
+	Lease_LeaseWatch_FullMethodName       = "/etcdserverpb.Lease/LeaseWatch"
 )
 
 // LeaseClient is the client API for Lease service.
@@ -477,6 +571,16 @@ type LeaseClient interface {
 	LeaseGrant(ctx context.Context, in *LeaseGrantRequest, opts ...grpc.CallOption) (*LeaseGrantResponse, error)
 	// LeaseRevoke revokes a lease. All keys attached to the lease will expire and be deleted.
 	LeaseRevoke(ctx context.Context, in *LeaseRevokeRequest, opts ...grpc.CallOption) (*LeaseRevokeResponse, error)
+	// LeaseRevokeBatch revokes a batch of leases. All keys attached to the leases will expire
+	// and be deleted. Leases that do not exist are skipped. The server splits large batches into
+	// raft entries of at most 1000 leases and 10000 attached keys, so a large batch is not
+	// revoked atomically.
+	// Supported since etcd 3.8.
+	LeaseRevokeBatch(ctx context.Context, in *LeaseRevokeBatchRequest, opts ...grpc.CallOption) (*LeaseRevokeBatchResponse, error)
+	// LeaseUpdateTTL changes the time to live of a lease and renews the lease with it. The keys
+	// attached to the lease stay attached.
+	// Supported since etcd 3.8.
+	LeaseUpdateTTL(ctx context.Context, in *LeaseUpdateTTLRequest, opts ...grpc.CallOption) (*LeaseUpdateTTLResponse, error)
 	// LeaseKeepAlive keeps the lease alive by streaming keep alive requests from the client
 	// to the server and streaming keep alive responses from the server to the client.
 	LeaseKeepAlive(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[LeaseKeepAliveRequest, LeaseKeepAliveResponse], error)
@@ -484,6 +588,13 @@ type LeaseClient interface {
 	LeaseTimeToLive(ctx context.Context, in *LeaseTimeToLiveRequest, opts ...grpc.CallOption) (*LeaseTimeToLiveResponse, error)
 	// LeaseLeases lists all existing leases.
 	LeaseLeases(ctx context.Context, in *LeaseLeasesRequest, opts ...grpc.CallOption) (*LeaseLeasesResponse, error)
+	// LeaseWatch streams the lifecycle events of leases as they are applied: grants,
+	// checkpoints, revocations and expiries. The events are not stored, so a stream only
+	// gets the events from when it is opened. Supported since etcd 3.8.
+	//
+	// This RPC is intentionally gRPC-only and does not provide a
+	// grpc-gateway REST mapping, like RangeStream.
+	LeaseWatch(ctx context.Context, in *LeaseWatchRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[LeaseWatchResponse], error)
 }
 
 type leaseClient struct {
@@ -514,6 +625,26 @@ func (c *leaseClient) LeaseRevoke(ctx context.Context, in *LeaseRevokeRequest, o
 	return out, nil
 }
 
+func (c *leaseClient) LeaseRevokeBatch(ctx context.Context, in *LeaseRevokeBatchRequest, opts ...grpc.CallOption) (*LeaseRevokeBatchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LeaseRevokeBatchResponse)
+	err := c.cc.Invoke(ctx, Lease_LeaseRevokeBatch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leaseClient) LeaseUpdateTTL(ctx context.Context, in *LeaseUpdateTTLRequest, opts ...grpc.CallOption) (*LeaseUpdateTTLResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LeaseUpdateTTLResponse)
+	err := c.cc.Invoke(ctx, Lease_LeaseUpdateTTL_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *leaseClient) LeaseKeepAlive(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[LeaseKeepAliveRequest, LeaseKeepAliveResponse], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	stream, err := c.cc.NewStream(ctx, &Lease_ServiceDesc.Streams[0], Lease_LeaseKeepAlive_FullMethodName, cOpts...)
@@ -547,6 +678,25 @@ func (c *leaseClient) LeaseLeases(ctx context.Context, in *LeaseLeasesRequest, o
 	return out, nil
 }
 
+func (c *leaseClient) LeaseWatch(ctx context.Context, in *LeaseWatchRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[LeaseWatchResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Lease_ServiceDesc.Streams[1], Lease_LeaseWatch_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[LeaseWatchRequest, LeaseWatchResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Lease_LeaseWatchClient = grpc.ServerStreamingClient[LeaseWatchResponse]
+
 // LeaseServer is the server API for Lease service.
 // All implementations must embed UnimplementedLeaseServer
 // for forward compatibility.
@@ -557,6 +707,16 @@ type LeaseServer interface {
 	LeaseGrant(context.Context, *LeaseGrantRequest) (*LeaseGrantResponse, error)
 	// LeaseRevoke revokes a lease. All keys attached to the lease will expire and be deleted.
 	LeaseRevoke(context.Context, *LeaseRevokeRequest) (*LeaseRevokeResponse, error)
+	// LeaseRevokeBatch revokes a batch of leases. All keys attached to the leases will expire
+	// and be deleted. Leases that do not exist are skipped. The server splits large batches into
+	// raft entries of at most 1000 leases and 10000 attached keys, so a large batch is not
+	// revoked atomically.
+	// Supported since etcd 3.8.
+	LeaseRevokeBatch(context.Context, *LeaseRevokeBatchRequest) (*LeaseRevokeBatchResponse, error)
+	// LeaseUpdateTTL changes the time to live of a lease and renews the lease with it. The keys
+	// attached to the lease stay attached.
+	// Supported since etcd 3.8.
+	LeaseUpdateTTL(context.Context, *LeaseUpdateTTLRequest) (*LeaseUpdateTTLResponse, error)
 	// LeaseKeepAlive keeps the lease alive by streaming keep alive requests from the client
 	// to the server and streaming keep alive responses from the server to the client.
 	LeaseKeepAlive(grpc.BidiStreamingServer[LeaseKeepAliveRequest, LeaseKeepAliveResponse]) error
@@ -564,6 +724,13 @@ type LeaseServer interface {
 	LeaseTimeToLive(context.Context, *LeaseTimeToLiveRequest) (*LeaseTimeToLiveResponse, error)
 	// LeaseLeases lists all existing leases.
 	LeaseLeases(context.Context, *LeaseLeasesRequest) (*LeaseLeasesResponse, error)
+	// LeaseWatch streams the lifecycle events of leases as they are applied: grants,
+	// checkpoints, revocations and expiries. The events are not stored, so a stream only
+	// gets the events from when it is opened. Supported since etcd 3.8.
+	//
+	// This RPC is intentionally gRPC-only and does not provide a
+	// grpc-gateway REST mapping, like RangeStream.
+	LeaseWatch(*LeaseWatchRequest, grpc.ServerStreamingServer[LeaseWatchResponse]) error
 	mustEmbedUnimplementedLeaseServer()
 }
 
@@ -580,6 +747,12 @@ func (UnimplementedLeaseServer) LeaseGrant(context.Context, *LeaseGrantRequest)
 func (UnimplementedLeaseServer) LeaseRevoke(context.Context, *LeaseRevokeRequest) (*LeaseRevokeResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method LeaseRevoke not implemented")
 }
+func (UnimplementedLeaseServer) LeaseRevokeBatch(context.Context, *LeaseRevokeBatchRequest) (*LeaseRevokeBatchResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method LeaseRevokeBatch not implemented")
+}
+func (UnimplementedLeaseServer) LeaseUpdateTTL(context.Context, *LeaseUpdateTTLRequest) (*LeaseUpdateTTLResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method LeaseUpdateTTL not implemented")
+}
 func (UnimplementedLeaseServer) LeaseKeepAlive(grpc.BidiStreamingServer[LeaseKeepAliveRequest, LeaseKeepAliveResponse]) error {
 	return status.Error(codes.Unimplemented, "method LeaseKeepAlive not implemented")
 }
@@ -589,6 +762,9 @@ func (UnimplementedLeaseServer) LeaseTimeToLive(context.Context, *LeaseTimeToLiv
 func (UnimplementedLeaseServer) LeaseLeases(context.Context, *LeaseLeasesRequest) (*LeaseLeasesResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method LeaseLeases not implemented")
 }
+func (UnimplementedLeaseServer) LeaseWatch(*LeaseWatchRequest, grpc.ServerStreamingServer[LeaseWatchResponse]) error {
+	return status.Error(codes.Unimplemented, "method LeaseWatch not implemented")
+}
 func (UnimplementedLeaseServer) mustEmbedUnimplementedLeaseServer() {}
 func (UnimplementedLeaseServer) testEmbeddedByValue()               {}
 
@@ -646,6 +822,42 @@ func _Lease_LeaseRevoke_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Lease_LeaseRevokeBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaseRevokeBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeaseServer).LeaseRevokeBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Lease_LeaseRevokeBatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeaseServer).LeaseRevokeBatch(ctx, req.(*LeaseRevokeBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lease_LeaseUpdateTTL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaseUpdateTTLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeaseServer).LeaseUpdateTTL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Lease_LeaseUpdateTTL_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeaseServer).LeaseUpdateTTL(ctx, req.(*LeaseUpdateTTLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Lease_LeaseKeepAlive_Handler(srv interface{}, stream grpc.ServerStream) error {
 	return srv.(LeaseServer).LeaseKeepAlive(&grpc.GenericServerStream[LeaseKeepAliveRequest, LeaseKeepAliveResponse]{ServerStream: stream})
 }
@@ -689,6 +901,17 @@ func _Lease_LeaseLeases_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Lease_LeaseWatch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(LeaseWatchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LeaseServer).LeaseWatch(m, &grpc.GenericServerStream[LeaseWatchRequest, LeaseWatchResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Lease_LeaseWatchServer = grpc.ServerStreamingServer[LeaseWatchResponse]
+
 // Lease_ServiceDesc is the grpc.ServiceDesc for Lease service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -704,6 +927,14 @@ var Lease_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "LeaseRevoke",
 			Handler:    _Lease_LeaseRevoke_Handler,
 		},
+		{
+			MethodName: "LeaseRevokeBatch",
+			Handler:    _Lease_LeaseRevokeBatch_Handler,
+		},
+		{
+			MethodName: "LeaseUpdateTTL",
+			Handler:    _Lease_LeaseUpdateTTL_Handler,
+		},
 		{
 			MethodName: "LeaseTimeToLive",
 			Handler:    _Lease_LeaseTimeToLive_Handler,
@@ -720,6 +951,11 @@ var Lease_ServiceDesc = grpc.ServiceDesc{
 			ServerStreams: true,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "LeaseWatch",
+			Handler:       _Lease_LeaseWatch_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "rpc.proto",
 }
@@ -989,14 +1225,17 @@ var Cluster_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	Maintenance_Alarm_FullMethodName      = "/etcdserverpb.Maintenance/Alarm"
-	Maintenance_Status_FullMethodName     = "/etcdserverpb.Maintenance/Status"
-	Maintenance_Defragment_FullMethodName = "/etcdserverpb.Maintenance/Defragment"
-	Maintenance_Hash_FullMethodName       = "/etcdserverpb.Maintenance/Hash"
-	Maintenance_HashKV_FullMethodName     = "/etcdserverpb.Maintenance/HashKV"
-	Maintenance_Snapshot_FullMethodName   = "/etcdserverpb.Maintenance/Snapshot"
-	Maintenance_MoveLeader_FullMethodName = "/etcdserverpb.Maintenance/MoveLeader"
-	Maintenance_Downgrade_FullMethodName  = "/etcdserverpb.Maintenance/Downgrade"
+	Maintenance_Alarm_FullMethodName            = "/etcdserverpb.Maintenance/Alarm"
+	Maintenance_Status_FullMethodName           = "/etcdserverpb.Maintenance/Status"
+	Maintenance_Defragment_FullMethodName       = "/etcdserverpb.Maintenance/Defragment"
+	Maintenance_Hash_FullMethodName             = "/etcdserverpb.Maintenance/Hash"
+	Maintenance_HashKV_FullMethodName           = "/etcdserverpb.Maintenance/HashKV"
+	Maintenance_Snapshot_FullMethodName         = "/etcdserverpb.Maintenance/Snapshot"
+	Maintenance_MoveLeader_FullMethodName       = "/etcdserverpb.Maintenance/MoveLeader"
+	Maintenance_Downgrade_FullMethodName        = "/etcdserverpb.Maintenance/Downgrade"
+	Maintenance_RevisionHold_FullMethodName     = "/etcdserverpb.Maintenance/RevisionHold"
+	Maintenance_CompactionPolicy_FullMethodName = "/etcdserverpb.Maintenance/CompactionPolicy"
+	Maintenance_Watchers_FullMethodName         = "/etcdserverpb.Maintenance/Watchers"
 )
 
 // MaintenanceClient is the client API for Maintenance service.
@@ -1027,6 +1266,19 @@ type MaintenanceClient interface {
 	// on the cluster version.
 	// Supported since etcd 3.5.
 	Downgrade(ctx context.Context, in *DowngradeRequest, opts ...grpc.CallOption) (*DowngradeResponse, error)
+	// RevisionHold creates, releases and lists revision holds. Compaction never
+	// removes a held revision; compaction requests past the oldest held revision
+	// are clamped to it.
+	// Supported since etcd 3.8.
+	RevisionHold(ctx context.Context, in *RevisionHoldRequest, opts ...grpc.CallOption) (*RevisionHoldResponse, error)
+	// CompactionPolicy returns the auto compaction policy of the member and
+	// the revision the keys under each retention rule are compacted up to.
+	// Supported since etcd 3.8.
+	CompactionPolicy(ctx context.Context, in *CompactionPolicyRequest, opts ...grpc.CallOption) (*CompactionPolicyResponse, error)
+	// Watchers lists the watchers of the member, with how far each of them
+	// caught up with the store and how long it was blocked on its watch stream.
+	// Supported since etcd 3.8.
+	Watchers(ctx context.Context, in *WatchersRequest, opts ...grpc.CallOption) (*WatchersResponse, error)
 }
 
 type maintenanceClient struct {
@@ -1126,6 +1378,36 @@ func (c *maintenanceClient) Downgrade(ctx context.Context, in *DowngradeRequest,
 	return out, nil
 }
 
+func (c *maintenanceClient) RevisionHold(ctx context.Context, in *RevisionHoldRequest, opts ...grpc.CallOption) (*RevisionHoldResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevisionHoldResponse)
+	err := c.cc.Invoke(ctx, Maintenance_RevisionHold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *maintenanceClient) CompactionPolicy(ctx context.Context, in *CompactionPolicyRequest, opts ...grpc.CallOption) (*CompactionPolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CompactionPolicyResponse)
+	err := c.cc.Invoke(ctx, Maintenance_CompactionPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *maintenanceClient) Watchers(ctx context.Context, in *WatchersRequest, opts ...grpc.CallOption) (*WatchersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WatchersResponse)
+	err := c.cc.Invoke(ctx, Maintenance_Watchers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MaintenanceServer is the server API for Maintenance service.
 // All implementations must embed UnimplementedMaintenanceServer
 // for forward compatibility.
@@ -1154,6 +1436,19 @@ type MaintenanceServer interface {
 	// on the cluster version.
 	// Supported since etcd 3.5.
 	Downgrade(context.Context, *DowngradeRequest) (*DowngradeResponse, error)
+	// RevisionHold creates, releases and lists revision holds. Compaction never
+	// removes a held revision; compaction requests past the oldest held revision
+	// are clamped to it.
+	// Supported since etcd 3.8.
+	RevisionHold(context.Context, *RevisionHoldRequest) (*RevisionHoldResponse, error)
+	// CompactionPolicy returns the auto compaction policy of the member and
+	// the revision the keys under each retention rule are compacted up to.
+	// Supported since etcd 3.8.
+	CompactionPolicy(context.Context, *CompactionPolicyRequest) (*CompactionPolicyResponse, error)
+	// Watchers lists the watchers of the member, with how far each of them
+	// caught up with the store and how long it was blocked on its watch stream.
+	// Supported since etcd 3.8.
+	Watchers(context.Context, *WatchersRequest) (*WatchersResponse, error)
 	mustEmbedUnimplementedMaintenanceServer()
 }
 
@@ -1188,6 +1483,15 @@ func (UnimplementedMaintenanceServer) MoveLeader(context.Context, *MoveLeaderReq
 func (UnimplementedMaintenanceServer) Downgrade(context.Context, *DowngradeRequest) (*DowngradeResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method Downgrade not implemented")
 }
+func (UnimplementedMaintenanceServer) RevisionHold(context.Context, *RevisionHoldRequest) (*RevisionHoldResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RevisionHold not implemented")
+}
+func (UnimplementedMaintenanceServer) CompactionPolicy(context.Context, *CompactionPolicyRequest) (*CompactionPolicyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CompactionPolicy not implemented")
+}
+func (UnimplementedMaintenanceServer) Watchers(context.Context, *WatchersRequest) (*WatchersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Watchers not implemented")
+}
 func (UnimplementedMaintenanceServer) mustEmbedUnimplementedMaintenanceServer() {}
 func (UnimplementedMaintenanceServer) testEmbeddedByValue()                     {}
 
@@ -1346,6 +1650,60 @@ func _Maintenance_Downgrade_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Maintenance_RevisionHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevisionHoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MaintenanceServer).RevisionHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Maintenance_RevisionHold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MaintenanceServer).RevisionHold(ctx, req.(*RevisionHoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Maintenance_CompactionPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompactionPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MaintenanceServer).CompactionPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Maintenance_CompactionPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MaintenanceServer).CompactionPolicy(ctx, req.(*CompactionPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Maintenance_Watchers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WatchersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MaintenanceServer).Watchers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Maintenance_Watchers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MaintenanceServer).Watchers(ctx, req.(*WatchersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Maintenance_ServiceDesc is the grpc.ServiceDesc for Maintenance service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1381,6 +1739,18 @@ var Maintenance_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Downgrade",
 			Handler:    _Maintenance_Downgrade_Handler,
 		},
+		{
+			MethodName: "RevisionHold",
+			Handler:    _Maintenance_RevisionHold_Handler,
+		},
+		{
+			MethodName: "CompactionPolicy",
+			Handler:    _Maintenance_CompactionPolicy_Handler,
+		},
+		{
+			MethodName: "Watchers",
+			Handler:    _Maintenance_Watchers_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
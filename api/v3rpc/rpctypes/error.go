@@ -29,6 +29,11 @@ var (
 	ErrGRPCDuplicateKey            = status.Error(codes.InvalidArgument, "etcdserver: duplicate key given in txn request")
 	ErrGRPCInvalidClientAPIVersion = status.Error(codes.InvalidArgument, "etcdserver: invalid client api version")
 	ErrGRPCInvalidSortOption       = status.Error(codes.InvalidArgument, "etcdserver: invalid sort option")
+	ErrGRPCInvalidRangeFilter      = status.Error(codes.InvalidArgument, "etcdserver: invalid range filter")
+	ErrGRPCInvalidContinueToken    = status.Error(codes.InvalidArgument, "etcdserver: invalid continue token")
+	ErrGRPCInvalidBatchedDelete    = status.Error(codes.InvalidArgument, "etcdserver: invalid batched delete")
+	ErrGRPCInvalidCounter          = status.Error(codes.FailedPrecondition, "etcdserver: value is not a valid counter")
+	ErrGRPCCounterOverflow         = status.Error(codes.OutOfRange, "etcdserver: counter overflow")
 	ErrGRPCCompacted               = status.Error(codes.OutOfRange, "etcdserver: mvcc: required revision has been compacted")
 	ErrGRPCFutureRev               = status.Error(codes.OutOfRange, "etcdserver: mvcc: required revision is a future revision")
 	ErrGRPCNoSpace                 = status.Error(codes.ResourceExhausted, "etcdserver: mvcc: database space exceeded")
@@ -36,8 +41,20 @@ var (
 	ErrGRPCLeaseNotFound    = status.Error(codes.NotFound, "etcdserver: requested lease not found")
 	ErrGRPCLeaseExist       = status.Error(codes.FailedPrecondition, "etcdserver: lease already exists")
 	ErrGRPCLeaseTTLTooLarge = status.Error(codes.OutOfRange, "etcdserver: too large lease TTL")
+	ErrGRPCInvalidTTL       = status.Error(codes.InvalidArgument, "etcdserver: invalid ttl")
+	ErrGRPCLeaseIDReserved  = status.Error(codes.InvalidArgument, "etcdserver: lease ID is reserved")
+	ErrGRPCLeaseWatcherSlow = status.Error(codes.ResourceExhausted, "etcdserver: lease watcher is too slow")
 
-	ErrGRPCWatchCanceled = status.Error(codes.Canceled, "etcdserver: watch canceled")
+	ErrGRPCRevisionHoldNotFound = status.Error(codes.NotFound, "etcdserver: revision hold not found")
+	ErrGRPCRevisionHoldExist    = status.Error(codes.FailedPrecondition, "etcdserver: revision hold already exists")
+
+	ErrGRPCSubscriptionNotFound        = status.Error(codes.NotFound, "etcdserver: subscription not found")
+	ErrGRPCSubscriptionNameNotProvided = status.Error(codes.InvalidArgument, "etcdserver: subscription name not provided")
+
+	ErrGRPCWatchCanceled           = status.Error(codes.Canceled, "etcdserver: watch canceled")
+	ErrGRPCTooManyWatchersOnStream = status.Error(codes.ResourceExhausted, "etcdserver: too many watchers on the watch stream")
+	ErrGRPCTooManyWatchersForUser  = status.Error(codes.ResourceExhausted, "etcdserver: too many watchers for the user")
+	ErrGRPCWatchRateExceeded       = status.Error(codes.ResourceExhausted, "etcdserver: watch event rate exceeded")
 
 	ErrGRPCMemberExist            = status.Error(codes.FailedPrecondition, "etcdserver: member ID already exist")
 	ErrGRPCPeerURLExist           = status.Error(codes.FailedPrecondition, "etcdserver: Peer URLs already exists")
@@ -103,16 +120,34 @@ var (
 		ErrorDesc(ErrGRPCValueProvided): ErrGRPCValueProvided,
 		ErrorDesc(ErrGRPCLeaseProvided): ErrGRPCLeaseProvided,
 
-		ErrorDesc(ErrGRPCTooManyOps):        ErrGRPCTooManyOps,
-		ErrorDesc(ErrGRPCDuplicateKey):      ErrGRPCDuplicateKey,
-		ErrorDesc(ErrGRPCInvalidSortOption): ErrGRPCInvalidSortOption,
-		ErrorDesc(ErrGRPCCompacted):         ErrGRPCCompacted,
-		ErrorDesc(ErrGRPCFutureRev):         ErrGRPCFutureRev,
-		ErrorDesc(ErrGRPCNoSpace):           ErrGRPCNoSpace,
+		ErrorDesc(ErrGRPCTooManyOps):           ErrGRPCTooManyOps,
+		ErrorDesc(ErrGRPCDuplicateKey):         ErrGRPCDuplicateKey,
+		ErrorDesc(ErrGRPCInvalidSortOption):    ErrGRPCInvalidSortOption,
+		ErrorDesc(ErrGRPCInvalidRangeFilter):   ErrGRPCInvalidRangeFilter,
+		ErrorDesc(ErrGRPCInvalidContinueToken): ErrGRPCInvalidContinueToken,
+		ErrorDesc(ErrGRPCInvalidBatchedDelete): ErrGRPCInvalidBatchedDelete,
+		ErrorDesc(ErrGRPCInvalidCounter):       ErrGRPCInvalidCounter,
+		ErrorDesc(ErrGRPCCounterOverflow):      ErrGRPCCounterOverflow,
+		ErrorDesc(ErrGRPCCompacted):            ErrGRPCCompacted,
+		ErrorDesc(ErrGRPCFutureRev):            ErrGRPCFutureRev,
+		ErrorDesc(ErrGRPCNoSpace):              ErrGRPCNoSpace,
 
 		ErrorDesc(ErrGRPCLeaseNotFound):    ErrGRPCLeaseNotFound,
 		ErrorDesc(ErrGRPCLeaseExist):       ErrGRPCLeaseExist,
 		ErrorDesc(ErrGRPCLeaseTTLTooLarge): ErrGRPCLeaseTTLTooLarge,
+		ErrorDesc(ErrGRPCInvalidTTL):       ErrGRPCInvalidTTL,
+		ErrorDesc(ErrGRPCLeaseIDReserved):  ErrGRPCLeaseIDReserved,
+		ErrorDesc(ErrGRPCLeaseWatcherSlow): ErrGRPCLeaseWatcherSlow,
+
+		ErrorDesc(ErrGRPCRevisionHoldNotFound): ErrGRPCRevisionHoldNotFound,
+		ErrorDesc(ErrGRPCRevisionHoldExist):    ErrGRPCRevisionHoldExist,
+
+		ErrorDesc(ErrGRPCSubscriptionNotFound):        ErrGRPCSubscriptionNotFound,
+		ErrorDesc(ErrGRPCSubscriptionNameNotProvided): ErrGRPCSubscriptionNameNotProvided,
+
+		ErrorDesc(ErrGRPCTooManyWatchersOnStream): ErrGRPCTooManyWatchersOnStream,
+		ErrorDesc(ErrGRPCTooManyWatchersForUser):  ErrGRPCTooManyWatchersForUser,
+		ErrorDesc(ErrGRPCWatchRateExceeded):       ErrGRPCWatchRateExceeded,
 
 		ErrorDesc(ErrGRPCMemberExist):            ErrGRPCMemberExist,
 		ErrorDesc(ErrGRPCPeerURLExist):           ErrGRPCPeerURLExist,
@@ -167,20 +202,38 @@ var (
 
 // client-side error
 var (
-	ErrEmptyKey          = Error(ErrGRPCEmptyKey)
-	ErrKeyNotFound       = Error(ErrGRPCKeyNotFound)
-	ErrValueProvided     = Error(ErrGRPCValueProvided)
-	ErrLeaseProvided     = Error(ErrGRPCLeaseProvided)
-	ErrTooManyOps        = Error(ErrGRPCTooManyOps)
-	ErrDuplicateKey      = Error(ErrGRPCDuplicateKey)
-	ErrInvalidSortOption = Error(ErrGRPCInvalidSortOption)
-	ErrCompacted         = Error(ErrGRPCCompacted)
-	ErrFutureRev         = Error(ErrGRPCFutureRev)
-	ErrNoSpace           = Error(ErrGRPCNoSpace)
+	ErrEmptyKey             = Error(ErrGRPCEmptyKey)
+	ErrKeyNotFound          = Error(ErrGRPCKeyNotFound)
+	ErrValueProvided        = Error(ErrGRPCValueProvided)
+	ErrLeaseProvided        = Error(ErrGRPCLeaseProvided)
+	ErrTooManyOps           = Error(ErrGRPCTooManyOps)
+	ErrDuplicateKey         = Error(ErrGRPCDuplicateKey)
+	ErrInvalidSortOption    = Error(ErrGRPCInvalidSortOption)
+	ErrInvalidRangeFilter   = Error(ErrGRPCInvalidRangeFilter)
+	ErrInvalidContinueToken = Error(ErrGRPCInvalidContinueToken)
+	ErrInvalidBatchedDelete = Error(ErrGRPCInvalidBatchedDelete)
+	ErrInvalidCounter       = Error(ErrGRPCInvalidCounter)
+	ErrCounterOverflow      = Error(ErrGRPCCounterOverflow)
+	ErrCompacted            = Error(ErrGRPCCompacted)
+	ErrFutureRev            = Error(ErrGRPCFutureRev)
+	ErrNoSpace              = Error(ErrGRPCNoSpace)
 
 	ErrLeaseNotFound    = Error(ErrGRPCLeaseNotFound)
 	ErrLeaseExist       = Error(ErrGRPCLeaseExist)
 	ErrLeaseTTLTooLarge = Error(ErrGRPCLeaseTTLTooLarge)
+	ErrInvalidTTL       = Error(ErrGRPCInvalidTTL)
+	ErrLeaseIDReserved  = Error(ErrGRPCLeaseIDReserved)
+	ErrLeaseWatcherSlow = Error(ErrGRPCLeaseWatcherSlow)
+
+	ErrRevisionHoldNotFound = Error(ErrGRPCRevisionHoldNotFound)
+	ErrRevisionHoldExist    = Error(ErrGRPCRevisionHoldExist)
+
+	ErrSubscriptionNotFound        = Error(ErrGRPCSubscriptionNotFound)
+	ErrSubscriptionNameNotProvided = Error(ErrGRPCSubscriptionNameNotProvided)
+
+	ErrTooManyWatchersOnStream = Error(ErrGRPCTooManyWatchersOnStream)
+	ErrTooManyWatchersForUser  = Error(ErrGRPCTooManyWatchersForUser)
+	ErrWatchRateExceeded       = Error(ErrGRPCWatchRateExceeded)
 
 	ErrMemberExist            = Error(ErrGRPCMemberExist)
 	ErrPeerURLExist           = Error(ErrGRPCPeerURLExist)
@@ -428,12 +428,26 @@ func (c *Cache) validateWatch(key string, op clientv3.Op) (pred KeyPredicate, er
 		return nil, fmt.Errorf("%w: PrevKV not supported", ErrUnsupportedRequest)
 	case op.IsFragment():
 		return nil, fmt.Errorf("%w: Fragment not supported", ErrUnsupportedRequest)
+	case op.IsCoalesce():
+		return nil, fmt.Errorf("%w: Coalesce not supported", ErrUnsupportedRequest)
+	case op.Subscription() != "":
+		return nil, fmt.Errorf("%w: Subscription not supported", ErrUnsupportedRequest)
 	case op.IsCreatedNotify():
 		return nil, fmt.Errorf("%w: CreatedNotify not supported", ErrUnsupportedRequest)
 	case op.IsFilterPut():
 		return nil, fmt.Errorf("%w: FilterPut not supported", ErrUnsupportedRequest)
 	case op.IsFilterDelete():
 		return nil, fmt.Errorf("%w: FilterDelete not supported", ErrUnsupportedRequest)
+	case op.IsFilterUnchanged():
+		return nil, fmt.Errorf("%w: FilterUnchanged not supported", ErrUnsupportedRequest)
+	case op.IsFilterUpdate():
+		return nil, fmt.Errorf("%w: FilterUpdate not supported", ErrUnsupportedRequest)
+	case len(op.ValuePrefix()) != 0:
+		return nil, fmt.Errorf("%w: ValuePrefix not supported", ErrUnsupportedRequest)
+	case op.LeaseID() != 0:
+		return nil, fmt.Errorf("%w: Lease not supported", ErrUnsupportedRequest)
+	case len(op.Ranges()) != 0:
+		return nil, fmt.Errorf("%w: Ranges not supported", ErrUnsupportedRequest)
 	}
 
 	startKey := []byte(key)
@@ -463,6 +477,16 @@ func (c *Cache) validateGet(key string, op clientv3.Op) (KeyPredicate, error) {
 		return nil, fmt.Errorf("%w: MinCreateRev(%d) not supported", ErrUnsupportedRequest, op.MinCreateRev())
 	case op.MaxCreateRev() != 0:
 		return nil, fmt.Errorf("%w: MaxCreateRev(%d) not supported", ErrUnsupportedRequest, op.MaxCreateRev())
+	case len(op.ValuePrefix()) != 0:
+		return nil, fmt.Errorf("%w: ValuePrefix not supported", ErrUnsupportedRequest)
+	case op.MinValueSize() != 0:
+		return nil, fmt.Errorf("%w: MinValueSize(%d) not supported", ErrUnsupportedRequest, op.MinValueSize())
+	case op.MaxValueSize() != 0:
+		return nil, fmt.Errorf("%w: MaxValueSize(%d) not supported", ErrUnsupportedRequest, op.MaxValueSize())
+	case op.LeaseFilter() != clientv3.LeaseFilterAny:
+		return nil, fmt.Errorf("%w: LeaseFilter(%d) not supported", ErrUnsupportedRequest, op.LeaseFilter())
+	case len(op.ContinueToken()) != 0:
+		return nil, fmt.Errorf("%w: ContinueToken not supported", ErrUnsupportedRequest)
 	}
 
 	startKey := []byte(key)
@@ -548,6 +548,20 @@ func (m *mockWatcher) Watch(ctx context.Context, _ string, opts ...clientv3.OpOp
 
 func (m *mockWatcher) RequestProgress(_ context.Context) error { return m.progressErr }
 
+func (m *mockWatcher) RequestLinearizableProgress(_ context.Context) error { return m.progressErr }
+
+func (m *mockWatcher) AckSubscription(_ context.Context, _ string, _ int64) (*clientv3.SubscriptionResponse, error) {
+	return nil, nil
+}
+
+func (m *mockWatcher) DeleteSubscription(_ context.Context, _ string) (*clientv3.SubscriptionResponse, error) {
+	return nil, nil
+}
+
+func (m *mockWatcher) Subscriptions(_ context.Context) (*clientv3.SubscriptionResponse, error) {
+	return nil, nil
+}
+
 func (m *mockWatcher) Close() error {
 	m.closeOnce.Do(func() { close(m.responses) })
 	m.wg.Wait()
@@ -664,6 +678,10 @@ func (s *kvStub) GetStream(ctx context.Context, key string, opts ...clientv3.OpO
 	return nil, errors.New("GetStream not implemented")
 }
 
+func (s *kvStub) KeyHistory(ctx context.Context, key string, opts ...clientv3.OpOption) (*clientv3.KeyHistoryResponse, error) {
+	return nil, errors.New("KeyHistory not implemented")
+}
+
 func (s *kvStub) Do(ctx context.Context, op clientv3.Op) (clientv3.OpResponse, error) {
 	return clientv3.OpResponse{}, nil
 }
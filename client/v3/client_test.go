@@ -506,6 +506,26 @@ func (mm mockMaintenance) Downgrade(ctx context.Context, action DowngradeAction,
 	return nil, nil
 }
 
+func (mm mockMaintenance) HoldRevision(ctx context.Context, rev int64, leaseID LeaseID) (*RevisionHoldResponse, error) {
+	return nil, nil
+}
+
+func (mm mockMaintenance) ReleaseRevisionHold(ctx context.Context, id int64) (*RevisionHoldResponse, error) {
+	return nil, nil
+}
+
+func (mm mockMaintenance) RevisionHolds(ctx context.Context) (*RevisionHoldResponse, error) {
+	return nil, nil
+}
+
+func (mm mockMaintenance) CompactionPolicy(ctx context.Context, endpoint string) (*CompactionPolicyResponse, error) {
+	return nil, nil
+}
+
+func (mm mockMaintenance) Watchers(ctx context.Context, endpoint string) (*WatchersResponse, error) {
+	return nil, nil
+}
+
 type mockFailingAuthServer struct {
 	etcdserverpb.UnimplementedAuthServer
 }
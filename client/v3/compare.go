@@ -88,6 +88,12 @@ func Compare(cmp Cmp, result string, v any) Cmp {
 		cmp.c.TargetUnion = &pb.Compare_ModRevision{ModRevision: mustInt64(v)}
 	case pb.Compare_LEASE:
 		cmp.c.TargetUnion = &pb.Compare_Lease{Lease: mustInt64orLeaseID(v)}
+	case pb.Compare_COUNT:
+		cmp.c.TargetUnion = &pb.Compare_Count{Count: mustInt64(v)}
+	case pb.Compare_MAX_CREATE:
+		cmp.c.TargetUnion = &pb.Compare_MaxCreateRevision{MaxCreateRevision: mustInt64(v)}
+	case pb.Compare_MAX_MOD:
+		cmp.c.TargetUnion = &pb.Compare_MaxModRevision{MaxModRevision: mustInt64(v)}
 	default:
 		panic("Unknown compare type")
 	}
@@ -116,6 +122,25 @@ func LeaseValue(key string) Cmp {
 	return Cmp{c: &pb.Compare{Key: []byte(key), Target: pb.Compare_LEASE}}
 }
 
+// Count compares the number of keys in the range given by WithRange or
+// WithPrefix. For example, 'Compare(Count(prefix).WithPrefix(), "<", 10)'
+// succeeds only if there are fewer than 10 keys under the prefix.
+func Count(key string) Cmp {
+	return Cmp{c: &pb.Compare{Key: []byte(key), Target: pb.Compare_COUNT}}
+}
+
+// MaxCreateRevision compares the greatest creation revision of the keys in
+// the range given by WithRange or WithPrefix. An empty range compares as 0.
+func MaxCreateRevision(key string) Cmp {
+	return Cmp{c: &pb.Compare{Key: []byte(key), Target: pb.Compare_MAX_CREATE}}
+}
+
+// MaxModRevision compares the greatest modification revision of the keys in
+// the range given by WithRange or WithPrefix. An empty range compares as 0.
+func MaxModRevision(key string) Cmp {
+	return Cmp{c: &pb.Compare{Key: []byte(key), Target: pb.Compare_MAX_MOD}}
+}
+
 func (cmp *Cmp) ensureCompare() {
 	if cmp.c == nil {
 		cmp.c = &pb.Compare{}
@@ -47,7 +47,13 @@ func NewSession(client *v3.Client, opts ...SessionOption) (*Session, error) {
 
 	id := ops.leaseID
 	if id == v3.NoLease {
-		resp, err := client.Grant(ops.ctx, int64(ops.ttl))
+		var resp *v3.LeaseGrantResponse
+		var err error
+		if ops.parent != v3.NoLease {
+			resp, err = client.GrantChild(ops.ctx, ops.parent, int64(ops.ttl))
+		} else {
+			resp, err = client.Grant(ops.ctx, int64(ops.ttl))
+		}
 		if err != nil {
 			return nil, err
 		}
@@ -117,6 +123,7 @@ func (s *Session) Close() error {
 type sessionOptions struct {
 	ttl     int
 	leaseID v3.LeaseID
+	parent  v3.LeaseID
 	ctx     context.Context
 }
 
@@ -144,6 +151,16 @@ func WithLease(leaseID v3.LeaseID) SessionOption {
 	}
 }
 
+// WithParent makes the session a child session of the given parent session.
+// The lease of a child session is revoked along with the parent session lease,
+// so the child session ends when the parent session is closed or expires.
+// It has no effect along with WithLease.
+func WithParent(parent *Session) SessionOption {
+	return func(so *sessionOptions, _ *zap.Logger) {
+		so.parent = parent.Lease()
+	}
+}
+
 // WithContext assigns a context to the session instead of defaulting to
 // using the client context. This is useful for canceling NewSession and
 // Close operations immediately without having to close the client. If the
@@ -27,12 +27,14 @@ import (
 )
 
 type (
-	CompactResponse pb.CompactionResponse
-	PutResponse     pb.PutResponse
-	GetResponse     pb.RangeResponse
-	GetStreamChan   <-chan RangeStreamResponse
-	DeleteResponse  pb.DeleteRangeResponse
-	TxnResponse     pb.TxnResponse
+	CompactResponse    pb.CompactionResponse
+	PutResponse        pb.PutResponse
+	GetResponse        pb.RangeResponse
+	GetStreamChan      <-chan RangeStreamResponse
+	KeyHistoryResponse pb.KeyHistoryResponse
+	DeleteResponse     pb.DeleteRangeResponse
+	TxnResponse        pb.TxnResponse
+	IncrementResponse  pb.IncrementResponse
 )
 
 type KV interface {
@@ -59,8 +61,21 @@ type KV interface {
 	// When passed WithRev(rev) with rev > 0, retrieves keys at the given revision;
 	// if the required revision is compacted, the request will fail with ErrCompacted .
 	// When passed WithLimit(limit), the number of returned keys is bounded by limit.
+	// If the stream breaks with a transient error, it is resumed at the same
+	// revision after the last chunk received.
 	GetStream(ctx context.Context, key string, opts ...OpOption) (GetStreamChan, error)
 
+	// KeyHistory retrieves every revision of "key" retained in etcd, oldest first.
+	// A revision deleting the key is returned with version 0 and no value.
+	// When passed WithRev(rev) with rev > 0, the history is read at the given revision;
+	// if the required revision is compacted, the request will fail with ErrCompacted .
+	// When passed WithMinModRev(rev) or WithMaxModRev(rev), only the revisions within
+	// the bounds are returned.
+	// When passed WithLimit(limit), the number of returned revisions is bounded by limit.
+	// When passed WithSort() with SortDescend, the newest revisions are returned first.
+	// Supported since etcd 3.8.
+	KeyHistory(ctx context.Context, key string, opts ...OpOption) (*KeyHistoryResponse, error)
+
 	// Delete deletes a key, or optionally using WithRange(end), [key, end).
 	Delete(ctx context.Context, key string, opts ...OpOption) (*DeleteResponse, error)
 
@@ -87,7 +102,14 @@ type KV interface {
 // Err().
 type RangeStreamResponse struct {
 	*pb.RangeResponse
-	closeErr error
+	resumeToken []byte
+	closeErr    error
+}
+
+// ResumeToken returns the token to resume the stream after this chunk from,
+// by passing it to WithContinue. It is empty on the final chunk.
+func (r *RangeStreamResponse) ResumeToken() []byte {
+	return r.resumeToken
 }
 
 // Err returns the error value if this RangeStreamResponse is the terminal
@@ -112,12 +134,14 @@ type OpResponse struct {
 	get *GetResponse
 	del *DeleteResponse
 	txn *TxnResponse
+	inc *IncrementResponse
 }
 
-func (op OpResponse) Put() *PutResponse    { return op.put }
-func (op OpResponse) Get() *GetResponse    { return op.get }
-func (op OpResponse) Del() *DeleteResponse { return op.del }
-func (op OpResponse) Txn() *TxnResponse    { return op.txn }
+func (op OpResponse) Put() *PutResponse             { return op.put }
+func (op OpResponse) Get() *GetResponse             { return op.get }
+func (op OpResponse) Del() *DeleteResponse          { return op.del }
+func (op OpResponse) Txn() *TxnResponse             { return op.txn }
+func (op OpResponse) Increment() *IncrementResponse { return op.inc }
 
 func (resp *PutResponse) OpResponse() OpResponse {
 	return OpResponse{put: resp}
@@ -135,6 +159,10 @@ func (resp *TxnResponse) OpResponse() OpResponse {
 	return OpResponse{txn: resp}
 }
 
+func (resp *IncrementResponse) OpResponse() OpResponse {
+	return OpResponse{inc: resp}
+}
+
 type kv struct {
 	remote   pb.KVClient
 	callOpts []grpc.CallOption
@@ -166,6 +194,15 @@ func (kv *kv) Get(ctx context.Context, key string, opts ...OpOption) (*GetRespon
 	return r.get, ContextError(ctx, err)
 }
 
+func (kv *kv) KeyHistory(ctx context.Context, key string, opts ...OpOption) (*KeyHistoryResponse, error) {
+	op := OpGet(key, opts...)
+	resp, err := kv.remote.KeyHistory(ctx, op.toKeyHistoryRequest(), kv.callOpts...)
+	if err != nil {
+		return nil, ContextError(ctx, err)
+	}
+	return (*KeyHistoryResponse)(resp), nil
+}
+
 func (kv *kv) GetStream(ctx context.Context, key string, opts ...OpOption) (GetStreamChan, error) {
 	op := OpGet(key, opts...)
 	c, err := kv.remote.RangeStream(ctx, op.toRangeRequest(), kv.callOpts...)
@@ -183,7 +220,7 @@ func (kv *kv) GetStream(ctx context.Context, key string, opts ...OpOption) (GetS
 				}
 				return
 			}
-			respCh <- RangeStreamResponse{RangeResponse: resp.RangeResponse}
+			respCh <- RangeStreamResponse{RangeResponse: resp.RangeResponse, resumeToken: resp.ResumeToken}
 		}
 	}()
 	return respCh, nil
@@ -225,15 +262,13 @@ func (kv *kv) Do(ctx context.Context, op Op) (OpResponse, error) {
 		}
 	case tPut:
 		var resp *pb.PutResponse
-		r := &pb.PutRequest{Key: op.key, Value: op.val, Lease: int64(op.leaseID), PrevKv: op.prevKV, IgnoreValue: op.ignoreValue, IgnoreLease: op.ignoreLease}
-		resp, err = kv.remote.Put(ctx, r, kv.callOpts...)
+		resp, err = kv.remote.Put(ctx, op.toPutRequest(), kv.callOpts...)
 		if err == nil {
 			return OpResponse{put: (*PutResponse)(resp)}, nil
 		}
 	case tDeleteRange:
 		var resp *pb.DeleteRangeResponse
-		r := &pb.DeleteRangeRequest{Key: op.key, RangeEnd: op.end, PrevKv: op.prevKV}
-		resp, err = kv.remote.DeleteRange(ctx, r, kv.callOpts...)
+		resp, err = kv.remote.DeleteRange(ctx, op.toDeleteRequest(), kv.callOpts...)
 		if err == nil {
 			return OpResponse{del: (*DeleteResponse)(resp)}, nil
 		}
@@ -243,6 +278,16 @@ func (kv *kv) Do(ctx context.Context, op Op) (OpResponse, error) {
 		if err == nil {
 			return OpResponse{txn: (*TxnResponse)(resp)}, nil
 		}
+	case tIncrement:
+		// increments are only served within a txn
+		var resp *pb.TxnResponse
+		r := &pb.TxnRequest{Success: []*pb.RequestOp{op.toRequestOp()}}
+		resp, err = kv.remote.Txn(ctx, r, kv.callOpts...)
+		if err == nil {
+			inc := resp.Responses[0].GetResponseIncrement()
+			inc.Header = resp.Header
+			return OpResponse{inc: (*IncrementResponse)(inc)}, nil
+		}
 	default:
 		panic("Unknown op")
 	}
@@ -17,6 +17,7 @@ package clientv3
 import (
 	"context"
 	"errors"
+	"io"
 	"sync"
 	"time"
 
@@ -29,8 +30,10 @@ import (
 )
 
 type (
-	LeaseRevokeResponse pb.LeaseRevokeResponse
-	LeaseID             int64
+	LeaseRevokeResponse      pb.LeaseRevokeResponse
+	LeaseRevokeBatchResponse pb.LeaseRevokeBatchResponse
+	LeaseUpdateTTLResponse   pb.LeaseUpdateTTLResponse
+	LeaseID                  int64
 )
 
 // LeaseGrantResponse wraps the protobuf message LeaseGrantResponse.
@@ -61,12 +64,23 @@ type LeaseTimeToLiveResponse struct {
 
 	// Keys is the list of keys attached to this lease.
 	Keys [][]byte `json:"keys"`
+
+	// MoreKeys tells whether there are more attached keys past Keys when they
+	// are limited with WithAttachedKeysLimit.
+	MoreKeys bool `json:"more-keys,omitempty"`
 }
 
 // LeaseStatus represents a lease status.
 type LeaseStatus struct {
 	ID LeaseID `json:"id"`
 	// TODO: TTL int64
+
+	// Keys is the list of keys attached to the lease, if requested with WithAttachedKeys.
+	Keys [][]byte `json:"keys,omitempty"`
+
+	// MoreKeys tells whether there are more attached keys past Keys. The
+	// remaining keys are listed with TimeToLive.
+	MoreKeys bool `json:"more-keys,omitempty"`
 }
 
 // LeaseLeasesResponse wraps the protobuf message LeaseLeasesResponse.
@@ -75,6 +89,26 @@ type LeaseLeasesResponse struct {
 	Leases []LeaseStatus `json:"leases"`
 }
 
+// LeaseWatchChan receives the responses of a lease watch.
+type LeaseWatchChan <-chan LeaseWatchResponse
+
+// LeaseWatchResponse wraps the protobuf message LeaseWatchResponse.
+//
+// If the stream fails, the final value sent on the channel is a terminal
+// LeaseWatchResponse with no events and a non-nil Err().
+type LeaseWatchResponse struct {
+	*pb.ResponseHeader
+	Events []*pb.LeaseEvent
+
+	closeErr error
+}
+
+// Err returns the error value if this LeaseWatchResponse is the terminal
+// error response of a failed stream.
+func (r *LeaseWatchResponse) Err() error {
+	return r.closeErr
+}
+
 const (
 	// defaultTTL is the assumed lease TTL used for the first keepalive
 	// deadline before the actual TTL is known to the client.
@@ -110,14 +144,36 @@ type Lease interface {
 	// Grant creates a new lease.
 	Grant(ctx context.Context, ttl int64) (*LeaseGrantResponse, error)
 
+	// GrantChild creates a new lease as a child of the given parent lease.
+	// The child lease is revoked along with its parent, whether the parent
+	// is revoked or expires.
+	GrantChild(ctx context.Context, parent LeaseID, ttl int64) (*LeaseGrantResponse, error)
+
 	// Revoke revokes the given lease.
 	Revoke(ctx context.Context, id LeaseID) (*LeaseRevokeResponse, error)
 
+	// RevokeBatch revokes the given leases in a single request. Leases that do
+	// not exist are skipped; the response lists the revoked ones.
+	RevokeBatch(ctx context.Context, ids []LeaseID) (*LeaseRevokeBatchResponse, error)
+
+	// UpdateTTL changes the TTL of the given lease and renews the lease with
+	// it. The keys attached to the lease stay attached.
+	UpdateTTL(ctx context.Context, id LeaseID, ttl int64) (*LeaseUpdateTTLResponse, error)
+
 	// TimeToLive retrieves the lease information of the given lease ID.
 	TimeToLive(ctx context.Context, id LeaseID, opts ...LeaseOption) (*LeaseTimeToLiveResponse, error)
 
-	// Leases retrieves all leases.
-	Leases(ctx context.Context) (*LeaseLeasesResponse, error)
+	// Leases retrieves all leases, or the ones selected with WithAttachedKeyRange
+	// or WithAttachedKeyPrefix.
+	Leases(ctx context.Context, opts ...LeaseOption) (*LeaseLeasesResponse, error)
+
+	// WatchLeases streams the lifecycle events of the given lease, or of all
+	// leases for NoLease: grants, checkpoints, revocations and expiries. The
+	// events are not stored, so only the events applied after the watch is
+	// opened are received. When passed WithAttachedKeys, revocations and
+	// expiries list the keys that were attached to the lease.
+	// Supported since etcd 3.8.
+	WatchLeases(ctx context.Context, id LeaseID, opts ...LeaseOption) (LeaseWatchChan, error)
 
 	// KeepAlive attempts to keep the given lease alive forever. If the keepalive responses posted
 	// to the channel are not consumed promptly the channel may become full. When full, the lease
@@ -220,7 +276,14 @@ func NewLeaseFromLeaseClient(remote pb.LeaseClient, c *Client, keepAliveTimeout
 }
 
 func (l *lessor) Grant(ctx context.Context, ttl int64) (*LeaseGrantResponse, error) {
-	r := &pb.LeaseGrantRequest{TTL: ttl}
+	return l.grant(ctx, &pb.LeaseGrantRequest{TTL: ttl})
+}
+
+func (l *lessor) GrantChild(ctx context.Context, parent LeaseID, ttl int64) (*LeaseGrantResponse, error) {
+	return l.grant(ctx, &pb.LeaseGrantRequest{TTL: ttl, Parent: int64(parent)})
+}
+
+func (l *lessor) grant(ctx context.Context, r *pb.LeaseGrantRequest) (*LeaseGrantResponse, error) {
 	resp, err := l.remote.LeaseGrant(ctx, r, l.callOpts...)
 	if err == nil {
 		gresp := &LeaseGrantResponse{
@@ -243,6 +306,27 @@ func (l *lessor) Revoke(ctx context.Context, id LeaseID) (*LeaseRevokeResponse,
 	return nil, ContextError(ctx, err)
 }
 
+func (l *lessor) RevokeBatch(ctx context.Context, ids []LeaseID) (*LeaseRevokeBatchResponse, error) {
+	r := &pb.LeaseRevokeBatchRequest{IDs: make([]int64, len(ids))}
+	for i, id := range ids {
+		r.IDs[i] = int64(id)
+	}
+	resp, err := l.remote.LeaseRevokeBatch(ctx, r, l.callOpts...)
+	if err == nil {
+		return (*LeaseRevokeBatchResponse)(resp), nil
+	}
+	return nil, ContextError(ctx, err)
+}
+
+func (l *lessor) UpdateTTL(ctx context.Context, id LeaseID, ttl int64) (*LeaseUpdateTTLResponse, error) {
+	r := &pb.LeaseUpdateTTLRequest{ID: int64(id), TTL: ttl}
+	resp, err := l.remote.LeaseUpdateTTL(ctx, r, l.callOpts...)
+	if err == nil {
+		return (*LeaseUpdateTTLResponse)(resp), nil
+	}
+	return nil, ContextError(ctx, err)
+}
+
 func (l *lessor) TimeToLive(ctx context.Context, id LeaseID, opts ...LeaseOption) (*LeaseTimeToLiveResponse, error) {
 	r := toLeaseTimeToLiveRequest(id, opts...)
 	resp, err := l.remote.LeaseTimeToLive(ctx, r, l.callOpts...)
@@ -255,22 +339,58 @@ func (l *lessor) TimeToLive(ctx context.Context, id LeaseID, opts ...LeaseOption
 		TTL:            resp.TTL,
 		GrantedTTL:     resp.GrantedTTL,
 		Keys:           resp.Keys,
+		MoreKeys:       resp.MoreKeys,
 	}
 	return gresp, nil
 }
 
-func (l *lessor) Leases(ctx context.Context) (*LeaseLeasesResponse, error) {
-	resp, err := l.remote.LeaseLeases(ctx, &pb.LeaseLeasesRequest{}, l.callOpts...)
+func (l *lessor) Leases(ctx context.Context, opts ...LeaseOption) (*LeaseLeasesResponse, error) {
+	r := toLeaseLeasesRequest(opts...)
+	resp, err := l.remote.LeaseLeases(ctx, r, l.callOpts...)
 	if err == nil {
 		leases := make([]LeaseStatus, len(resp.Leases))
 		for i := range resp.Leases {
-			leases[i] = LeaseStatus{ID: LeaseID(resp.Leases[i].ID)}
+			leases[i] = LeaseStatus{ID: LeaseID(resp.Leases[i].ID), Keys: resp.Leases[i].Keys, MoreKeys: resp.Leases[i].MoreKeys}
 		}
 		return &LeaseLeasesResponse{ResponseHeader: resp.GetHeader(), Leases: leases}, nil
 	}
 	return nil, ContextError(ctx, err)
 }
 
+func (l *lessor) WatchLeases(ctx context.Context, id LeaseID, opts ...LeaseOption) (LeaseWatchChan, error) {
+	c, err := l.remote.LeaseWatch(ctx, toLeaseWatchRequest(id, opts...), l.callOpts...)
+	if err != nil {
+		return nil, ContextError(ctx, err)
+	}
+	respCh := make(chan LeaseWatchResponse, 1)
+	go func() {
+		defer close(respCh)
+		for {
+			wr := LeaseWatchResponse{}
+			resp, err := c.Recv()
+			switch {
+			case errors.Is(err, io.EOF):
+				return
+			case err != nil:
+				wr.closeErr = ContextError(ctx, err)
+			default:
+				wr.ResponseHeader, wr.Events = resp.GetHeader(), resp.Events
+			}
+			// a watch lives until canceled, so do not block on a caller that
+			// stopped reading
+			select {
+			case respCh <- wr:
+			case <-ctx.Done():
+				return
+			}
+			if err != nil {
+				return
+			}
+		}
+	}()
+	return respCh, nil
+}
+
 // To identify the context passed to `KeepAlive`, a key/value pair is
 // attached to the context. The key is a `keepAliveCtxKey` object, and
 // the value is the pointer to the context object itself, ensuring
@@ -15,6 +15,7 @@
 package leasing
 
 import (
+	"bytes"
 	"context"
 	"strings"
 	"sync"
@@ -186,7 +187,10 @@ func (lc *leaseCache) EvictRange(key, end string) {
 	}
 }
 
-func isBadOp(op v3.Op) bool { return op.Rev() > 0 || len(op.RangeBytes()) > 0 }
+func isBadOp(op v3.Op) bool {
+	return op.Rev() > 0 || len(op.RangeBytes()) > 0 || op.LeaseFilter() != v3.LeaseFilterAny ||
+		len(op.ContinueToken()) > 0
+}
 
 func (lc *leaseCache) Get(ctx context.Context, op v3.Op) (*v3.GetResponse, bool) {
 	if isBadOp(op) {
@@ -216,6 +220,9 @@ func (lk *leaseKey) get(op v3.Op) *v3.GetResponse {
 	empty = empty || (op.MaxModRev() != 0 && op.MaxModRev() < resp.Kvs[0].ModRevision)
 	empty = empty || (op.MinCreateRev() > resp.Kvs[0].CreateRevision)
 	empty = empty || (op.MaxCreateRev() != 0 && op.MaxCreateRev() < resp.Kvs[0].CreateRevision)
+	empty = empty || !bytes.HasPrefix(resp.Kvs[0].Value, op.ValuePrefix())
+	empty = empty || (op.MinValueSize() > int64(len(resp.Kvs[0].Value)))
+	empty = empty || (op.MaxValueSize() != 0 && op.MaxValueSize() < int64(len(resp.Kvs[0].Value)))
 
 	ret := copyGetResponseMetadataOnly(resp)
 	if empty {
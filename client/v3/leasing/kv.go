@@ -95,6 +95,12 @@ func (lkv *leasingKV) GetStream(ctx context.Context, key string, opts ...v3.OpOp
 	return nil, status.Error(codes.Unimplemented, "GetStream is not supported by leasingKV")
 }
 
+// KeyHistory reads the history of the key from the server, since the history
+// is never cached.
+func (lkv *leasingKV) KeyHistory(ctx context.Context, key string, opts ...v3.OpOption) (*v3.KeyHistoryResponse, error) {
+	return lkv.kv.KeyHistory(ctx, key, opts...)
+}
+
 func (lkv *leasingKV) Delete(ctx context.Context, key string, opts ...v3.OpOption) (*v3.DeleteResponse, error) {
 	return lkv.delete(ctx, v3.OpDelete(key, opts...))
 }
@@ -114,6 +120,14 @@ func (lkv *leasingKV) Do(ctx context.Context, op v3.Op) (v3.OpResponse, error) {
 		cmps, thenOps, elseOps := op.Txn()
 		resp, err := lkv.Txn(ctx).If(cmps...).Then(thenOps...).Else(elseOps...).Commit()
 		return resp.OpResponse(), err
+	case op.IsIncrement():
+		resp, err := lkv.Txn(ctx).Then(op).Commit()
+		if err != nil {
+			return v3.OpResponse{}, err
+		}
+		incResp := resp.Responses[0].GetResponseIncrement()
+		incResp.Header = resp.Header
+		return (*v3.IncrementResponse)(incResp).OpResponse(), nil
 	}
 	return v3.OpResponse{}, nil
 }
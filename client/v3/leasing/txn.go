@@ -157,9 +157,9 @@ func (txn *txnLeasing) guard(ops []v3.Op) ([]v3.Cmp, error) {
 }
 
 func (txn *txnLeasing) commitToCache(txnResp *v3pb.TxnResponse, userTxn v3.Op) {
-	ops := gatherResponseOps(txnResp.Responses, []v3.Op{userTxn})
+	ops, resps := gatherResponseOps(txnResp.Responses, []v3.Op{userTxn})
 	txn.lkv.leases.mu.Lock()
-	for _, op := range ops {
+	for i, op := range ops {
 		key := string(op.KeyBytes())
 		if op.IsDelete() && len(op.RangeBytes()) > 0 {
 			end := string(op.RangeBytes())
@@ -174,6 +174,10 @@ func (txn *txnLeasing) commitToCache(txnResp *v3pb.TxnResponse, userTxn v3.Op) {
 		if op.IsPut() {
 			txn.lkv.leases.Update(op.KeyBytes(), op.ValueBytes(), txnResp.Header)
 		}
+		if op.IsIncrement() {
+			val := counterValue(op.CounterEncoding(), resps[i].GetResponseIncrement().Value)
+			txn.lkv.leases.Update(op.KeyBytes(), val, txnResp.Header)
+		}
 	}
 	txn.lkv.leases.mu.Unlock()
 }
@@ -16,6 +16,8 @@ package leasing
 
 import (
 	"bytes"
+	"encoding/binary"
+	"strconv"
 
 	v3pb "go.etcd.io/etcd/api/v3/etcdserverpb"
 	"go.etcd.io/etcd/api/v3/mvccpb"
@@ -55,6 +57,28 @@ func evalCmp(resp *v3.GetResponse, tcmp v3.Cmp) bool {
 			if tv, _ := cmp.GetTargetUnion().(*v3pb.Compare_Version); tv != nil {
 				result = compareInt64(kv.Version, tv.Version)
 			}
+		case v3pb.Compare_COUNT:
+			if tv, _ := cmp.GetTargetUnion().(*v3pb.Compare_Count); tv != nil {
+				result = compareInt64(1, tv.Count)
+			}
+		case v3pb.Compare_MAX_CREATE:
+			if tv, _ := cmp.GetTargetUnion().(*v3pb.Compare_MaxCreateRevision); tv != nil {
+				result = compareInt64(kv.CreateRevision, tv.MaxCreateRevision)
+			}
+		case v3pb.Compare_MAX_MOD:
+			if tv, _ := cmp.GetTargetUnion().(*v3pb.Compare_MaxModRevision); tv != nil {
+				result = compareInt64(kv.ModRevision, tv.MaxModRevision)
+			}
+		}
+	} else {
+		// aggregate targets over an empty range compare as zero
+		switch tv := tcmp.GetCompare().GetTargetUnion().(type) {
+		case *v3pb.Compare_Count:
+			result = compareInt64(0, tv.Count)
+		case *v3pb.Compare_MaxCreateRevision:
+			result = compareInt64(0, tv.MaxCreateRevision)
+		case *v3pb.Compare_MaxModRevision:
+			result = compareInt64(0, tv.MaxModRevision)
 		}
 	}
 	switch tcmp.GetCompare().GetResult() {
@@ -82,20 +106,30 @@ func gatherOps(ops []v3.Op) (ret []v3.Op) {
 	return ret
 }
 
-func gatherResponseOps(resp []*v3pb.ResponseOp, ops []v3.Op) (ret []v3.Op) {
+// gatherResponseOps flattens the ops executed by a txn along with their responses.
+func gatherResponseOps(resp []*v3pb.ResponseOp, ops []v3.Op) (ret []v3.Op, retResp []*v3pb.ResponseOp) {
 	for i, op := range ops {
 		if !op.IsTxn() {
-			ret = append(ret, op)
+			ret, retResp = append(ret, op), append(retResp, resp[i])
 			continue
 		}
 		_, thenOps, elseOps := op.Txn()
-		if txnResp := resp[i].GetResponseTxn(); txnResp.Succeeded {
-			ret = append(ret, gatherResponseOps(txnResp.Responses, thenOps)...)
-		} else {
-			ret = append(ret, gatherResponseOps(txnResp.Responses, elseOps)...)
+		txnResp := resp[i].GetResponseTxn()
+		if !txnResp.Succeeded {
+			thenOps = elseOps
 		}
+		subOps, subResps := gatherResponseOps(txnResp.Responses, thenOps)
+		ret, retResp = append(ret, subOps...), append(retResp, subResps...)
 	}
-	return ret
+	return ret, retResp
+}
+
+// counterValue encodes a counter the same way the server stores it for an increment.
+func counterValue(enc v3.CounterEncoding, n int64) []byte {
+	if enc == v3.CounterBigEndian {
+		return binary.BigEndian.AppendUint64(nil, uint64(n))
+	}
+	return strconv.AppendInt(nil, n, 10)
 }
 
 func copyHeader(hdr *v3pb.ResponseHeader) *v3pb.ResponseHeader {
@@ -117,10 +151,11 @@ func copyGetResponseMetadataOnly(resp *v3.GetResponse) *v3.GetResponse {
 	}
 
 	return &v3.GetResponse{
-		Header: copyHeader(resp.Header),
-		Kvs:    nil,
-		More:   resp.More,
-		Count:  resp.Count,
+		Header:        copyHeader(resp.Header),
+		Kvs:           nil,
+		More:          resp.More,
+		Count:         resp.Count,
+		ContinueToken: bytes.Clone(resp.ContinueToken),
 	}
 }
 
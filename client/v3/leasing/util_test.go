@@ -50,8 +50,8 @@ func TestCopyHeader(t *testing.T) {
 }
 
 func TestCopyGetResponseMetadataOnly(t *testing.T) {
-	t.Run("GetResponse should have 4 protobuf fields", func(t *testing.T) {
-		require.Equal(t, 4, countProtobufFields(&v3.GetResponse{}))
+	t.Run("GetResponse should have 5 protobuf fields", func(t *testing.T) {
+		require.Equal(t, 5, countProtobufFields(&v3.GetResponse{}))
 	})
 
 	t.Run("nil GetResponse", func(t *testing.T) {
@@ -75,14 +75,16 @@ func TestCopyGetResponseMetadataOnly(t *testing.T) {
 					Version:        3,
 				},
 			},
-			More:  true,
-			Count: 1,
+			More:          true,
+			Count:         1,
+			ContinueToken: []byte("token"),
 		}
 		actual := copyGetResponseMetadataOnly(want)
 		require.Equal(t, want.Header, actual.Header)
 		require.Nil(t, actual.Kvs)
 		require.True(t, actual.More)
 		require.Equal(t, int64(1), actual.Count)
+		require.Equal(t, want.ContinueToken, actual.ContinueToken)
 
 		actual.Header.ClusterId = 999
 		require.Equal(t, uint64(123), want.Header.ClusterId)
@@ -35,6 +35,10 @@ type (
 	MoveLeaderResponse pb.MoveLeaderResponse
 	DowngradeResponse  pb.DowngradeResponse
 
+	RevisionHoldResponse     pb.RevisionHoldResponse
+	CompactionPolicyResponse pb.CompactionPolicyResponse
+	WatchersResponse         pb.WatchersResponse
+
 	DowngradeAction pb.DowngradeRequest_DowngradeAction
 )
 
@@ -87,6 +91,31 @@ type Maintenance interface {
 	// on the cluster version.
 	// Supported since etcd 3.5.
 	Downgrade(ctx context.Context, action DowngradeAction, version string) (*DowngradeResponse, error)
+
+	// HoldRevision protects the given revision from compaction until the hold
+	// is released or the given lease is revoked. If rev is 0, the current
+	// revision is held. If leaseID is NoLease, the hold lives until it is released.
+	// Compactions past the oldest held revision are clamped to it.
+	// Supported since etcd 3.8.
+	HoldRevision(ctx context.Context, rev int64, leaseID LeaseID) (*RevisionHoldResponse, error)
+
+	// ReleaseRevisionHold releases the hold with the given ID.
+	// Supported since etcd 3.8.
+	ReleaseRevisionHold(ctx context.Context, id int64) (*RevisionHoldResponse, error)
+
+	// RevisionHolds lists all revision holds, ordered by revision.
+	// Supported since etcd 3.8.
+	RevisionHolds(ctx context.Context) (*RevisionHoldResponse, error)
+
+	// CompactionPolicy gets the auto compaction policy of the endpoint and
+	// the revision the keys under each of its retention rules are compacted up to.
+	// Supported since etcd 3.8.
+	CompactionPolicy(ctx context.Context, endpoint string) (*CompactionPolicyResponse, error)
+
+	// Watchers lists the watchers of the endpoint, with how far each of them
+	// caught up with the store and how long it was blocked on its watch stream.
+	// Supported since etcd 3.8.
+	Watchers(ctx context.Context, endpoint string) (*WatchersResponse, error)
 }
 
 // SnapshotResponse is aggregated response from the snapshot stream.
@@ -214,6 +243,32 @@ func (m *maintenance) Status(ctx context.Context, endpoint string) (*StatusRespo
 	return (*StatusResponse)(resp), nil
 }
 
+func (m *maintenance) CompactionPolicy(ctx context.Context, endpoint string) (*CompactionPolicyResponse, error) {
+	remote, cancel, err := m.dial(endpoint)
+	if err != nil {
+		return nil, ContextError(ctx, err)
+	}
+	defer cancel()
+	resp, err := remote.CompactionPolicy(ctx, &pb.CompactionPolicyRequest{}, m.callOpts...)
+	if err != nil {
+		return nil, ContextError(ctx, err)
+	}
+	return (*CompactionPolicyResponse)(resp), nil
+}
+
+func (m *maintenance) Watchers(ctx context.Context, endpoint string) (*WatchersResponse, error) {
+	remote, cancel, err := m.dial(endpoint)
+	if err != nil {
+		return nil, ContextError(ctx, err)
+	}
+	defer cancel()
+	resp, err := remote.Watchers(ctx, &pb.WatchersRequest{}, m.callOpts...)
+	if err != nil {
+		return nil, ContextError(ctx, err)
+	}
+	return (*WatchersResponse)(resp), nil
+}
+
 func (m *maintenance) HashKV(ctx context.Context, endpoint string, rev int64) (*HashKVResponse, error) {
 	remote, cancel, err := m.dial(endpoint)
 	if err != nil {
@@ -348,3 +403,29 @@ func (m *maintenance) Downgrade(ctx context.Context, action DowngradeAction, ver
 	resp, err := m.remote.Downgrade(ctx, &pb.DowngradeRequest{Action: actionType, Version: version}, m.callOpts...)
 	return (*DowngradeResponse)(resp), ContextError(ctx, err)
 }
+
+func (m *maintenance) HoldRevision(ctx context.Context, rev int64, leaseID LeaseID) (*RevisionHoldResponse, error) {
+	req := &pb.RevisionHoldRequest{Action: pb.RevisionHoldRequest_CREATE, Revision: rev, Lease: int64(leaseID)}
+	resp, err := m.remote.RevisionHold(ctx, req, m.callOpts...)
+	if err != nil {
+		return nil, ContextError(ctx, err)
+	}
+	return (*RevisionHoldResponse)(resp), nil
+}
+
+func (m *maintenance) ReleaseRevisionHold(ctx context.Context, id int64) (*RevisionHoldResponse, error) {
+	req := &pb.RevisionHoldRequest{Action: pb.RevisionHoldRequest_RELEASE, ID: id}
+	resp, err := m.remote.RevisionHold(ctx, req, m.callOpts...)
+	if err != nil {
+		return nil, ContextError(ctx, err)
+	}
+	return (*RevisionHoldResponse)(resp), nil
+}
+
+func (m *maintenance) RevisionHolds(ctx context.Context) (*RevisionHoldResponse, error) {
+	resp, err := m.remote.RevisionHold(ctx, &pb.RevisionHoldRequest{Action: pb.RevisionHoldRequest_LIST}, m.callOpts...)
+	if err != nil {
+		return nil, ContextError(ctx, err)
+	}
+	return (*RevisionHoldResponse)(resp), nil
+}
@@ -192,6 +192,10 @@ func (m *mockKVServer) RangeStream(*pb.RangeRequest, grpc.ServerStreamingServer[
 	return status.Error(codes.Unimplemented, "RangeStream is not supported by the mock server")
 }
 
+func (m *mockKVServer) KeyHistory(context.Context, *pb.KeyHistoryRequest) (*pb.KeyHistoryResponse, error) {
+	return &pb.KeyHistoryResponse{}, nil
+}
+
 func (m *mockKVServer) Compact(context.Context, *pb.CompactionRequest) (*pb.CompactionResponse, error) {
 	return &pb.CompactionResponse{}, nil
 }
@@ -213,6 +217,14 @@ func (s *mockLeaseServer) LeaseRevoke(context.Context, *pb.LeaseRevokeRequest) (
 	return &pb.LeaseRevokeResponse{}, nil
 }
 
+func (s *mockLeaseServer) LeaseRevokeBatch(context.Context, *pb.LeaseRevokeBatchRequest) (*pb.LeaseRevokeBatchResponse, error) {
+	return &pb.LeaseRevokeBatchResponse{}, nil
+}
+
+func (s *mockLeaseServer) LeaseUpdateTTL(context.Context, *pb.LeaseUpdateTTLRequest) (*pb.LeaseUpdateTTLResponse, error) {
+	return &pb.LeaseUpdateTTLResponse{}, nil
+}
+
 func (s *mockLeaseServer) LeaseKeepAlive(pb.Lease_LeaseKeepAliveServer) error {
 	return nil
 }
@@ -224,3 +236,7 @@ func (s *mockLeaseServer) LeaseTimeToLive(context.Context, *pb.LeaseTimeToLiveRe
 func (s *mockLeaseServer) LeaseLeases(context.Context, *pb.LeaseLeasesRequest) (*pb.LeaseLeasesResponse, error) {
 	return &pb.LeaseLeasesResponse{}, nil
 }
+
+func (s *mockLeaseServer) LeaseWatch(*pb.LeaseWatchRequest, pb.Lease_LeaseWatchServer) error {
+	return nil
+}
@@ -17,9 +17,6 @@ package namespace
 import (
 	"context"
 
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
-
 	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
 	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
 	clientv3 "go.etcd.io/etcd/client/v3"
@@ -67,9 +64,45 @@ func (kv *kvPrefix) Get(ctx context.Context, key string, opts ...clientv3.OpOpti
 	return get, nil
 }
 
-// GetStream is not supported by kvPrefix.
 func (kv *kvPrefix) GetStream(ctx context.Context, key string, opts ...clientv3.OpOption) (clientv3.GetStreamChan, error) {
-	return nil, status.Error(codes.Unimplemented, "GetStream is not supported by kvPrefix")
+	if len(key) == 0 && !(clientv3.IsOptsWithFromKey(opts) || clientv3.IsOptsWithPrefix(opts)) {
+		return nil, rpctypes.ErrEmptyKey
+	}
+	getOp := clientv3.OpGet(key, opts...)
+	if !getOp.IsSortOptionValid() {
+		return nil, rpctypes.ErrInvalidSortOption
+	}
+	// the prefixed range overrides the range set by the options
+	begin, end := kv.prefixInterval(getOp.KeyBytes(), getOp.RangeBytes())
+	stream, err := kv.KV.GetStream(ctx, string(begin), append(opts, clientv3.WithRange(string(end)))...)
+	if err != nil {
+		return nil, err
+	}
+	respCh := make(chan clientv3.RangeStreamResponse, 1)
+	go func() {
+		defer close(respCh)
+		for resp := range stream {
+			if resp.RangeResponse != nil {
+				kv.unprefixGetResponse((*clientv3.GetResponse)(resp.RangeResponse))
+			}
+			respCh <- resp
+		}
+	}()
+	return respCh, nil
+}
+
+func (kv *kvPrefix) KeyHistory(ctx context.Context, key string, opts ...clientv3.OpOption) (*clientv3.KeyHistoryResponse, error) {
+	if len(key) == 0 {
+		return nil, rpctypes.ErrEmptyKey
+	}
+	resp, err := kv.KV.KeyHistory(ctx, kv.pfx+key, opts...)
+	if err != nil {
+		return nil, err
+	}
+	for i := range resp.Kvs {
+		resp.Kvs[i].Key = resp.Kvs[i].Key[len(kv.pfx):]
+	}
+	return resp, nil
 }
 
 func (kv *kvPrefix) Delete(ctx context.Context, key string, opts ...clientv3.OpOption) (*clientv3.DeleteResponse, error) {
@@ -102,6 +135,8 @@ func (kv *kvPrefix) Do(ctx context.Context, op clientv3.Op) (clientv3.OpResponse
 		kv.unprefixDeleteResponse(r.Del())
 	case r.Txn() != nil:
 		kv.unprefixTxnResponse(r.Txn())
+	case r.Increment() != nil:
+		kv.unprefixIncrementResponse(r.Increment())
 	}
 	return r, nil
 }
@@ -168,6 +203,12 @@ func (kv *kvPrefix) unprefixDeleteResponse(resp *clientv3.DeleteResponse) {
 	}
 }
 
+func (kv *kvPrefix) unprefixIncrementResponse(resp *clientv3.IncrementResponse) {
+	if resp.PrevKv != nil {
+		resp.PrevKv.Key = resp.PrevKv.Key[len(kv.pfx):]
+	}
+}
+
 func (kv *kvPrefix) unprefixTxnResponse(resp *clientv3.TxnResponse) {
 	for _, r := range resp.Responses {
 		switch tv := r.Response.(type) {
@@ -183,6 +224,10 @@ func (kv *kvPrefix) unprefixTxnResponse(resp *clientv3.TxnResponse) {
 			if tv.ResponseDeleteRange != nil {
 				kv.unprefixDeleteResponse((*clientv3.DeleteResponse)(tv.ResponseDeleteRange))
 			}
+		case *pb.ResponseOp_ResponseIncrement:
+			if tv.ResponseIncrement != nil {
+				kv.unprefixIncrementResponse((*clientv3.IncrementResponse)(tv.ResponseIncrement))
+			}
 		case *pb.ResponseOp_ResponseTxn:
 			if tv.ResponseTxn != nil {
 				kv.unprefixTxnResponse((*clientv3.TxnResponse)(tv.ResponseTxn))
@@ -27,7 +27,8 @@ type leasePrefix struct {
 }
 
 // NewLease wraps a Lease interface to filter for only keys with a prefix
-// and remove that prefix when fetching attached keys through TimeToLive.
+// and remove that prefix when fetching attached keys through TimeToLive, Leases
+// and WatchLeases.
 func NewLease(l clientv3.Lease, prefix string) clientv3.Lease {
 	return &leasePrefix{l, []byte(prefix)}
 }
@@ -37,21 +38,60 @@ func (l *leasePrefix) TimeToLive(ctx context.Context, id clientv3.LeaseID, opts
 	if err != nil {
 		return nil, err
 	}
-	if len(resp.Keys) > 0 {
-		var outKeys [][]byte
-		for i := range resp.Keys {
-			if len(resp.Keys[i]) < len(l.pfx) {
-				// too short
-				continue
+	resp.Keys = l.unprefixKeys(resp.Keys)
+	return resp, nil
+}
+
+func (l *leasePrefix) Leases(ctx context.Context, opts ...clientv3.LeaseOption) (*clientv3.LeaseLeasesResponse, error) {
+	resp, err := l.Lease.Leases(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+	for i := range resp.Leases {
+		resp.Leases[i].Keys = l.unprefixKeys(resp.Leases[i].Keys)
+	}
+	return resp, nil
+}
+
+func (l *leasePrefix) WatchLeases(ctx context.Context, id clientv3.LeaseID, opts ...clientv3.LeaseOption) (clientv3.LeaseWatchChan, error) {
+	wch, err := l.Lease.WatchLeases(ctx, id, opts...)
+	if err != nil {
+		return nil, err
+	}
+	pfxch := make(chan clientv3.LeaseWatchResponse)
+	go func() {
+		defer close(pfxch)
+		for wr := range wch {
+			for _, ev := range wr.Events {
+				ev.Keys = l.unprefixKeys(ev.Keys)
 			}
-			if !bytes.Equal(resp.Keys[i][:len(l.pfx)], l.pfx) {
-				// doesn't match prefix
-				continue
+			select {
+			case pfxch <- wr:
+			case <-ctx.Done():
+				return
 			}
-			// strip prefix
-			outKeys = append(outKeys, resp.Keys[i][len(l.pfx):])
 		}
-		resp.Keys = outKeys
+	}()
+	return pfxch, nil
+}
+
+// unprefixKeys drops the keys outside of the prefix and strips it from the others.
+func (l *leasePrefix) unprefixKeys(keys [][]byte) [][]byte {
+	if len(keys) == 0 {
+		return keys
 	}
-	return resp, nil
+	var outKeys [][]byte
+	for i := range keys {
+		if len(keys[i]) < len(l.pfx) {
+			// too short
+			continue
+		}
+		if !bytes.Equal(keys[i][:len(l.pfx)], l.pfx) {
+			// doesn't match prefix
+			continue
+		}
+		// strip prefix
+		outKeys = append(outKeys, keys[i][len(l.pfx):])
+	}
+	return outKeys
 }
@@ -45,6 +45,25 @@ func (w *watcherPrefix) Watch(ctx context.Context, key string, opts ...clientv3.
 	if pfxEnd != nil {
 		opts = append(opts, clientv3.WithRange(string(pfxEnd)))
 	}
+	if ranges := op.Ranges(); len(ranges) != 0 {
+		pfxRanges := make([]clientv3.KeyRange, len(ranges))
+		for i, r := range ranges {
+			k, e := prefixInterval(w.pfx, []byte(r.Key), []byte(r.End))
+			pfxRanges[i] = clientv3.KeyRange{Key: string(k), End: string(e)}
+		}
+		opts = append(opts, clientv3.WithRanges(pfxRanges...))
+	}
+
+	if known := op.Bootstrap(); known != nil {
+		opts = append(opts, clientv3.WithBootstrap(func() []string {
+			keys := known()
+			pfxKeys := make([]string, len(keys))
+			for i, k := range keys {
+				pfxKeys[i] = w.pfx + k
+			}
+			return pfxKeys
+		}))
+	}
 
 	wch := w.Watcher.Watch(ctx, string(pfxBegin), opts...)
 
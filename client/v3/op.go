@@ -24,6 +24,24 @@ const (
 	tPut
 	tDeleteRange
 	tTxn
+	tIncrement
+)
+
+// LeaseFilter selects keys for 'Get' based on whether they are attached to a lease.
+type LeaseFilter int
+
+const (
+	LeaseFilterAny LeaseFilter = iota
+	LeaseFilterAttached
+	LeaseFilterDetached
+)
+
+// CounterEncoding is how the value of a counter key is stored for 'Increment'.
+type CounterEncoding int
+
+const (
+	CounterDecimal CounterEncoding = iota
+	CounterBigEndian
 )
 
 var noPrefixEnd = []byte{0}
@@ -44,6 +62,11 @@ type Op struct {
 	maxModRev    int64
 	minCreateRev int64
 	maxCreateRev int64
+	valuePrefix  []byte
+	minValueSize int64
+	maxValueSize int64
+	leaseFilter  LeaseFilter
+	continueTok  []byte
 
 	// for range, watch
 	rev int64
@@ -57,6 +80,10 @@ type Op struct {
 	// "--max-request-bytes" flag value + 512-byte
 	fragment           bool
 	watchBufLogEnabled bool
+	coalesce           bool
+	subscription       string
+	ranges             []KeyRange
+	bootstrap          func() []string
 
 	// for put
 	ignoreValue bool
@@ -67,12 +94,24 @@ type Op struct {
 	// createdNotify is for created event
 	createdNotify bool
 	// filters for watchers
-	filterPut    bool
-	filterDelete bool
+	filterPut       bool
+	filterDelete    bool
+	filterUnchanged bool
+	filterUpdate    bool
 
 	// for put
 	val     []byte
 	leaseID LeaseID
+	ttl     int64
+
+	// for delete
+	batchSize   int64
+	atomicBatch bool
+	maxBatches  int64
+
+	// for increment
+	delta    int64
+	encoding CounterEncoding
 
 	// txn
 	cmps    []Cmp
@@ -119,6 +158,15 @@ func (op Op) IsGet() bool { return op.t == tRange }
 // IsDelete returns true iff the operation is a Delete.
 func (op Op) IsDelete() bool { return op.t == tDeleteRange }
 
+// IsIncrement returns true iff the operation is an Increment.
+func (op Op) IsIncrement() bool { return op.t == tIncrement }
+
+// Delta returns the amount added to the counter by an Increment.
+func (op Op) Delta() int64 { return op.delta }
+
+// CounterEncoding returns the counter encoding of an Increment.
+func (op Op) CounterEncoding() CounterEncoding { return op.encoding }
+
 // IsSerializable returns true if the serializable field is true.
 func (op Op) IsSerializable() bool { return op.serializable }
 
@@ -141,6 +189,19 @@ func (op Op) IsPrevKV() bool { return op.prevKV }
 // IsFragment returns whether WithFragment() is set.
 func (op Op) IsFragment() bool { return op.fragment }
 
+// IsCoalesce returns whether WithCoalesce() is set.
+func (op Op) IsCoalesce() bool { return op.coalesce }
+
+// Subscription returns the subscription set by WithSubscription().
+func (op Op) Subscription() string { return op.subscription }
+
+// Ranges returns the additional key ranges set by WithRanges().
+func (op Op) Ranges() []KeyRange { return op.ranges }
+
+// Bootstrap returns the known keys function set by WithBootstrap(), or nil
+// if the option is not set.
+func (op Op) Bootstrap() func() []string { return op.bootstrap }
+
 // IsProgressNotify returns whether WithProgressNotify() is set.
 func (op Op) IsProgressNotify() bool { return op.progressNotify }
 
@@ -153,6 +214,15 @@ func (op Op) IsFilterPut() bool { return op.filterPut }
 // IsFilterDelete returns whether WithFilterDelete() is set.
 func (op Op) IsFilterDelete() bool { return op.filterDelete }
 
+// IsFilterUnchanged returns whether WithFilterUnchanged() is set.
+func (op Op) IsFilterUnchanged() bool { return op.filterUnchanged }
+
+// IsFilterUpdate returns whether WithFilterUpdate() is set.
+func (op Op) IsFilterUpdate() bool { return op.filterUpdate }
+
+// LeaseID returns the lease ID set by WithLease(), if any.
+func (op Op) LeaseID() LeaseID { return op.leaseID }
+
 // MinModRev returns the operation's minimum modify revision.
 func (op Op) MinModRev() int64 { return op.minModRev }
 
@@ -165,6 +235,26 @@ func (op Op) MinCreateRev() int64 { return op.minCreateRev }
 // MaxCreateRev returns the operation's maximum create revision.
 func (op Op) MaxCreateRev() int64 { return op.maxCreateRev }
 
+// ValuePrefix returns the operation's value prefix filter, if any.
+func (op Op) ValuePrefix() []byte { return op.valuePrefix }
+
+// MinValueSize returns the operation's minimum value size.
+func (op Op) MinValueSize() int64 { return op.minValueSize }
+
+// MaxValueSize returns the operation's maximum value size.
+func (op Op) MaxValueSize() int64 { return op.maxValueSize }
+
+// LeaseFilter returns the operation's lease filter.
+func (op Op) LeaseFilter() LeaseFilter { return op.leaseFilter }
+
+// ContinueToken returns the continue token the range resumes from, if any.
+func (op Op) ContinueToken() []byte { return op.continueTok }
+
+// hasValueFilters returns true if any of the value or lease filters is set.
+func (op Op) hasValueFilters() bool {
+	return len(op.valuePrefix) != 0 || op.minValueSize != 0 || op.maxValueSize != 0 || op.leaseFilter != LeaseFilterAny
+}
+
 // WithRangeBytes sets the byte slice for the Op's range end.
 func (op *Op) WithRangeBytes(end []byte) { op.end = end }
 
@@ -190,6 +280,11 @@ func (op Op) toRangeRequest() *pb.RangeRequest {
 		MaxModRevision:    op.maxModRev,
 		MinCreateRevision: op.minCreateRev,
 		MaxCreateRevision: op.maxCreateRev,
+		ValuePrefix:       op.valuePrefix,
+		MinValueSize:      op.minValueSize,
+		MaxValueSize:      op.maxValueSize,
+		LeaseFilter:       pb.RangeRequest_LeaseFilter(op.leaseFilter),
+		ContinueToken:     op.continueTok,
 	}
 	if op.sort != nil {
 		r.SortOrder = pb.RangeRequest_SortOrder(op.sort.Order)
@@ -198,6 +293,22 @@ func (op Op) toRangeRequest() *pb.RangeRequest {
 	return r
 }
 
+func (op Op) toKeyHistoryRequest() *pb.KeyHistoryRequest {
+	r := &pb.KeyHistoryRequest{
+		Key:            op.key,
+		Revision:       op.rev,
+		MinModRevision: op.minModRev,
+		MaxModRevision: op.maxModRev,
+		Limit:          op.limit,
+		Serializable:   op.serializable,
+		KeysOnly:       op.keysOnly,
+	}
+	if op.sort != nil {
+		r.SortOrder = pb.RangeRequest_SortOrder(op.sort.Order)
+	}
+	return r
+}
+
 func (op Op) toTxnRequest() *pb.TxnRequest {
 	thenOps := make([]*pb.RequestOp, len(op.thenOps))
 	for i, tOp := range op.thenOps {
@@ -220,18 +331,52 @@ func (op Op) toRequestOp() *pb.RequestOp {
 	case tRange:
 		return &pb.RequestOp{Request: &pb.RequestOp_RequestRange{RequestRange: op.toRangeRequest()}}
 	case tPut:
-		r := &pb.PutRequest{Key: op.key, Value: op.val, Lease: int64(op.leaseID), PrevKv: op.prevKV, IgnoreValue: op.ignoreValue, IgnoreLease: op.ignoreLease}
-		return &pb.RequestOp{Request: &pb.RequestOp_RequestPut{RequestPut: r}}
+		return &pb.RequestOp{Request: &pb.RequestOp_RequestPut{RequestPut: op.toPutRequest()}}
 	case tDeleteRange:
-		r := &pb.DeleteRangeRequest{Key: op.key, RangeEnd: op.end, PrevKv: op.prevKV}
-		return &pb.RequestOp{Request: &pb.RequestOp_RequestDeleteRange{RequestDeleteRange: r}}
+		return &pb.RequestOp{Request: &pb.RequestOp_RequestDeleteRange{RequestDeleteRange: op.toDeleteRequest()}}
 	case tTxn:
 		return &pb.RequestOp{Request: &pb.RequestOp_RequestTxn{RequestTxn: op.toTxnRequest()}}
+	case tIncrement:
+		return &pb.RequestOp{Request: &pb.RequestOp_RequestIncrement{RequestIncrement: op.toIncrementRequest()}}
 	default:
 		panic("Unknown Op")
 	}
 }
 
+func (op Op) toDeleteRequest() *pb.DeleteRangeRequest {
+	return &pb.DeleteRangeRequest{
+		Key:        op.key,
+		RangeEnd:   op.end,
+		PrevKv:     op.prevKV,
+		BatchSize:  op.batchSize,
+		Atomic:     op.atomicBatch,
+		MaxBatches: op.maxBatches,
+	}
+}
+
+func (op Op) toPutRequest() *pb.PutRequest {
+	return &pb.PutRequest{
+		Key:         op.key,
+		Value:       op.val,
+		Lease:       int64(op.leaseID),
+		PrevKv:      op.prevKV,
+		IgnoreValue: op.ignoreValue,
+		IgnoreLease: op.ignoreLease,
+		Ttl:         op.ttl,
+	}
+}
+
+func (op Op) toIncrementRequest() *pb.IncrementRequest {
+	return &pb.IncrementRequest{
+		Key:         op.key,
+		Delta:       op.delta,
+		Encoding:    pb.IncrementRequest_Encoding(op.encoding),
+		Lease:       int64(op.leaseID),
+		IgnoreLease: op.ignoreLease,
+		PrevKv:      op.prevKV,
+	}
+}
+
 func (op Op) isWrite() bool {
 	if op.t == tTxn {
 		for _, tOp := range op.thenOps {
@@ -275,8 +420,12 @@ func OpDelete(key string, opts ...OpOption) Op {
 	switch {
 	case ret.leaseID != 0:
 		panic("unexpected lease in delete")
+	case ret.ttl != 0:
+		panic("unexpected ttl in delete")
 	case ret.limit != 0:
 		panic("unexpected limit in delete")
+	case ret.continueTok != nil:
+		panic("unexpected continue token in delete")
 	case ret.rev != 0:
 		panic("unexpected revision in delete")
 	case ret.sort != nil:
@@ -289,7 +438,9 @@ func OpDelete(key string, opts ...OpOption) Op {
 		panic("unexpected mod revision filter in delete")
 	case ret.minCreateRev != 0, ret.maxCreateRev != 0:
 		panic("unexpected create revision filter in delete")
-	case ret.filterDelete, ret.filterPut:
+	case ret.hasValueFilters():
+		panic("unexpected value filter in delete")
+	case ret.filterDelete, ret.filterPut, ret.filterUnchanged, ret.filterUpdate:
 		panic("unexpected filter in delete")
 	case ret.createdNotify:
 		panic("unexpected createdNotify in delete")
@@ -306,6 +457,8 @@ func OpPut(key, val string, opts ...OpOption) Op {
 		panic("unexpected range in put")
 	case ret.limit != 0:
 		panic("unexpected limit in put")
+	case ret.continueTok != nil:
+		panic("unexpected continue token in put")
 	case ret.rev != 0:
 		panic("unexpected revision in put")
 	case ret.sort != nil:
@@ -318,7 +471,9 @@ func OpPut(key, val string, opts ...OpOption) Op {
 		panic("unexpected mod revision filter in put")
 	case ret.minCreateRev != 0, ret.maxCreateRev != 0:
 		panic("unexpected create revision filter in put")
-	case ret.filterDelete, ret.filterPut:
+	case ret.hasValueFilters():
+		panic("unexpected value filter in put")
+	case ret.filterDelete, ret.filterPut, ret.filterUnchanged, ret.filterUpdate:
 		panic("unexpected filter in put")
 	case ret.createdNotify:
 		panic("unexpected createdNotify in put")
@@ -326,6 +481,45 @@ func OpPut(key, val string, opts ...OpOption) Op {
 	return ret
 }
 
+// OpIncrement returns "increment" operation based on given key, delta and
+// operation options. The current value of the key is interpreted as a counter
+// and delta is added to it atomically; a missing key counts as 0.
+func OpIncrement(key string, delta int64, opts ...OpOption) Op {
+	ret := Op{t: tIncrement, key: []byte(key), delta: delta}
+	ret.applyOpts(opts)
+	switch {
+	case ret.end != nil:
+		panic("unexpected range in increment")
+	case ret.limit != 0:
+		panic("unexpected limit in increment")
+	case ret.continueTok != nil:
+		panic("unexpected continue token in increment")
+	case ret.rev != 0:
+		panic("unexpected revision in increment")
+	case ret.sort != nil:
+		panic("unexpected sort in increment")
+	case ret.serializable:
+		panic("unexpected serializable in increment")
+	case ret.countOnly:
+		panic("unexpected countOnly in increment")
+	case ret.minModRev != 0, ret.maxModRev != 0:
+		panic("unexpected mod revision filter in increment")
+	case ret.minCreateRev != 0, ret.maxCreateRev != 0:
+		panic("unexpected create revision filter in increment")
+	case ret.hasValueFilters():
+		panic("unexpected value filter in increment")
+	case ret.ignoreValue:
+		panic("unexpected ignoreValue in increment")
+	case ret.ttl != 0:
+		panic("unexpected ttl in increment")
+	case ret.filterDelete, ret.filterPut, ret.filterUnchanged, ret.filterUpdate:
+		panic("unexpected filter in increment")
+	case ret.createdNotify:
+		panic("unexpected createdNotify in increment")
+	}
+	return ret
+}
+
 // OpTxn returns "txn" operation based on given transaction conditions.
 func OpTxn(cmps []Cmp, thenOps []Op, elseOps []Op) Op {
 	clonedCmps := make([]Cmp, len(cmps))
@@ -339,10 +533,10 @@ func OpWatch(key string, opts ...OpOption) Op {
 	ret := Op{t: tRange, key: []byte(key)}
 	ret.applyOpts(opts)
 	switch {
-	case ret.leaseID != 0:
-		panic("unexpected lease in watch")
 	case ret.limit != 0:
 		panic("unexpected limit in watch")
+	case ret.continueTok != nil:
+		panic("unexpected continue token in watch")
 	case ret.sort != nil:
 		panic("unexpected sort in watch")
 	case ret.serializable:
@@ -353,6 +547,8 @@ func OpWatch(key string, opts ...OpOption) Op {
 		panic("unexpected mod revision filter in watch")
 	case ret.minCreateRev != 0, ret.maxCreateRev != 0:
 		panic("unexpected create revision filter in watch")
+	case ret.minValueSize != 0, ret.maxValueSize != 0, ret.leaseFilter != LeaseFilterAny:
+		panic("unexpected value filter in watch")
 	}
 	return ret
 }
@@ -366,11 +562,25 @@ func (op *Op) applyOpts(opts []OpOption) {
 // OpOption configures Operations like Get, Put, Delete.
 type OpOption func(*Op)
 
-// WithLease attaches a lease ID to a key in 'Put' request.
+// WithLease attaches a lease ID to a key in 'Put' request. For 'Watch', it
+// discards the events on keys not attached to the lease from the watcher. The
+// server reads the previous key-value pair of each DELETE event to tell, and
+// keeps the event if that pair is compacted.
 func WithLease(leaseID LeaseID) OpOption {
 	return func(op *Op) { op.leaseID = leaseID }
 }
 
+// WithTTL sets a time to live in seconds for the key of a 'Put' request. The
+// server attaches the key to a shared lease expiring at the end of the same
+// second as the TTL; this option can not be combined with WithLease or
+// WithIgnoreLease.
+func WithTTL(ttl int64) OpOption {
+	return func(op *Op) { op.ttl = ttl }
+}
+
+// TTL returns the time to live of a put, if any.
+func (op Op) TTL() int64 { return op.ttl }
+
 // WithLimit limits the number of results to return from 'Get' request.
 // If WithLimit is given a 0 limit, it is treated as no limit.
 func WithLimit(n int64) OpOption { return func(op *Op) { op.limit = n } }
@@ -488,6 +698,36 @@ func WithMinCreateRev(rev int64) OpOption { return func(op *Op) { op.minCreateRe
 // WithMaxCreateRev filters out keys for Get with creation revisions greater than the given revision.
 func WithMaxCreateRev(rev int64) OpOption { return func(op *Op) { op.maxCreateRev = rev } }
 
+// WithValuePrefix filters out keys for Get whose values do not start with the given prefix.
+// Combined with WithMaxValueSize(len(prefix)), it only returns keys whose value equals the prefix.
+// For Watch, it discards the PUT events whose values do not start with the prefix.
+func WithValuePrefix(prefix string) OpOption {
+	return func(op *Op) { op.valuePrefix = []byte(prefix) }
+}
+
+// WithMinValueSize filters out keys for Get with values smaller than the given size in bytes.
+func WithMinValueSize(size int64) OpOption { return func(op *Op) { op.minValueSize = size } }
+
+// WithMaxValueSize filters out keys for Get with values larger than the given size in bytes.
+// If WithMaxValueSize is given a 0 size, it is treated as no upper bound.
+func WithMaxValueSize(size int64) OpOption { return func(op *Op) { op.maxValueSize = size } }
+
+// WithLeaseFilter filters out keys for Get based on whether they are attached to a lease.
+func WithLeaseFilter(filter LeaseFilter) OpOption {
+	return func(op *Op) { op.leaseFilter = filter }
+}
+
+// WithContinue resumes a 'Get' request with a limit after the last key returned
+// by a previous response, using the ContinueToken of that response. All pages
+// are read at the revision of the first one and in the same sort order, so the
+// other options of the request must not change between pages, except for the limit.
+// Only pages sorted by key in ascending order resume where the previous page
+// ended; for any other sort, the server reads, filters and sorts the whole range
+// again for every page, so paginating such a range costs a full range read per page.
+func WithContinue(token []byte) OpOption {
+	return func(op *Op) { op.continueTok = token }
+}
+
 // WithFirstCreate gets the key with the oldest creation revision in the request range.
 func WithFirstCreate() []OpOption { return withTop(SortByCreateRevision, SortAscend) }
 
@@ -537,6 +777,20 @@ func WithFilterDelete() OpOption {
 	return func(op *Op) { op.filterDelete = true }
 }
 
+// WithFilterUnchanged discards PUT events leaving the value of the key
+// unchanged from the watcher. The server reads the previous value of the key
+// for each PUT event, and keeps the event if that value is compacted.
+func WithFilterUnchanged() OpOption {
+	return func(op *Op) { op.filterUnchanged = true }
+}
+
+// WithFilterUpdate discards PUT events updating an existing key from the
+// watcher. Combined with WithFilterDelete, the watcher only gets the PUT
+// events creating a key.
+func WithFilterUpdate() OpOption {
+	return func(op *Op) { op.filterUpdate = true }
+}
+
 // WithPrevKV gets the previous key-value pair before the event happens. If the previous KV is already compacted,
 // nothing will be returned.
 func WithPrevKV() OpOption {
@@ -545,6 +799,28 @@ func WithPrevKV() OpOption {
 	}
 }
 
+// WithBatchSize makes a 'Delete' of a range delete it in batches of at most n
+// keys, each at its own revision, so that deleting a very large range does not
+// stall the cluster. This option can not be combined with WithPrevKV or used
+// in a transaction.
+func WithBatchSize(n int64) OpOption {
+	return func(op *Op) { op.batchSize = n }
+}
+
+// WithAtomicBatch makes a 'Delete' with WithBatchSize delete the range at a
+// single revision. The delete fails if the range does not fit in one batch.
+func WithAtomicBatch() OpOption {
+	return func(op *Op) { op.atomicBatch = true }
+}
+
+// WithMaxBatches makes a 'Delete' with WithBatchSize stop after n batches.
+// If keys are left in the range, the response carries the key to resume the
+// delete from in NextKey. A delete canceled between batches is also resumed
+// by deleting the range again.
+func WithMaxBatches(n int64) OpOption {
+	return func(op *Op) { op.maxBatches = n }
+}
+
 // WithFragment to receive raw watch response with fragmentation.
 // Fragmentation is disabled by default. If fragmentation is enabled,
 // etcd watch server will split watch response before sending to clients
@@ -556,6 +832,50 @@ func WithFragment() OpOption {
 	return func(op *Op) { op.fragment = true }
 }
 
+// WithCoalesce lets the server collapse the events of a key to the most
+// recent one while the watcher catches up on the store. Responses that had
+// events collapsed are marked Coalesced, so the watcher sees the latest
+// state of each key but not every revision it went through.
+func WithCoalesce() OpOption {
+	return func(op *Op) { op.coalesce = true }
+}
+
+// WithSubscription resumes a watch without a start revision right after the
+// revision last acknowledged by the named subscription, see
+// Watcher.AckSubscription. If the subscription does not exist, the watch
+// starts at the current revision.
+func WithSubscription(name string) OpOption {
+	return func(op *Op) { op.subscription = name }
+}
+
+// WithBootstrap makes a 'Watch' that fails because its start revision was
+// compacted list the watched keys at the current revision instead, and keep
+// watching from there. The listing arrives as one response marked Bootstrap,
+// holding a PUT event for each watched key and a DELETE event for each key
+// returned by known that no longer exists. known reports the keys the caller
+// holds and may be nil. The events pass the watch filters, but unlike the
+// events of a watch, they do not tell the revisions the keys went through.
+func WithBootstrap(known func() []string) OpOption {
+	if known == nil {
+		known = func() []string { return nil }
+	}
+	return func(op *Op) { op.bootstrap = known }
+}
+
+// KeyRange is the range of keys [Key, End). An empty End is the single key
+// Key, and an End of "\x00" is all keys greater than or equal to Key.
+type KeyRange struct {
+	Key, End string
+}
+
+// WithRanges makes a 'Watch' also watch the given key ranges. The events of
+// all ranges are delivered on the one watch channel in revision order, with
+// the same start revision and options as the key of the watch. Each call
+// replaces the ranges set by a previous call.
+func WithRanges(ranges ...KeyRange) OpOption {
+	return func(op *Op) { op.ranges = ranges }
+}
+
 // WithWatchBufLog enables watch response buffer logging.
 func WithWatchBufLog() OpOption {
 	return func(op *Op) { op.watchBufLogEnabled = true }
@@ -579,12 +899,25 @@ func WithIgnoreLease() OpOption {
 	}
 }
 
+// WithCounterEncoding sets how the counter value is stored for 'Increment'.
+// By default the counter is stored as a base 10 string.
+func WithCounterEncoding(enc CounterEncoding) OpOption {
+	return func(op *Op) { op.encoding = enc }
+}
+
 // LeaseOp represents an Operation that lease can execute.
 type LeaseOp struct {
 	id LeaseID
 
-	// for TimeToLive
+	// for TimeToLive, Leases and WatchLeases
 	attachedKeys bool
+
+	// for TimeToLive
+	keysLimit int64
+	keysFrom  []byte
+
+	// for Leases
+	key, end []byte
 }
 
 // LeaseOption configures lease operations.
@@ -596,15 +929,55 @@ func (op *LeaseOp) applyOpts(opts []LeaseOption) {
 	}
 }
 
-// WithAttachedKeys makes TimeToLive list the keys attached to the given lease ID.
+// WithAttachedKeys makes TimeToLive list the keys attached to the given lease ID,
+// Leases the keys attached to each lease, and WatchLeases the keys attached to
+// each revoked or expired lease.
 func WithAttachedKeys() LeaseOption {
 	return func(op *LeaseOp) { op.attachedKeys = true }
 }
 
+// WithAttachedKeysLimit limits the number of attached keys TimeToLive lists with
+// WithAttachedKeys, or Leases lists per lease. The keys are listed in ascending
+// order, and MoreKeys tells whether there are more of them.
+func WithAttachedKeysLimit(limit int64) LeaseOption {
+	return func(op *LeaseOp) { op.keysLimit = limit }
+}
+
+// WithAttachedKeysFrom makes TimeToLive list the attached keys from the given key
+// on. To list the next page of keys, pass the last key of the previous page
+// followed by a zero byte.
+func WithAttachedKeysFrom(key string) LeaseOption {
+	return func(op *LeaseOp) { op.keysFrom = []byte(key) }
+}
+
+// WithAttachedKeyRange makes Leases list only the leases with at least one
+// attached key in the range [key, end), following the conventions of Get.
+func WithAttachedKeyRange(key, end string) LeaseOption {
+	return func(op *LeaseOp) { op.key, op.end = []byte(key), []byte(end) }
+}
+
+// WithAttachedKeyPrefix makes Leases list only the leases with at least one
+// attached key under the given prefix.
+func WithAttachedKeyPrefix(prefix string) LeaseOption {
+	return WithAttachedKeyRange(prefix, GetPrefixRangeEnd(prefix))
+}
+
 func toLeaseTimeToLiveRequest(id LeaseID, opts ...LeaseOption) *pb.LeaseTimeToLiveRequest {
 	ret := &LeaseOp{id: id}
 	ret.applyOpts(opts)
-	return &pb.LeaseTimeToLiveRequest{ID: int64(id), Keys: ret.attachedKeys}
+	return &pb.LeaseTimeToLiveRequest{ID: int64(id), Keys: ret.attachedKeys, KeysLimit: ret.keysLimit, KeysFrom: ret.keysFrom}
+}
+
+func toLeaseLeasesRequest(opts ...LeaseOption) *pb.LeaseLeasesRequest {
+	ret := &LeaseOp{}
+	ret.applyOpts(opts)
+	return &pb.LeaseLeasesRequest{Key: ret.key, RangeEnd: ret.end, Keys: ret.attachedKeys, KeysLimit: ret.keysLimit}
+}
+
+func toLeaseWatchRequest(id LeaseID, opts ...LeaseOption) *pb.LeaseWatchRequest {
+	ret := &LeaseOp{id: id}
+	ret.applyOpts(opts)
+	return &pb.LeaseWatchRequest{ID: int64(id), Keys: ret.attachedKeys}
 }
 
 // IsOptsWithPrefix returns true if WithPrefix option is called in the given opts.
@@ -37,6 +37,48 @@ func TestOpWithSort(t *testing.T) {
 	}
 }
 
+func TestOpWithValueFilters(t *testing.T) {
+	opReq := OpGet("foo", WithPrefix(), WithValuePrefix("bar"), WithMinValueSize(3), WithMaxValueSize(10), WithLeaseFilter(LeaseFilterAttached)).toRequestOp().Request
+	q, ok := opReq.(*pb.RequestOp_RequestRange)
+	if !ok {
+		t.Fatalf("expected range request, got %v", reflect.TypeOf(opReq))
+	}
+	req := q.RequestRange
+	wreq := &pb.RangeRequest{
+		Key:          []byte("foo"),
+		RangeEnd:     []byte("fop"),
+		ValuePrefix:  []byte("bar"),
+		MinValueSize: 3,
+		MaxValueSize: 10,
+		LeaseFilter:  pb.RangeRequest_ATTACHED,
+	}
+	if !reflect.DeepEqual(req, wreq) {
+		t.Fatalf("expected %+v, got %+v", wreq, req)
+	}
+}
+
+func TestOpIncrement(t *testing.T) {
+	op := OpIncrement("foo", -3, WithLease(LeaseID(7)), WithPrevKV(), WithCounterEncoding(CounterBigEndian))
+	if !op.IsIncrement() || !op.isWrite() {
+		t.Fatalf("expected write increment op, got %+v", op)
+	}
+	opReq := op.toRequestOp().Request
+	q, ok := opReq.(*pb.RequestOp_RequestIncrement)
+	if !ok {
+		t.Fatalf("expected increment request, got %v", reflect.TypeOf(opReq))
+	}
+	wreq := &pb.IncrementRequest{
+		Key:      []byte("foo"),
+		Delta:    -3,
+		Encoding: pb.IncrementRequest_BIG_ENDIAN,
+		Lease:    7,
+		PrevKv:   true,
+	}
+	if !reflect.DeepEqual(q.RequestIncrement, wreq) {
+		t.Fatalf("expected %+v, got %+v", wreq, q.RequestIncrement)
+	}
+}
+
 func TestIsSortOptionValid(t *testing.T) {
 	rangeReqs := []struct {
 		sortOrder     pb.RangeRequest_SortOrder
@@ -17,7 +17,9 @@ package clientv3
 import (
 	"context"
 	"errors"
+	"io"
 
+	"github.com/golang/protobuf/proto" //nolint:staticcheck // TODO: remove for a supported version
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -108,8 +110,73 @@ func (rkv *retryKVClient) Range(ctx context.Context, in *pb.RangeRequest, opts .
 	return rkv.kc.Range(ctx, in, append(opts, withRepeatablePolicy())...)
 }
 
+func (rkv *retryKVClient) KeyHistory(ctx context.Context, in *pb.KeyHistoryRequest, opts ...grpc.CallOption) (resp *pb.KeyHistoryResponse, err error) {
+	return rkv.kc.KeyHistory(ctx, in, append(opts, withRepeatablePolicy())...)
+}
+
 func (rkv *retryKVClient) RangeStream(ctx context.Context, in *pb.RangeRequest, opts ...grpc.CallOption) (pb.KV_RangeStreamClient, error) {
-	return rkv.kc.RangeStream(ctx, in, opts...)
+	stream, err := rkv.kc.RangeStream(ctx, in, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resumingRangeStream{KV_RangeStreamClient: stream, kc: rkv.kc, ctx: ctx, req: in, opts: opts}, nil
+}
+
+// resumingRangeStream resumes a range stream that broke with a transient
+// error from the resume token of the last chunk received, so that the caller
+// receives the same key-value pairs as from an unbroken stream.
+type resumingRangeStream struct {
+	pb.KV_RangeStreamClient
+	kc   pb.KVClient
+	ctx  context.Context
+	req  *pb.RangeRequest
+	opts []grpc.CallOption
+
+	// token is the resume token of the last chunk received.
+	token []byte
+	// received is the number of keys received over all streams, skipped
+	// the number of keys received before the current stream was opened.
+	received, skipped int64
+}
+
+func (s *resumingRangeStream) Recv() (*pb.RangeStreamResponse, error) {
+	for attempt := uint(1); ; attempt++ {
+		resp, err := s.KV_RangeStreamClient.Recv()
+		if err == nil {
+			if rr := resp.RangeResponse; rr != nil {
+				s.received += int64(len(rr.Kvs))
+				if rr.Header != nil {
+					// the count of a resumed stream only includes the keys after the resume token
+					rr.Count += s.skipped
+				}
+			}
+			s.token = resp.ResumeToken
+			return resp, nil
+		}
+		if errors.Is(err, io.EOF) || attempt > defaultUnaryMaxRetries || !isSafeRetryImmutableRPC(err) {
+			return nil, err
+		}
+		// chunks from servers without resume tokens cannot be resumed from
+		if s.received != 0 && len(s.token) == 0 {
+			return nil, err
+		}
+		if werr := waitRetryBackoff(s.ctx, attempt, defaultOptions); werr != nil {
+			return nil, werr
+		}
+		req := proto.Clone(s.req).(*pb.RangeRequest)
+		if s.received != 0 {
+			req.ContinueToken = s.token
+			if req.Limit > 0 {
+				req.Limit -= s.received
+			}
+		}
+		stream, serr := s.kc.RangeStream(s.ctx, req, s.opts...)
+		if serr != nil {
+			return nil, serr
+		}
+		s.KV_RangeStreamClient = stream
+		s.skipped = s.received
+	}
 }
 
 func (rkv *retryKVClient) Put(ctx context.Context, in *pb.PutRequest, opts ...grpc.CallOption) (resp *pb.PutResponse, err error) {
@@ -155,10 +222,22 @@ func (rlc *retryLeaseClient) LeaseRevoke(ctx context.Context, in *pb.LeaseRevoke
 	return rlc.lc.LeaseRevoke(ctx, in, append(opts, withRepeatablePolicy())...)
 }
 
+func (rlc *retryLeaseClient) LeaseRevokeBatch(ctx context.Context, in *pb.LeaseRevokeBatchRequest, opts ...grpc.CallOption) (resp *pb.LeaseRevokeBatchResponse, err error) {
+	return rlc.lc.LeaseRevokeBatch(ctx, in, append(opts, withRepeatablePolicy())...)
+}
+
+func (rlc *retryLeaseClient) LeaseUpdateTTL(ctx context.Context, in *pb.LeaseUpdateTTLRequest, opts ...grpc.CallOption) (resp *pb.LeaseUpdateTTLResponse, err error) {
+	return rlc.lc.LeaseUpdateTTL(ctx, in, append(opts, withRepeatablePolicy())...)
+}
+
 func (rlc *retryLeaseClient) LeaseKeepAlive(ctx context.Context, opts ...grpc.CallOption) (stream pb.Lease_LeaseKeepAliveClient, err error) {
 	return rlc.lc.LeaseKeepAlive(ctx, append(opts, withRepeatablePolicy())...)
 }
 
+func (rlc *retryLeaseClient) LeaseWatch(ctx context.Context, in *pb.LeaseWatchRequest, opts ...grpc.CallOption) (stream pb.Lease_LeaseWatchClient, err error) {
+	return rlc.lc.LeaseWatch(ctx, in, append(opts, withRepeatablePolicy())...)
+}
+
 type retryClusterClient struct {
 	cc pb.ClusterClient
 }
@@ -213,6 +292,14 @@ func (rmc *retryMaintenanceClient) Hash(ctx context.Context, in *pb.HashRequest,
 	return rmc.mc.Hash(ctx, in, append(opts, withRepeatablePolicy())...)
 }
 
+func (rmc *retryMaintenanceClient) CompactionPolicy(ctx context.Context, in *pb.CompactionPolicyRequest, opts ...grpc.CallOption) (resp *pb.CompactionPolicyResponse, err error) {
+	return rmc.mc.CompactionPolicy(ctx, in, append(opts, withRepeatablePolicy())...)
+}
+
+func (rmc *retryMaintenanceClient) Watchers(ctx context.Context, in *pb.WatchersRequest, opts ...grpc.CallOption) (resp *pb.WatchersResponse, err error) {
+	return rmc.mc.Watchers(ctx, in, append(opts, withRepeatablePolicy())...)
+}
+
 func (rmc *retryMaintenanceClient) HashKV(ctx context.Context, in *pb.HashKVRequest, opts ...grpc.CallOption) (resp *pb.HashKVResponse, err error) {
 	return rmc.mc.HashKV(ctx, in, append(opts, withRepeatablePolicy())...)
 }
@@ -233,6 +320,13 @@ func (rmc *retryMaintenanceClient) Downgrade(ctx context.Context, in *pb.Downgra
 	return rmc.mc.Downgrade(ctx, in, opts...)
 }
 
+func (rmc *retryMaintenanceClient) RevisionHold(ctx context.Context, in *pb.RevisionHoldRequest, opts ...grpc.CallOption) (resp *pb.RevisionHoldResponse, err error) {
+	if in.Action == pb.RevisionHoldRequest_LIST {
+		opts = append(opts, withRepeatablePolicy())
+	}
+	return rmc.mc.RevisionHold(ctx, in, opts...)
+}
+
 type retryAuthClient struct {
 	ac pb.AuthClient
 }
@@ -0,0 +1,164 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clientv3
+
+import (
+	"context"
+	"io"
+	"slices"
+	"testing"
+
+	"github.com/golang/protobuf/proto" //nolint:staticcheck // TODO: remove for a supported version
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
+)
+
+// fakeRangeStreamKV serves range streams from a fixed list of chunks and
+// breaks each stream with an error after the configured number of chunks.
+type fakeRangeStreamKV struct {
+	pb.KVClient
+	chunks []*pb.RangeStreamResponse
+	breaks []int
+	err    error
+
+	reqs []*pb.RangeRequest
+}
+
+func (kv *fakeRangeStreamKV) RangeStream(ctx context.Context, in *pb.RangeRequest, opts ...grpc.CallOption) (pb.KV_RangeStreamClient, error) {
+	kv.reqs = append(kv.reqs, in)
+	start := 0
+	for i, c := range kv.chunks {
+		if string(c.ResumeToken) == string(in.ContinueToken) && len(in.ContinueToken) != 0 {
+			start = i + 1
+		}
+	}
+	// the count of the final chunk only includes the keys of this stream
+	chunks := slices.Clone(kv.chunks[start:])
+	final := proto.Clone(chunks[len(chunks)-1]).(*pb.RangeStreamResponse)
+	for _, c := range chunks {
+		final.RangeResponse.Count += int64(len(c.RangeResponse.Kvs))
+	}
+	chunks[len(chunks)-1] = final
+	stream := &fakeRangeStream{chunks: chunks, err: io.EOF}
+	if len(kv.breaks) != 0 {
+		stream.chunks, stream.err = stream.chunks[:kv.breaks[0]], kv.err
+		kv.breaks = kv.breaks[1:]
+	}
+	return stream, nil
+}
+
+type fakeRangeStream struct {
+	grpc.ClientStream
+	chunks []*pb.RangeStreamResponse
+	err    error
+}
+
+func (s *fakeRangeStream) Recv() (*pb.RangeStreamResponse, error) {
+	if len(s.chunks) == 0 {
+		return nil, s.err
+	}
+	c := s.chunks[0]
+	s.chunks = s.chunks[1:]
+	return c, nil
+}
+
+func TestRetryKVClientRangeStreamResume(t *testing.T) {
+	chunk := func(token string, keys ...string) *pb.RangeStreamResponse {
+		resp := &pb.RangeStreamResponse{RangeResponse: &pb.RangeResponse{}, ResumeToken: []byte(token)}
+		for _, k := range keys {
+			resp.RangeResponse.Kvs = append(resp.RangeResponse.Kvs, &mvccpb.KeyValue{Key: []byte(k)})
+		}
+		return resp
+	}
+	final := chunk("", "e")
+	final.RangeResponse.Header = &pb.ResponseHeader{Revision: 10}
+
+	tests := []struct {
+		name      string
+		breaks    []int
+		err       error
+		wantErr   error
+		wantKeys  []string
+		wantReqs  int
+		wantLimit int64
+	}{
+		{
+			name:      "unbroken",
+			wantKeys:  []string{"a", "b", "c", "d", "e"},
+			wantReqs:  1,
+			wantLimit: 10,
+		},
+		{
+			name:      "resumed",
+			breaks:    []int{1, 0, 1},
+			err:       status.Error(codes.Unavailable, "transport is closing"),
+			wantKeys:  []string{"a", "b", "c", "d", "e"},
+			wantReqs:  4,
+			wantLimit: 7,
+		},
+		{
+			name:      "not resumed on non-transient errors",
+			breaks:    []int{1},
+			err:       rpctypes.ErrGRPCCompacted,
+			wantErr:   rpctypes.ErrGRPCCompacted,
+			wantKeys:  []string{"a", "b"},
+			wantReqs:  1,
+			wantLimit: 10,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			fake := &fakeRangeStreamKV{
+				chunks: []*pb.RangeStreamResponse{chunk("t1", "a", "b"), chunk("t2", "c"), chunk("t3", "d"), final},
+				breaks: tc.breaks,
+				err:    tc.err,
+			}
+			rkv := &retryKVClient{kc: fake}
+			stream, err := rkv.RangeStream(t.Context(), &pb.RangeRequest{Key: []byte("a"), Limit: 10})
+			require.NoError(t, err)
+
+			var keys []string
+			var last *pb.RangeStreamResponse
+			for {
+				resp, rerr := stream.Recv()
+				if rerr != nil {
+					if tc.wantErr == nil {
+						require.ErrorIs(t, rerr, io.EOF)
+					} else {
+						require.ErrorIs(t, rerr, tc.wantErr)
+					}
+					break
+				}
+				for _, kv := range resp.RangeResponse.Kvs {
+					keys = append(keys, string(kv.Key))
+				}
+				last = resp
+			}
+			assert.Equal(t, tc.wantKeys, keys)
+			require.Len(t, fake.reqs, tc.wantReqs)
+			assert.Equal(t, tc.wantLimit, fake.reqs[len(fake.reqs)-1].Limit)
+			if tc.wantErr == nil {
+				assert.Equal(t, int64(len(tc.wantKeys)), last.RangeResponse.Count)
+			}
+		})
+	}
+}
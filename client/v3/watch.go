@@ -15,6 +15,7 @@
 package clientv3
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
@@ -94,10 +95,36 @@ type Watcher interface {
 	// RequestProgress requests a progress notify response be sent in all watch channels.
 	RequestProgress(ctx context.Context) error
 
+	// RequestLinearizableProgress is like RequestProgress, but the progress
+	// notify response is only sent once the member applied all writes
+	// committed in the cluster and all watchers of the stream caught up, so
+	// that its revision is at least the revision of any write completed
+	// before the request. Supported since etcd 3.8.
+	RequestLinearizableProgress(ctx context.Context) error
+
+	// AckSubscription records rev as the last revision processed by the
+	// subscription with the given name, creating the subscription if needed.
+	// A watch created WithSubscription(name) and no start revision resumes
+	// right after the acknowledged revision. If rev is 0, the current revision
+	// is acknowledged. While the subscription lags no more than the server's
+	// "--subscription-max-lag" revisions behind, compaction keeps the revisions
+	// it resumes from. Supported since etcd 3.8.
+	AckSubscription(ctx context.Context, name string, rev int64) (*SubscriptionResponse, error)
+
+	// DeleteSubscription deletes the subscription with the given name.
+	// Supported since etcd 3.8.
+	DeleteSubscription(ctx context.Context, name string) (*SubscriptionResponse, error)
+
+	// Subscriptions lists all subscriptions, ordered by name.
+	// Supported since etcd 3.8.
+	Subscriptions(ctx context.Context) (*SubscriptionResponse, error)
+
 	// Close closes the watcher and cancels all watch requests.
 	Close() error
 }
 
+type SubscriptionResponse pb.SubscriptionResponse
+
 type WatchResponse struct {
 	Header *pb.ResponseHeader
 	Events []*Event
@@ -117,6 +144,14 @@ type WatchResponse struct {
 
 	// CancelReason is a reason of canceling watch
 	CancelReason string
+
+	// Coalesced is set when events of the same key were collapsed to the
+	// most recent one, see WithCoalesce.
+	Coalesced bool
+
+	// Bootstrap is set when the events list the watched keys after the start
+	// revision of the watch was compacted, see WithBootstrap.
+	Bootstrap bool
 }
 
 // Err is the error value if this WatchResponse holds an error.
@@ -135,6 +170,28 @@ func (wr *WatchResponse) Err() error {
 	return nil
 }
 
+// RevisionEvents holds the events of a single revision.
+type RevisionEvents struct {
+	Revision int64
+	Events   []*Event
+}
+
+// Revisions groups the events of the response by revision, in the order the
+// revisions were committed. The events of one revision are the changes of one
+// transaction, so they can be applied atomically. Responses always hold all
+// events of their revisions, unless they are Coalesced.
+func (wr *WatchResponse) Revisions() []RevisionEvents {
+	var revs []RevisionEvents
+	for i, ev := range wr.Events {
+		if i == 0 || ev.Kv.ModRevision != wr.Events[i-1].Kv.ModRevision {
+			revs = append(revs, RevisionEvents{Revision: ev.Kv.ModRevision})
+		}
+		last := &revs[len(revs)-1]
+		last.Events = append(last.Events, ev)
+	}
+	return revs
+}
+
 // IsProgressNotify returns true if the WatchResponse is progress notification.
 func (wr *WatchResponse) IsProgressNotify() bool {
 	return len(wr.Events) == 0 && !wr.Canceled && !wr.Created && wr.CompactRevision == 0 && wr.Header.GetRevision() != 0
@@ -144,6 +201,8 @@ func (wr *WatchResponse) IsProgressNotify() bool {
 type watcher struct {
 	remote   pb.WatchClient
 	callOpts []grpc.CallOption
+	// kv lists the keys of bootstrapping watches
+	kv KV
 
 	// mu protects the grpc streams map
 	mu sync.Mutex
@@ -202,6 +261,8 @@ type watchRequest struct {
 	key string
 	end string
 	rev int64
+	// ranges are the additional key ranges to watch
+	ranges []KeyRange
 
 	// send created notification event if this field is true
 	createdNotify bool
@@ -211,11 +272,20 @@ type watchRequest struct {
 	// if true, split watch events when total exceeds
 	// "--max-request-bytes" flag value + 512-byte
 	fragment bool
+	// coalesce collapses the events of a key while the watcher catches up
+	coalesce bool
+	// subscription resumes the watch after the revision acknowledged by the
+	// named subscription
+	subscription string
 	// watchBufLogEnabled enables watch response buffer logging.
 	watchBufLogEnabled bool
 
 	// filters is the list of events to filter out
 	filters []pb.WatchCreateRequest_FilterType
+	// valuePrefix filters out put events with values not starting with it
+	valuePrefix []byte
+	// lease filters out events on keys not attached to it
+	lease LeaseID
 	// get the previous key-value pair before the event happens
 	prevKV bool
 	// retc receives a chan WatchResponse once the watcher is established
@@ -223,7 +293,9 @@ type watchRequest struct {
 }
 
 // progressRequest is issued by the subscriber to request watch progress
-type progressRequest struct{}
+type progressRequest struct {
+	linearizable bool
+}
 
 // watcherStream represents a registered watcher
 type watcherStream struct {
@@ -261,6 +333,9 @@ func NewWatchFromWatchClient(wc pb.WatchClient, c *Client) Watcher {
 	if c != nil {
 		w.callOpts = c.callOpts
 		w.lg = c.GetLogger()
+		// the KV of the client before any wrapping, e.g. by namespace,
+		// which matches the keys of the watcher
+		w.kv = c.KV
 	}
 	return w
 }
@@ -303,7 +378,13 @@ func (w *watcher) newWatcherGRPCStream(inctx context.Context) *watchGRPCStream {
 // Watch posts a watch request to run() and waits for a new watcher channel
 func (w *watcher) Watch(ctx context.Context, key string, opts ...OpOption) WatchChan {
 	ow := OpWatch(key, opts...)
+	if ow.bootstrap != nil {
+		return w.bootstrapWatch(ctx, ow)
+	}
+	return w.watch(ctx, ow)
+}
 
+func (w *watcher) watch(ctx context.Context, ow Op) WatchChan {
 	var filters []pb.WatchCreateRequest_FilterType
 	if ow.filterPut {
 		filters = append(filters, pb.WatchCreateRequest_NOPUT)
@@ -311,6 +392,12 @@ func (w *watcher) Watch(ctx context.Context, key string, opts ...OpOption) Watch
 	if ow.filterDelete {
 		filters = append(filters, pb.WatchCreateRequest_NODELETE)
 	}
+	if ow.filterUnchanged {
+		filters = append(filters, pb.WatchCreateRequest_NOUNCHANGED)
+	}
+	if ow.filterUpdate {
+		filters = append(filters, pb.WatchCreateRequest_NOUPDATE)
+	}
 
 	wr := &watchRequest{
 		ctx:                ctx,
@@ -318,10 +405,15 @@ func (w *watcher) Watch(ctx context.Context, key string, opts ...OpOption) Watch
 		key:                string(ow.key),
 		end:                string(ow.end),
 		rev:                ow.rev,
+		ranges:             ow.ranges,
 		progressNotify:     ow.progressNotify,
 		fragment:           ow.fragment,
+		coalesce:           ow.coalesce,
+		subscription:       ow.subscription,
 		watchBufLogEnabled: ow.watchBufLogEnabled,
 		filters:            filters,
+		valuePrefix:        ow.valuePrefix,
+		lease:              ow.leaseID,
 		prevKV:             ow.prevKV,
 		retc:               make(chan chan WatchResponse, 1),
 	}
@@ -392,6 +484,114 @@ func (w *watcher) Watch(ctx context.Context, key string, opts ...OpOption) Watch
 	return closeCh
 }
 
+// bootstrapWatch runs the watch ow, replacing a compaction of its start
+// revision by a listing of the watched keys. See WithBootstrap.
+func (w *watcher) bootstrapWatch(ctx context.Context, ow Op) WatchChan {
+	outc := make(chan WatchResponse)
+	go func() {
+		defer close(outc)
+		wch := w.watch(ctx, ow)
+		for {
+			wr, ok := <-wch
+			if !ok {
+				return
+			}
+			if errors.Is(wr.Err(), v3rpc.ErrCompacted) {
+				// the compacted watcher is canceled; list the keys and
+				// watch again right after the listing
+				resp, err := w.bootstrap(ctx, ow)
+				if err != nil {
+					wr = WatchResponse{Header: &pb.ResponseHeader{}, Canceled: true, closeErr: err}
+				} else {
+					wr = *resp
+					ow.rev = resp.Header.Revision + 1
+					ow.createdNotify = false
+					wch = w.watch(ctx, ow)
+				}
+			}
+			select {
+			case outc <- wr:
+			case <-ctx.Done():
+				return
+			}
+			if wr.closeErr != nil {
+				return
+			}
+		}
+	}()
+	return outc
+}
+
+// bootstrapPageSize is the number of keys listed at once by a bootstrapping
+// watch.
+const bootstrapPageSize = 1000
+
+// bootstrap lists the keys watched by ow at the current revision as a watch
+// response of synthetic events.
+func (w *watcher) bootstrap(ctx context.Context, ow Op) (*WatchResponse, error) {
+	if w.kv == nil {
+		return nil, errors.New("watch bootstrap requires a client")
+	}
+	wr := &WatchResponse{Bootstrap: true}
+	present := make(map[string]struct{})
+	ranges := append([]KeyRange{{Key: string(ow.key), End: string(ow.end)}}, ow.ranges...)
+	for _, r := range ranges {
+		key := r.Key
+		var token []byte
+		for {
+			opts := []OpOption{WithRange(r.End), WithLimit(bootstrapPageSize), WithContinue(token)}
+			// all ranges and pages are listed at the revision of the first page
+			if wr.Header != nil {
+				opts = append(opts, WithRev(wr.Header.Revision))
+			}
+			resp, err := w.kv.Get(ctx, key, opts...)
+			if err != nil {
+				return nil, err
+			}
+			if wr.Header == nil {
+				wr.Header = resp.Header
+			}
+			for _, kv := range resp.Kvs {
+				// a key filtered out is still present, it must not be deleted
+				present[string(kv.Key)] = struct{}{}
+				if ow.filterPut || !bytes.HasPrefix(kv.Value, ow.valuePrefix) {
+					continue
+				}
+				if ow.leaseID != NoLease && LeaseID(kv.Lease) != ow.leaseID {
+					continue
+				}
+				wr.Events = append(wr.Events, &Event{Type: EventTypePut, Kv: kv})
+			}
+			if !resp.More {
+				break
+			}
+			if len(resp.ContinueToken) != 0 {
+				token = resp.ContinueToken
+				continue
+			}
+			// servers before 3.8 return no continue token; list the next
+			// page from right after the last key instead
+			if len(resp.Kvs) == 0 {
+				return nil, errors.New("watch bootstrap cannot list the next page of keys")
+			}
+			key = string(resp.Kvs[len(resp.Kvs)-1].Key) + "\x00"
+		}
+	}
+	if ow.filterDelete {
+		return wr, nil
+	}
+	for _, key := range ow.bootstrap() {
+		if _, ok := present[key]; ok {
+			continue
+		}
+		wr.Events = append(wr.Events, &Event{
+			Type: EventTypeDelete,
+			Kv:   &mvccpb.KeyValue{Key: []byte(key), ModRevision: wr.Header.Revision},
+		})
+	}
+	return wr, nil
+}
+
 func (w *watcher) Close() (err error) {
 	w.mu.Lock()
 	streams := w.streams
@@ -409,8 +609,42 @@ func (w *watcher) Close() (err error) {
 	return err
 }
 
+func (w *watcher) AckSubscription(ctx context.Context, name string, rev int64) (*SubscriptionResponse, error) {
+	req := &pb.SubscriptionRequest{Action: pb.SubscriptionRequest_ACK, Name: name, Revision: rev}
+	resp, err := w.remote.Subscription(ctx, req, w.callOpts...)
+	if err != nil {
+		return nil, ContextError(ctx, err)
+	}
+	return (*SubscriptionResponse)(resp), nil
+}
+
+func (w *watcher) DeleteSubscription(ctx context.Context, name string) (*SubscriptionResponse, error) {
+	req := &pb.SubscriptionRequest{Action: pb.SubscriptionRequest_DELETE, Name: name}
+	resp, err := w.remote.Subscription(ctx, req, w.callOpts...)
+	if err != nil {
+		return nil, ContextError(ctx, err)
+	}
+	return (*SubscriptionResponse)(resp), nil
+}
+
+func (w *watcher) Subscriptions(ctx context.Context) (*SubscriptionResponse, error) {
+	resp, err := w.remote.Subscription(ctx, &pb.SubscriptionRequest{Action: pb.SubscriptionRequest_LIST}, w.callOpts...)
+	if err != nil {
+		return nil, ContextError(ctx, err)
+	}
+	return (*SubscriptionResponse)(resp), nil
+}
+
 // RequestProgress requests a progress notify response be sent in all watch channels.
 func (w *watcher) RequestProgress(ctx context.Context) (err error) {
+	return w.requestProgress(ctx, &progressRequest{})
+}
+
+func (w *watcher) RequestLinearizableProgress(ctx context.Context) (err error) {
+	return w.requestProgress(ctx, &progressRequest{linearizable: true})
+}
+
+func (w *watcher) requestProgress(ctx context.Context, pr *progressRequest) (err error) {
 	ctxKey := streamKeyFromCtx(ctx)
 
 	w.mu.Lock()
@@ -427,8 +661,6 @@ func (w *watcher) RequestProgress(ctx context.Context) (err error) {
 	reqc := wgs.reqc
 	w.mu.Unlock()
 
-	pr := &progressRequest{}
-
 	select {
 	case reqc <- pr:
 		return nil
@@ -439,7 +671,7 @@ func (w *watcher) RequestProgress(ctx context.Context) (err error) {
 			return wgs.closeErr
 		}
 		// retry; may have dropped stream from no ctxs
-		return w.RequestProgress(ctx)
+		return w.requestProgress(ctx, pr)
 	}
 }
 
@@ -730,6 +962,7 @@ func (w *watchGRPCStream) dispatchEvent(pbresp *pb.WatchResponse) bool {
 		Created:         pbresp.Created,
 		Canceled:        pbresp.Canceled,
 		CancelReason:    pbresp.CancelReason,
+		Coalesced:       pbresp.Coalesced,
 	}
 
 	// watch IDs are zero indexed, so request notify watch responses are assigned a watch ID of InvalidWatchID to
@@ -1071,6 +1304,13 @@ func (wr *watchRequest) toPB() *pb.WatchRequest {
 		Filters:        wr.filters,
 		PrevKv:         wr.prevKV,
 		Fragment:       wr.fragment,
+		Coalesce:       wr.coalesce,
+		Subscription:   wr.subscription,
+		ValuePrefix:    wr.valuePrefix,
+		Lease:          int64(wr.lease),
+	}
+	for _, r := range wr.ranges {
+		req.Ranges = append(req.Ranges, &pb.KeyRange{Key: []byte(r.Key), RangeEnd: []byte(r.End)})
 	}
 	cr := &pb.WatchRequest_CreateRequest{CreateRequest: req}
 	return &pb.WatchRequest{RequestUnion: cr}
@@ -1078,7 +1318,7 @@ func (wr *watchRequest) toPB() *pb.WatchRequest {
 
 // toPB converts an internal progress request structure to its protobuf WatchRequest structure.
 func (pr *progressRequest) toPB() *pb.WatchRequest {
-	req := &pb.WatchProgressRequest{}
+	req := &pb.WatchProgressRequest{Linearizable: pr.linearizable}
 	cr := &pb.WatchRequest_ProgressRequest{ProgressRequest: req}
 	return &pb.WatchRequest{RequestUnion: cr}
 }
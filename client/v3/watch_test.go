@@ -16,10 +16,13 @@ package clientv3
 
 import (
 	"context"
+	"fmt"
+	"reflect"
 	"sync"
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/metadata"
 
@@ -61,6 +64,33 @@ func TestEvent(t *testing.T) {
 	}
 }
 
+func TestWatchResponseRevisions(t *testing.T) {
+	event := func(key string, rev int64) *Event {
+		return &Event{Type: EventTypePut, Kv: &mvccpb.KeyValue{Key: []byte(key), ModRevision: rev}}
+	}
+	a2, b2, a3, c4, d4 := event("a", 2), event("b", 2), event("a", 3), event("c", 4), event("d", 4)
+	tests := []struct {
+		events []*Event
+		revs   []RevisionEvents
+	}{{
+		events: nil,
+		revs:   nil,
+	}, {
+		events: []*Event{a2, b2, a3, c4, d4},
+		revs: []RevisionEvents{
+			{Revision: 2, Events: []*Event{a2, b2}},
+			{Revision: 3, Events: []*Event{a3}},
+			{Revision: 4, Events: []*Event{c4, d4}},
+		},
+	}}
+	for i, tt := range tests {
+		wr := &WatchResponse{Events: tt.events}
+		if revs := wr.Revisions(); !reflect.DeepEqual(revs, tt.revs) {
+			t.Errorf("#%d: expected revisions %v, got %v", i, tt.revs, revs)
+		}
+	}
+}
+
 // TestStreamKeyFromCtx tests the streamKeyFromCtx function to ensure it correctly
 // formats metadata as a map[string][]string when extracting metadata from the context.
 //
@@ -204,3 +234,45 @@ func TestServeSubstreamLogsSlowConsumer(t *testing.T) {
 	}
 	w.wg.Wait()
 }
+
+// pageKV lists the keys of a range a page at a time without continue tokens,
+// like servers before 3.8.
+type pageKV struct {
+	KV
+	keys []string
+	gets int
+}
+
+func (kv *pageKV) Get(_ context.Context, key string, opts ...OpOption) (*GetResponse, error) {
+	kv.gets++
+	op := OpGet(key, opts...)
+	end := string(op.RangeBytes())
+	resp := &GetResponse{Header: &pb.ResponseHeader{Revision: 10}}
+	for _, k := range kv.keys {
+		if k < key || (end != "\x00" && k >= end) {
+			continue
+		}
+		if int64(len(resp.Kvs)) == op.Limit() {
+			resp.More = true
+			break
+		}
+		resp.Kvs = append(resp.Kvs, &mvccpb.KeyValue{Key: []byte(k), Value: []byte("v")})
+	}
+	return resp, nil
+}
+
+func TestWatchBootstrapWithoutContinueToken(t *testing.T) {
+	kv := &pageKV{}
+	for i := 0; i < 2*bootstrapPageSize+1; i++ {
+		kv.keys = append(kv.keys, fmt.Sprintf("k%04d", i))
+	}
+	w := &watcher{kv: kv}
+
+	wr, err := w.bootstrap(t.Context(), OpWatch("k", WithPrefix(), WithBootstrap(nil)))
+	require.NoError(t, err)
+	require.Len(t, wr.Events, len(kv.keys))
+	for i, ev := range wr.Events {
+		require.Equal(t, kv.keys[i], string(ev.Kv.Key))
+	}
+	require.Equal(t, 3, kv.gets)
+}
@@ -30,6 +30,9 @@ var (
 	delPrevKV  bool
 	delFromKey bool
 	delRange   bool
+
+	delBatchSize int64
+	delAtomic    bool
 )
 
 // NewDelCommand returns the cobra command for "del".
@@ -45,12 +48,18 @@ func NewDelCommand() *cobra.Command {
 	cmd.Flags().BoolVar(&delPrevKV, "prev-kv", false, "return deleted key-value pairs")
 	cmd.Flags().BoolVar(&delFromKey, "from-key", false, "delete keys that are greater than or equal to the given key using byte compare")
 	cmd.Flags().BoolVar(&delRange, "range", false, "delete range of keys")
+	cmd.Flags().Int64Var(&delBatchSize, "batch-size", 0, "delete the keys in batches of the given size, each at its own revision")
+	cmd.Flags().BoolVar(&delAtomic, "atomic", false, "with --batch-size, delete the keys at a single revision, or fail if they do not fit in one batch")
 	return cmd
 }
 
 // delCommandFunc executes the "del" command.
 func delCommandFunc(cmd *cobra.Command, args []string) {
 	key, opts := getDelOp(args)
+	if delBatchSize > 0 && !delAtomic {
+		display.Del(delInBatches(cmd, key, opts))
+		return
+	}
 	ctx, cancel := commandCtx(cmd)
 	resp, err := mustClientFromCmd(cmd).Delete(ctx, key, opts...)
 	cancel()
@@ -60,6 +69,29 @@ func delCommandFunc(cmd *cobra.Command, args []string) {
 	display.Del(resp)
 }
 
+// delInBatches deletes the range of the "del" command one batch per request,
+// each within the command timeout, and reports the key to resume the delete
+// from if a request fails.
+func delInBatches(cmd *cobra.Command, key string, opts []clientv3.OpOption) *clientv3.DeleteResponse {
+	c := mustClientFromCmd(cmd)
+	end := string(clientv3.OpDelete(key, opts...).RangeBytes())
+	var deleted int64
+	for {
+		ctx, cancel := commandCtx(cmd)
+		resp, err := c.Delete(ctx, key, clientv3.WithRange(end), clientv3.WithBatchSize(delBatchSize), clientv3.WithMaxBatches(1))
+		cancel()
+		if err != nil {
+			cobrautl.ExitWithError(cobrautl.ExitError, fmt.Errorf("deleted %d keys, resume from key %q: %w", deleted, key, err))
+		}
+		deleted += resp.Deleted
+		if len(resp.NextKey) == 0 {
+			resp.Deleted = deleted
+			return resp
+		}
+		key = string(resp.NextKey)
+	}
+}
+
 func getDelOp(args []string) (string, []clientv3.OpOption) {
 	if len(args) == 0 || len(args) > 2 {
 		cobrautl.ExitWithError(cobrautl.ExitBadArgs, fmt.Errorf("del command needs one argument as key and an optional argument as range_end"))
@@ -69,6 +101,16 @@ func getDelOp(args []string) (string, []clientv3.OpOption) {
 		cobrautl.ExitWithError(cobrautl.ExitBadArgs, fmt.Errorf("`--prefix` and `--from-key` cannot be set at the same time, choose one"))
 	}
 
+	if delBatchSize < 0 {
+		cobrautl.ExitWithError(cobrautl.ExitBadArgs, fmt.Errorf("bad batch size %v", delBatchSize))
+	}
+	if delBatchSize > 0 && delPrevKV {
+		cobrautl.ExitWithError(cobrautl.ExitBadArgs, fmt.Errorf("`--batch-size` and `--prev-kv` cannot be set at the same time"))
+	}
+	if delAtomic && delBatchSize == 0 {
+		cobrautl.ExitWithError(cobrautl.ExitBadArgs, fmt.Errorf("`--atomic` requires `--batch-size`"))
+	}
+
 	var opts []clientv3.OpOption
 	key := args[0]
 	if len(args) > 1 {
@@ -102,5 +144,12 @@ func getDelOp(args []string) (string, []clientv3.OpOption) {
 		opts = append(opts, clientv3.WithFromKey())
 	}
 
+	if delBatchSize > 0 {
+		opts = append(opts, clientv3.WithBatchSize(delBatchSize))
+	}
+	if delAtomic {
+		opts = append(opts, clientv3.WithAtomicBatch())
+	}
+
 	return key, opts
 }
@@ -39,7 +39,13 @@ var (
 	getMaxCreateRev int64
 	getMinModRev    int64
 	getMaxModRev    int64
+	getValuePrefix  string
+	getMinValueSize int64
+	getMaxValueSize int64
+	getLeaseFilter  string
 	getStream       bool
+	getPageSize     int64
+	getHistory      bool
 )
 
 // NewGetCommand returns the cobra command for "get".
@@ -65,7 +71,13 @@ func NewGetCommand() *cobra.Command {
 	cmd.Flags().Int64Var(&getMaxCreateRev, "max-create-rev", 0, "Maximum create revision")
 	cmd.Flags().Int64Var(&getMinModRev, "min-mod-rev", 0, "Minimum modification revision")
 	cmd.Flags().Int64Var(&getMaxModRev, "max-mod-rev", 0, "Maximum modification revision")
+	cmd.Flags().StringVar(&getValuePrefix, "value-prefix", "", "Get only keys whose value starts with the given prefix")
+	cmd.Flags().Int64Var(&getMinValueSize, "min-value-size", 0, "Minimum value size in bytes")
+	cmd.Flags().Int64Var(&getMaxValueSize, "max-value-size", 0, "Maximum value size in bytes")
+	cmd.Flags().StringVar(&getLeaseFilter, "lease-filter", "", "Filter keys by lease; ATTACHED or DETACHED")
 	cmd.Flags().BoolVar(&getStream, "stream", false, "Use the RangeStream RPC")
+	cmd.Flags().Int64Var(&getPageSize, "page-size", 0, "Get the results in pages of the given size, all read at the same revision. Each page of a range sorted other than by key in ascending order reads the whole range")
+	cmd.Flags().BoolVar(&getHistory, "history", false, "Get every retained revision of the key, deletions included")
 
 	cmd.RegisterFlagCompletionFunc("consistency", func(_ *cobra.Command, _ []string, _ string) ([]string, cobra.ShellCompDirective) {
 		return []string{"l", "s"}, cobra.ShellCompDirectiveDefault
@@ -76,6 +88,9 @@ func NewGetCommand() *cobra.Command {
 	cmd.RegisterFlagCompletionFunc("sort-by", func(_ *cobra.Command, _ []string, _ string) ([]string, cobra.ShellCompDirective) {
 		return []string{"CREATE", "KEY", "MODIFY", "VALUE", "VERSION"}, cobra.ShellCompDirectiveDefault
 	})
+	cmd.RegisterFlagCompletionFunc("lease-filter", func(_ *cobra.Command, _ []string, _ string) ([]string, cobra.ShellCompDirective) {
+		return []string{"ATTACHED", "DETACHED"}, cobra.ShellCompDirectiveDefault
+	})
 
 	return cmd
 }
@@ -83,26 +98,61 @@ func NewGetCommand() *cobra.Command {
 // getCommandFunc executes the "get" command.
 func getCommandFunc(cmd *cobra.Command, args []string) {
 	key, opts := getGetOp(args)
-	ctx, cancel := commandCtx(cmd)
 	client := mustClientFromCmd(cmd)
+	if getPageSize > 0 {
+		getPages(cmd, client, key, opts)
+		return
+	}
+
+	ctx, cancel := commandCtx(cmd)
 	var (
 		resp *clientv3.GetResponse
 		err  error
 	)
-	if getStream {
+	switch {
+	case getHistory:
+		var hresp *clientv3.KeyHistoryResponse
+		hresp, err = client.KeyHistory(ctx, key, opts...)
+		if err == nil {
+			resp = &clientv3.GetResponse{Header: hresp.Header, Kvs: hresp.Kvs, More: hresp.More, Count: hresp.Count}
+		}
+	case getStream:
 		var stream clientv3.GetStreamChan
 		stream, err = client.GetStream(ctx, key, opts...)
 		if err == nil {
 			resp, err = clientv3.GetStreamToGetResponse(stream)
 		}
-	} else {
+	default:
 		resp, err = client.Get(ctx, key, opts...)
 	}
 	cancel()
 	if err != nil {
 		cobrautl.ExitWithError(cobrautl.ExitError, err)
 	}
+	displayGet(resp)
+}
+
+// getPages gets the range page by page, each page resuming from the continue
+// token of the previous one, and displays the pages as they are received.
+func getPages(cmd *cobra.Command, client *clientv3.Client, key string, opts []clientv3.OpOption) {
+	opts = append(opts, clientv3.WithLimit(getPageSize))
+	var token []byte
+	for {
+		ctx, cancel := commandCtx(cmd)
+		resp, err := client.Get(ctx, key, append(opts, clientv3.WithContinue(token))...)
+		cancel()
+		if err != nil {
+			cobrautl.ExitWithError(cobrautl.ExitError, err)
+		}
+		displayGet(resp)
+		if len(resp.ContinueToken) == 0 {
+			return
+		}
+		token = resp.ContinueToken
+	}
+}
 
+func displayGet(resp *clientv3.GetResponse) {
 	if getCountOnly {
 		if _, fields := display.(*fieldsPrinter); !fields {
 			cobrautl.ExitWithError(cobrautl.ExitBadArgs, fmt.Errorf("--count-only is only for `--write-out=fields`"))
@@ -132,6 +182,17 @@ func getGetOp(args []string) (string, []clientv3.OpOption) {
 		cobrautl.ExitWithError(cobrautl.ExitBadArgs, fmt.Errorf("`--keys-only` and `--count-only` cannot be set at the same time, choose one"))
 	}
 
+	if getPageSize < 0 {
+		cobrautl.ExitWithError(cobrautl.ExitBadArgs, fmt.Errorf("bad page size %v", getPageSize))
+	}
+	if getPageSize > 0 && (getLimit != 0 || getCountOnly || getStream) {
+		cobrautl.ExitWithError(cobrautl.ExitBadArgs, fmt.Errorf("`--page-size` cannot be set with `--limit`, `--count-only` or `--stream`"))
+	}
+
+	if getHistory && (len(args) > 1 || getPrefix || getFromKey || getCountOnly || getStream || getPageSize > 0) {
+		cobrautl.ExitWithError(cobrautl.ExitBadArgs, fmt.Errorf("`--history` cannot be set with a range_end, `--prefix`, `--from-key`, `--count-only`, `--stream` or `--page-size`"))
+	}
+
 	var opts []clientv3.OpOption
 	if IsSerializable(getConsistency) {
 		opts = append(opts, clientv3.WithSerializable())
@@ -232,5 +293,32 @@ func getGetOp(args []string) (string, []clientv3.OpOption) {
 		opts = append(opts, clientv3.WithMaxModRev(getMaxModRev))
 	}
 
+	if getValuePrefix != "" {
+		opts = append(opts, clientv3.WithValuePrefix(getValuePrefix))
+	}
+
+	if getMinValueSize > 0 {
+		opts = append(opts, clientv3.WithMinValueSize(getMinValueSize))
+	}
+
+	if getMaxValueSize > 0 {
+		if getMinValueSize > getMaxValueSize {
+			cobrautl.ExitWithError(cobrautl.ExitBadFeature,
+				fmt.Errorf("getMinValueSize(=%v) > getMaxValueSize(=%v)", getMinValueSize, getMaxValueSize))
+		}
+		opts = append(opts, clientv3.WithMaxValueSize(getMaxValueSize))
+	}
+
+	switch leaseFilter := strings.ToUpper(getLeaseFilter); leaseFilter {
+	case "ATTACHED":
+		opts = append(opts, clientv3.WithLeaseFilter(clientv3.LeaseFilterAttached))
+	case "DETACHED":
+		opts = append(opts, clientv3.WithLeaseFilter(clientv3.LeaseFilterDetached))
+	case "":
+		// nothing
+	default:
+		cobrautl.ExitWithError(cobrautl.ExitBadFeature, fmt.Errorf("bad lease filter %v", getLeaseFilter))
+	}
+
 	return key, opts
 }
@@ -0,0 +1,133 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package command
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"go.etcd.io/etcd/pkg/v3/cobrautl"
+)
+
+var holdLease string
+
+// NewHoldCommand returns the cobra command for "hold".
+func NewHoldCommand() *cobra.Command {
+	hc := &cobra.Command{
+		Use:     "hold <subcommand>",
+		Short:   "Revision hold related commands. Use `etcdctl hold --help` to see subcommands",
+		Long:    "Revision hold related commands. A hold protects a revision from compaction until it is released",
+		GroupID: groupClusterMaintenanceID,
+	}
+
+	hc.AddCommand(NewHoldCreateCommand())
+	hc.AddCommand(NewHoldReleaseCommand())
+	hc.AddCommand(NewHoldListCommand())
+
+	return hc
+}
+
+// NewHoldCreateCommand returns the cobra command for "hold create".
+func NewHoldCreateCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create [revision] [options]",
+		Short: "Holds a revision back from compaction, the current revision by default",
+
+		Run: holdCreateCommandFunc,
+	}
+	cmd.Flags().StringVar(&holdLease, "lease", "0", "lease ID (in hexadecimal) to attach the hold to")
+
+	return cmd
+}
+
+// holdCreateCommandFunc executes the "hold create" command.
+func holdCreateCommandFunc(cmd *cobra.Command, args []string) {
+	if len(args) > 1 {
+		cobrautl.ExitWithError(cobrautl.ExitBadArgs, fmt.Errorf("hold create command accepts at most 1 argument"))
+	}
+
+	var rev int64
+	if len(args) == 1 {
+		var err error
+		if rev, err = strconv.ParseInt(args[0], 10, 64); err != nil {
+			cobrautl.ExitWithError(cobrautl.ExitBadArgs, fmt.Errorf("bad revision (%w)", err))
+		}
+	}
+
+	ctx, cancel := commandCtx(cmd)
+	resp, err := mustClientFromCmd(cmd).HoldRevision(ctx, rev, leaseFromArgs(holdLease))
+	cancel()
+	if err != nil {
+		cobrautl.ExitWithError(cobrautl.ExitError, fmt.Errorf("failed to create hold (%w)", err))
+	}
+	display.HoldCreate(resp)
+}
+
+// NewHoldReleaseCommand returns the cobra command for "hold release".
+func NewHoldReleaseCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "release <holdID>",
+		Short: "Releases revision holds",
+
+		Run: holdReleaseCommandFunc,
+	}
+}
+
+// holdReleaseCommandFunc executes the "hold release" command.
+func holdReleaseCommandFunc(cmd *cobra.Command, args []string) {
+	if len(args) != 1 {
+		cobrautl.ExitWithError(cobrautl.ExitBadArgs, fmt.Errorf("hold release command needs 1 argument"))
+	}
+
+	id, err := strconv.ParseInt(args[0], 16, 64)
+	if err != nil {
+		cobrautl.ExitWithError(cobrautl.ExitBadArgs, fmt.Errorf("bad hold ID arg (%w), expecting ID in Hex", err))
+	}
+
+	ctx, cancel := commandCtx(cmd)
+	resp, err := mustClientFromCmd(cmd).ReleaseRevisionHold(ctx, id)
+	cancel()
+	if err != nil {
+		cobrautl.ExitWithError(cobrautl.ExitError, fmt.Errorf("failed to release hold (%w)", err))
+	}
+	display.HoldRelease(id, resp)
+}
+
+// NewHoldListCommand returns the cobra command for "hold list".
+func NewHoldListCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "Lists all revision holds",
+
+		Run: holdListCommandFunc,
+	}
+}
+
+// holdListCommandFunc executes the "hold list" command.
+func holdListCommandFunc(cmd *cobra.Command, args []string) {
+	if len(args) != 0 {
+		cobrautl.ExitWithError(cobrautl.ExitBadArgs, fmt.Errorf("hold list command accepts no arguments"))
+	}
+
+	ctx, cancel := commandCtx(cmd)
+	resp, err := mustClientFromCmd(cmd).RevisionHolds(ctx)
+	cancel()
+	if err != nil {
+		cobrautl.ExitWithError(cobrautl.ExitError, fmt.Errorf("failed to list holds (%w)", err))
+	}
+	display.HoldList(resp)
+}
@@ -36,13 +36,17 @@ func NewLeaseCommand() *cobra.Command {
 
 	lc.AddCommand(NewLeaseGrantCommand())
 	lc.AddCommand(NewLeaseRevokeCommand())
+	lc.AddCommand(NewLeaseUpdateTTLCommand())
 	lc.AddCommand(NewLeaseTimeToLiveCommand())
 	lc.AddCommand(NewLeaseListCommand())
 	lc.AddCommand(NewLeaseKeepAliveCommand())
+	lc.AddCommand(NewLeaseWatchCommand())
 
 	return lc
 }
 
+var leaseGrantParent string
+
 // NewLeaseGrantCommand returns the cobra command for "lease grant".
 func NewLeaseGrantCommand() *cobra.Command {
 	lc := &cobra.Command{
@@ -51,6 +55,7 @@ func NewLeaseGrantCommand() *cobra.Command {
 
 		Run: leaseGrantCommandFunc,
 	}
+	lc.Flags().StringVar(&leaseGrantParent, "parent", "", "Grant the lease as a child of the given lease ID, revoked along with it")
 
 	return lc
 }
@@ -66,8 +71,18 @@ func leaseGrantCommandFunc(cmd *cobra.Command, args []string) {
 		cobrautl.ExitWithError(cobrautl.ExitBadArgs, fmt.Errorf("bad TTL (%w)", err))
 	}
 
+	var parent v3.LeaseID
+	if leaseGrantParent != "" {
+		parent = leaseFromArgs(leaseGrantParent)
+	}
+
 	ctx, cancel := commandCtx(cmd)
-	resp, err := mustClientFromCmd(cmd).Grant(ctx, ttl)
+	var resp *v3.LeaseGrantResponse
+	if parent != v3.NoLease {
+		resp, err = mustClientFromCmd(cmd).GrantChild(ctx, parent, ttl)
+	} else {
+		resp, err = mustClientFromCmd(cmd).Grant(ctx, ttl)
+	}
 	cancel()
 	if err != nil {
 		cobrautl.ExitWithError(cobrautl.ExitError, fmt.Errorf("failed to grant lease (%w)", err))
@@ -75,22 +90,40 @@ func leaseGrantCommandFunc(cmd *cobra.Command, args []string) {
 	display.Grant(resp)
 }
 
+var leaseRevokePrefix bool
+
 // NewLeaseRevokeCommand returns the cobra command for "lease revoke".
 func NewLeaseRevokeCommand() *cobra.Command {
 	lc := &cobra.Command{
-		Use:   "revoke <leaseID>",
+		Use:   "revoke <leaseID> [<leaseID>...]",
 		Short: "Revokes leases",
 
 		Run: leaseRevokeCommandFunc,
 	}
+	lc.Flags().BoolVar(&leaseRevokePrefix, "prefix", false, "Revoke the leases with keys attached under the given prefix")
 
 	return lc
 }
 
-// leaseRevokeCommandFunc executes the "lease grant" command.
+// leaseRevokeCommandFunc executes the "lease revoke" command.
 func leaseRevokeCommandFunc(cmd *cobra.Command, args []string) {
-	if len(args) != 1 {
-		cobrautl.ExitWithError(cobrautl.ExitBadArgs, fmt.Errorf("lease revoke command needs 1 argument"))
+	if len(args) == 0 {
+		cobrautl.ExitWithError(cobrautl.ExitBadArgs, fmt.Errorf("lease revoke command needs at least 1 argument"))
+	}
+	if leaseRevokePrefix {
+		if len(args) != 1 {
+			cobrautl.ExitWithError(cobrautl.ExitBadArgs, fmt.Errorf("lease revoke --prefix command needs the key prefix as argument"))
+		}
+		leaseRevokeByPrefix(cmd, args[0])
+		return
+	}
+	if len(args) > 1 {
+		ids := make([]v3.LeaseID, len(args))
+		for i := range args {
+			ids[i] = leaseFromArgs(args[i])
+		}
+		leaseRevokeBatch(cmd, ids)
+		return
 	}
 
 	id := leaseFromArgs(args[0])
@@ -103,6 +136,66 @@ func leaseRevokeCommandFunc(cmd *cobra.Command, args []string) {
 	display.Revoke(id, resp)
 }
 
+// leaseRevokeBatch revokes the given leases in a single request.
+func leaseRevokeBatch(cmd *cobra.Command, ids []v3.LeaseID) {
+	ctx, cancel := commandCtx(cmd)
+	resp, err := mustClientFromCmd(cmd).RevokeBatch(ctx, ids)
+	cancel()
+	if err != nil {
+		cobrautl.ExitWithError(cobrautl.ExitError, fmt.Errorf("failed to revoke leases (%w)", err))
+	}
+	display.RevokeBatch(resp)
+}
+
+// leaseRevokeByPrefix revokes the leases with keys attached under prefix.
+func leaseRevokeByPrefix(cmd *cobra.Command, prefix string) {
+	c := mustClientFromCmd(cmd)
+	ctx, cancel := commandCtx(cmd)
+	lresp, err := c.Leases(ctx, v3.WithAttachedKeyPrefix(prefix))
+	cancel()
+	if err != nil {
+		cobrautl.ExitWithError(cobrautl.ExitError, fmt.Errorf("failed to list leases (%w)", err))
+	}
+	ids := make([]v3.LeaseID, len(lresp.Leases))
+	for i := range lresp.Leases {
+		ids[i] = lresp.Leases[i].ID
+	}
+	leaseRevokeBatch(cmd, ids)
+}
+
+// NewLeaseUpdateTTLCommand returns the cobra command for "lease update-ttl".
+func NewLeaseUpdateTTLCommand() *cobra.Command {
+	lc := &cobra.Command{
+		Use:   "update-ttl <leaseID> <ttl>",
+		Short: "Changes the TTL of a lease and renews it",
+
+		Run: leaseUpdateTTLCommandFunc,
+	}
+
+	return lc
+}
+
+// leaseUpdateTTLCommandFunc executes the "lease update-ttl" command.
+func leaseUpdateTTLCommandFunc(cmd *cobra.Command, args []string) {
+	if len(args) != 2 {
+		cobrautl.ExitWithError(cobrautl.ExitBadArgs, fmt.Errorf("lease update-ttl command needs lease ID and TTL arguments"))
+	}
+
+	id := leaseFromArgs(args[0])
+	ttl, err := strconv.ParseInt(args[1], 10, 64)
+	if err != nil {
+		cobrautl.ExitWithError(cobrautl.ExitBadArgs, fmt.Errorf("bad TTL (%w)", err))
+	}
+
+	ctx, cancel := commandCtx(cmd)
+	resp, err := mustClientFromCmd(cmd).UpdateTTL(ctx, id, ttl)
+	cancel()
+	if err != nil {
+		cobrautl.ExitWithError(cobrautl.ExitError, fmt.Errorf("failed to update lease TTL (%w)", err))
+	}
+	display.UpdateTTL(resp)
+}
+
 var timeToLiveKeys bool
 
 // NewLeaseTimeToLiveCommand returns the cobra command for "lease timetolive".
@@ -134,6 +227,8 @@ func leaseTimeToLiveCommandFunc(cmd *cobra.Command, args []string) {
 	display.TimeToLive(resp, timeToLiveKeys)
 }
 
+var leaseListWithKeys bool
+
 // NewLeaseListCommand returns the cobra command for "lease list".
 func NewLeaseListCommand() *cobra.Command {
 	lc := &cobra.Command{
@@ -141,16 +236,21 @@ func NewLeaseListCommand() *cobra.Command {
 		Short: "List all active leases",
 		Run:   leaseListCommandFunc,
 	}
+	lc.Flags().BoolVar(&leaseListWithKeys, "with-keys", false, "List the keys attached to each lease")
 	return lc
 }
 
 // leaseListCommandFunc executes the "lease list" command.
 func leaseListCommandFunc(cmd *cobra.Command, args []string) {
-	resp, rerr := mustClientFromCmd(cmd).Leases(context.TODO())
+	var opts []v3.LeaseOption
+	if leaseListWithKeys {
+		opts = append(opts, v3.WithAttachedKeys())
+	}
+	resp, rerr := mustClientFromCmd(cmd).Leases(context.TODO(), opts...)
 	if rerr != nil {
 		cobrautl.ExitWithError(cobrautl.ExitBadConnection, rerr)
 	}
-	display.Leases(resp)
+	display.Leases(resp, leaseListWithKeys)
 }
 
 var leaseKeepAliveOnce bool
@@ -199,6 +299,48 @@ func leaseKeepAliveCommandFunc(cmd *cobra.Command, args []string) {
 	}
 }
 
+var leaseWatchWithKeys bool
+
+// NewLeaseWatchCommand returns the cobra command for "lease watch".
+func NewLeaseWatchCommand() *cobra.Command {
+	lc := &cobra.Command{
+		Use:   "watch [options] [leaseID]",
+		Short: "Watches the events of a lease, or of all leases",
+
+		Run: leaseWatchCommandFunc,
+	}
+
+	lc.Flags().BoolVar(&leaseWatchWithKeys, "with-keys", false, "List the keys attached to revoked and expired leases")
+
+	return lc
+}
+
+// leaseWatchCommandFunc executes the "lease watch" command.
+func leaseWatchCommandFunc(cmd *cobra.Command, args []string) {
+	if len(args) > 1 {
+		cobrautl.ExitWithError(cobrautl.ExitBadArgs, fmt.Errorf("lease watch command takes at most one lease ID as argument"))
+	}
+
+	id := v3.NoLease
+	if len(args) == 1 {
+		id = leaseFromArgs(args[0])
+	}
+	var opts []v3.LeaseOption
+	if leaseWatchWithKeys {
+		opts = append(opts, v3.WithAttachedKeys())
+	}
+	wch, werr := mustClientFromCmd(cmd).WatchLeases(context.TODO(), id, opts...)
+	if werr != nil {
+		cobrautl.ExitWithError(cobrautl.ExitBadConnection, werr)
+	}
+	for resp := range wch {
+		if err := resp.Err(); err != nil {
+			cobrautl.ExitWithError(cobrautl.ExitError, err)
+		}
+		display.LeaseWatch(&resp)
+	}
+}
+
 func leaseFromArgs(arg string) v3.LeaseID {
 	id, err := strconv.ParseInt(arg, 16, 64)
 	if err != nil {
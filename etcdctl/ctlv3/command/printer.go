@@ -36,9 +36,12 @@ type printer interface {
 
 	Grant(r *v3.LeaseGrantResponse)
 	Revoke(id v3.LeaseID, r *v3.LeaseRevokeResponse)
+	RevokeBatch(r *v3.LeaseRevokeBatchResponse)
+	UpdateTTL(r *v3.LeaseUpdateTTLResponse)
 	KeepAlive(r *v3.LeaseKeepAliveResponse)
 	TimeToLive(r *v3.LeaseTimeToLiveResponse, keys bool)
-	Leases(r *v3.LeaseLeasesResponse)
+	Leases(r *v3.LeaseLeasesResponse, keys bool)
+	LeaseWatch(r *v3.LeaseWatchResponse)
 
 	MemberAdd(*v3.MemberAddResponse)
 	MemberRemove(id uint64, r *v3.MemberRemoveResponse)
@@ -57,6 +60,14 @@ type printer interface {
 
 	Alarm(*v3.AlarmResponse)
 
+	HoldCreate(r *v3.RevisionHoldResponse)
+	HoldRelease(id int64, r *v3.RevisionHoldResponse)
+	HoldList(r *v3.RevisionHoldResponse)
+
+	SubscriptionAck(r *v3.SubscriptionResponse)
+	SubscriptionDelete(name string, r *v3.SubscriptionResponse)
+	SubscriptionList(r *v3.SubscriptionResponse)
+
 	RoleAdd(role string, r *v3.AuthRoleAddResponse)
 	RoleGet(role string, r *v3.AuthRoleGetResponse)
 	RoleDelete(role string, r *v3.AuthRoleDeleteResponse)
@@ -102,11 +113,18 @@ func (p *printerRPC) Put(r *v3.PutResponse)     { p.p((*pb.PutResponse)(r)) }
 func (p *printerRPC) Txn(r *v3.TxnResponse)     { p.p((*pb.TxnResponse)(r)) }
 func (p *printerRPC) Watch(r *v3.WatchResponse) { p.p(r) }
 
-func (p *printerRPC) Grant(r *v3.LeaseGrantResponse)                      { p.p(r) }
-func (p *printerRPC) Revoke(id v3.LeaseID, r *v3.LeaseRevokeResponse)     { p.p(r) }
+func (p *printerRPC) Grant(r *v3.LeaseGrantResponse)                  { p.p(r) }
+func (p *printerRPC) Revoke(id v3.LeaseID, r *v3.LeaseRevokeResponse) { p.p(r) }
+func (p *printerRPC) RevokeBatch(r *v3.LeaseRevokeBatchResponse) {
+	p.p((*pb.LeaseRevokeBatchResponse)(r))
+}
+func (p *printerRPC) UpdateTTL(r *v3.LeaseUpdateTTLResponse)              { p.p((*pb.LeaseUpdateTTLResponse)(r)) }
 func (p *printerRPC) KeepAlive(r *v3.LeaseKeepAliveResponse)              { p.p(r) }
 func (p *printerRPC) TimeToLive(r *v3.LeaseTimeToLiveResponse, keys bool) { p.p(r) }
-func (p *printerRPC) Leases(r *v3.LeaseLeasesResponse)                    { p.p(r) }
+func (p *printerRPC) Leases(r *v3.LeaseLeasesResponse, keys bool)         { p.p(r) }
+func (p *printerRPC) LeaseWatch(r *v3.LeaseWatchResponse) {
+	p.p(&pb.LeaseWatchResponse{Header: r.ResponseHeader, Events: r.Events})
+}
 
 func (p *printerRPC) MemberAdd(r *v3.MemberAddResponse) { p.p((*pb.MemberAddResponse)(r)) }
 func (p *printerRPC) MemberRemove(id uint64, r *v3.MemberRemoveResponse) {
@@ -122,6 +140,22 @@ func (p *printerRPC) MemberPromote(id uint64, r *v3.MemberPromoteResponse) {
 }
 func (p *printerRPC) MemberList(r *v3.MemberListResponse) { p.p((*pb.MemberListResponse)(r)) }
 func (p *printerRPC) Alarm(r *v3.AlarmResponse)           { p.p((*pb.AlarmResponse)(r)) }
+func (p *printerRPC) HoldCreate(r *v3.RevisionHoldResponse) {
+	p.p((*pb.RevisionHoldResponse)(r))
+}
+
+func (p *printerRPC) HoldRelease(id int64, r *v3.RevisionHoldResponse) {
+	p.p((*pb.RevisionHoldResponse)(r))
+}
+func (p *printerRPC) HoldList(r *v3.RevisionHoldResponse) { p.p((*pb.RevisionHoldResponse)(r)) }
+func (p *printerRPC) SubscriptionAck(r *v3.SubscriptionResponse) {
+	p.p((*pb.SubscriptionResponse)(r))
+}
+
+func (p *printerRPC) SubscriptionDelete(name string, r *v3.SubscriptionResponse) {
+	p.p((*pb.SubscriptionResponse)(r))
+}
+func (p *printerRPC) SubscriptionList(r *v3.SubscriptionResponse) { p.p((*pb.SubscriptionResponse)(r)) }
 func (p *printerRPC) MoveLeader(leader, target uint64, r *v3.MoveLeaderResponse) {
 	p.p((*pb.MoveLeaderResponse)(r))
 }
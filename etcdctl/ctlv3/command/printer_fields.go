@@ -83,6 +83,9 @@ func (p *fieldsPrinter) Put(r *v3.PutResponse) {
 	if resp.GetPrevKv() != nil {
 		p.kv("Prev", resp.GetPrevKv())
 	}
+	if resp.GetExpiry() > 0 {
+		fmt.Println(`"Expiry" :`, resp.GetExpiry())
+	}
 }
 
 func (p *fieldsPrinter) Txn(r *v3.TxnResponse) {
@@ -97,6 +100,12 @@ func (p *fieldsPrinter) Txn(r *v3.TxnResponse) {
 			p.Put((*v3.PutResponse)(v.ResponsePut))
 		case *pb.ResponseOp_ResponseRange:
 			p.Get((*v3.GetResponse)(v.ResponseRange))
+		case *pb.ResponseOp_ResponseIncrement:
+			p.hdr(v.ResponseIncrement.GetHeader())
+			fmt.Println(`"Value" :`, v.ResponseIncrement.GetValue())
+			if v.ResponseIncrement.GetPrevKv() != nil {
+				p.kv("Prev", v.ResponseIncrement.GetPrevKv())
+			}
 		default:
 			fmt.Printf("\"Unknown\" : %q\n", fmt.Sprintf("%+v", v))
 		}
@@ -132,6 +141,27 @@ func (p *fieldsPrinter) Revoke(id v3.LeaseID, r *v3.LeaseRevokeResponse) {
 	p.hdr((*pb.LeaseRevokeResponse)(r).GetHeader())
 }
 
+func (p *fieldsPrinter) RevokeBatch(r *v3.LeaseRevokeBatchResponse) {
+	p.hdr((*pb.LeaseRevokeBatchResponse)(r).GetHeader())
+	for _, id := range r.Revoked {
+		if p.isHex {
+			fmt.Printf("\"Revoked\" : %016x\n", id)
+		} else {
+			fmt.Println(`"Revoked" :`, id)
+		}
+	}
+}
+
+func (p *fieldsPrinter) UpdateTTL(r *v3.LeaseUpdateTTLResponse) {
+	p.hdr((*pb.LeaseUpdateTTLResponse)(r).GetHeader())
+	if p.isHex {
+		fmt.Printf("\"ID\" : %016x\n", r.ID)
+	} else {
+		fmt.Println(`"ID" :`, r.ID)
+	}
+	fmt.Println(`"TTL" :`, r.TTL)
+}
+
 func (p *fieldsPrinter) KeepAlive(r *v3.LeaseKeepAliveResponse) {
 	p.hdr(r.ResponseHeader)
 	if p.isHex {
@@ -156,7 +186,26 @@ func (p *fieldsPrinter) TimeToLive(r *v3.LeaseTimeToLiveResponse, keys bool) {
 	}
 }
 
-func (p *fieldsPrinter) Leases(r *v3.LeaseLeasesResponse) {
+func (p *fieldsPrinter) LeaseWatch(r *v3.LeaseWatchResponse) {
+	p.hdr(r.ResponseHeader)
+	for _, ev := range r.Events {
+		fmt.Println(`"Type" :`, ev.Type)
+		if p.isHex {
+			fmt.Printf("\"ID\" : %016x\n", ev.ID)
+			fmt.Printf("\"Parent\" : %016x\n", ev.Parent)
+		} else {
+			fmt.Println(`"ID" :`, ev.ID)
+			fmt.Println(`"Parent" :`, ev.Parent)
+		}
+		fmt.Println(`"TTL" :`, ev.TTL)
+		fmt.Println(`"Revision" :`, ev.Revision)
+		for _, k := range ev.Keys {
+			fmt.Printf("\"Key\" : %q\n", string(k))
+		}
+	}
+}
+
+func (p *fieldsPrinter) Leases(r *v3.LeaseLeasesResponse, keys bool) {
 	p.hdr(r.ResponseHeader)
 	for _, item := range r.Leases {
 		if p.isHex {
@@ -164,6 +213,12 @@ func (p *fieldsPrinter) Leases(r *v3.LeaseLeasesResponse) {
 		} else {
 			fmt.Println(`"ID" :`, item.ID)
 		}
+		for _, k := range item.Keys {
+			fmt.Printf("\"Key\" : %q\n", string(k))
+		}
+		if keys {
+			fmt.Println(`"MoreKeys" :`, item.MoreKeys)
+		}
 	}
 }
 
@@ -230,6 +285,48 @@ func (p *fieldsPrinter) EndpointHashKV(hs []epHashKV) {
 	}
 }
 
+func (p *fieldsPrinter) HoldCreate(r *v3.RevisionHoldResponse) { p.holds(r) }
+
+func (p *fieldsPrinter) HoldRelease(id int64, r *v3.RevisionHoldResponse) { p.holds(r) }
+
+func (p *fieldsPrinter) HoldList(r *v3.RevisionHoldResponse) { p.holds(r) }
+
+func (p *fieldsPrinter) SubscriptionAck(r *v3.SubscriptionResponse) { p.subscriptions(r) }
+
+func (p *fieldsPrinter) SubscriptionDelete(name string, r *v3.SubscriptionResponse) {
+	p.subscriptions(r)
+}
+
+func (p *fieldsPrinter) SubscriptionList(r *v3.SubscriptionResponse) { p.subscriptions(r) }
+
+func (p *fieldsPrinter) subscriptions(r *v3.SubscriptionResponse) {
+	resp := (*pb.SubscriptionResponse)(r)
+	p.hdr(resp.GetHeader())
+	for _, sub := range resp.GetSubscriptions() {
+		fmt.Printf("\"Name\" : %q\n", sub.GetName())
+		fmt.Println(`"Revision" :`, sub.GetRevision())
+		fmt.Println(`"MaxLag" :`, sub.GetMaxLag())
+		fmt.Println()
+	}
+}
+
+func (p *fieldsPrinter) holds(r *v3.RevisionHoldResponse) {
+	resp := (*pb.RevisionHoldResponse)(r)
+	p.hdr(resp.GetHeader())
+	for _, h := range resp.GetHolds() {
+		if p.isHex {
+			fmt.Printf("\"ID\" : %016x\n", h.GetID())
+			fmt.Printf("\"Lease\" : %016x\n", h.GetLease())
+		} else {
+			fmt.Println(`"ID" :`, h.GetID())
+			fmt.Println(`"Lease" :`, h.GetLease())
+		}
+		fmt.Println(`"Revision" :`, h.GetRevision())
+		fmt.Println(`"CreateTime" :`, h.GetCreateTime())
+		fmt.Println()
+	}
+}
+
 func (p *fieldsPrinter) Alarm(r *v3.AlarmResponse) {
 	resp := (*pb.AlarmResponse)(r)
 	p.hdr(resp.GetHeader())
@@ -115,6 +115,7 @@ type ResponseOpResponseJSON struct {
 	ResponsePut         *pb.PutResponse         `json:"response_put,omitempty"`
 	ResponseDeleteRange *pb.DeleteRangeResponse `json:"response_delete_range,omitempty"`
 	ResponseTxn         *TxnResponseJSON        `json:"response_txn,omitempty"`
+	ResponseIncrement   *pb.IncrementResponse   `json:"response_increment,omitempty"`
 }
 
 func (t *TxnResponseJSON) ToProto() *pb.TxnResponse {
@@ -136,6 +137,8 @@ func (t *TxnResponseJSON) ToProto() *pb.TxnResponse {
 			r.Responses = append(r.Responses, &pb.ResponseOp{Response: &pb.ResponseOp_ResponseTxn{ResponseTxn: jsonResponse.Response.ResponseTxn.ToProto()}})
 		case jsonResponse.Response.ResponsePut != nil:
 			r.Responses = append(r.Responses, &pb.ResponseOp{Response: &pb.ResponseOp_ResponsePut{ResponsePut: jsonResponse.Response.ResponsePut}})
+		case jsonResponse.Response.ResponseIncrement != nil:
+			r.Responses = append(r.Responses, &pb.ResponseOp{Response: &pb.ResponseOp_ResponseIncrement{ResponseIncrement: jsonResponse.Response.ResponseIncrement}})
 		default:
 			// unknown
 			r.Responses = append(r.Responses, &pb.ResponseOp{})
@@ -160,6 +163,8 @@ func TxnResponseJSONFromProto(protoResponse *pb.TxnResponse) TxnResponseJSON {
 		case *pb.ResponseOp_ResponseTxn:
 			responseTxn := TxnResponseJSONFromProto(response.ResponseTxn)
 			r.Responses = append(r.Responses, ResponseOpJSON{ResponseOpResponseJSON{ResponseTxn: &responseTxn}})
+		case *pb.ResponseOp_ResponseIncrement:
+			r.Responses = append(r.Responses, ResponseOpJSON{ResponseOpResponseJSON{ResponseIncrement: response.ResponseIncrement}})
 		default:
 			r.Responses = append(r.Responses, ResponseOpJSON{ResponseOpResponseJSON{}})
 		}
@@ -72,6 +72,11 @@ func (s *simplePrinter) Txn(resp *v3.TxnResponse) {
 			s.Put((*v3.PutResponse)(v.ResponsePut))
 		case *pb.ResponseOp_ResponseRange:
 			s.Get((*v3.GetResponse)(v.ResponseRange))
+		case *pb.ResponseOp_ResponseIncrement:
+			fmt.Println(v.ResponseIncrement.GetValue())
+			if v.ResponseIncrement.GetPrevKv() != nil {
+				printKV(s.isHex, s.valueOnly, v.ResponseIncrement.GetPrevKv())
+			}
 		default:
 			fmt.Printf("unexpected response %+v\n", opResp)
 		}
@@ -96,6 +101,17 @@ func (s *simplePrinter) Revoke(id v3.LeaseID, r *v3.LeaseRevokeResponse) {
 	fmt.Printf("lease %016x revoked\n", id)
 }
 
+func (s *simplePrinter) RevokeBatch(r *v3.LeaseRevokeBatchResponse) {
+	fmt.Printf("revoked %d leases\n", len(r.Revoked))
+	for _, id := range r.Revoked {
+		fmt.Printf("%016x\n", id)
+	}
+}
+
+func (s *simplePrinter) UpdateTTL(r *v3.LeaseUpdateTTLResponse) {
+	fmt.Printf("lease %016x updated with TTL(%ds)\n", r.ID, r.TTL)
+}
+
 func (s *simplePrinter) KeepAlive(resp *v3.LeaseKeepAliveResponse) {
 	fmt.Printf("lease %016x keepalived with TTL(%d)\n", resp.ID, resp.TTL)
 }
@@ -117,10 +133,39 @@ func (s *simplePrinter) TimeToLive(resp *v3.LeaseTimeToLiveResponse, keys bool)
 	fmt.Println(txt)
 }
 
-func (s *simplePrinter) Leases(resp *v3.LeaseLeasesResponse) {
+func (s *simplePrinter) LeaseWatch(resp *v3.LeaseWatchResponse) {
+	for _, ev := range resp.Events {
+		txt := fmt.Sprintf("%s %016x", ev.Type, ev.ID)
+		switch ev.Type {
+		case pb.LeaseEvent_GRANT, pb.LeaseEvent_CHECKPOINT:
+			txt += fmt.Sprintf(" TTL(%ds)", ev.TTL)
+		}
+		if ev.Parent != 0 {
+			txt += fmt.Sprintf(" parent(%016x)", ev.Parent)
+		}
+		fmt.Println(txt)
+		for _, k := range ev.Keys {
+			fmt.Println(string(k))
+		}
+	}
+}
+
+func (s *simplePrinter) Leases(resp *v3.LeaseLeasesResponse, keys bool) {
 	fmt.Printf("found %d leases\n", len(resp.Leases))
 	for _, item := range resp.Leases {
-		fmt.Printf("%016x\n", item.ID)
+		if !keys {
+			fmt.Printf("%016x\n", item.ID)
+			continue
+		}
+		ks := make([]string, len(item.Keys))
+		for i := range item.Keys {
+			ks[i] = string(item.Keys[i])
+		}
+		if item.MoreKeys {
+			fmt.Printf("%016x, attached keys(%v), more keys\n", item.ID, ks)
+			continue
+		}
+		fmt.Printf("%016x, attached keys(%v)\n", item.ID, ks)
 	}
 }
 
@@ -131,6 +176,44 @@ func (s *simplePrinter) Alarm(resp *v3.AlarmResponse) {
 	}
 }
 
+func (s *simplePrinter) HoldCreate(resp *v3.RevisionHoldResponse) {
+	for _, h := range resp.Holds {
+		fmt.Printf("hold %016x created for revision %d\n", h.ID, h.Revision)
+	}
+}
+
+func (s *simplePrinter) HoldRelease(id int64, r *v3.RevisionHoldResponse) {
+	fmt.Printf("hold %016x released\n", id)
+}
+
+func (s *simplePrinter) HoldList(resp *v3.RevisionHoldResponse) {
+	fmt.Printf("found %d holds\n", len(resp.Holds))
+	for _, h := range resp.Holds {
+		if h.Lease != 0 {
+			fmt.Printf("%016x, revision %d, lease %016x\n", h.ID, h.Revision, h.Lease)
+		} else {
+			fmt.Printf("%016x, revision %d\n", h.ID, h.Revision)
+		}
+	}
+}
+
+func (s *simplePrinter) SubscriptionAck(resp *v3.SubscriptionResponse) {
+	for _, sub := range resp.Subscriptions {
+		fmt.Printf("subscription %q acknowledged revision %d\n", sub.Name, sub.Revision)
+	}
+}
+
+func (s *simplePrinter) SubscriptionDelete(name string, r *v3.SubscriptionResponse) {
+	fmt.Printf("subscription %q deleted\n", name)
+}
+
+func (s *simplePrinter) SubscriptionList(resp *v3.SubscriptionResponse) {
+	fmt.Printf("found %d subscriptions\n", len(resp.Subscriptions))
+	for _, sub := range resp.Subscriptions {
+		fmt.Printf("%s, revision %d, max lag %d\n", sub.Name, sub.Revision, sub.MaxLag)
+	}
+}
+
 func (s *simplePrinter) MemberAdd(r *v3.MemberAddResponse) {
 	resp := (*pb.MemberAddResponse)(r)
 	asLearner := " "
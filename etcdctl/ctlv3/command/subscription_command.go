@@ -0,0 +1,123 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package command
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"go.etcd.io/etcd/pkg/v3/cobrautl"
+)
+
+// NewSubscriptionCommand returns the cobra command for "subscription".
+func NewSubscriptionCommand() *cobra.Command {
+	sc := &cobra.Command{
+		Use:     "subscription <subcommand>",
+		Short:   "Watch subscription related commands. Use `etcdctl subscription --help` to see subcommands",
+		Long:    "Watch subscription related commands. A subscription records the last revision a named watcher processed",
+		GroupID: groupKVID,
+	}
+
+	sc.AddCommand(NewSubscriptionAckCommand())
+	sc.AddCommand(NewSubscriptionDeleteCommand())
+	sc.AddCommand(NewSubscriptionListCommand())
+
+	return sc
+}
+
+// NewSubscriptionAckCommand returns the cobra command for "subscription ack".
+func NewSubscriptionAckCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "ack <name> [revision]",
+		Short: "Acknowledges a revision for a subscription, the current revision by default",
+
+		Run: subscriptionAckCommandFunc,
+	}
+}
+
+// subscriptionAckCommandFunc executes the "subscription ack" command.
+func subscriptionAckCommandFunc(cmd *cobra.Command, args []string) {
+	if len(args) != 1 && len(args) != 2 {
+		cobrautl.ExitWithError(cobrautl.ExitBadArgs, fmt.Errorf("subscription ack command needs 1 or 2 arguments"))
+	}
+
+	var rev int64
+	if len(args) == 2 {
+		var err error
+		if rev, err = strconv.ParseInt(args[1], 10, 64); err != nil {
+			cobrautl.ExitWithError(cobrautl.ExitBadArgs, fmt.Errorf("bad revision (%w)", err))
+		}
+	}
+
+	ctx, cancel := commandCtx(cmd)
+	resp, err := mustClientFromCmd(cmd).AckSubscription(ctx, args[0], rev)
+	cancel()
+	if err != nil {
+		cobrautl.ExitWithError(cobrautl.ExitError, fmt.Errorf("failed to acknowledge subscription (%w)", err))
+	}
+	display.SubscriptionAck(resp)
+}
+
+// NewSubscriptionDeleteCommand returns the cobra command for "subscription delete".
+func NewSubscriptionDeleteCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "delete <name>",
+		Short: "Deletes a subscription",
+
+		Run: subscriptionDeleteCommandFunc,
+	}
+}
+
+// subscriptionDeleteCommandFunc executes the "subscription delete" command.
+func subscriptionDeleteCommandFunc(cmd *cobra.Command, args []string) {
+	if len(args) != 1 {
+		cobrautl.ExitWithError(cobrautl.ExitBadArgs, fmt.Errorf("subscription delete command needs 1 argument"))
+	}
+
+	ctx, cancel := commandCtx(cmd)
+	resp, err := mustClientFromCmd(cmd).DeleteSubscription(ctx, args[0])
+	cancel()
+	if err != nil {
+		cobrautl.ExitWithError(cobrautl.ExitError, fmt.Errorf("failed to delete subscription (%w)", err))
+	}
+	display.SubscriptionDelete(args[0], resp)
+}
+
+// NewSubscriptionListCommand returns the cobra command for "subscription list".
+func NewSubscriptionListCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "Lists all subscriptions",
+
+		Run: subscriptionListCommandFunc,
+	}
+}
+
+// subscriptionListCommandFunc executes the "subscription list" command.
+func subscriptionListCommandFunc(cmd *cobra.Command, args []string) {
+	if len(args) != 0 {
+		cobrautl.ExitWithError(cobrautl.ExitBadArgs, fmt.Errorf("subscription list command accepts no arguments"))
+	}
+
+	ctx, cancel := commandCtx(cmd)
+	resp, err := mustClientFromCmd(cmd).Subscriptions(ctx)
+	cancel()
+	if err != nil {
+		cobrautl.ExitWithError(cobrautl.ExitError, fmt.Errorf("failed to list subscriptions (%w)", err))
+	}
+	display.SubscriptionList(resp)
+}
@@ -28,7 +28,14 @@ import (
 	"go.etcd.io/etcd/pkg/v3/cobrautl"
 )
 
-var txnInteractive bool
+var (
+	txnInteractive bool
+
+	incLeaseStr    string
+	incPrevKV      bool
+	incIgnoreLease bool
+	incBigEndian   bool
+)
 
 // NewTxnCommand returns the cobra command for "txn".
 func NewTxnCommand() *cobra.Command {
@@ -49,10 +56,11 @@ etcdctl txn -i
 # compares:
 mod("key1") > "0"
 
-# success requests (get, put, delete):
+# success requests (get, put, delete, inc):
 put key1 "overwrote-key1"
+inc counter1 1
 
-# failure requests (get, put, delete):
+# failure requests (get, put, delete, inc):
 put key1 "created-key1"
 put key2 "some extra key"
 ---
@@ -76,9 +84,9 @@ func txnCommandFunc(cmd *cobra.Command, args []string) {
 	txn := mustClientFromCmd(cmd).Txn(context.Background())
 	promptInteractive("compares:")
 	txn.If(readCompares(reader)...)
-	promptInteractive("success requests (get, put, del):")
+	promptInteractive("success requests (get, put, del, inc):")
 	txn.Then(readOps(reader)...)
-	promptInteractive("failure requests (get, put, del):")
+	promptInteractive("failure requests (get, put, del, inc):")
 	txn.Else(readOps(reader)...)
 
 	resp, err := txn.Commit()
@@ -167,8 +175,16 @@ func parseRequestUnion(line string) (*clientv3.Op, error) {
 		key, opts := getDelOp(args)
 		opc <- clientv3.OpDelete(key, opts...)
 	}
+	inc := newIncCommand()
+	inc.Run = func(cmd *cobra.Command, args []string) {
+		key, delta, opts, err := getIncOp(args)
+		if err != nil {
+			cobrautl.ExitWithError(cobrautl.ExitBadArgs, err)
+		}
+		opc <- clientv3.OpIncrement(key, delta, opts...)
+	}
 	cmds := &cobra.Command{SilenceErrors: true}
-	cmds.AddCommand(put, get, del)
+	cmds.AddCommand(put, get, del, inc)
 
 	cmds.SetArgs(args)
 	if err := cmds.Execute(); err != nil {
@@ -179,6 +195,48 @@ func parseRequestUnion(line string) (*clientv3.Op, error) {
 	return &op, nil
 }
 
+// newIncCommand returns the cobra command for the "inc" txn request.
+func newIncCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "inc [options] <key> <delta>",
+		Short: "Atomically adds delta to the counter stored in the given key",
+	}
+	cmd.Flags().StringVar(&incLeaseStr, "lease", "0", "lease ID (in hexadecimal) to attach to the key")
+	cmd.Flags().BoolVar(&incPrevKV, "prev-kv", false, "return the previous key-value pair before modification")
+	cmd.Flags().BoolVar(&incIgnoreLease, "ignore-lease", false, "updates the key using its current lease")
+	cmd.Flags().BoolVar(&incBigEndian, "big-endian", false, "the counter is stored as an 8 byte big-endian integer instead of a decimal string")
+	return cmd
+}
+
+func getIncOp(args []string) (string, int64, []clientv3.OpOption, error) {
+	if len(args) != 2 {
+		return "", 0, nil, fmt.Errorf("inc command needs 2 arguments")
+	}
+	delta, err := strconv.ParseInt(args[1], 10, 64)
+	if err != nil {
+		return "", 0, nil, fmt.Errorf("bad delta (%w)", err)
+	}
+	id, err := strconv.ParseInt(incLeaseStr, 16, 64)
+	if err != nil {
+		return "", 0, nil, fmt.Errorf("bad lease ID (%w), expecting ID in Hex", err)
+	}
+
+	var opts []clientv3.OpOption
+	if id != 0 {
+		opts = append(opts, clientv3.WithLease(clientv3.LeaseID(id)))
+	}
+	if incPrevKV {
+		opts = append(opts, clientv3.WithPrevKV())
+	}
+	if incIgnoreLease {
+		opts = append(opts, clientv3.WithIgnoreLease())
+	}
+	if incBigEndian {
+		opts = append(opts, clientv3.WithCounterEncoding(clientv3.CounterBigEndian))
+	}
+	return args[0], delta, opts, nil
+}
+
 func ParseCompare(line string) (*clientv3.Cmp, error) {
 	var (
 		key string
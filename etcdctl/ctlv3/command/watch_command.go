@@ -16,15 +16,21 @@ package command
 
 import (
 	"bufio"
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"os"
 	"os/exec"
+	"path/filepath"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
+	"go.etcd.io/etcd/api/v3/mvccpb"
 	clientv3 "go.etcd.io/etcd/client/v3"
 	"go.etcd.io/etcd/pkg/v3/cobrautl"
 )
@@ -34,6 +40,7 @@ var (
 	errBadArgsNumConflictEnv   = errors.New("bad number of arguments (found conflicting environment key)")
 	errBadArgsNumSeparator     = errors.New("bad number of arguments (found separator --, but no commands)")
 	errBadArgsInteractiveWatch = errors.New("args[0] must be 'watch' for interactive calls")
+	errWatchFlagsInteractive   = errors.New("--debounce, --rev-file and --exit-on are not supported in interactive mode")
 )
 
 var (
@@ -42,6 +49,11 @@ var (
 	watchInteractive bool
 	watchPrevKey     bool
 	progressNotify   bool
+	watchSub         string
+	watchJSONLines   bool
+	watchDebounce    time.Duration
+	watchRevFile     string
+	watchExitOn      string
 )
 
 // NewWatchCommand returns the cobra command for "watch".
@@ -58,6 +70,11 @@ func NewWatchCommand() *cobra.Command {
 	cmd.Flags().Int64Var(&watchRev, "rev", 0, "Revision to start watching")
 	cmd.Flags().BoolVar(&watchPrevKey, "prev-kv", false, "get the previous key-value pair before the event happens")
 	cmd.Flags().BoolVar(&progressNotify, "progress-notify", false, "get periodic watch progress notification from server")
+	cmd.Flags().StringVar(&watchSub, "subscription", "", "resume after the revision acknowledged by the named subscription if --rev is not set")
+	cmd.Flags().BoolVar(&watchJSONLines, "json-lines", false, "print each event as a line of JSON")
+	cmd.Flags().DurationVar(&watchDebounce, "debounce", 0, "run the exec-command once for all events received until no event is received for the given duration")
+	cmd.Flags().StringVar(&watchRevFile, "rev-file", "", "file recording the last revision handled, to resume after it; --rev is used if the file does not exist")
+	cmd.Flags().StringVar(&watchExitOn, "exit-on", "", "exit once the given key is put with the given value (key=value) or deleted (key)")
 
 	return cmd
 }
@@ -70,6 +87,9 @@ func watchCommandFunc(cmd *cobra.Command, args []string) {
 	}
 
 	if watchInteractive {
+		if watchDebounce != 0 || watchRevFile != "" || watchExitOn != "" {
+			cobrautl.ExitWithError(cobrautl.ExitBadArgs, errWatchFlagsInteractive)
+		}
 		watchInteractiveFunc(cmd, os.Args, envKey, envRange)
 		return
 	}
@@ -85,10 +105,13 @@ func watchCommandFunc(cmd *cobra.Command, args []string) {
 		cobrautl.ExitWithError(cobrautl.ExitBadArgs, err)
 	}
 
-	printWatchCh(c, wc, execArgs)
+	exited := printWatchCh(c, wc, execArgs)
 	if err = c.Close(); err != nil {
 		cobrautl.ExitWithError(cobrautl.ExitBadConnection, err)
 	}
+	if exited {
+		return
+	}
 	cobrautl.ExitWithError(cobrautl.ExitInterrupted, fmt.Errorf("watch is canceled by the server"))
 }
 
@@ -144,7 +167,17 @@ func getWatchChan(c *clientv3.Client, args []string) (clientv3.WatchChan, error)
 	}
 
 	key := args[0]
-	opts := []clientv3.OpOption{clientv3.WithRev(watchRev)}
+	rev := watchRev
+	if watchRevFile != "" {
+		last, err := readWatchRevFile(watchRevFile)
+		if err != nil {
+			return nil, err
+		}
+		if last > 0 {
+			rev = last + 1
+		}
+	}
+	opts := []clientv3.OpOption{clientv3.WithRev(rev)}
 	if len(args) == 2 {
 		if watchPrefix {
 			return nil, fmt.Errorf("`range_end` and `--prefix` are mutually exclusive")
@@ -160,35 +193,250 @@ func getWatchChan(c *clientv3.Client, args []string) (clientv3.WatchChan, error)
 	if progressNotify {
 		opts = append(opts, clientv3.WithProgressNotify())
 	}
+	if watchSub != "" {
+		opts = append(opts, clientv3.WithSubscription(watchSub))
+	}
 	return c.Watch(clientv3.WithRequireLeader(context.Background()), key, opts...), nil
 }
 
-func printWatchCh(c *clientv3.Client, ch clientv3.WatchChan, execArgs []string) {
-	for resp := range ch {
-		if resp.Canceled {
-			fmt.Fprintf(os.Stderr, "watch was canceled (%v)\n", resp.Err())
+// printWatchCh prints the responses of ch and runs execArgs on their events
+// until ch is closed or an event matches --exit-on, in which case it returns
+// true.
+func printWatchCh(c *clientv3.Client, ch clientv3.WatchChan, execArgs []string) bool {
+	exitOn, err := parseWatchExitOn(watchExitOn)
+	if err != nil {
+		cobrautl.ExitWithError(cobrautl.ExitBadArgs, err)
+	}
+	h := &watchHandler{c: c, execArgs: execArgs, exitOn: exitOn}
+
+	// quiet fires once no event was received for the debounce window
+	var quiet <-chan time.Time
+	for {
+		select {
+		case resp, ok := <-ch:
+			if !ok {
+				h.flush()
+				return false
+			}
+			if h.handle(&resp) {
+				h.flush()
+				return true
+			}
+			if len(resp.Events) > 0 && len(h.pending) > 0 {
+				quiet = time.After(watchDebounce)
+			}
+		case <-quiet:
+			quiet = nil
+			h.flush()
 		}
+	}
+}
+
+type watchHandler struct {
+	c        *clientv3.Client
+	execArgs []string
+	exitOn   func(*clientv3.Event) bool
+
+	// pending holds the events left to pass to execArgs once the debounce
+	// window elapses, up to pendingRev
+	pending    []*clientv3.Event
+	pendingRev int64
+}
+
+// handle prints resp and runs execArgs on its events, unless they are
+// debounced. It returns true if an event of resp matches --exit-on.
+func (h *watchHandler) handle(resp *clientv3.WatchResponse) bool {
+	if resp.Canceled {
+		fmt.Fprintf(os.Stderr, "watch was canceled (%v)\n", resp.Err())
+	}
+	rev := resp.Header.GetRevision()
+	if watchJSONLines {
+		printWatchJSONLines(os.Stdout, rev, resp.IsProgressNotify(), resp.Events)
+	} else {
 		if resp.IsProgressNotify() {
-			fmt.Fprintf(os.Stdout, "progress notify: %d\n", resp.Header.GetRevision())
-		}
-		display.Watch(&resp)
-
-		if len(execArgs) > 0 {
-			for _, event := range resp.Events {
-				cmd := exec.CommandContext(c.Ctx(), execArgs[0], execArgs[1:]...)
-				cmd.Env = os.Environ()
-				cmd.Env = append(cmd.Env, fmt.Sprintf("ETCD_WATCH_REVISION=%d", resp.Header.GetRevision()))
-				cmd.Env = append(cmd.Env, fmt.Sprintf("ETCD_WATCH_EVENT_TYPE=%q", event.GetType()))
-				cmd.Env = append(cmd.Env, fmt.Sprintf("ETCD_WATCH_KEY=%q", event.GetKv().GetKey()))
-				cmd.Env = append(cmd.Env, fmt.Sprintf("ETCD_WATCH_VALUE=%q", event.GetKv().GetValue()))
-				cmd.Stdout, cmd.Stderr = os.Stdout, os.Stderr
-				if err := cmd.Run(); err != nil {
-					fmt.Fprintf(os.Stderr, "command %q error (%v)\n", execArgs, err)
-					os.Exit(1)
-				}
-			}
+			fmt.Fprintf(os.Stdout, "progress notify: %d\n", rev)
+		}
+		display.Watch(resp)
+	}
+
+	switch {
+	case len(h.execArgs) > 0 && watchDebounce > 0:
+		if len(resp.Events) > 0 {
+			h.pending = append(h.pending, resp.Events...)
+			h.pendingRev = handledRev(resp)
+		}
+	case len(h.execArgs) > 0:
+		for _, event := range resp.Events {
+			cmd := exec.CommandContext(h.c.Ctx(), h.execArgs[0], h.execArgs[1:]...)
+			cmd.Env = os.Environ()
+			cmd.Env = append(cmd.Env, fmt.Sprintf("ETCD_WATCH_REVISION=%d", rev))
+			cmd.Env = append(cmd.Env, fmt.Sprintf("ETCD_WATCH_EVENT_TYPE=%q", event.GetType()))
+			cmd.Env = append(cmd.Env, fmt.Sprintf("ETCD_WATCH_KEY=%q", event.GetKv().GetKey()))
+			cmd.Env = append(cmd.Env, fmt.Sprintf("ETCD_WATCH_VALUE=%q", event.GetKv().GetValue()))
+			h.run(cmd)
+		}
+	}
+	// the revision of a progress notification is only handled once the
+	// debounced events are
+	if !resp.Canceled && len(h.pending) == 0 {
+		h.saveRev(handledRev(resp))
+	}
+
+	for _, event := range resp.Events {
+		if h.exitOn(event) {
+			return true
+		}
+	}
+	return false
+}
+
+// handledRev returns the revision up to which resp was handled: the last
+// revision of its events, or the header revision of a progress notification.
+// The header revision of a response with events may be past its events when
+// the server sends a catch up in several batches.
+func handledRev(resp *clientv3.WatchResponse) int64 {
+	if resp.IsProgressNotify() {
+		return resp.Header.GetRevision()
+	}
+	var rev int64
+	for _, ev := range resp.Events {
+		if ev.Kv.ModRevision > rev {
+			rev = ev.Kv.ModRevision
+		}
+	}
+	return rev
+}
+
+// flush runs execArgs once on the pending events, passed as JSON lines on
+// its standard input.
+func (h *watchHandler) flush() {
+	if len(h.pending) == 0 {
+		return
+	}
+	var in bytes.Buffer
+	printWatchJSONLines(&in, h.pendingRev, false, h.pending)
+
+	cmd := exec.CommandContext(h.c.Ctx(), h.execArgs[0], h.execArgs[1:]...)
+	cmd.Env = os.Environ()
+	cmd.Env = append(cmd.Env, fmt.Sprintf("ETCD_WATCH_REVISION=%d", h.pendingRev))
+	cmd.Env = append(cmd.Env, fmt.Sprintf("ETCD_WATCH_EVENTS=%d", len(h.pending)))
+	cmd.Stdin = &in
+	h.run(cmd)
+
+	h.saveRev(h.pendingRev)
+	h.pending, h.pendingRev = nil, 0
+}
+
+func (h *watchHandler) run(cmd *exec.Cmd) {
+	cmd.Stdout, cmd.Stderr = os.Stdout, os.Stderr
+	if err := cmd.Run(); err != nil {
+		fmt.Fprintf(os.Stderr, "command %q error (%v)\n", h.execArgs, err)
+		os.Exit(1)
+	}
+}
+
+func (h *watchHandler) saveRev(rev int64) {
+	if watchRevFile == "" || rev == 0 {
+		return
+	}
+	if err := writeWatchRevFile(watchRevFile, rev); err != nil {
+		cobrautl.ExitWithError(cobrautl.ExitError, err)
+	}
+}
+
+type watchEventJSON struct {
+	Revision       int64  `json:"revision"`
+	ProgressNotify bool   `json:"progress_notify,omitempty"`
+	Type           string `json:"type,omitempty"`
+	Key            string `json:"key,omitempty"`
+	Value          string `json:"value,omitempty"`
+	CreateRevision int64  `json:"create_revision,omitempty"`
+	ModRevision    int64  `json:"mod_revision,omitempty"`
+	Version        int64  `json:"version,omitempty"`
+	Lease          int64  `json:"lease,omitempty"`
+	PrevValue      string `json:"prev_value,omitempty"`
+}
+
+// printWatchJSONLines prints each event as a line of JSON, or a single line
+// for a progress notification.
+func printWatchJSONLines(w io.Writer, rev int64, progressNotify bool, events []*clientv3.Event) {
+	if progressNotify {
+		printJSONTo(w, watchEventJSON{Revision: rev, ProgressNotify: true})
+		return
+	}
+	for _, ev := range events {
+		e := watchEventJSON{
+			Revision:       rev,
+			Type:           ev.Type.String(),
+			Key:            string(ev.Kv.Key),
+			Value:          string(ev.Kv.Value),
+			CreateRevision: ev.Kv.CreateRevision,
+			ModRevision:    ev.Kv.ModRevision,
+			Version:        ev.Kv.Version,
+			Lease:          ev.Kv.Lease,
 		}
+		if ev.PrevKv != nil {
+			e.PrevValue = string(ev.PrevKv.Value)
+		}
+		printJSONTo(w, e)
+	}
+}
+
+// parseWatchExitOn returns the condition of --exit-on, which never matches
+// if the flag is not set.
+func parseWatchExitOn(s string) (func(*clientv3.Event) bool, error) {
+	if s == "" {
+		return func(*clientv3.Event) bool { return false }, nil
+	}
+	key, value, put := strings.Cut(s, "=")
+	if key == "" {
+		return nil, fmt.Errorf("invalid --exit-on %q (key is empty)", s)
+	}
+	return func(ev *clientv3.Event) bool {
+		if string(ev.Kv.Key) != key {
+			return false
+		}
+		if put {
+			return ev.Type == mvccpb.Event_PUT && string(ev.Kv.Value) == value
+		}
+		return ev.Type == mvccpb.Event_DELETE
+	}, nil
+}
+
+// readWatchRevFile returns the revision recorded in path, or 0 if the file
+// does not exist.
+func readWatchRevFile(path string) (int64, error) {
+	b, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	rev, err := strconv.ParseInt(strings.TrimSpace(string(b)), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid revision in %q (%w)", path, err)
+	}
+	return rev, nil
+}
+
+// writeWatchRevFile records rev in path, replacing the file so that it is
+// never left partially written.
+func writeWatchRevFile(path string, rev int64) error {
+	f, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp")
+	if err != nil {
+		return err
+	}
+	if _, err = fmt.Fprintf(f, "%d\n", rev); err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return err
+	}
+	if err = f.Close(); err != nil {
+		os.Remove(f.Name())
+		return err
 	}
+	return os.Rename(f.Name(), path)
 }
 
 // "commandArgs" is the command arguments after "spf13/cobra" parses
@@ -15,10 +15,16 @@
 package command
 
 import (
+	"bytes"
+	"path/filepath"
 	"reflect"
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	clientv3 "go.etcd.io/etcd/client/v3"
 )
 
 func Test_parseWatchArgs(t *testing.T) {
@@ -546,3 +552,102 @@ func Test_parseWatchArgs(t *testing.T) {
 		}
 	}
 }
+
+func Test_parseWatchExitOn(t *testing.T) {
+	put := func(key, value string) *clientv3.Event {
+		return &clientv3.Event{Type: mvccpb.Event_PUT, Kv: &mvccpb.KeyValue{Key: []byte(key), Value: []byte(value)}}
+	}
+	del := func(key string) *clientv3.Event {
+		return &clientv3.Event{Type: mvccpb.Event_DELETE, Kv: &mvccpb.KeyValue{Key: []byte(key)}}
+	}
+	tt := []struct {
+		exitOn string
+		event  *clientv3.Event
+		match  bool
+	}{
+		{exitOn: "", event: put("foo", "bar"), match: false},
+		{exitOn: "foo=bar", event: put("foo", "bar"), match: true},
+		{exitOn: "foo=bar", event: put("foo", "baz"), match: false},
+		{exitOn: "foo=bar", event: put("fo", "bar"), match: false},
+		{exitOn: "foo=bar", event: del("foo"), match: false},
+		{exitOn: "foo=", event: put("foo", ""), match: true},
+		{exitOn: "foo=a=b", event: put("foo", "a=b"), match: true},
+		{exitOn: "foo", event: del("foo"), match: true},
+		{exitOn: "foo", event: put("foo", "bar"), match: false},
+	}
+	for i, ts := range tt {
+		exitOn, err := parseWatchExitOn(ts.exitOn)
+		require.NoError(t, err)
+		require.Equalf(t, ts.match, exitOn(ts.event), "#%d: --exit-on %q", i, ts.exitOn)
+	}
+
+	_, err := parseWatchExitOn("=bar")
+	require.Error(t, err)
+}
+
+func Test_printWatchJSONLines(t *testing.T) {
+	events := []*clientv3.Event{
+		{
+			Type:   mvccpb.Event_PUT,
+			Kv:     &mvccpb.KeyValue{Key: []byte("foo"), Value: []byte("bar"), CreateRevision: 2, ModRevision: 3, Version: 2},
+			PrevKv: &mvccpb.KeyValue{Key: []byte("foo"), Value: []byte("baz")},
+		},
+		{
+			Type: mvccpb.Event_DELETE,
+			Kv:   &mvccpb.KeyValue{Key: []byte("a"), ModRevision: 3},
+		},
+	}
+	var buf bytes.Buffer
+	printWatchJSONLines(&buf, 3, false, events)
+	printWatchJSONLines(&buf, 5, true, nil)
+	want := `{"revision":3,"type":"PUT","key":"foo","value":"bar","create_revision":2,"mod_revision":3,"version":2,"prev_value":"baz"}
+{"revision":3,"type":"DELETE","key":"a","mod_revision":3}
+{"revision":5,"progress_notify":true}
+`
+	require.Equal(t, want, buf.String())
+}
+
+func Test_watchRevFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "rev")
+
+	rev, err := readWatchRevFile(path)
+	require.NoError(t, err)
+	require.Equal(t, int64(0), rev)
+
+	require.NoError(t, writeWatchRevFile(path, 42))
+	require.NoError(t, writeWatchRevFile(path, 43))
+	rev, err = readWatchRevFile(path)
+	require.NoError(t, err)
+	require.Equal(t, int64(43), rev)
+
+	matches, err := filepath.Glob(path + ".tmp*")
+	require.NoError(t, err)
+	require.Empty(t, matches)
+}
+
+func Test_watchHandlerRevFile(t *testing.T) {
+	defer func(file string, jsonLines bool) { watchRevFile, watchJSONLines = file, jsonLines }(watchRevFile, watchJSONLines)
+	watchRevFile, watchJSONLines = filepath.Join(t.TempDir(), "rev"), true
+
+	h := &watchHandler{exitOn: func(*clientv3.Event) bool { return false }}
+	put := func(key string, rev int64) *clientv3.Event {
+		return &clientv3.Event{Type: mvccpb.PUT, Kv: &mvccpb.KeyValue{Key: []byte(key), ModRevision: rev}}
+	}
+
+	// a catch up split in batches carries the current revision in the
+	// header of each batch
+	h.handle(&clientv3.WatchResponse{Header: &pb.ResponseHeader{Revision: 10}, Events: []*clientv3.Event{put("a", 2), put("b", 3)}})
+	rev, err := readWatchRevFile(watchRevFile)
+	require.NoError(t, err)
+	require.Equalf(t, int64(3), rev, "resuming must not skip the events of the next batch")
+
+	h.handle(&clientv3.WatchResponse{Header: &pb.ResponseHeader{Revision: 10}, Events: []*clientv3.Event{put("c", 4), put("d", 10)}})
+	rev, err = readWatchRevFile(watchRevFile)
+	require.NoError(t, err)
+	require.Equal(t, int64(10), rev)
+
+	h.handle(&clientv3.WatchResponse{Header: &pb.ResponseHeader{Revision: 12}})
+	rev, err = readWatchRevFile(watchRevFile)
+	require.NoError(t, err)
+	require.Equal(t, int64(12), rev)
+}
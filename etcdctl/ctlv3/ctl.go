@@ -91,6 +91,8 @@ func init() {
 		command.NewTxnCommand(),
 		command.NewCompactionCommand(),
 		command.NewAlarmCommand(),
+		command.NewHoldCommand(),
+		command.NewSubscriptionCommand(),
 		command.NewDefragCommand(),
 		command.NewEndpointCommand(),
 		command.NewMoveLeaderCommand(),
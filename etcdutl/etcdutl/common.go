@@ -88,8 +88,16 @@ func (sl *SimpleLessor) Grant(id lease.LeaseID, ttl int64) (*lease.Lease, error)
 	return nil, nil
 }
 
+func (sl *SimpleLessor) GrantChild(id, parent lease.LeaseID, ttl int64) (*lease.Lease, error) {
+	return sl.Grant(id, ttl)
+}
+
 func (sl *SimpleLessor) Revoke(id lease.LeaseID) error { return nil }
 
+func (sl *SimpleLessor) UpdateTTL(id lease.LeaseID, ttl int64) (*lease.Lease, error) {
+	return nil, nil
+}
+
 func (sl *SimpleLessor) Checkpoint(id lease.LeaseID, remainingTTL int64) error { return nil }
 
 func (sl *SimpleLessor) Attach(id lease.LeaseID, items []lease.LeaseItem) error { return nil }
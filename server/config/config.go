@@ -118,6 +118,11 @@ type ServerConfig struct {
 
 	AutoCompactionRetention time.Duration
 	AutoCompactionMode      string
+	// AutoCompactionPrefixRetention maps key prefixes to the retention of
+	// their history, overriding AutoCompactionRetention for the keys under
+	// them.
+	AutoCompactionPrefixRetention map[string]time.Duration
+
 	CompactionBatchLimit    int
 	CompactionSleepInterval time.Duration
 	QuotaBackendBytes       int64
@@ -161,6 +166,9 @@ type ServerConfig struct {
 
 	// LeaseCheckpointInterval time.Duration is the wait duration between lease checkpoints.
 	LeaseCheckpointInterval time.Duration
+	// LeasePromoteJitter is the window the expiries of the leases are spread
+	// over when the member becomes leader. 0 disables the jitter.
+	LeasePromoteJitter time.Duration
 
 	EnableGRPCGateway bool
 
@@ -171,6 +179,24 @@ type ServerConfig struct {
 
 	WatchProgressNotifyInterval time.Duration
 
+	// SubscriptionMaxLag is the default number of revisions a watch
+	// subscription may fall behind while still holding back compaction.
+	SubscriptionMaxLag int64
+
+	// WatchMaxWatchersPerStream limits the number of watchers on a watch
+	// stream. 0 means unlimited.
+	WatchMaxWatchersPerStream int
+	// WatchMaxWatchersPerUser limits the number of watchers an authenticated
+	// user has across all watch streams. 0 means unlimited.
+	WatchMaxWatchersPerUser int
+	// WatchMaxEventsPerSecond limits the rate of events sent on a watch
+	// stream. A watcher whose events would wait more than 5s for the limit
+	// is canceled. 0 means unlimited.
+	WatchMaxEventsPerSecond int
+	// WatchMetricsPerUser enables the metrics of watchers, sent events and
+	// watch limits per user.
+	WatchMetricsPerUser bool
+
 	// UnsafeNoFsync disables all uses of fsync.
 	// Setting this is unsafe and will cause data loss.
 	UnsafeNoFsync bool `json:"unsafe-no-fsync"`
@@ -275,6 +275,12 @@ type Config struct {
 	// If no time unit is provided and compaction mode is 'periodic',
 	// the unit defaults to hour. For example, '5' translates into 5-hour.
 	AutoCompactionRetention string `json:"auto-compaction-retention"`
+	// AutoCompactionPrefixRetention is a comma separated list of prefix=retention
+	// pairs (e.g. '/events/=1h,/config/=24h') overriding the retention for the
+	// keys under each prefix. The retention is interpreted like
+	// AutoCompactionRetention. Keys under several prefixes follow the
+	// longest one.
+	AutoCompactionPrefixRetention string `json:"auto-compaction-prefix-retention"`
 
 	// GRPCKeepAliveMinTime is the minimum interval that a client should
 	// wait before pinging server. When client pings "too fast", server
@@ -366,6 +372,23 @@ type Config struct {
 	CompactionSleepInterval time.Duration `json:"compaction-sleep-interval"`
 	// WatchProgressNotifyInterval is the time duration of periodic watch progress notifications.
 	WatchProgressNotifyInterval time.Duration `json:"watch-progress-notify-interval"`
+	// SubscriptionMaxLag is the default number of revisions a watch subscription may fall
+	// behind while it still holds back compaction.
+	SubscriptionMaxLag int64 `json:"subscription-max-lag"`
+	// WatchMaxWatchersPerStream limits the number of watchers on a watch stream, 0 means unlimited.
+	WatchMaxWatchersPerStream int `json:"watch-max-watchers-per-stream"`
+	// WatchMaxWatchersPerUser limits the number of watchers an authenticated user has across all
+	// watch streams, 0 means unlimited.
+	WatchMaxWatchersPerUser int `json:"watch-max-watchers-per-user"`
+	// WatchMaxEventsPerSecond limits the rate of events sent on a watch stream, 0 means unlimited.
+	// A watcher whose events would wait more than 5s for the limit is canceled.
+	WatchMaxEventsPerSecond int `json:"watch-max-events-per-second"`
+	// WatchMetricsPerUser enables the metrics of watchers, sent events and watch limits per user.
+	// Their user label has a value per user of the auth store that watches.
+	WatchMetricsPerUser bool `json:"watch-metrics-per-user"`
+	// LeasePromoteJitter is the window the expiries of the leases are spread over when
+	// the member becomes leader, so that they do not expire in a burst. 0 disables the jitter.
+	LeasePromoteJitter time.Duration `json:"lease-promote-jitter"`
 	// WarningApplyDuration is the time duration after which a warning is generated if applying request
 	WarningApplyDuration time.Duration `json:"warning-apply-duration"`
 	// BootstrapDefragThresholdMegabytes is the minimum number of megabytes needed to be freed for etcd server to
@@ -562,6 +585,8 @@ func NewConfig() *Config {
 
 		CompactHashCheckTime: DefaultCompactHashCheckTime,
 
+		SubscriptionMaxLag: etcdserver.DefaultSubscriptionMaxLag,
+
 		DiscoveryCfg: v3discovery.DiscoveryConfig{
 			ConfigSpec: clientv3.ConfigSpec{
 				DialTimeout:      DefaultDiscoveryDialTimeout,
@@ -712,10 +737,11 @@ func (cfg *Config) AddFlags(fs *flag.FlagSet) {
 	fs.StringVar(&cfg.LogRotationConfigJSON, "log-rotation-config-json", DefaultLogRotationConfig, "Configures log rotation if enabled with a JSON logger config. Default: MaxSize=100(MB), MaxAge=0(days,no limit), MaxBackups=0(no limit), LocalTime=false(UTC), Compress=false(gzip)")
 
 	fs.StringVar(&cfg.AutoCompactionRetention, "auto-compaction-retention", "0", "Auto compaction retention for mvcc key value store. 0 means disable auto compaction.")
+	fs.StringVar(&cfg.AutoCompactionPrefixRetention, "auto-compaction-prefix-retention", "", "Comma separated list of prefix=retention pairs overriding 'auto-compaction-retention' for the keys under each prefix (e.g. '/events/=1h,/config/=24h').")
 	fs.StringVar(&cfg.AutoCompactionMode, "auto-compaction-mode", "periodic", "interpret 'auto-compaction-retention' one of: periodic|revision. 'periodic' for duration based retention, defaulting to hours if no time unit is provided (e.g. '5m'). 'revision' for revision number based retention.")
 
 	// pprof profiler via HTTP
-	fs.BoolVar(&cfg.EnablePprof, "enable-pprof", false, "Enable runtime profiling data via HTTP server. Address is at client URL + \"/debug/pprof/\"")
+	fs.BoolVar(&cfg.EnablePprof, "enable-pprof", false, "Enable runtime profiling data via HTTP server. Address is at client URL + \"/debug/pprof/\". The watchers of the member are listed at client URL + \"/debug/watchers\", for admins only if auth is enabled.")
 
 	// additional metrics
 	fs.StringVar(&cfg.Metrics, "metrics", cfg.Metrics, "Set level of detail for exported metrics, specify 'extensive' to include server side grpc histogram metrics and the full Go runtime metrics set")
@@ -739,6 +765,12 @@ func (cfg *Config) AddFlags(fs *flag.FlagSet) {
 	fs.IntVar(&cfg.CompactionBatchLimit, "compaction-batch-limit", cfg.CompactionBatchLimit, "Sets the maximum revisions deleted in each compaction batch.")
 	fs.DurationVar(&cfg.CompactionSleepInterval, "compaction-sleep-interval", cfg.CompactionSleepInterval, "Sets the sleep interval between each compaction batch.")
 	fs.DurationVar(&cfg.WatchProgressNotifyInterval, "watch-progress-notify-interval", cfg.WatchProgressNotifyInterval, "Duration of periodic watch progress notifications.")
+	fs.IntVar(&cfg.WatchMaxWatchersPerStream, "watch-max-watchers-per-stream", cfg.WatchMaxWatchersPerStream, "Maximum number of watchers on a watch stream. 0 means unlimited.")
+	fs.IntVar(&cfg.WatchMaxWatchersPerUser, "watch-max-watchers-per-user", cfg.WatchMaxWatchersPerUser, "Maximum number of watchers an authenticated user has across all watch streams. 0 means unlimited.")
+	fs.IntVar(&cfg.WatchMaxEventsPerSecond, "watch-max-events-per-second", cfg.WatchMaxEventsPerSecond, "Maximum rate of events sent on a watch stream. A watcher whose events would wait more than 5s for it is canceled. 0 means unlimited.")
+	fs.BoolVar(&cfg.WatchMetricsPerUser, "watch-metrics-per-user", cfg.WatchMetricsPerUser, "Enable the metrics of watchers, sent events and watch limits per user.")
+	fs.Int64Var(&cfg.SubscriptionMaxLag, "subscription-max-lag", cfg.SubscriptionMaxLag, "Default number of revisions a watch subscription may fall behind while it still holds back compaction.")
+	fs.DurationVar(&cfg.LeasePromoteJitter, "lease-promote-jitter", cfg.LeasePromoteJitter, "Window the expiries of the leases are spread over when the member becomes leader. 0 disables the jitter.")
 	fs.DurationVar(&cfg.DowngradeCheckTime, "downgrade-check-time", cfg.DowngradeCheckTime, "Duration of time between two downgrade status checks.")
 	fs.DurationVar(&cfg.WarningApplyDuration, "warning-apply-duration", cfg.WarningApplyDuration, "Time duration after which a warning is generated if watch progress takes more time.")
 	fs.DurationVar(&cfg.WarningUnaryRequestDuration, "warning-unary-request-duration", cfg.WarningUnaryRequestDuration, "Time duration after which a warning is generated if a unary request takes more time.")
@@ -1011,14 +1043,16 @@ func (cfg *Config) Validate() error {
 		}
 	}
 
-	if !cfg.ServerFeatureGate.Enabled(features.LeaseCheckpointPersist) && cfg.ServerFeatureGate.Enabled(features.LeaseCheckpoint) {
-		cfg.logger.Warn("Detected that checkpointing is enabled without persistence. Consider enabling feature gate LeaseCheckpointPersist")
-	}
-
+	// Checkpoints are persisted regardless of LeaseCheckpointPersist once the cluster
+	// version is 3.6 or later, so checkpointing without it is not warned about.
 	if cfg.ServerFeatureGate.Enabled(features.LeaseCheckpointPersist) && !cfg.ServerFeatureGate.Enabled(features.LeaseCheckpoint) {
 		return fmt.Errorf("enabling feature gate LeaseCheckpointPersist requires enabling feature gate LeaseCheckpoint")
 	}
 
+	if cfg.LeasePromoteJitter < 0 {
+		return fmt.Errorf("--lease-promote-jitter must be >=0 (set to %v)", cfg.LeasePromoteJitter)
+	}
+
 	if cfg.CompactHashCheckTime <= 0 {
 		return fmt.Errorf("--compact-hash-check-time must be >0 (set to %v)", cfg.CompactHashCheckTime)
 	}
@@ -109,7 +109,7 @@ func TestConfigFileFeatureGates(t *testing.T) {
 				features.StopGRPCServiceOnDefrag:      false,
 				features.InitialCorruptCheck:          false,
 				features.TxnModeWriteWithSharedBuffer: true,
-				features.LeaseCheckpoint:              false,
+				features.LeaseCheckpoint:              true,
 				features.LeaseCheckpointPersist:       false,
 				features.FastLeaseKeepAlive:           true,
 			},
@@ -120,6 +120,7 @@ func TestConfigFileFeatureGates(t *testing.T) {
 			expectedFeatures: map[featuregate.Feature]bool{
 				features.StopGRPCServiceOnDefrag:      true,
 				features.TxnModeWriteWithSharedBuffer: true,
+				features.LeaseCheckpoint:              true,
 				features.FastLeaseKeepAlive:           true,
 			},
 		},
@@ -129,6 +130,7 @@ func TestConfigFileFeatureGates(t *testing.T) {
 			expectedFeatures: map[featuregate.Feature]bool{
 				features.InitialCorruptCheck:          true,
 				features.TxnModeWriteWithSharedBuffer: true,
+				features.LeaseCheckpoint:              true,
 				features.FastLeaseKeepAlive:           true,
 			},
 		},
@@ -138,6 +140,7 @@ func TestConfigFileFeatureGates(t *testing.T) {
 			expectedFeatures: map[featuregate.Feature]bool{
 				features.StopGRPCServiceOnDefrag:      false,
 				features.TxnModeWriteWithSharedBuffer: true,
+				features.LeaseCheckpoint:              true,
 				features.FastLeaseKeepAlive:           true,
 			},
 		},
@@ -146,6 +149,7 @@ func TestConfigFileFeatureGates(t *testing.T) {
 			serverFeatureGatesJSON: "TxnModeWriteWithSharedBuffer=true",
 			expectedFeatures: map[featuregate.Feature]bool{
 				features.TxnModeWriteWithSharedBuffer: true,
+				features.LeaseCheckpoint:              true,
 				features.FastLeaseKeepAlive:           true,
 			},
 		},
@@ -154,6 +158,7 @@ func TestConfigFileFeatureGates(t *testing.T) {
 			serverFeatureGatesJSON: "TxnModeWriteWithSharedBuffer=false",
 			expectedFeatures: map[featuregate.Feature]bool{
 				features.TxnModeWriteWithSharedBuffer: false,
+				features.LeaseCheckpoint:              true,
 				features.FastLeaseKeepAlive:           true,
 			},
 		},
@@ -163,6 +168,7 @@ func TestConfigFileFeatureGates(t *testing.T) {
 			expectedFeatures: map[featuregate.Feature]bool{
 				features.CompactHashCheck:             true,
 				features.TxnModeWriteWithSharedBuffer: true,
+				features.LeaseCheckpoint:              true,
 				features.FastLeaseKeepAlive:           true,
 			},
 		},
@@ -181,6 +187,7 @@ func TestConfigFileFeatureGates(t *testing.T) {
 			serverFeatureGatesJSON: "FastLeaseKeepAlive=false",
 			expectedFeatures: map[featuregate.Feature]bool{
 				features.TxnModeWriteWithSharedBuffer: true,
+				features.LeaseCheckpoint:              true,
 				features.FastLeaseKeepAlive:           false,
 			},
 		},
@@ -608,9 +615,13 @@ func TestLeaseCheckpointValidate(t *testing.T) {
 		},
 		{
 			name:               "Enabling checkpoint leases persist without checkpointing itself should fail",
-			serverFeatureGates: "LeaseCheckpointPersist=true",
+			serverFeatureGates: "LeaseCheckpointPersist=true,LeaseCheckpoint=false",
 			expectError:        true,
 		},
+		{
+			name:               "Disabling checkpoint leases should pass",
+			serverFeatureGates: "LeaseCheckpoint=false",
+		},
 	}
 	for _, tc := range tcs {
 		t.Run(tc.name, func(t *testing.T) {
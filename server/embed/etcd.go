@@ -180,6 +180,10 @@ func StartEtcd(inCfg *Config) (e *Etcd, err error) {
 	if err != nil {
 		return e, err
 	}
+	autoCompactionPrefixRetention, err := parsePrefixCompactionRetention(cfg.AutoCompactionMode, cfg.AutoCompactionPrefixRetention)
+	if err != nil {
+		return e, err
+	}
 
 	backendFreelistType := parseBackendFreelistType(cfg.BackendFreelistType)
 
@@ -202,6 +206,7 @@ func StartEtcd(inCfg *Config) (e *Etcd, err error) {
 		InitialElectionTickAdvance:        cfg.InitialElectionTickAdvance,
 		AutoCompactionRetention:           autoCompactionRetention,
 		AutoCompactionMode:                cfg.AutoCompactionMode,
+		AutoCompactionPrefixRetention:     autoCompactionPrefixRetention,
 		QuotaBackendBytes:                 cfg.QuotaBackendBytes,
 		BackendBatchLimit:                 cfg.BackendBatchLimit,
 		BackendFreelistType:               backendFreelistType,
@@ -228,6 +233,12 @@ func StartEtcd(inCfg *Config) (e *Etcd, err error) {
 		CompactionBatchLimit:              cfg.CompactionBatchLimit,
 		CompactionSleepInterval:           cfg.CompactionSleepInterval,
 		WatchProgressNotifyInterval:       cfg.WatchProgressNotifyInterval,
+		SubscriptionMaxLag:                cfg.SubscriptionMaxLag,
+		LeasePromoteJitter:                cfg.LeasePromoteJitter,
+		WatchMaxWatchersPerStream:         cfg.WatchMaxWatchersPerStream,
+		WatchMaxWatchersPerUser:           cfg.WatchMaxWatchersPerUser,
+		WatchMaxEventsPerSecond:           cfg.WatchMaxEventsPerSecond,
+		WatchMetricsPerUser:               cfg.WatchMetricsPerUser,
 		DowngradeCheckTime:                cfg.DowngradeCheckTime,
 		WarningApplyDuration:              cfg.WarningApplyDuration,
 		WarningUnaryRequestDuration:       cfg.WarningUnaryRequestDuration,
@@ -365,6 +376,7 @@ func print(lg *zap.Logger, ec Config, sc config.ServerConfig, memberInitialized
 		zap.String("auto-compaction-mode", sc.AutoCompactionMode),
 		zap.Duration("auto-compaction-retention", sc.AutoCompactionRetention),
 		zap.String("auto-compaction-interval", sc.AutoCompactionRetention.String()),
+		zap.Any("auto-compaction-prefix-retention", sc.AutoCompactionPrefixRetention),
 
 		zap.String("discovery-token", sc.DiscoveryCfg.Token),
 		zap.String("discovery-endpoints", strings.Join(sc.DiscoveryCfg.Endpoints, ",")),
@@ -771,6 +783,10 @@ func (e *Etcd) serveClients() {
 	etcdhttp.HandleVersion(mux, e.Server)
 	etcdhttp.HandleMetrics(mux)
 	etcdhttp.HandleHealth(e.cfg.logger, mux, e.Server)
+	if e.cfg.EnablePprof || e.cfg.LogLevel == "debug" {
+		// like the profiling data, the watchers are only served for debugging
+		etcdhttp.HandleWatchers(mux, e.Server.KV(), e.Server.AuthStore())
+	}
 
 	var gopts []grpc.ServerOption
 	if e.cfg.GRPCKeepAliveMinTime > time.Duration(0) {
@@ -945,3 +961,28 @@ func parseCompactionRetention(mode, retention string) (ret time.Duration, err er
 	}
 	return ret, nil
 }
+
+// parsePrefixCompactionRetention parses a comma separated list of
+// prefix=retention pairs.
+func parsePrefixCompactionRetention(mode, retentions string) (map[string]time.Duration, error) {
+	if retentions == "" {
+		return nil, nil
+	}
+	ret := make(map[string]time.Duration)
+	for _, pair := range strings.Split(retentions, ",") {
+		i := strings.LastIndex(pair, "=")
+		if i <= 0 {
+			return nil, fmt.Errorf("invalid prefix compaction retention %q, expected prefix=retention", pair)
+		}
+		prefix := pair[:i]
+		if _, ok := ret[prefix]; ok {
+			return nil, fmt.Errorf("duplicate prefix compaction retention for %q", prefix)
+		}
+		d, err := parseCompactionRetention(mode, pair[i+1:])
+		if err != nil {
+			return nil, err
+		}
+		ret[prefix] = d
+	}
+	return ret, nil
+}
@@ -166,6 +166,8 @@ Clustering:
     Enable the raft Pre-Vote algorithm to prevent disruption when a node that has been partitioned away rejoins the cluster.
   --auto-compaction-retention '0'
     Auto compaction retention length. 0 means disable auto compaction.
+  --auto-compaction-prefix-retention ''
+    Comma separated list of prefix=retention pairs overriding 'auto-compaction-retention' for the keys under each prefix (e.g. '/events/=1h,/config/=24h').
   --auto-compaction-mode 'periodic'
     Interpret 'auto-compaction-retention' one of: periodic|revision. 'periodic' for duration based retention, defaulting to hours if no time unit is provided (e.g. '5m'). 'revision' for revision number based retention.
 
@@ -232,6 +234,7 @@ Auth:
 Profiling and Monitoring:
   --enable-pprof 'false'
     Enable runtime profiling data via HTTP server. Address is at client URL + "/debug/pprof/"
+    The watchers of the member are listed at client URL + "/debug/watchers", for admins only if auth is enabled.
   --metrics 'basic'
     Set level of detail for exported metrics, specify 'extensive' to include server side grpc histogram metrics and the full Go runtime metrics set.
   --listen-metrics-urls ''
@@ -276,6 +279,18 @@ Features:
     Skip verification of SAN field in client certificate for peer connections.
   --watch-progress-notify-interval '10m'
     Duration of periodical watch progress notification.
+  --watch-max-watchers-per-stream '0'
+    Maximum number of watchers on a watch stream. 0 means unlimited.
+  --watch-max-watchers-per-user '0'
+    Maximum number of watchers an authenticated user has across all watch streams. 0 means unlimited.
+  --watch-max-events-per-second '0'
+    Maximum rate of events sent on a watch stream. A watcher whose events would wait more than 5s for it is canceled. 0 means unlimited.
+  --watch-metrics-per-user 'false'
+    Enable the metrics of watchers, sent events and watch limits per user.
+  --subscription-max-lag '100000'
+    Default number of revisions a watch subscription may fall behind while it still holds back compaction.
+  --lease-promote-jitter '0s'
+    Window the expiries of the leases are spread over when the member becomes leader. 0 disables the jitter.
   --warning-apply-duration '100ms'
     Warning is generated if requests take more than this duration.
   --bootstrap-defrag-threshold-megabytes
@@ -0,0 +1,116 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcdhttp
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"google.golang.org/grpc/metadata"
+
+	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
+	"go.etcd.io/etcd/server/v3/auth"
+	"go.etcd.io/etcd/server/v3/storage/mvcc"
+)
+
+const (
+	watchersPath = "/debug/watchers"
+)
+
+type watchers struct {
+	Revision int64     `json:"revision"`
+	Watchers []watcher `json:"watchers"`
+}
+
+type watcher struct {
+	WatchID        int64      `json:"watch_id"`
+	Ranges         []keyRange `json:"ranges"`
+	StartRevision  int64      `json:"start_revision"`
+	Revision       int64      `json:"revision"`
+	Synced         bool       `json:"synced"`
+	PendingEvents  int        `json:"pending_events"`
+	Victim         bool       `json:"victim"`
+	VictimDuration string     `json:"victim_duration"`
+	User           string     `json:"user,omitempty"`
+	RemoteAddress  string     `json:"remote_address,omitempty"`
+}
+
+type keyRange struct {
+	Key      string `json:"key"`
+	RangeEnd string `json:"range_end,omitempty"`
+}
+
+// HandleWatchers registers the handler listing the watchers of kv, with how
+// far each of them caught up with the store. The watchers show the keys and
+// users of all clients, so when auth is enabled the request must carry the
+// token of an admin in the Authorization header, like the Watchers RPC.
+func HandleWatchers(mux *http.ServeMux, kv mvcc.WatchableKV, as auth.AuthStore) {
+	mux.HandleFunc(watchersPath, func(w http.ResponseWriter, r *http.Request) {
+		if !allowMethod(w, r, "GET") {
+			return
+		}
+		if err := isAdminPermitted(r, as); err != nil {
+			http.Error(w, err.Error(), http.StatusForbidden)
+			return
+		}
+		serveWatchers(w, kv.Rev(), kv.Watchers())
+	})
+}
+
+func isAdminPermitted(r *http.Request, as auth.AuthStore) error {
+	ctx := r.Context()
+	if token := r.Header.Get("Authorization"); token != "" {
+		ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(rpctypes.TokenFieldNameGRPC, token))
+	}
+	authInfo, err := as.AuthInfoFromCtx(ctx)
+	if err != nil {
+		return err
+	}
+	return as.IsAdminPermitted(authInfo)
+}
+
+func serveWatchers(w http.ResponseWriter, rev int64, infos []mvcc.WatcherInfo) {
+	ws := watchers{Revision: rev, Watchers: make([]watcher, 0, len(infos))}
+	for _, info := range infos {
+		wa := watcher{
+			WatchID:        int64(info.ID),
+			StartRevision:  info.StartRev,
+			Revision:       info.Rev,
+			Synced:         info.Synced,
+			PendingEvents:  info.PendingEvents,
+			Victim:         info.Victim,
+			VictimDuration: info.VictimTime.String(),
+			User:           info.User,
+			RemoteAddress:  info.RemoteAddr,
+		}
+		for _, r := range info.Ranges {
+			kr := keyRange{Key: string(r.Key), RangeEnd: string(r.End)}
+			if r.End != nil && len(r.End) == 0 {
+				// all keys from the key on, like "\x00" in watch requests
+				kr.RangeEnd = "\x00"
+			}
+			wa.Ranges = append(wa.Ranges, kr)
+		}
+		ws.Watchers = append(ws.Watchers, wa)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	b, err := json.Marshal(&ws)
+	if err != nil {
+		panic(fmt.Sprintf("cannot marshal watchers to json (%v)", err))
+	}
+	w.Write(b)
+}
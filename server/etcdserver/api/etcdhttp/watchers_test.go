@@ -0,0 +1,89 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcdhttp
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/metadata"
+
+	"go.etcd.io/etcd/api/v3/authpb"
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
+	"go.etcd.io/etcd/server/v3/auth"
+	"go.etcd.io/etcd/server/v3/lease"
+	betesting "go.etcd.io/etcd/server/v3/storage/backend/testing"
+	"go.etcd.io/etcd/server/v3/storage/mvcc"
+	"go.etcd.io/etcd/server/v3/storage/schema"
+)
+
+func TestWatchersAuth(t *testing.T) {
+	lg := zaptest.NewLogger(t)
+	be, _ := betesting.NewDefaultTmpBackend(t)
+	defer betesting.Close(t, be)
+	kv := mvcc.New(lg, be, &lease.FakeLessor{}, mvcc.StoreConfig{})
+	defer kv.Close()
+
+	indexWaiter := func(uint64) <-chan struct{} {
+		ch := make(chan struct{})
+		close(ch)
+		return ch
+	}
+	tp, err := auth.NewTokenProvider(lg, "simple", indexWaiter, time.Minute)
+	require.NoError(t, err)
+	as := auth.NewAuthStore(lg, schema.NewAuthBackend(lg, be), tp, bcrypt.MinCost)
+	defer as.Close()
+
+	mux := http.NewServeMux()
+	HandleWatchers(mux, kv, as)
+	get := func(token string) int {
+		req := httptest.NewRequest(http.MethodGet, watchersPath, nil)
+		if token != "" {
+			req.Header.Set("Authorization", token)
+		}
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, req)
+		return rec.Code
+	}
+	require.Equal(t, http.StatusOK, get(""))
+
+	for _, name := range []string{"root", "foo"} {
+		_, err = as.UserAdd(&pb.AuthUserAddRequest{Name: name, Options: &authpb.UserAddOptions{}})
+		require.NoError(t, err)
+	}
+	_, err = as.RoleAdd(&pb.AuthRoleAddRequest{Name: "root"})
+	require.NoError(t, err)
+	_, err = as.UserGrantRole(&pb.AuthUserGrantRoleRequest{User: "root", Role: "root"})
+	require.NoError(t, err)
+	require.NoError(t, as.AuthEnable())
+
+	md, _ := metadata.FromIncomingContext(as.WithRoot(t.Context()))
+	rootToken := md[rpctypes.TokenFieldNameGRPC][0]
+	ctx := context.WithValue(context.WithValue(t.Context(), auth.AuthenticateParamIndex{}, uint64(1)), auth.AuthenticateParamSimpleTokenPrefix{}, "foo")
+	resp, err := as.Authenticate(ctx, "foo", "")
+	require.NoError(t, err)
+
+	require.Equal(t, http.StatusForbidden, get(""))
+	require.Equal(t, http.StatusForbidden, get("invalid"))
+	require.Equal(t, http.StatusForbidden, get(resp.Token))
+	require.Equal(t, http.StatusOK, get(rootToken))
+}
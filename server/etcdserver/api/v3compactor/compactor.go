@@ -31,6 +31,8 @@ const (
 )
 
 // Compactor purges old log from the storage periodically.
+// Compactions are applied like any other compaction request, so they never
+// remove revisions protected by a revision hold.
 type Compactor interface {
 	// Run starts the main loop of the compactor in background.
 	// Use Stop() to halt the loop and release the resource.
@@ -51,17 +53,23 @@ type RevGetter interface {
 	Rev() int64
 }
 
-// New returns a new Compactor based on given "mode".
+// New returns a new Compactor based on given "mode". If prefixRetention is
+// not empty, the keys under each of its prefixes are compacted with the
+// retention of the prefix instead.
 func New(
 	lg *zap.Logger,
 	mode string,
 	retention time.Duration,
+	prefixRetention map[string]time.Duration,
 	rg RevGetter,
 	c Compactable,
 ) (Compactor, error) {
 	if lg == nil {
 		lg = zap.NewNop()
 	}
+	if len(prefixRetention) != 0 {
+		return newPrefix(lg, clockwork.NewRealClock(), mode, retention, prefixRetention, rg, c)
+	}
 	switch mode {
 	case ModePeriodic:
 		return newPeriodic(lg, clockwork.NewRealClock(), retention, rg, c), nil
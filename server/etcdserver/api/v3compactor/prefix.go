@@ -0,0 +1,175 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v3compactor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	"go.uber.org/zap"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	errorspkg "go.etcd.io/etcd/server/v3/etcdserver/errors"
+	"go.etcd.io/etcd/server/v3/storage/mvcc"
+)
+
+// Prefix compacts the keys under each configured prefix with the retention
+// of the prefix, and all other keys with the default retention. It runs a
+// compactor of the configured mode per retention. Every compaction lists the
+// revisions of all prefixes, so the keys under a prefix keep their own
+// history whichever compactor triggers it.
+type Prefix struct {
+	lg         *zap.Logger
+	c          Compactable
+	compactors []Compactor
+
+	// mu serializes compactions and protects revs
+	mu sync.Mutex
+	// revs maps the prefixes to the revision their keys were last compacted
+	// up to. The empty prefix stands for all other keys.
+	revs map[string]int64
+}
+
+// CompactRevGetter returns the revision the keys not under any compacted
+// prefix were last compacted up to, and the prefixes compacted past it.
+type CompactRevGetter interface {
+	CompactRevisions() (int64, []mvcc.PrefixCompaction)
+}
+
+// newPrefix creates a new instance of Prefix compactor that purges the log of
+// the keys under each prefix older than its retention, and of all other keys
+// older than the given retention. A retention of 0 disables compacting the
+// keys it applies to. If rg is a CompactRevGetter, the prefixes start from the
+// revisions the store was last compacted to, so that a restarted compactor
+// does not propose them from revision 0.
+func newPrefix(lg *zap.Logger, clock clockwork.Clock, mode string, retention time.Duration, prefixRetention map[string]time.Duration, rg RevGetter, c Compactable) (*Prefix, error) {
+	pc := &Prefix{
+		lg:   lg,
+		c:    c,
+		revs: make(map[string]int64),
+	}
+	retentions := map[string]time.Duration{"": retention}
+	maps.Copy(retentions, prefixRetention)
+	var (
+		compactRev      int64
+		compactPrefixes []mvcc.PrefixCompaction
+	)
+	if crg, ok := rg.(CompactRevGetter); ok {
+		compactRev, compactPrefixes = crg.CompactRevisions()
+		compactRev = max(compactRev, 0)
+	}
+	for _, prefix := range slices.Sorted(maps.Keys(retentions)) {
+		pc.revs[prefix] = compactRev
+		for _, p := range compactPrefixes {
+			if string(p.Prefix) == prefix {
+				pc.revs[prefix] = p.Revision
+			}
+		}
+		ret := retentions[prefix]
+		if ret == 0 {
+			continue
+		}
+		plg := lg
+		if prefix != "" {
+			plg = lg.With(zap.String("prefix", prefix))
+		}
+		pcc := &prefixCompactable{pc: pc, prefix: prefix}
+		switch mode {
+		case ModePeriodic:
+			pc.compactors = append(pc.compactors, newPeriodic(plg, clock, ret, rg, pcc))
+		case ModeRevision:
+			pc.compactors = append(pc.compactors, newRevision(plg, clock, int64(ret), rg, pcc))
+		default:
+			return nil, fmt.Errorf("unsupported compaction mode %s", mode)
+		}
+	}
+	return pc, nil
+}
+
+// compact compacts the keys under prefix up to rev, and all other keys up to
+// the revision they were last compacted to.
+func (pc *Prefix) compact(ctx context.Context, prefix string, rev int64) (*pb.CompactionResponse, error) {
+	pc.mu.Lock()
+	defer pc.mu.Unlock()
+
+	revs := maps.Clone(pc.revs)
+	revs[prefix] = rev
+	r := &pb.CompactionRequest{Revision: slices.Min(slices.Collect(maps.Values(revs)))}
+	for _, p := range slices.Sorted(maps.Keys(revs)) {
+		r.Prefixes = append(r.Prefixes, &pb.PrefixCompaction{Prefix: []byte(p), Revision: revs[p]})
+	}
+	resp, err := pc.c.Compact(ctx, r)
+	if errors.Is(err, errorspkg.ErrNotCapable) {
+		// members before 3.8 ignore the prefixes, so until the cluster is
+		// upgraded all keys are compacted up to the lowest revision of all
+		// prefixes instead; the revisions of the prefixes are kept so that
+		// the lowest of them advances
+		pc.revs = revs
+		if r.Revision <= 0 {
+			return resp, err
+		}
+		pc.lg.Debug("compacting all keys up to the lowest revision of all prefixes", zap.Int64("revision", r.Revision))
+		return pc.c.Compact(ctx, &pb.CompactionRequest{Revision: r.Revision})
+	}
+	if err == nil || errors.Is(err, mvcc.ErrCompacted) {
+		pc.revs = revs
+	}
+	return resp, err
+}
+
+// Run runs the compactors of all retentions.
+func (pc *Prefix) Run() {
+	for _, c := range pc.compactors {
+		c.Run()
+	}
+}
+
+// Stop stops the compactors of all retentions.
+func (pc *Prefix) Stop() {
+	for _, c := range pc.compactors {
+		c.Stop()
+	}
+}
+
+// Pause pauses the compactors of all retentions.
+func (pc *Prefix) Pause() {
+	for _, c := range pc.compactors {
+		c.Pause()
+	}
+}
+
+// Resume resumes the compactors of all retentions.
+func (pc *Prefix) Resume() {
+	for _, c := range pc.compactors {
+		c.Resume()
+	}
+}
+
+// prefixCompactable compacts the keys under a prefix as part of a compaction
+// of all prefixes.
+type prefixCompactable struct {
+	pc     *Prefix
+	prefix string
+}
+
+func (pcc *prefixCompactable) Compact(ctx context.Context, r *pb.CompactionRequest) (*pb.CompactionResponse, error) {
+	return pcc.pc.compact(ctx, pcc.prefix, r.Revision)
+}
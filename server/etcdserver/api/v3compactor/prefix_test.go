@@ -0,0 +1,122 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v3compactor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/client/pkg/v3/testutil"
+	"go.etcd.io/etcd/server/v3/etcdserver/errors"
+	"go.etcd.io/etcd/server/v3/storage/mvcc"
+)
+
+func TestPrefixCompact(t *testing.T) {
+	fc := &fakeCompactable{&testutil.RecorderBuffered{}}
+	pc, err := newPrefix(zaptest.NewLogger(t), clockwork.NewFakeClock(), ModeRevision, 10, map[string]time.Duration{"a/": 100, "b/": 0}, &fakeRevGetter{}, fc)
+	require.NoError(t, err)
+	// the keys under b/ are never compacted
+	assert.Len(t, pc.compactors, 2)
+
+	_, err = pc.compact(t.Context(), "", 30)
+	require.NoError(t, err)
+	_, err = pc.compact(t.Context(), "a/", 20)
+	require.NoError(t, err)
+
+	acts := fc.Action()
+	require.Len(t, acts, 2)
+	wreqs := []*pb.CompactionRequest{
+		{Revision: 0, Prefixes: []*pb.PrefixCompaction{
+			{Prefix: []byte(""), Revision: 30},
+			{Prefix: []byte("a/"), Revision: 0},
+			{Prefix: []byte("b/"), Revision: 0},
+		}},
+		{Revision: 0, Prefixes: []*pb.PrefixCompaction{
+			{Prefix: []byte(""), Revision: 30},
+			{Prefix: []byte("a/"), Revision: 20},
+			{Prefix: []byte("b/"), Revision: 0},
+		}},
+	}
+	for i, act := range acts {
+		assert.Equal(t, wreqs[i], act.Params[0])
+	}
+}
+
+type fakeCompactRevGetter struct {
+	fakeRevGetter
+	compactRev      int64
+	compactPrefixes []mvcc.PrefixCompaction
+}
+
+func (fr *fakeCompactRevGetter) CompactRevisions() (int64, []mvcc.PrefixCompaction) {
+	return fr.compactRev, fr.compactPrefixes
+}
+
+func TestPrefixCompactRestart(t *testing.T) {
+	fc := &fakeCompactable{&testutil.RecorderBuffered{}}
+	rg := &fakeCompactRevGetter{compactRev: 5, compactPrefixes: []mvcc.PrefixCompaction{{Prefix: []byte(""), Revision: 30}}}
+	pc, err := newPrefix(zaptest.NewLogger(t), clockwork.NewFakeClock(), ModeRevision, 10, map[string]time.Duration{"a/": 100}, rg, fc)
+	require.NoError(t, err)
+
+	// the prefixes start from the revisions the store was compacted to
+	_, err = pc.compact(t.Context(), "a/", 20)
+	require.NoError(t, err)
+
+	acts := fc.Action()
+	require.Len(t, acts, 1)
+	wreq := &pb.CompactionRequest{Revision: 20, Prefixes: []*pb.PrefixCompaction{
+		{Prefix: []byte(""), Revision: 30},
+		{Prefix: []byte("a/"), Revision: 20},
+	}}
+	assert.Equal(t, wreq, acts[0].Params[0])
+}
+
+// notCapableCompactable rejects compactions of prefixes like a cluster
+// before 3.8.
+type notCapableCompactable struct {
+	fakeCompactable
+}
+
+func (fc *notCapableCompactable) Compact(ctx context.Context, r *pb.CompactionRequest) (*pb.CompactionResponse, error) {
+	fc.fakeCompactable.Compact(ctx, r)
+	if len(r.Prefixes) != 0 {
+		return nil, errors.ErrNotCapable
+	}
+	return &pb.CompactionResponse{}, nil
+}
+
+func TestPrefixCompactNotCapable(t *testing.T) {
+	fc := &notCapableCompactable{fakeCompactable{&testutil.RecorderBuffered{}}}
+	pc, err := newPrefix(zaptest.NewLogger(t), clockwork.NewFakeClock(), ModeRevision, 10, map[string]time.Duration{"a/": 100}, &fakeRevGetter{}, fc)
+	require.NoError(t, err)
+
+	// the lowest revision of all prefixes is 0, nothing can be compacted
+	_, err = pc.compact(t.Context(), "", 30)
+	require.ErrorIs(t, err, errors.ErrNotCapable)
+	_, err = pc.compact(t.Context(), "a/", 20)
+	require.NoError(t, err)
+
+	acts := fc.Action()
+	require.Len(t, acts, 3)
+	// all keys are compacted up to the lowest revision of all prefixes
+	assert.Equal(t, &pb.CompactionRequest{Revision: 20}, acts[2].Params[0])
+}
@@ -752,6 +752,10 @@ func (fkv *fakeBaseKV) GetStream(ctx context.Context, key string, opts ...client
 	return nil, nil
 }
 
+func (fkv *fakeBaseKV) KeyHistory(ctx context.Context, key string, opts ...clientv3.OpOption) (*clientv3.KeyHistoryResponse, error) {
+	return nil, nil
+}
+
 func (fkv *fakeBaseKV) Delete(ctx context.Context, key string, opts ...clientv3.OpOption) (*clientv3.DeleteResponse, error) {
 	return nil, nil
 }
@@ -779,6 +783,22 @@ func (fw *fakeBaseWatcher) RequestProgress(ctx context.Context) error {
 	return nil
 }
 
+func (fw *fakeBaseWatcher) RequestLinearizableProgress(ctx context.Context) error {
+	return nil
+}
+
+func (fw *fakeBaseWatcher) AckSubscription(ctx context.Context, name string, rev int64) (*clientv3.SubscriptionResponse, error) {
+	return nil, nil
+}
+
+func (fw *fakeBaseWatcher) DeleteSubscription(ctx context.Context, name string) (*clientv3.SubscriptionResponse, error) {
+	return nil, nil
+}
+
+func (fw *fakeBaseWatcher) Subscriptions(ctx context.Context) (*clientv3.SubscriptionResponse, error) {
+	return nil, nil
+}
+
 func (fw *fakeBaseWatcher) Close() error {
 	return nil
 }
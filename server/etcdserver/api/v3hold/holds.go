@@ -0,0 +1,160 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package v3hold manages revision holds, which protect revisions from
+// compaction until they are released.
+package v3hold
+
+import (
+	"cmp"
+	"errors"
+	"slices"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/server/v3/storage/schema"
+)
+
+var (
+	ErrHoldNotFound = errors.New("revision hold not found")
+	ErrHoldExists   = errors.New("revision hold already exists")
+)
+
+// HoldStore persists revision holds to the backend.
+type HoldStore struct {
+	lg    *zap.Logger
+	mu    sync.Mutex
+	holds map[int64]*pb.RevisionHold
+
+	be schema.RevisionHoldBackend
+}
+
+func NewHoldStore(lg *zap.Logger, be schema.RevisionHoldBackend) (*HoldStore, error) {
+	if lg == nil {
+		lg = zap.NewNop()
+	}
+	ret := &HoldStore{lg: lg, holds: make(map[int64]*pb.RevisionHold), be: be}
+	if err := ret.restore(); err != nil {
+		return nil, err
+	}
+
+	reportHoldsMu.Lock()
+	reportHolds = ret.stats
+	reportHoldsMu.Unlock()
+	return ret, nil
+}
+
+// Create adds the hold to the store. It fails if a hold with the same ID exists.
+func (s *HoldStore) Create(h *pb.RevisionHold) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.holds[h.ID]; ok {
+		return ErrHoldExists
+	}
+	s.holds[h.ID] = h
+	s.be.MustPutRevisionHold(h)
+	return nil
+}
+
+// Release removes the hold with the given ID from the store.
+func (s *HoldStore) Release(id int64) (*pb.RevisionHold, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	h, ok := s.holds[id]
+	if !ok {
+		return nil, ErrHoldNotFound
+	}
+	delete(s.holds, id)
+	s.be.MustDeleteRevisionHold(h)
+	return h, nil
+}
+
+// ReleaseLease removes all holds attached to the given lease from the store.
+func (s *HoldStore) ReleaseLease(lease int64) (released []*pb.RevisionHold) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for id, h := range s.holds {
+		if h.Lease != lease {
+			continue
+		}
+		delete(s.holds, id)
+		s.be.MustDeleteRevisionHold(h)
+		released = append(released, h)
+	}
+	return released
+}
+
+// List returns all holds, ordered by revision and then by ID.
+func (s *HoldStore) List() []*pb.RevisionHold {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ret := make([]*pb.RevisionHold, 0, len(s.holds))
+	for _, h := range s.holds {
+		ret = append(ret, h)
+	}
+	slices.SortFunc(ret, func(a, b *pb.RevisionHold) int {
+		if c := cmp.Compare(a.Revision, b.Revision); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.ID, b.ID)
+	})
+	return ret
+}
+
+// Oldest returns the oldest held revision, or 0 if there are no holds.
+func (s *HoldStore) Oldest() int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var oldest int64
+	for _, h := range s.holds {
+		if oldest == 0 || h.Revision < oldest {
+			oldest = h.Revision
+		}
+	}
+	return oldest
+}
+
+// stats returns the number of holds and the creation time of the longest
+// standing one.
+func (s *HoldStore) stats() (count int, oldest time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, h := range s.holds {
+		created := time.Unix(h.CreateTime, 0)
+		if oldest.IsZero() || created.Before(oldest) {
+			oldest = created
+		}
+	}
+	return len(s.holds), oldest
+}
+
+func (s *HoldStore) restore() error {
+	hs, err := s.be.GetAllRevisionHolds()
+	if err != nil {
+		return err
+	}
+	for _, h := range hs {
+		s.holds[h.ID] = h
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v3hold
+
+import (
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	holdsCount = prometheus.NewGaugeFunc(
+		prometheus.GaugeOpts{
+			Namespace: "etcd",
+			Subsystem: "server",
+			Name:      "revision_holds",
+			Help:      "The number of revision holds protecting revisions from compaction.",
+		},
+		func() float64 {
+			reportHoldsMu.RLock()
+			defer reportHoldsMu.RUnlock()
+			count, _ := reportHolds()
+			return float64(count)
+		},
+	)
+	oldestHoldAge = prometheus.NewGaugeFunc(
+		prometheus.GaugeOpts{
+			Namespace: "etcd",
+			Subsystem: "server",
+			Name:      "revision_hold_oldest_age_seconds",
+			Help:      "The age of the longest standing revision hold in seconds, or 0 if there are no holds.",
+		},
+		func() float64 {
+			reportHoldsMu.RLock()
+			defer reportHoldsMu.RUnlock()
+			_, oldest := reportHolds()
+			if oldest.IsZero() {
+				return 0
+			}
+			return time.Since(oldest).Seconds()
+		},
+	)
+	// overridden by hold store initialization
+	reportHoldsMu sync.RWMutex
+	reportHolds   = func() (int, time.Time) { return 0, time.Time{} }
+)
+
+func init() {
+	prometheus.MustRegister(holdsCount)
+	prometheus.MustRegister(oldestHoldAge)
+}
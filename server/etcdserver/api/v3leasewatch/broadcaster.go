@@ -0,0 +1,125 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package v3leasewatch broadcasts the lifecycle events of leases, as they
+// are applied, to the lease watchers of a member.
+package v3leasewatch
+
+import (
+	"errors"
+	"sync"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+)
+
+// watcherBufferLen is the number of event batches a watcher may fall behind
+// before it is closed.
+const watcherBufferLen = 128
+
+var ErrWatcherSlow = errors.New("lease watcher is too slow")
+
+// Broadcaster fans the lease events out to its watchers. Events are not
+// stored, so a watcher only gets the events notified after it was created.
+type Broadcaster struct {
+	mu       sync.Mutex
+	watchers map[*Watcher]struct{}
+}
+
+func NewBroadcaster() *Broadcaster {
+	return &Broadcaster{watchers: make(map[*Watcher]struct{})}
+}
+
+// Watcher receives the events of the lease it watches, or of all leases.
+type Watcher struct {
+	id   int64
+	keys bool
+
+	ch  chan []*pb.LeaseEvent
+	err error
+}
+
+// Chan returns the channel the watcher receives event batches on. It is
+// closed when the watcher is canceled or falls behind.
+func (w *Watcher) Chan() <-chan []*pb.LeaseEvent { return w.ch }
+
+// Err returns ErrWatcherSlow if the watcher was closed because it fell
+// behind. It must only be called once the channel is closed.
+func (w *Watcher) Err() error { return w.err }
+
+// Watch creates a watcher for the events of the lease with the given ID, or
+// of all leases if the ID is 0. The attached keys of revoked and expired
+// leases are only kept if keys is set.
+func (b *Broadcaster) Watch(id int64, keys bool) *Watcher {
+	w := &Watcher{id: id, keys: keys, ch: make(chan []*pb.LeaseEvent, watcherBufferLen)}
+	b.mu.Lock()
+	b.watchers[w] = struct{}{}
+	b.mu.Unlock()
+	return w
+}
+
+// Cancel removes the watcher and closes its channel.
+func (b *Broadcaster) Cancel(w *Watcher) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if _, ok := b.watchers[w]; ok {
+		delete(b.watchers, w)
+		close(w.ch)
+	}
+}
+
+// Notify sends the events to the watchers. It never blocks; a watcher that
+// cannot take the events is closed instead, so a slow watcher does not hold
+// up the apply loop.
+func (b *Broadcaster) Notify(events []*pb.LeaseEvent) {
+	if len(events) == 0 {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for w := range b.watchers {
+		evs := w.filter(events)
+		if len(evs) == 0 {
+			continue
+		}
+		select {
+		case w.ch <- evs:
+		default:
+			w.err = ErrWatcherSlow
+			delete(b.watchers, w)
+			close(w.ch)
+		}
+	}
+}
+
+// filter returns the events the watcher is interested in. The events are
+// shared between watchers, so they are copied rather than modified.
+func (w *Watcher) filter(events []*pb.LeaseEvent) []*pb.LeaseEvent {
+	var ret []*pb.LeaseEvent
+	for _, ev := range events {
+		if w.id != 0 && ev.ID != w.id {
+			continue
+		}
+		if !w.keys && len(ev.Keys) > 0 {
+			ev = &pb.LeaseEvent{
+				Type:     ev.Type,
+				ID:       ev.ID,
+				TTL:      ev.TTL,
+				Parent:   ev.Parent,
+				Revision: ev.Revision,
+			}
+		}
+		ret = append(ret, ev)
+	}
+	return ret
+}
@@ -0,0 +1,70 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v3leasewatch
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+)
+
+func TestBroadcasterSlowWatcher(t *testing.T) {
+	b := NewBroadcaster()
+	slow := b.Watch(0, false)
+	other := b.Watch(2, false)
+
+	for range watcherBufferLen + 1 {
+		b.Notify([]*pb.LeaseEvent{{Type: pb.LeaseEvent_GRANT, ID: 1}})
+	}
+	n := 0
+	for range slow.Chan() {
+		n++
+	}
+	assert.Equal(t, watcherBufferLen, n)
+	require.ErrorIs(t, slow.Err(), ErrWatcherSlow)
+
+	// the watchers of other leases are not affected
+	b.Notify([]*pb.LeaseEvent{{Type: pb.LeaseEvent_GRANT, ID: 2}})
+	b.Cancel(other)
+	n = 0
+	for range other.Chan() {
+		n++
+	}
+	assert.Equal(t, 1, n)
+	require.NoError(t, other.Err())
+}
+
+func TestBroadcasterKeys(t *testing.T) {
+	b := NewBroadcaster()
+	withKeys := b.Watch(0, true)
+	withoutKeys := b.Watch(0, false)
+
+	ev := &pb.LeaseEvent{Type: pb.LeaseEvent_EXPIRE, ID: 1, Revision: 5, Keys: [][]byte{[]byte("foo")}}
+	b.Notify([]*pb.LeaseEvent{ev})
+
+	evs := <-withKeys.Chan()
+	require.Len(t, evs, 1)
+	assert.Same(t, ev, evs[0])
+
+	evs = <-withoutKeys.Chan()
+	require.Len(t, evs, 1)
+	assert.Empty(t, evs[0].Keys)
+	assert.Equal(t, int64(5), evs[0].Revision)
+	// the notified event is left as is for the other watchers
+	assert.Len(t, ev.Keys, 1)
+}
@@ -26,6 +26,7 @@ import (
 	"go.etcd.io/etcd/pkg/v3/adt"
 	"go.etcd.io/etcd/server/v3/etcdserver"
 	"go.etcd.io/etcd/server/v3/etcdserver/txn"
+	"go.etcd.io/etcd/server/v3/lease"
 )
 
 type kvServer struct {
@@ -87,6 +88,20 @@ func (s *headerFillingRangeStream) Send(resp *pb.RangeStreamResponse) error {
 	return s.KV_RangeStreamServer.Send(resp)
 }
 
+func (s *kvServer) KeyHistory(ctx context.Context, r *pb.KeyHistoryRequest) (*pb.KeyHistoryResponse, error) {
+	if err := checkKeyHistoryRequest(r); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.kv.KeyHistory(ctx, r)
+	if err != nil {
+		return nil, togRPCError(err)
+	}
+
+	s.hdr.fill(resp.Header)
+	return resp, nil
+}
+
 func (s *kvServer) Put(ctx context.Context, r *pb.PutRequest) (*pb.PutResponse, error) {
 	if err := checkPutRequest(r); err != nil {
 		return nil, err
@@ -163,6 +178,15 @@ func checkRangeRequest(r *pb.RangeRequest) error {
 		return rpctypes.ErrGRPCInvalidSortOption
 	}
 
+	if _, ok := pb.RangeRequest_LeaseFilter_name[int32(r.LeaseFilter)]; !ok {
+		return rpctypes.ErrGRPCInvalidRangeFilter
+	}
+
+	if r.MinValueSize < 0 || r.MaxValueSize < 0 ||
+		(r.MaxValueSize != 0 && r.MaxValueSize < r.MinValueSize) {
+		return rpctypes.ErrGRPCInvalidRangeFilter
+	}
+
 	return nil
 }
 
@@ -170,12 +194,27 @@ func checkRangeStreamRequest(r *pb.RangeRequest) error {
 	if err := checkRangeRequest(r); err != nil {
 		return err
 	}
+	// the order NONE sorts on another target in ascending order, as Range
+	// does through txn.SortOrder, with the chunks chained by continue tokens
 	if !txn.IsDefaultOrdering(r.SortTarget, r.SortOrder) {
 		return status.Errorf(codes.Unimplemented, "RangeStream does not support custom sort orders")
 	}
 	if txn.HasRevisionFilters(r) {
 		return status.Errorf(codes.Unimplemented, "RangeStream does not support revision filters")
 	}
+	if txn.HasValueFilters(r) {
+		return status.Errorf(codes.Unimplemented, "RangeStream does not support value filters")
+	}
+	return nil
+}
+
+func checkKeyHistoryRequest(r *pb.KeyHistoryRequest) error {
+	if len(r.Key) == 0 {
+		return rpctypes.ErrGRPCEmptyKey
+	}
+	if _, ok := pb.RangeRequest_SortOrder_name[int32(r.SortOrder)]; !ok {
+		return rpctypes.ErrGRPCInvalidSortOption
+	}
 	return nil
 }
 
@@ -189,6 +228,33 @@ func checkPutRequest(r *pb.PutRequest) error {
 	if r.IgnoreLease && r.Lease != 0 {
 		return rpctypes.ErrGRPCLeaseProvided
 	}
+	if r.Ttl < 0 {
+		return rpctypes.ErrGRPCInvalidTTL
+	}
+	if r.Ttl > 0 && (r.Lease != 0 || r.IgnoreLease) {
+		return rpctypes.ErrGRPCLeaseProvided
+	}
+	// keys are only attached to the shared TTL leases through a TTL
+	if lease.IsTTLLeaseID(lease.LeaseID(r.Lease)) {
+		return rpctypes.ErrGRPCLeaseIDReserved
+	}
+	if r.Ttl > lease.MaxLeaseTTL {
+		return rpctypes.ErrGRPCLeaseTTLTooLarge
+	}
+	return nil
+}
+
+func checkIncrementRequest(r *pb.IncrementRequest) error {
+	if len(r.Key) == 0 {
+		return rpctypes.ErrGRPCEmptyKey
+	}
+	if r.IgnoreLease && r.Lease != 0 {
+		return rpctypes.ErrGRPCLeaseProvided
+	}
+	// keys are only attached to the shared TTL leases through a TTL
+	if lease.IsTTLLeaseID(lease.LeaseID(r.Lease)) {
+		return rpctypes.ErrGRPCLeaseIDReserved
+	}
 	return nil
 }
 
@@ -196,6 +262,10 @@ func checkDeleteRequest(r *pb.DeleteRangeRequest) error {
 	if len(r.Key) == 0 {
 		return rpctypes.ErrGRPCEmptyKey
 	}
+	if r.BatchSize < 0 || (r.BatchSize > 0 && r.PrevKv) || (r.Atomic && r.BatchSize == 0) ||
+		r.MaxBatches < 0 || (r.MaxBatches > 0 && r.BatchSize == 0) {
+		return rpctypes.ErrGRPCInvalidBatchedDelete
+	}
 	return nil
 }
 
@@ -232,7 +302,7 @@ func checkTxnRequest(r *pb.TxnRequest, maxTxnOps int) error {
 
 // checkIntervals tests whether puts and deletes overlap for a list of ops. If
 // there is an overlap, returns an error. If no overlap, return put and delete
-// sets for recursive evaluation.
+// sets for recursive evaluation. Increments are treated as puts.
 func checkIntervals(reqs []*pb.RequestOp) (map[string]struct{}, adt.IntervalTree, error) {
 	dels := adt.NewIntervalTree()
 
@@ -298,11 +368,21 @@ func checkIntervals(reqs []*pb.RequestOp) (map[string]struct{}, adt.IntervalTree
 
 	// collect and check this level's puts
 	for _, req := range reqs {
-		tv, ok := req.Request.(*pb.RequestOp_RequestPut)
-		if !ok || tv.RequestPut == nil {
+		var k string
+		switch tv := req.Request.(type) {
+		case *pb.RequestOp_RequestPut:
+			if tv.RequestPut == nil {
+				continue
+			}
+			k = string(tv.RequestPut.Key)
+		case *pb.RequestOp_RequestIncrement:
+			if tv.RequestIncrement == nil {
+				continue
+			}
+			k = string(tv.RequestIncrement.Key)
+		default:
 			continue
 		}
-		k := string(tv.RequestPut.Key)
 		if _, ok := puts[k]; ok {
 			return nil, dels, rpctypes.ErrGRPCDuplicateKey
 		}
@@ -322,7 +402,13 @@ func checkRequestOp(u *pb.RequestOp, maxTxnOps int) error {
 	case *pb.RequestOp_RequestPut:
 		return checkPutRequest(uv.RequestPut)
 	case *pb.RequestOp_RequestDeleteRange:
+		if uv.RequestDeleteRange.GetBatchSize() != 0 {
+			// a txn is applied in a single raft entry
+			return rpctypes.ErrGRPCInvalidBatchedDelete
+		}
 		return checkDeleteRequest(uv.RequestDeleteRange)
+	case *pb.RequestOp_RequestIncrement:
+		return checkIncrementRequest(uv.RequestIncrement)
 	case *pb.RequestOp_RequestTxn:
 		return checkTxnRequest(uv.RequestTxn, maxTxnOps)
 	default:
@@ -19,6 +19,7 @@ import (
 
 	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
 	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
+	"go.etcd.io/etcd/server/v3/lease"
 )
 
 func TestCheckRangeRequest(t *testing.T) {
@@ -59,6 +60,280 @@ func TestCheckRangeRequest(t *testing.T) {
 	}
 }
 
+func TestCheckRangeRequestFilters(t *testing.T) {
+	tests := []struct {
+		name          string
+		req           *pb.RangeRequest
+		expectedError error
+	}{
+		{
+			name: "valid value size bounds",
+			req:  &pb.RangeRequest{MinValueSize: 1, MaxValueSize: 10},
+		},
+		{
+			name: "unbounded max value size",
+			req:  &pb.RangeRequest{MinValueSize: 10},
+		},
+		{
+			name:          "negative min value size",
+			req:           &pb.RangeRequest{MinValueSize: -1},
+			expectedError: rpctypes.ErrGRPCInvalidRangeFilter,
+		},
+		{
+			name:          "max value size lower than min value size",
+			req:           &pb.RangeRequest{MinValueSize: 10, MaxValueSize: 1},
+			expectedError: rpctypes.ErrGRPCInvalidRangeFilter,
+		},
+		{
+			name:          "unknown lease filter",
+			req:           &pb.RangeRequest{LeaseFilter: 100},
+			expectedError: rpctypes.ErrGRPCInvalidRangeFilter,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.req.Key = []byte{1, 2, 3}
+			actualRet := checkRangeRequest(tc.req)
+			if getError(actualRet) != getError(tc.expectedError) {
+				t.Errorf("expected %q, but got %q", getError(tc.expectedError), getError(actualRet))
+			}
+		})
+	}
+}
+
+func TestCheckRangeStreamRequestSort(t *testing.T) {
+	tests := []struct {
+		sortOrder  pb.RangeRequest_SortOrder
+		sortTarget pb.RangeRequest_SortTarget
+		wantErr    bool
+	}{
+		{sortOrder: pb.RangeRequest_NONE, sortTarget: pb.RangeRequest_KEY},
+		{sortOrder: pb.RangeRequest_ASCEND, sortTarget: pb.RangeRequest_KEY},
+		{sortOrder: pb.RangeRequest_DESCEND, sortTarget: pb.RangeRequest_KEY, wantErr: true},
+		// sorting on another target is in ascending order by default, like Range
+		{sortOrder: pb.RangeRequest_NONE, sortTarget: pb.RangeRequest_MOD},
+		{sortOrder: pb.RangeRequest_ASCEND, sortTarget: pb.RangeRequest_VALUE, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		err := checkRangeStreamRequest(&pb.RangeRequest{Key: []byte{1, 2, 3}, SortOrder: tt.sortOrder, SortTarget: tt.sortTarget})
+		if (err != nil) != tt.wantErr {
+			t.Errorf("sortOrder (%s) and sortTarget (%s): got error %v, want error %v", tt.sortOrder, tt.sortTarget, err, tt.wantErr)
+		}
+	}
+}
+
+func TestCheckPutRequestTTL(t *testing.T) {
+	tests := []struct {
+		name          string
+		req           *pb.PutRequest
+		expectedError error
+	}{
+		{
+			name: "ttl",
+			req:  &pb.PutRequest{Ttl: 10},
+		},
+		{
+			name:          "negative ttl",
+			req:           &pb.PutRequest{Ttl: -1},
+			expectedError: rpctypes.ErrGRPCInvalidTTL,
+		},
+		{
+			name:          "ttl with lease",
+			req:           &pb.PutRequest{Ttl: 10, Lease: 1},
+			expectedError: rpctypes.ErrGRPCLeaseProvided,
+		},
+		{
+			name:          "ttl with ignore lease",
+			req:           &pb.PutRequest{Ttl: 10, IgnoreLease: true},
+			expectedError: rpctypes.ErrGRPCLeaseProvided,
+		},
+		{
+			name:          "ttl too large",
+			req:           &pb.PutRequest{Ttl: 9000000001},
+			expectedError: rpctypes.ErrGRPCLeaseTTLTooLarge,
+		},
+		{
+			name:          "reserved lease",
+			req:           &pb.PutRequest{Lease: int64(lease.TTLLeaseID(100))},
+			expectedError: rpctypes.ErrGRPCLeaseIDReserved,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.req.Key = []byte{1, 2, 3}
+			actualRet := checkPutRequest(tc.req)
+			if getError(actualRet) != getError(tc.expectedError) {
+				t.Errorf("expected %q, but got %q", getError(tc.expectedError), getError(actualRet))
+			}
+		})
+	}
+}
+
+func TestCheckIncrementRequestLease(t *testing.T) {
+	tests := []struct {
+		name          string
+		req           *pb.IncrementRequest
+		expectedError error
+	}{
+		{
+			name: "lease",
+			req:  &pb.IncrementRequest{Lease: 1},
+		},
+		{
+			name:          "lease with ignore lease",
+			req:           &pb.IncrementRequest{Lease: 1, IgnoreLease: true},
+			expectedError: rpctypes.ErrGRPCLeaseProvided,
+		},
+		{
+			name:          "reserved lease",
+			req:           &pb.IncrementRequest{Lease: int64(lease.TTLLeaseID(100))},
+			expectedError: rpctypes.ErrGRPCLeaseIDReserved,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.req.Key = []byte{1, 2, 3}
+			actualRet := checkIncrementRequest(tc.req)
+			if getError(actualRet) != getError(tc.expectedError) {
+				t.Errorf("expected %q, but got %q", getError(tc.expectedError), getError(actualRet))
+			}
+			txn := &pb.TxnRequest{Success: []*pb.RequestOp{{Request: &pb.RequestOp_RequestIncrement{RequestIncrement: tc.req}}}}
+			if getError(checkTxnRequest(txn, 128)) != getError(tc.expectedError) {
+				t.Errorf("expected %q in txn, but got %q", getError(tc.expectedError), getError(checkTxnRequest(txn, 128)))
+			}
+		})
+	}
+}
+
+func TestCheckDeleteRequestBatch(t *testing.T) {
+	tests := []struct {
+		name          string
+		req           *pb.DeleteRangeRequest
+		expectedError error
+	}{
+		{
+			name: "batch size",
+			req:  &pb.DeleteRangeRequest{BatchSize: 100},
+		},
+		{
+			name: "atomic batch",
+			req:  &pb.DeleteRangeRequest{BatchSize: 100, Atomic: true},
+		},
+		{
+			name:          "negative batch size",
+			req:           &pb.DeleteRangeRequest{BatchSize: -1},
+			expectedError: rpctypes.ErrGRPCInvalidBatchedDelete,
+		},
+		{
+			name:          "batch size with prev kv",
+			req:           &pb.DeleteRangeRequest{BatchSize: 100, PrevKv: true},
+			expectedError: rpctypes.ErrGRPCInvalidBatchedDelete,
+		},
+		{
+			name:          "atomic without batch size",
+			req:           &pb.DeleteRangeRequest{Atomic: true},
+			expectedError: rpctypes.ErrGRPCInvalidBatchedDelete,
+		},
+		{
+			name: "max batches",
+			req:  &pb.DeleteRangeRequest{BatchSize: 100, MaxBatches: 2},
+		},
+		{
+			name:          "negative max batches",
+			req:           &pb.DeleteRangeRequest{BatchSize: 100, MaxBatches: -1},
+			expectedError: rpctypes.ErrGRPCInvalidBatchedDelete,
+		},
+		{
+			name:          "max batches without batch size",
+			req:           &pb.DeleteRangeRequest{MaxBatches: 2},
+			expectedError: rpctypes.ErrGRPCInvalidBatchedDelete,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.req.Key = []byte{1, 2, 3}
+			actualRet := checkDeleteRequest(tc.req)
+			if getError(actualRet) != getError(tc.expectedError) {
+				t.Errorf("expected %q, but got %q", getError(tc.expectedError), getError(actualRet))
+			}
+			txn := &pb.TxnRequest{Success: []*pb.RequestOp{{Request: &pb.RequestOp_RequestDeleteRange{RequestDeleteRange: tc.req}}}}
+			if tc.req.BatchSize != 0 && getError(checkTxnRequest(txn, 128)) != getError(rpctypes.ErrGRPCInvalidBatchedDelete) {
+				t.Errorf("expected batched delete to be rejected in txn")
+			}
+		})
+	}
+}
+
+func TestCheckTxnRequestIncrement(t *testing.T) {
+	inc := func(key string) *pb.RequestOp {
+		return &pb.RequestOp{Request: &pb.RequestOp_RequestIncrement{RequestIncrement: &pb.IncrementRequest{Key: []byte(key), Delta: 1}}}
+	}
+	put := func(key string) *pb.RequestOp {
+		return &pb.RequestOp{Request: &pb.RequestOp_RequestPut{RequestPut: &pb.PutRequest{Key: []byte(key)}}}
+	}
+	del := func(key, end string) *pb.RequestOp {
+		return &pb.RequestOp{Request: &pb.RequestOp_RequestDeleteRange{RequestDeleteRange: &pb.DeleteRangeRequest{Key: []byte(key), RangeEnd: []byte(end)}}}
+	}
+	tests := []struct {
+		name          string
+		req           *pb.TxnRequest
+		expectedError error
+	}{
+		{
+			name: "distinct keys",
+			req:  &pb.TxnRequest{Success: []*pb.RequestOp{inc("a"), put("b"), del("c", "d")}},
+		},
+		{
+			name: "same key on both branches",
+			req:  &pb.TxnRequest{Success: []*pb.RequestOp{inc("a")}, Failure: []*pb.RequestOp{inc("a")}},
+		},
+		{
+			name:          "duplicate increment",
+			req:           &pb.TxnRequest{Success: []*pb.RequestOp{inc("a"), inc("a")}},
+			expectedError: rpctypes.ErrGRPCDuplicateKey,
+		},
+		{
+			name:          "increment of put key",
+			req:           &pb.TxnRequest{Success: []*pb.RequestOp{put("a"), inc("a")}},
+			expectedError: rpctypes.ErrGRPCDuplicateKey,
+		},
+		{
+			name:          "increment of deleted key",
+			req:           &pb.TxnRequest{Success: []*pb.RequestOp{del("a", "c"), inc("b")}},
+			expectedError: rpctypes.ErrGRPCDuplicateKey,
+		},
+		{
+			name:          "empty key",
+			req:           &pb.TxnRequest{Success: []*pb.RequestOp{inc("")}},
+			expectedError: rpctypes.ErrGRPCEmptyKey,
+		},
+		{
+			name: "lease with ignore lease",
+			req: &pb.TxnRequest{Success: []*pb.RequestOp{{Request: &pb.RequestOp_RequestIncrement{
+				RequestIncrement: &pb.IncrementRequest{Key: []byte("a"), Lease: 1, IgnoreLease: true},
+			}}}},
+			expectedError: rpctypes.ErrGRPCLeaseProvided,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			actualRet := checkTxnRequest(tc.req, 128)
+			if actualRet == nil {
+				_, _, actualRet = checkIntervals(tc.req.Success)
+			}
+			if getError(actualRet) != getError(tc.expectedError) {
+				t.Errorf("expected %q, but got %q", getError(tc.expectedError), getError(actualRet))
+			}
+		})
+	}
+}
+
 func getError(err error) string {
 	if err == nil {
 		return ""
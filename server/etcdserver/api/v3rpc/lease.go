@@ -59,6 +59,24 @@ func (ls *LeaseServer) LeaseRevoke(ctx context.Context, rr *pb.LeaseRevokeReques
 	return resp, nil
 }
 
+func (ls *LeaseServer) LeaseRevokeBatch(ctx context.Context, rr *pb.LeaseRevokeBatchRequest) (*pb.LeaseRevokeBatchResponse, error) {
+	resp, err := ls.le.LeaseRevokeBatch(ctx, rr)
+	if err != nil {
+		return nil, togRPCError(err)
+	}
+	ls.hdr.fill(resp.Header)
+	return resp, nil
+}
+
+func (ls *LeaseServer) LeaseUpdateTTL(ctx context.Context, rr *pb.LeaseUpdateTTLRequest) (*pb.LeaseUpdateTTLResponse, error) {
+	resp, err := ls.le.LeaseUpdateTTL(ctx, rr)
+	if err != nil {
+		return nil, togRPCError(err)
+	}
+	ls.hdr.fill(resp.Header)
+	return resp, nil
+}
+
 func (ls *LeaseServer) LeaseTimeToLive(ctx context.Context, rr *pb.LeaseTimeToLiveRequest) (*pb.LeaseTimeToLiveResponse, error) {
 	resp, err := ls.le.LeaseTimeToLive(ctx, rr)
 	if err != nil && !errors.Is(err, lease.ErrLeaseNotFound) {
@@ -90,6 +108,27 @@ func (ls *LeaseServer) LeaseLeases(ctx context.Context, rr *pb.LeaseLeasesReques
 	return resp, nil
 }
 
+func (ls *LeaseServer) LeaseWatch(rr *pb.LeaseWatchRequest, stream pb.Lease_LeaseWatchServer) error {
+	err := ls.le.LeaseWatch(rr, &headerFillingLeaseWatchStream{Lease_LeaseWatchServer: stream, hdr: &ls.hdr})
+	if err != nil {
+		return togRPCError(err)
+	}
+	return nil
+}
+
+// headerFillingLeaseWatchStream wraps Lease_LeaseWatchServer to fill the
+// cluster header of the responses. The revision is the one the events were
+// applied at, so it is left as set by the handler.
+type headerFillingLeaseWatchStream struct {
+	pb.Lease_LeaseWatchServer
+	hdr *header
+}
+
+func (s *headerFillingLeaseWatchStream) Send(resp *pb.LeaseWatchResponse) error {
+	s.hdr.fill(resp.Header)
+	return s.Lease_LeaseWatchServer.Send(resp)
+}
+
 func (ls *LeaseServer) LeaseKeepAlive(stream pb.Lease_LeaseKeepAliveServer) (err error) {
 	errc := make(chan error, 1)
 	go func() {
@@ -19,6 +19,8 @@ import (
 	"crypto/sha256"
 	errorspkg "errors"
 	"io"
+	"maps"
+	"slices"
 	"time"
 
 	"github.com/dustin/go-humanize"
@@ -29,6 +31,7 @@ import (
 	"go.etcd.io/etcd/api/v3/version"
 	"go.etcd.io/etcd/server/v3/config"
 	"go.etcd.io/etcd/server/v3/etcdserver"
+	"go.etcd.io/etcd/server/v3/etcdserver/api/v3compactor"
 	"go.etcd.io/etcd/server/v3/etcdserver/apply"
 	"go.etcd.io/etcd/server/v3/etcdserver/errors"
 	serverversion "go.etcd.io/etcd/server/v3/etcdserver/version"
@@ -58,6 +61,10 @@ type Alarmer interface {
 	Alarm(ctx context.Context, ar *pb.AlarmRequest) (*pb.AlarmResponse, error)
 }
 
+type RevisionHolder interface {
+	RevisionHold(ctx context.Context, r *pb.RevisionHoldRequest) (*pb.RevisionHoldResponse, error)
+}
+
 type Downgrader interface {
 	Downgrade(ctx context.Context, dr *pb.DowngradeRequest) (*pb.DowngradeResponse, error)
 }
@@ -77,6 +84,7 @@ type ConfigGetter interface {
 type maintenanceServer struct {
 	lg     *zap.Logger
 	rg     apply.RaftStatusGetter
+	kg     KVGetter
 	hasher mvcc.HashStorage
 	bg     BackendGetter
 	defrag Defrager
@@ -85,6 +93,7 @@ type maintenanceServer struct {
 	hdr    header
 	cs     ClusterStatusGetter
 	d      Downgrader
+	rh     RevisionHolder
 	vs     serverversion.Server
 	cg     ConfigGetter
 
@@ -98,6 +107,7 @@ func NewMaintenanceServer(s *etcdserver.EtcdServer, healthNotifier notifier) pb.
 	srv := &maintenanceServer{
 		lg:             s.Cfg.Logger,
 		rg:             s,
+		kg:             s,
 		hasher:         s.KV().HashStorage(),
 		bg:             s,
 		defrag:         s,
@@ -106,6 +116,7 @@ func NewMaintenanceServer(s *etcdserver.EtcdServer, healthNotifier notifier) pb.
 		hdr:            newHeader(s),
 		cs:             s,
 		d:              s,
+		rh:             s,
 		vs:             etcdserver.NewServerVersionAdapter(s),
 		healthNotifier: healthNotifier,
 		cg:             s,
@@ -316,6 +327,75 @@ func (ms *maintenanceServer) Downgrade(ctx context.Context, r *pb.DowngradeReque
 	return resp, nil
 }
 
+func (ms *maintenanceServer) RevisionHold(ctx context.Context, r *pb.RevisionHoldRequest) (*pb.RevisionHoldResponse, error) {
+	resp, err := ms.rh.RevisionHold(ctx, r)
+	if err != nil {
+		return nil, togRPCError(err)
+	}
+	if resp.Header == nil {
+		resp.Header = &pb.ResponseHeader{}
+	}
+	ms.hdr.fill(resp.Header)
+	return resp, nil
+}
+
+func (ms *maintenanceServer) CompactionPolicy(ctx context.Context, r *pb.CompactionPolicyRequest) (*pb.CompactionPolicyResponse, error) {
+	cfg := ms.cg.Config()
+	kv := ms.kg.KV()
+	resp := &pb.CompactionPolicyResponse{
+		Header:          &pb.ResponseHeader{},
+		Mode:            cfg.AutoCompactionMode,
+		CompactRevision: kv.FirstRev(),
+	}
+	retentions := map[string]time.Duration{"": cfg.AutoCompactionRetention}
+	maps.Copy(retentions, cfg.AutoCompactionPrefixRetention)
+	for _, prefix := range slices.Sorted(maps.Keys(retentions)) {
+		retention := int64(retentions[prefix])
+		if cfg.AutoCompactionMode == v3compactor.ModePeriodic {
+			retention = int64(retentions[prefix] / time.Second)
+		}
+		resp.Retentions = append(resp.Retentions, &pb.CompactionRetention{
+			Prefix:          []byte(prefix),
+			Retention:       retention,
+			CompactRevision: kv.CompactRev([]byte(prefix), nil),
+		})
+	}
+	ms.hdr.fill(resp.Header)
+	return resp, nil
+}
+
+func (ms *maintenanceServer) Watchers(ctx context.Context, r *pb.WatchersRequest) (*pb.WatchersResponse, error) {
+	resp := &pb.WatchersResponse{Header: &pb.ResponseHeader{}}
+	for _, w := range ms.kg.KV().Watchers() {
+		info := &pb.WatcherInfo{
+			WatchId:        int64(w.ID),
+			StartRevision:  w.StartRev,
+			Revision:       w.Rev,
+			Synced:         w.Synced,
+			PendingEvents:  int64(w.PendingEvents),
+			Victim:         w.Victim,
+			VictimDuration: w.VictimTime.Milliseconds(),
+			User:           w.User,
+			RemoteAddress:  w.RemoteAddr,
+		}
+		for _, kr := range w.Ranges {
+			info.Ranges = append(info.Ranges, keyRangeToPB(kr))
+		}
+		resp.Watchers = append(resp.Watchers, info)
+	}
+	ms.hdr.fill(resp.Header)
+	return resp, nil
+}
+
+// keyRangeToPB converts the range of an mvcc watcher back to the key range
+// of a watch create request, see watchRange.
+func keyRangeToPB(kr mvcc.KeyRange) *pb.KeyRange {
+	if kr.End != nil && len(kr.End) == 0 {
+		return &pb.KeyRange{Key: kr.Key, RangeEnd: []byte{0}}
+	}
+	return &pb.KeyRange{Key: kr.Key, RangeEnd: kr.End}
+}
+
 type authMaintenanceServer struct {
 	*maintenanceServer
 	*AuthAdmin
@@ -374,6 +454,22 @@ func (ams *authMaintenanceServer) Status(ctx context.Context, ar *pb.StatusReque
 	return ams.maintenanceServer.Status(ctx, ar)
 }
 
+func (ams *authMaintenanceServer) CompactionPolicy(ctx context.Context, r *pb.CompactionPolicyRequest) (*pb.CompactionPolicyResponse, error) {
+	if err := ams.requireAuthInfo(ctx); err != nil {
+		return nil, togRPCError(err)
+	}
+
+	return ams.maintenanceServer.CompactionPolicy(ctx, r)
+}
+
+func (ams *authMaintenanceServer) Watchers(ctx context.Context, r *pb.WatchersRequest) (*pb.WatchersResponse, error) {
+	if err := ams.isPermitted(ctx); err != nil {
+		return nil, togRPCError(err)
+	}
+
+	return ams.maintenanceServer.Watchers(ctx, r)
+}
+
 func (ams *authMaintenanceServer) MoveLeader(ctx context.Context, tr *pb.MoveLeaderRequest) (*pb.MoveLeaderResponse, error) {
 	if err := ams.isPermitted(ctx); err != nil {
 		return nil, togRPCError(err)
@@ -389,3 +485,17 @@ func (ams *authMaintenanceServer) Downgrade(ctx context.Context, r *pb.Downgrade
 
 	return ams.maintenanceServer.Downgrade(ctx, r)
 }
+
+func (ams *authMaintenanceServer) RevisionHold(ctx context.Context, r *pb.RevisionHoldRequest) (*pb.RevisionHoldResponse, error) {
+	switch r.GetAction() {
+	case pb.RevisionHoldRequest_LIST:
+		if err := ams.requireAuthInfo(ctx); err != nil {
+			return nil, togRPCError(err)
+		}
+	default:
+		if err := ams.isPermitted(ctx); err != nil {
+			return nil, togRPCError(err)
+		}
+	}
+	return ams.maintenanceServer.RevisionHold(ctx, r)
+}
@@ -100,6 +100,80 @@ var (
 			Buckets: prometheus.ExponentialBuckets(0.001, 2, 14),
 		},
 	)
+
+	watchEventDeliveryLatency = prometheus.NewHistogram(
+		prometheus.HistogramOpts{
+			Namespace: "etcd_debugging",
+			Subsystem: "server",
+			Name:      "watch_event_delivery_latency_seconds",
+			Help:      "The latency in seconds from committing a revision to sending its events to a watcher, for events not read back from the backend.",
+			// lowest bucket start of upper bound 0.0001 sec (0.1 ms) with factor 2
+			// highest bucket start of 0.0001 sec * 2^16 == 6.5536 sec
+			Buckets: prometheus.ExponentialBuckets(0.0001, 2, 17),
+		},
+	)
+
+	watchThrottledSeconds = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: "etcd",
+			Subsystem: "server",
+			Name:      "watch_throttled_seconds_total",
+			Help:      "The total duration in seconds watch streams waited for the event rate limit.",
+		},
+	)
+
+	watchersRejected = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "etcd",
+			Subsystem: "server",
+			Name:      "watchers_rejected_total",
+			Help:      "The total number of watchers rejected or canceled by the watch limits, per limit exceeded.",
+		},
+		[]string{"limit"},
+	)
+
+	// The metrics per user are only recorded with the watch-metrics-per-user
+	// flag. Their user label has a value per user of the auth store that
+	// watches, and an empty one for unauthenticated clients.
+	userWatchers = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "etcd",
+			Subsystem: "server",
+			Name:      "user_watchers",
+			Help:      "The number of watchers per user. Watchers of unauthenticated clients have an empty user.",
+		},
+		[]string{"user"},
+	)
+
+	userWatchEventsSent = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "etcd",
+			Subsystem: "server",
+			Name:      "user_watch_events_sent_total",
+			Help:      "The total number of watch events sent per user.",
+		},
+		[]string{"user"},
+	)
+
+	userWatchThrottledSeconds = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "etcd",
+			Subsystem: "server",
+			Name:      "user_watch_throttled_seconds_total",
+			Help:      "The total duration in seconds watch streams of a user waited for the event rate limit.",
+		},
+		[]string{"user"},
+	)
+
+	userWatchersRejected = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "etcd",
+			Subsystem: "server",
+			Name:      "user_watchers_rejected_total",
+			Help:      "The total number of watchers rejected or canceled by the watch limits, per user and limit exceeded.",
+		},
+		[]string{"user", "limit"},
+	)
 )
 
 func init() {
@@ -111,4 +185,11 @@ func init() {
 	prometheus.MustRegister(watchSendLoopWatchStreamDurationPerEvent)
 	prometheus.MustRegister(watchSendLoopControlStreamDuration)
 	prometheus.MustRegister(watchSendLoopProgressDuration)
+	prometheus.MustRegister(watchEventDeliveryLatency)
+	prometheus.MustRegister(watchThrottledSeconds)
+	prometheus.MustRegister(watchersRejected)
+	prometheus.MustRegister(userWatchers)
+	prometheus.MustRegister(userWatchEventsSent)
+	prometheus.MustRegister(userWatchThrottledSeconds)
+	prometheus.MustRegister(userWatchersRejected)
 }
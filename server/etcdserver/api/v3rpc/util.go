@@ -26,6 +26,9 @@ import (
 	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
 	"go.etcd.io/etcd/server/v3/auth"
 	"go.etcd.io/etcd/server/v3/etcdserver/api/membership"
+	"go.etcd.io/etcd/server/v3/etcdserver/api/v3hold"
+	"go.etcd.io/etcd/server/v3/etcdserver/api/v3leasewatch"
+	"go.etcd.io/etcd/server/v3/etcdserver/api/v3subscription"
 	"go.etcd.io/etcd/server/v3/etcdserver/errors"
 	"go.etcd.io/etcd/server/v3/etcdserver/version"
 	"go.etcd.io/etcd/server/v3/lease"
@@ -59,6 +62,10 @@ var toGRPCErrorMap = map[error]error{
 	errors.ErrTimeoutWaitAppliedIndex:    rpctypes.ErrGRPCTimeoutWaitAppliedIndex,
 	errors.ErrUnhealthy:                  rpctypes.ErrGRPCUnhealthy,
 	errors.ErrKeyNotFound:                rpctypes.ErrGRPCKeyNotFound,
+	errors.ErrInvalidCounter:             rpctypes.ErrGRPCInvalidCounter,
+	errors.ErrCounterOverflow:            rpctypes.ErrGRPCCounterOverflow,
+	errors.ErrInvalidContinueToken:       rpctypes.ErrGRPCInvalidContinueToken,
+	errors.ErrNotCapable:                 rpctypes.ErrGRPCNotCapable,
 	errors.ErrCorrupt:                    rpctypes.ErrGRPCCorrupt,
 	errors.ErrBadLeaderTransferee:        rpctypes.ErrGRPCBadLeaderTransferee,
 
@@ -71,6 +78,14 @@ var toGRPCErrorMap = map[error]error{
 	lease.ErrLeaseNotFound:    rpctypes.ErrGRPCLeaseNotFound,
 	lease.ErrLeaseExists:      rpctypes.ErrGRPCLeaseExist,
 	lease.ErrLeaseTTLTooLarge: rpctypes.ErrGRPCLeaseTTLTooLarge,
+	lease.ErrLeaseIDReserved:  rpctypes.ErrGRPCLeaseIDReserved,
+
+	v3leasewatch.ErrWatcherSlow: rpctypes.ErrGRPCLeaseWatcherSlow,
+
+	v3hold.ErrHoldNotFound: rpctypes.ErrGRPCRevisionHoldNotFound,
+	v3hold.ErrHoldExists:   rpctypes.ErrGRPCRevisionHoldExist,
+
+	v3subscription.ErrSubscriptionNotFound: rpctypes.ErrGRPCSubscriptionNotFound,
 
 	auth.ErrRootUserNotExist:     rpctypes.ErrGRPCRootUserNotExist,
 	auth.ErrRootRoleNotExist:     rpctypes.ErrGRPCRootRoleNotExist,
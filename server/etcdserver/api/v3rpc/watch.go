@@ -15,6 +15,7 @@
 package v3rpc
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"io"
@@ -25,6 +26,8 @@ import (
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
+	"golang.org/x/time/rate"
+	"google.golang.org/grpc/peer"
 	"google.golang.org/protobuf/proto"
 
 	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
@@ -39,7 +42,25 @@ import (
 	"go.etcd.io/etcd/server/v3/storage/mvcc"
 )
 
-const minWatchProgressInterval = 100 * time.Millisecond
+const (
+	minWatchProgressInterval = 100 * time.Millisecond
+	// linearizableProgressRetryInterval is how often a linearizable progress
+	// request is retried while the linearizable read fails or watchers of the
+	// stream are not synced.
+	linearizableProgressRetryInterval = 100 * time.Millisecond
+)
+
+// Subscriber tracks the revisions acknowledged by watch subscriptions.
+type Subscriber interface {
+	Subscription(ctx context.Context, r *pb.SubscriptionRequest) (*pb.SubscriptionResponse, error)
+	SubscriptionRevision(name string) (int64, bool)
+}
+
+// LinearizableReadNotifier waits until the member applied the entries
+// committed in the cluster.
+type LinearizableReadNotifier interface {
+	LinearizableReadNotify(ctx context.Context) error
+}
 
 type watchServer struct {
 	lg *zap.Logger
@@ -52,6 +73,12 @@ type watchServer struct {
 	sg        apply.RaftStatusGetter
 	watchable mvcc.WatchableKV
 	ag        AuthGetter
+	subs      Subscriber
+	lr        LinearizableReadNotifier
+	hdr       header
+
+	limits watchLimits
+	counts *watcherCounts
 
 	// we want compile errors if new methods are added
 	pb.UnsafeWatchServer
@@ -70,6 +97,16 @@ func NewWatchServer(s *etcdserver.EtcdServer) pb.WatchServer {
 		sg:        s,
 		watchable: s.Watchable(),
 		ag:        s,
+		subs:      s,
+		lr:        s,
+		hdr:       newHeader(s),
+
+		limits: watchLimits{
+			maxWatchersPerStream: s.Cfg.WatchMaxWatchersPerStream,
+			maxWatchersPerUser:   s.Cfg.WatchMaxWatchersPerUser,
+			maxEventsPerSecond:   s.Cfg.WatchMaxEventsPerSecond,
+		},
+		counts: newWatcherCounts(s.Cfg.WatchMetricsPerUser),
 	}
 	if srv.lg == nil {
 		srv.lg = zap.NewNop()
@@ -138,25 +175,46 @@ type serverWatchStream struct {
 	sg        apply.RaftStatusGetter
 	watchable mvcc.WatchableKV
 	ag        AuthGetter
+	subs      Subscriber
+	lr        LinearizableReadNotifier
+
+	// user is the authenticated user of the stream, if any
+	user string
+	// remoteAddr is the address of the client of the stream
+	remoteAddr string
+
+	limits  watchLimits
+	counts  *watcherCounts
+	limiter *rate.Limiter
 
 	gRPCStream  pb.Watch_WatchServer
 	watchStream mvcc.WatchStream
 	ctrlStream  chan *pb.WatchResponse
 
-	// mu protects progress, prevKV, fragment
+	// mu protects watchers, progress, prevKV, prevFilters, fragment,
+	// linearizableProgressRunning, linearizableProgressPending
 	mu sync.RWMutex
+	// watchers tracks the watchers counted against the watcher limits
+	watchers map[mvcc.WatchID]struct{}
 	// tracks the watchID that stream might need to send progress to
 	// TODO: combine progress and prevKV into a single struct?
 	progress map[mvcc.WatchID]bool
 	// record watch IDs that need return previous key-value pair
 	prevKV map[mvcc.WatchID]bool
+	// records the filters of watch IDs that need the previous key-value pair
+	prevFilters map[mvcc.WatchID][]mvcc.FilterFunc
 	// records fragmented watch IDs
 	fragment map[mvcc.WatchID]bool
+	// linearizableProgressRunning is set while a linearizable progress
+	// request is served, and linearizableProgressPending when another one
+	// arrived meanwhile, to be served once it is done.
+	linearizableProgressRunning bool
+	linearizableProgressPending bool
 
 	// closec indicates the stream is closed.
 	closec chan struct{}
 
-	// wg waits for the send loop to complete
+	// wg waits for the send loop and linearizable progress requests to complete
 	wg sync.WaitGroup
 }
 
@@ -172,18 +230,32 @@ func (ws *watchServer) Watch(stream pb.Watch_WatchServer) (err error) {
 		sg:        ws.sg,
 		watchable: ws.watchable,
 		ag:        ws.ag,
+		subs:      ws.subs,
+		lr:        ws.lr,
+
+		limits:  ws.limits,
+		counts:  ws.counts,
+		limiter: newWatchLimiter(ws.limits),
 
 		gRPCStream:  stream,
 		watchStream: ws.watchable.NewWatchStream(),
 		// chan for sending control response like watcher created and canceled.
 		ctrlStream: make(chan *pb.WatchResponse, ctrlStreamBufLen),
 
-		progress: make(map[mvcc.WatchID]bool),
-		prevKV:   make(map[mvcc.WatchID]bool),
-		fragment: make(map[mvcc.WatchID]bool),
+		watchers:    make(map[mvcc.WatchID]struct{}),
+		progress:    make(map[mvcc.WatchID]bool),
+		prevKV:      make(map[mvcc.WatchID]bool),
+		prevFilters: make(map[mvcc.WatchID][]mvcc.FilterFunc),
+		fragment:    make(map[mvcc.WatchID]bool),
 
 		closec: make(chan struct{}),
 	}
+	if authInfo, err := ws.ag.AuthInfoFromCtx(stream.Context()); err == nil && authInfo != nil {
+		sws.user = authInfo.Username
+	}
+	if p, ok := peer.FromContext(stream.Context()); ok {
+		sws.remoteAddr = p.Addr.String()
+	}
 
 	sws.wg.Add(1)
 	go func() {
@@ -234,6 +306,34 @@ func (ws *watchServer) Watch(stream pb.Watch_WatchServer) (err error) {
 	return err
 }
 
+func (ws *watchServer) Subscription(ctx context.Context, r *pb.SubscriptionRequest) (*pb.SubscriptionResponse, error) {
+	if r.Action != pb.SubscriptionRequest_LIST && r.Name == "" {
+		return nil, rpctypes.ErrGRPCSubscriptionNameNotProvided
+	}
+	// a subscription clamps compaction for the whole cluster, so only
+	// admins may create, acknowledge or delete one
+	aa := AuthAdmin{ws.ag}
+	switch r.Action {
+	case pb.SubscriptionRequest_LIST:
+		if err := aa.requireAuthInfo(ctx); err != nil {
+			return nil, togRPCError(err)
+		}
+	default:
+		if err := aa.isPermitted(ctx); err != nil {
+			return nil, togRPCError(err)
+		}
+	}
+	resp, err := ws.subs.Subscription(ctx, r)
+	if err != nil {
+		return nil, togRPCError(err)
+	}
+	if resp.Header == nil {
+		resp.Header = &pb.ResponseHeader{}
+	}
+	ws.hdr.fill(resp.Header)
+	return resp, nil
+}
+
 func (sws *serverWatchStream) isWatchPermitted(wcr *pb.WatchCreateRequest) error {
 	authInfo, err := sws.ag.AuthInfoFromCtx(sws.gRPCStream.Context())
 	if err != nil {
@@ -243,7 +343,30 @@ func (sws *serverWatchStream) isWatchPermitted(wcr *pb.WatchCreateRequest) error
 		// if auth is enabled, IsRangePermitted() can cause an error
 		authInfo = &auth.AuthInfo{}
 	}
-	return sws.ag.AuthStore().IsRangePermitted(authInfo, wcr.Key, wcr.RangeEnd)
+	if err = sws.ag.AuthStore().IsRangePermitted(authInfo, wcr.Key, wcr.RangeEnd); err != nil {
+		return err
+	}
+	for _, r := range wcr.Ranges {
+		if err = sws.ag.AuthStore().IsRangePermitted(authInfo, r.Key, r.RangeEnd); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// watchRange converts a key and range end of a watch create request to the
+// range of an mvcc watcher.
+func watchRange(key, rangeEnd []byte) mvcc.KeyRange {
+	if len(rangeEnd) == 0 {
+		// force nil since watchstream.Watch distinguishes
+		// between nil and []byte{} for single key / >=
+		rangeEnd = nil
+	}
+	if len(rangeEnd) == 1 && rangeEnd[0] == 0 {
+		// support  >= key queries
+		rangeEnd = []byte{}
+	}
+	return mvcc.KeyRange{Key: key, End: rangeEnd}
 }
 
 func (sws *serverWatchStream) recvLoop() error {
@@ -317,40 +440,73 @@ func (sws *serverWatchStream) recvLoop() error {
 				}
 			}
 
-			if len(creq.RangeEnd) == 0 {
-				// force nil since watchstream.Watch distinguishes
-				// between nil and []byte{} for single key / >=
-				creq.RangeEnd = nil
+			if err := sws.admitWatcher(); err != nil {
+				wr := &pb.WatchResponse{
+					Header:       sws.newResponseHeader(sws.watchStream.Rev()),
+					WatchId:      clientv3.InvalidWatchID,
+					Canceled:     true,
+					Created:      true,
+					CancelReason: rpctypes.ErrorDesc(err),
+				}
+
+				select {
+				case sws.ctrlStream <- wr:
+					continue
+				case <-sws.closec:
+					return nil
+				}
+			}
+
+			if creq.StartRevision == 0 && creq.Subscription != "" {
+				// resume right after the revision the subscription acknowledged
+				if rev, ok := sws.subs.SubscriptionRevision(creq.Subscription); ok {
+					creq.StartRevision = rev + 1
+				}
 			}
-			if len(creq.RangeEnd) == 1 && creq.RangeEnd[0] == 0 {
-				// support  >= key queries
-				creq.RangeEnd = []byte{}
+
+			ranges := []mvcc.KeyRange{watchRange(creq.Key, creq.RangeEnd)}
+			for _, r := range creq.Ranges {
+				ranges = append(ranges, watchRange(r.Key, r.RangeEnd))
 			}
 
 			filters := FiltersFromRequest(creq)
 			ctx, _ := traceutil.Tracer.Start(sws.gRPCStream.Context(), "watch", trace.WithAttributes(
 				attribute.String("key", string(creq.Key)),
-				attribute.String("range_end", string(creq.RangeEnd)),
+				attribute.String("range_end", string(ranges[0].End)),
 				attribute.Int64("start_rev", creq.StartRevision),
 				attribute.Bool("progress_notify", creq.ProgressNotify),
 				attribute.Bool("prev_kv", creq.PrevKv),
 				attribute.Bool("fragment", creq.Fragment),
+				attribute.Int("ranges", len(ranges)),
+				attribute.Bool("coalesce", creq.Coalesce),
+				attribute.String("subscription", creq.Subscription),
 			))
 
-			id, err := sws.watchStream.Watch(ctx, mvcc.WatchID(creq.WatchId), creq.Key, creq.RangeEnd, creq.StartRevision, filters...)
+			opts := mvcc.WatchOptions{Filters: filters, Coalesce: creq.Coalesce, User: sws.user, RemoteAddr: sws.remoteAddr}
+			id, err := sws.watchStream.WatchRanges(ctx, mvcc.WatchID(creq.WatchId), ranges, creq.StartRevision, opts)
 			if err == nil {
 				sws.mu.Lock()
+				if sws.watchers != nil {
+					sws.watchers[id] = struct{}{}
+				} else {
+					// the stream was closed meanwhile
+					sws.counts.remove(sws.user, 1)
+				}
 				if creq.ProgressNotify {
 					sws.progress[id] = true
 				}
 				if creq.PrevKv {
 					sws.prevKV[id] = true
 				}
+				if prevFilters := PrevKVFiltersFromRequest(creq); len(prevFilters) != 0 {
+					sws.prevFilters[id] = prevFilters
+				}
 				if creq.Fragment {
 					sws.fragment[id] = true
 				}
 				sws.mu.Unlock()
 			} else {
+				sws.counts.remove(sws.user, 1)
 				id = clientv3.InvalidWatchID
 			}
 
@@ -386,14 +542,18 @@ func (sws *serverWatchStream) recvLoop() error {
 					}
 
 					sws.mu.Lock()
+					sws.releaseWatcher(mvcc.WatchID(id))
 					delete(sws.progress, mvcc.WatchID(id))
 					delete(sws.prevKV, mvcc.WatchID(id))
+					delete(sws.prevFilters, mvcc.WatchID(id))
 					delete(sws.fragment, mvcc.WatchID(id))
 					sws.mu.Unlock()
 				}
 			}
 		case *pb.WatchRequest_ProgressRequest:
-			if uv.ProgressRequest != nil {
+			if uv.ProgressRequest.GetLinearizable() {
+				sws.requestLinearizableProgress()
+			} else if uv.ProgressRequest != nil {
 				sws.mu.Lock()
 				sws.watchStream.RequestProgressAll()
 				sws.mu.Unlock()
@@ -408,6 +568,98 @@ func (sws *serverWatchStream) recvLoop() error {
 	}
 }
 
+// requestLinearizableProgress serves a linearizable progress request. At most
+// one request is served at a time on the stream; the requests arriving
+// meanwhile are merged and served once it is done.
+func (sws *serverWatchStream) requestLinearizableProgress() {
+	sws.mu.Lock()
+	defer sws.mu.Unlock()
+	select {
+	case <-sws.closec:
+		return
+	default:
+	}
+	if sws.linearizableProgressRunning {
+		sws.linearizableProgressPending = true
+		return
+	}
+	sws.linearizableProgressRunning = true
+	sws.wg.Add(1)
+	go func() {
+		defer sws.wg.Done()
+		sws.linearizableProgress()
+	}()
+}
+
+// linearizableProgress serves the linearizable progress requests of the
+// stream until none is pending.
+func (sws *serverWatchStream) linearizableProgress() {
+	ctx, cancel := context.WithCancel(sws.gRPCStream.Context())
+	defer cancel()
+	go func() {
+		select {
+		case <-sws.closec:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+	for {
+		sws.sendLinearizableProgress(ctx)
+		sws.mu.Lock()
+		if !sws.linearizableProgressPending || ctx.Err() != nil {
+			sws.linearizableProgressRunning = false
+			sws.linearizableProgressPending = false
+			sws.mu.Unlock()
+			return
+		}
+		sws.linearizableProgressPending = false
+		sws.mu.Unlock()
+	}
+}
+
+// sendLinearizableProgress sends a progress notification once the member
+// applied the entries committed in the cluster and all watchers of the stream
+// are synced, so that the watchers have seen all writes completed before.
+func (sws *serverWatchStream) sendLinearizableProgress(ctx context.Context) {
+	// the client waits for the progress notification, so the read is retried
+	// until it succeeds, e.g. once the member rejoins the cluster, or the
+	// stream is closed
+	for {
+		err := sws.lr.LinearizableReadNotify(ctx)
+		if err == nil {
+			break
+		}
+		if ctx.Err() != nil {
+			return
+		}
+		sws.lg.Warn("failed to wait for linearizable watch progress, retrying", zap.Error(err))
+		select {
+		case <-time.After(linearizableProgressRetryInterval):
+		case <-ctx.Done():
+			return
+		}
+	}
+	for {
+		sws.mu.Lock()
+		select {
+		case <-sws.closec:
+			sws.mu.Unlock()
+			return
+		default:
+		}
+		sent := sws.watchStream.RequestProgressAll()
+		sws.mu.Unlock()
+		if sent {
+			return
+		}
+		select {
+		case <-time.After(linearizableProgressRetryInterval):
+		case <-sws.closec:
+			return
+		}
+	}
+}
+
 func (sws *serverWatchStream) sendLoop() {
 	// watch ids that are currently active
 	ids := make(map[mvcc.WatchID]struct{})
@@ -441,28 +693,32 @@ func (sws *serverWatchStream) sendLoop() {
 
 			// TODO(fuweid): do we still need copy here?
 			evs := wresp.Events
-			events := make([]*mvccpb.Event, len(evs))
 			sws.mu.RLock()
 			needPrevKV := sws.prevKV[wresp.WatchID]
+			prevFilters := sws.prevFilters[wresp.WatchID]
 			sws.mu.RUnlock()
-			for i := range evs {
-				events[i] = evs[i]
-				if needPrevKV && !IsCreateEvent(evs[i]) {
-					opt := mvcc.RangeOptions{Rev: evs[i].Kv.ModRevision - 1}
-					r, err := sws.watchable.Range(context.TODO(), evs[i].Kv.Key, nil, opt)
-					if err == nil && len(r.KVs) != 0 {
-						events[i].PrevKv = r.KVs[0]
-					}
+			events := filterPrevKV(sws.watchable, evs, needPrevKV, prevFilters)
+			if filtered := len(evs) - len(events); filtered != 0 {
+				mvcc.ReportEventReceived(filtered)
+				if len(events) == 0 {
+					// all events are filtered out
+					continue
 				}
 			}
 
 			canceled := wresp.CompactRevision != 0
+			if canceled {
+				sws.mu.Lock()
+				sws.releaseWatcher(wresp.WatchID)
+				sws.mu.Unlock()
+			}
 			wr := &pb.WatchResponse{
 				Header:          sws.newResponseHeader(wresp.Revision),
 				WatchId:         int64(wresp.WatchID),
 				Events:          events,
 				CompactRevision: wresp.CompactRevision,
 				Canceled:        canceled,
+				Coalesced:       wresp.Coalesced,
 			}
 
 			// Progress notifications can have WatchID -1
@@ -476,12 +732,37 @@ func (sws *serverWatchStream) sendLoop() {
 				}
 			}
 
-			mvcc.ReportEventReceived(len(evs))
+			mvcc.ReportEventReceived(len(events))
 
 			sws.mu.RLock()
 			fragmented, ok := sws.fragment[wresp.WatchID]
 			sws.mu.RUnlock()
 
+			if len(events) > 0 {
+				ok, err := sws.throttle(len(events))
+				if !ok {
+					return
+				}
+				if err != nil {
+					// cancel the watcher rather than stall the stream
+					sws.watchStream.Cancel(wresp.WatchID)
+					sws.mu.Lock()
+					sws.releaseWatcher(wresp.WatchID)
+					delete(sws.progress, wresp.WatchID)
+					delete(sws.prevKV, wresp.WatchID)
+					delete(sws.prevFilters, wresp.WatchID)
+					delete(sws.fragment, wresp.WatchID)
+					sws.mu.Unlock()
+					wr = &pb.WatchResponse{
+						Header:       wr.Header,
+						WatchId:      wr.WatchId,
+						Canceled:     true,
+						CancelReason: rpctypes.ErrorDesc(err),
+					}
+					events = nil
+				}
+			}
+
 			var serr error
 			// gofail: var beforeSendWatchResponse struct{}
 			if !fragmented && !ok {
@@ -499,6 +780,10 @@ func (sws *serverWatchStream) sendLoop() {
 				}
 				return
 			}
+			sws.sent(len(events))
+			if len(events) > 0 && !wresp.Committed.IsZero() {
+				watchEventDeliveryLatency.Observe(time.Since(wresp.Committed).Seconds())
+			}
 
 			sws.mu.Lock()
 			if len(evs) > 0 && sws.progress[wresp.WatchID] {
@@ -549,6 +834,7 @@ func (sws *serverWatchStream) sendLoop() {
 						}
 						return
 					}
+					sws.sent(len(v.Events))
 				}
 				delete(pending, wid)
 			}
@@ -593,6 +879,7 @@ func sendFragments(
 	for {
 		// Keep this explicit field copy in sync with pb.WatchResponse.
 		// TestWatchResponseProtoFieldCount guards against missing new fields.
+		// PartialRevision is not copied, it is decided for each fragment.
 		//
 		// Header is the same for all fragments from one response, so
 		// it is safe to reuse. However, we cannot reuse wr itself.
@@ -608,6 +895,7 @@ func sendFragments(
 			Canceled:        wr.Canceled,
 			CompactRevision: wr.CompactRevision,
 			CancelReason:    wr.CancelReason,
+			Coalesced:       wr.Coalesced,
 			Fragment:        true,
 			Events:          make([]*mvccpb.Event, 0),
 		}
@@ -620,6 +908,17 @@ func sendFragments(
 			}
 			idx++
 		}
+		if idx < len(wr.Events) && wr.Events[idx].Kv.ModRevision == cur.Events[len(cur.Events)-1].Kv.ModRevision {
+			// the fragment ends within a revision; cut it at the last
+			// revision boundary, or mark the revision as continued if
+			// the fragment holds events of this revision only
+			if n := lastRevisionStart(cur.Events); n > 0 {
+				idx -= len(cur.Events) - n
+				cur.Events = cur.Events[:n]
+			} else {
+				cur.PartialRevision = true
+			}
+		}
 		if idx == len(wr.Events) {
 			// last response has no more fragment
 			cur.Fragment = false
@@ -634,10 +933,31 @@ func sendFragments(
 	return nil
 }
 
+// lastRevisionStart returns the index of the first event of the last
+// revision in events.
+func lastRevisionStart(events []*mvccpb.Event) int {
+	n := len(events) - 1
+	for n > 0 && events[n-1].Kv.ModRevision == events[n].Kv.ModRevision {
+		n--
+	}
+	return n
+}
+
 func (sws *serverWatchStream) close() {
+	// the streams are closed under mu so that no linearizable progress request
+	// is started once the wait group is waited on, or sent to closed watchers
+	sws.mu.Lock()
 	sws.watchStream.Close()
 	close(sws.closec)
+	sws.mu.Unlock()
 	sws.wg.Wait()
+
+	sws.mu.Lock()
+	if n := len(sws.watchers); n > 0 {
+		sws.counts.remove(sws.user, n)
+	}
+	sws.watchers = nil
+	sws.mu.Unlock()
 }
 
 func (sws *serverWatchStream) newResponseHeader(rev int64) *pb.ResponseHeader {
@@ -657,17 +977,108 @@ func filterNoPut(e *mvccpb.Event) bool {
 	return e.Type == mvccpb.Event_PUT
 }
 
+func filterNoUpdate(e *mvccpb.Event) bool {
+	return e.Type == mvccpb.Event_PUT && !IsCreateEvent(e)
+}
+
+func filterNoUnchanged(e *mvccpb.Event) bool {
+	return e.Type == mvccpb.Event_PUT && e.PrevKv != nil && bytes.Equal(e.Kv.Value, e.PrevKv.Value)
+}
+
+func filterValuePrefix(prefix []byte) mvcc.FilterFunc {
+	return func(e *mvccpb.Event) bool {
+		return e.Type == mvccpb.Event_PUT && !bytes.HasPrefix(e.Kv.Value, prefix)
+	}
+}
+
+func filterPutLease(id int64) mvcc.FilterFunc {
+	return func(e *mvccpb.Event) bool {
+		return e.Type == mvccpb.Event_PUT && e.Kv.Lease != id
+	}
+}
+
+func filterDeleteLease(id int64) mvcc.FilterFunc {
+	return func(e *mvccpb.Event) bool {
+		return e.Type == mvccpb.Event_DELETE && e.PrevKv != nil && e.PrevKv.Lease != id
+	}
+}
+
+// filterPrevKV returns the events left by the filters needing the previous
+// key-value pair of an event, with the pair set if needPrevKV. Each event
+// besides the ones creating a key costs a read of the pair at the revision
+// before it. If that revision is compacted, the event has no previous pair
+// and is left by the filters.
+func filterPrevKV(rv mvcc.ReadView, evs []*mvccpb.Event, needPrevKV bool, prevFilters []mvcc.FilterFunc) []*mvccpb.Event {
+	if !needPrevKV && len(prevFilters) == 0 {
+		return evs
+	}
+	events := make([]*mvccpb.Event, 0, len(evs))
+	for _, ev := range evs {
+		var prevKV *mvccpb.KeyValue
+		if !IsCreateEvent(ev) {
+			opt := mvcc.RangeOptions{Rev: ev.Kv.ModRevision - 1}
+			r, err := rv.Range(context.TODO(), ev.Kv.Key, nil, opt)
+			if err == nil && len(r.KVs) != 0 {
+				prevKV = r.KVs[0]
+			}
+		}
+		if len(prevFilters) != 0 && isFiltered(&mvccpb.Event{Type: ev.Type, Kv: ev.Kv, PrevKv: prevKV}, prevFilters) {
+			continue
+		}
+		if needPrevKV && prevKV != nil {
+			ev.PrevKv = prevKV
+		}
+		events = append(events, ev)
+	}
+	return events
+}
+
+func isFiltered(e *mvccpb.Event, filters []mvcc.FilterFunc) bool {
+	for _, filter := range filters {
+		if filter(e) {
+			return true
+		}
+	}
+	return false
+}
+
 // FiltersFromRequest returns "mvcc.FilterFunc" from a given watch create request.
+// The filters needing the previous key-value pair of an event are left out; see
+// PrevKVFiltersFromRequest.
 func FiltersFromRequest(creq *pb.WatchCreateRequest) []mvcc.FilterFunc {
-	filters := make([]mvcc.FilterFunc, 0, len(creq.Filters))
+	filters := make([]mvcc.FilterFunc, 0, len(creq.Filters)+2)
 	for _, ft := range creq.Filters {
 		switch ft {
 		case pb.WatchCreateRequest_NOPUT:
 			filters = append(filters, filterNoPut)
 		case pb.WatchCreateRequest_NODELETE:
 			filters = append(filters, filterNoDelete)
+		case pb.WatchCreateRequest_NOUPDATE:
+			filters = append(filters, filterNoUpdate)
 		default:
 		}
 	}
+	if len(creq.ValuePrefix) != 0 {
+		filters = append(filters, filterValuePrefix(creq.ValuePrefix))
+	}
+	if creq.Lease != 0 {
+		filters = append(filters, filterPutLease(creq.Lease))
+	}
+	return filters
+}
+
+// PrevKVFiltersFromRequest returns the "mvcc.FilterFunc" of a given watch create
+// request that need the previous key-value pair of an event. They are applied
+// to events carrying it, after the filters returned by FiltersFromRequest.
+func PrevKVFiltersFromRequest(creq *pb.WatchCreateRequest) []mvcc.FilterFunc {
+	var filters []mvcc.FilterFunc
+	for _, ft := range creq.Filters {
+		if ft == pb.WatchCreateRequest_NOUNCHANGED {
+			filters = append(filters, filterNoUnchanged)
+		}
+	}
+	if creq.Lease != 0 {
+		filters = append(filters, filterDeleteLease(creq.Lease))
+	}
 	return filters
 }
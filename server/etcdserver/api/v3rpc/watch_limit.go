@@ -0,0 +1,185 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v3rpc
+
+import (
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
+	"go.etcd.io/etcd/server/v3/storage/mvcc"
+)
+
+// watchLimits bounds the watchers and the event rate of watch streams, so that
+// a single client cannot degrade the watches of everyone else. A zero limit
+// means unlimited.
+type watchLimits struct {
+	maxWatchersPerStream int
+	// maxWatchersPerUser only applies to authenticated users
+	maxWatchersPerUser int
+	maxEventsPerSecond int
+}
+
+// maxWatchThrottleDelay is the longest a watch stream waits for the event rate
+// limit before a response. A watcher whose events would take longer is
+// canceled instead.
+var maxWatchThrottleDelay = 5 * time.Second
+
+// watcherCounts counts the watchers of each user across all watch streams.
+type watcherCounts struct {
+	mu     sync.Mutex
+	counts map[string]int
+	// userMetrics enables the metrics per user
+	userMetrics bool
+}
+
+func newWatcherCounts(userMetrics bool) *watcherCounts {
+	return &watcherCounts{counts: make(map[string]int), userMetrics: userMetrics}
+}
+
+// add counts a new watcher of the user, unless the user has limit watchers
+// already.
+func (wc *watcherCounts) add(user string, limit int) bool {
+	wc.mu.Lock()
+	defer wc.mu.Unlock()
+	if limit > 0 && user != "" && wc.counts[user] >= limit {
+		return false
+	}
+	wc.counts[user]++
+	if wc.userMetrics {
+		userWatchers.WithLabelValues(user).Inc()
+	}
+	return true
+}
+
+func (wc *watcherCounts) remove(user string, n int) {
+	wc.mu.Lock()
+	defer wc.mu.Unlock()
+	if wc.counts[user] -= n; wc.counts[user] <= 0 {
+		delete(wc.counts, user)
+		if wc.userMetrics {
+			userWatchers.DeleteLabelValues(user)
+		}
+		return
+	}
+	if wc.userMetrics {
+		userWatchers.WithLabelValues(user).Set(float64(wc.counts[user]))
+	}
+}
+
+// admitWatcher counts a new watcher on the stream, or returns the error of
+// the limit it exceeds.
+func (sws *serverWatchStream) admitWatcher() error {
+	sws.mu.RLock()
+	n := len(sws.watchers)
+	sws.mu.RUnlock()
+	if limit := sws.limits.maxWatchersPerStream; limit > 0 && n >= limit {
+		sws.rejected("stream")
+		return rpctypes.ErrGRPCTooManyWatchersOnStream
+	}
+	if !sws.counts.add(sws.user, sws.limits.maxWatchersPerUser) {
+		sws.rejected("user")
+		return rpctypes.ErrGRPCTooManyWatchersForUser
+	}
+	return nil
+}
+
+// rejected records a watcher rejected or canceled for exceeding limit.
+func (sws *serverWatchStream) rejected(limit string) {
+	watchersRejected.WithLabelValues(limit).Inc()
+	if sws.counts.userMetrics {
+		userWatchersRejected.WithLabelValues(sws.user, limit).Inc()
+	}
+}
+
+// sent records n events sent on the stream.
+func (sws *serverWatchStream) sent(n int) {
+	if sws.counts.userMetrics && n > 0 {
+		userWatchEventsSent.WithLabelValues(sws.user).Add(float64(n))
+	}
+}
+
+// releaseWatcher stops counting the watcher with the given id. sws.mu must be
+// held.
+func (sws *serverWatchStream) releaseWatcher(id mvcc.WatchID) {
+	if _, ok := sws.watchers[id]; ok {
+		delete(sws.watchers, id)
+		sws.counts.remove(sws.user, 1)
+	}
+}
+
+// throttle waits until the stream may send n more events. Rather than stall
+// the stream for longer than maxWatchThrottleDelay, it returns
+// ErrGRPCWatchRateExceeded without waiting, so that the watcher is canceled.
+// It returns false if the stream is closed meanwhile.
+//
+// A single response with more events than the limit allows within
+// maxWatchThrottleDelay, e.g. of a large txn, is never canceled: a watcher
+// resuming at its revision would get the same response again. It is charged
+// for the events allowed within maxWatchThrottleDelay and always waited for,
+// so that only the responses after it are held back.
+func (sws *serverWatchStream) throttle(n int) (bool, error) {
+	if sws.limiter == nil {
+		return true, nil
+	}
+	maxN := sws.limiter.Burst() + int(float64(sws.limiter.Limit())*maxWatchThrottleDelay.Seconds())
+	oversized := n > maxN
+	if oversized {
+		n = maxN
+	}
+	var delay time.Duration
+	var rs []*rate.Reservation
+	now := time.Now()
+	// a response may carry more events than the limiter bursts
+	for n > 0 {
+		m := min(n, sws.limiter.Burst())
+		r := sws.limiter.ReserveN(now, m)
+		rs = append(rs, r)
+		delay = r.DelayFrom(now)
+		n -= m
+	}
+	if delay <= 0 {
+		return true, nil
+	}
+	if delay > maxWatchThrottleDelay && !oversized {
+		// give back the events not sent, latest reservation first
+		for i := len(rs) - 1; i >= 0; i-- {
+			rs[i].CancelAt(now)
+		}
+		sws.rejected("rate")
+		return true, rpctypes.ErrGRPCWatchRateExceeded
+	}
+	t := time.NewTimer(delay)
+	defer t.Stop()
+	select {
+	case <-t.C:
+		watchThrottledSeconds.Add(delay.Seconds())
+		if sws.counts.userMetrics {
+			userWatchThrottledSeconds.WithLabelValues(sws.user).Add(delay.Seconds())
+		}
+		return true, nil
+	case <-sws.closec:
+		return false, nil
+	}
+}
+
+func newWatchLimiter(limits watchLimits) *rate.Limiter {
+	if limits.maxEventsPerSecond <= 0 {
+		return nil
+	}
+	return rate.NewLimiter(rate.Limit(limits.maxEventsPerSecond), limits.maxEventsPerSecond)
+}
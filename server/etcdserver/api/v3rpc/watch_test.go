@@ -20,9 +20,21 @@ import (
 	"math"
 	"reflect"
 	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+	"google.golang.org/protobuf/proto"
 
 	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
 	"go.etcd.io/etcd/api/v3/mvccpb"
+	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
+	"go.etcd.io/etcd/pkg/v3/traceutil"
+	"go.etcd.io/etcd/server/v3/lease"
+	betesting "go.etcd.io/etcd/server/v3/storage/backend/testing"
+	"go.etcd.io/etcd/server/v3/storage/mvcc"
 )
 
 func TestSendFragment(t *testing.T) {
@@ -84,8 +96,72 @@ func TestSendFragment(t *testing.T) {
 	}
 }
 
+func TestSendFragmentRevisionBoundary(t *testing.T) {
+	tt := []struct {
+		name      string
+		revs      []int64
+		partial   []bool
+		fragments [][]int64
+	}{
+		{
+			name:      "revisions fit into fragments",
+			revs:      []int64{2, 2, 3, 3},
+			partial:   []bool{false, false},
+			fragments: [][]int64{{2, 2}, {3, 3}},
+		},
+		{
+			name:      "cut at last revision boundary",
+			revs:      []int64{2, 3, 3, 4},
+			partial:   []bool{false, false, false},
+			fragments: [][]int64{{2}, {3, 3}, {4}},
+		},
+		{
+			name:      "revision larger than a fragment",
+			revs:      []int64{2, 2, 2, 2, 2, 3},
+			partial:   []bool{true, true, false},
+			fragments: [][]int64{{2, 2}, {2, 2}, {2, 3}},
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			wr := &pb.WatchResponse{}
+			for _, rev := range tc.revs {
+				wr.Events = append(wr.Events, &mvccpb.Event{Kv: &mvccpb.KeyValue{Key: bytes.Repeat([]byte("a"), 10), ModRevision: rev}})
+			}
+			// room for two events per fragment
+			maxRequestBytes := uint(proto.Size(&pb.WatchResponse{Fragment: true, Events: wr.Events[:3]}))
+
+			var resps []*pb.WatchResponse
+			err := sendFragments(wr, maxRequestBytes, func(wr *pb.WatchResponse) error {
+				resps = append(resps, wr)
+				return nil
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var partial []bool
+			var fragments [][]int64
+			for _, resp := range resps {
+				partial = append(partial, resp.PartialRevision)
+				var revs []int64
+				for _, ev := range resp.Events {
+					revs = append(revs, ev.Kv.ModRevision)
+				}
+				fragments = append(fragments, revs)
+			}
+			if !reflect.DeepEqual(partial, tc.partial) {
+				t.Errorf("expected partial revisions %v, got %v", tc.partial, partial)
+			}
+			if !reflect.DeepEqual(fragments, tc.fragments) {
+				t.Errorf("expected fragment revisions %v, got %v", tc.fragments, fragments)
+			}
+		})
+	}
+}
+
 func TestWatchResponseProtoFieldCount(t *testing.T) {
-	const expectedWatchResponseProtoFields = 8
+	const expectedWatchResponseProtoFields = 10
 
 	fields := 0
 	typ := reflect.TypeOf(pb.WatchResponse{})
@@ -104,6 +180,118 @@ func TestWatchResponseProtoFieldCount(t *testing.T) {
 	}
 }
 
+func TestFiltersFromRequest(t *testing.T) {
+	events := []*mvccpb.Event{
+		// created attached to lease 1
+		{Type: mvccpb.Event_PUT, Kv: &mvccpb.KeyValue{Key: []byte("a"), Value: []byte("x1"), CreateRevision: 2, ModRevision: 2, Lease: 1}},
+		// rewritten with the same value
+		{Type: mvccpb.Event_PUT, Kv: &mvccpb.KeyValue{Key: []byte("a"), Value: []byte("x1"), CreateRevision: 2, ModRevision: 3, Lease: 1},
+			PrevKv: &mvccpb.KeyValue{Key: []byte("a"), Value: []byte("x1"), CreateRevision: 2, ModRevision: 2, Lease: 1}},
+		// updated to a new value, detached from lease 1
+		{Type: mvccpb.Event_PUT, Kv: &mvccpb.KeyValue{Key: []byte("a"), Value: []byte("y"), CreateRevision: 2, ModRevision: 4},
+			PrevKv: &mvccpb.KeyValue{Key: []byte("a"), Value: []byte("x1"), CreateRevision: 2, ModRevision: 3, Lease: 1}},
+		// deleted while attached to lease 1
+		{Type: mvccpb.Event_DELETE, Kv: &mvccpb.KeyValue{Key: []byte("b"), ModRevision: 5},
+			PrevKv: &mvccpb.KeyValue{Key: []byte("b"), Value: []byte("x2"), CreateRevision: 1, ModRevision: 1, Lease: 1}},
+		// deleted while not attached to a lease
+		{Type: mvccpb.Event_DELETE, Kv: &mvccpb.KeyValue{Key: []byte("c"), ModRevision: 6},
+			PrevKv: &mvccpb.KeyValue{Key: []byte("c"), Value: []byte("y"), CreateRevision: 1, ModRevision: 1}},
+	}
+
+	tests := []struct {
+		name string
+		creq *pb.WatchCreateRequest
+		want []int
+	}{
+		{
+			name: "no filter",
+			creq: &pb.WatchCreateRequest{},
+			want: []int{0, 1, 2, 3, 4},
+		},
+		{
+			name: "no put",
+			creq: &pb.WatchCreateRequest{Filters: []pb.WatchCreateRequest_FilterType{pb.WatchCreateRequest_NOPUT}},
+			want: []int{3, 4},
+		},
+		{
+			name: "no unchanged",
+			creq: &pb.WatchCreateRequest{Filters: []pb.WatchCreateRequest_FilterType{pb.WatchCreateRequest_NOUNCHANGED}},
+			want: []int{0, 2, 3, 4},
+		},
+		{
+			name: "creates only",
+			creq: &pb.WatchCreateRequest{Filters: []pb.WatchCreateRequest_FilterType{pb.WatchCreateRequest_NOUPDATE, pb.WatchCreateRequest_NODELETE}},
+			want: []int{0},
+		},
+		{
+			name: "value prefix",
+			creq: &pb.WatchCreateRequest{ValuePrefix: []byte("x")},
+			want: []int{0, 1, 3, 4},
+		},
+		{
+			name: "lease",
+			creq: &pb.WatchCreateRequest{Lease: 1},
+			want: []int{0, 1, 3},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			filters := append(FiltersFromRequest(tc.creq), PrevKVFiltersFromRequest(tc.creq)...)
+			var got []int
+			for i, ev := range events {
+				if !isFiltered(ev, filters) {
+					got = append(got, i)
+				}
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("events = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestFilterPrevKV(t *testing.T) {
+	be, _ := betesting.NewDefaultTmpBackend(t)
+	defer betesting.Close(t, be)
+	s := mvcc.New(zaptest.NewLogger(t), be, &lease.FakeLessor{}, mvcc.StoreConfig{})
+	defer s.Close()
+
+	s.Put([]byte("a"), []byte("x"), lease.NoLease)
+	s.Put([]byte("a"), []byte("x"), lease.NoLease)
+	s.Put([]byte("b"), []byte("y"), lease.NoLease)
+	s.DeleteRange([]byte("b"), nil)
+	events := func() []*mvccpb.Event {
+		return []*mvccpb.Event{
+			{Type: mvccpb.Event_PUT, Kv: &mvccpb.KeyValue{Key: []byte("a"), Value: []byte("x"), CreateRevision: 2, ModRevision: 2, Version: 1}},
+			{Type: mvccpb.Event_PUT, Kv: &mvccpb.KeyValue{Key: []byte("a"), Value: []byte("x"), CreateRevision: 2, ModRevision: 3, Version: 2}},
+			{Type: mvccpb.Event_PUT, Kv: &mvccpb.KeyValue{Key: []byte("b"), Value: []byte("y"), CreateRevision: 4, ModRevision: 4, Version: 1}},
+			{Type: mvccpb.Event_DELETE, Kv: &mvccpb.KeyValue{Key: []byte("b"), ModRevision: 5}},
+		}
+	}
+	filters := PrevKVFiltersFromRequest(&pb.WatchCreateRequest{Filters: []pb.WatchCreateRequest_FilterType{pb.WatchCreateRequest_NOUNCHANGED}, Lease: 7})
+
+	got := filterPrevKV(s, events(), true, filters)
+	require.Len(t, got, 2)
+	assert.Equal(t, int64(2), got[0].Kv.ModRevision)
+	assert.Equal(t, int64(4), got[1].Kv.ModRevision)
+	assert.Nil(t, got[0].PrevKv)
+
+	got = filterPrevKV(s, events(), true, nil)
+	require.Len(t, got, 4)
+	assert.Equal(t, []byte("x"), got[1].PrevKv.Value)
+	assert.Equal(t, []byte("y"), got[3].PrevKv.Value)
+
+	// the previous key-value pairs are compacted, so the filters keep the events
+	done, err := s.Compact(traceutil.TODO(), 5)
+	require.NoError(t, err)
+	<-done
+	got = filterPrevKV(s, events(), true, filters)
+	require.Len(t, got, 4)
+	for _, ev := range got {
+		assert.Nil(t, ev.PrevKv)
+	}
+}
+
 func createResponse(dataSize, events int) (resp *pb.WatchResponse) {
 	resp = &pb.WatchResponse{Events: make([]*mvccpb.Event, events)}
 	for i := range resp.Events {
@@ -115,3 +303,55 @@ func createResponse(dataSize, events int) (resp *pb.WatchResponse) {
 	}
 	return resp
 }
+
+func TestWatchThrottle(t *testing.T) {
+	defer func(d time.Duration) { maxWatchThrottleDelay = d }(maxWatchThrottleDelay)
+	maxWatchThrottleDelay = 10 * time.Millisecond
+
+	sws := &serverWatchStream{
+		counts:  newWatcherCounts(false),
+		limiter: newWatchLimiter(watchLimits{maxEventsPerSecond: 100}),
+		closec:  make(chan struct{}),
+	}
+
+	ok, err := sws.throttle(100)
+	require.True(t, ok)
+	require.NoError(t, err)
+
+	// the events would wait for longer than maxWatchThrottleDelay
+	ok, err = sws.throttle(20)
+	require.True(t, ok)
+	require.ErrorIs(t, err, rpctypes.ErrGRPCWatchRateExceeded)
+
+	// a single revision with more events than the limit allows within
+	// maxWatchThrottleDelay is sent, or a watcher could never get past it
+	start := time.Now()
+	ok, err = sws.throttle(5000)
+	require.True(t, ok)
+	require.NoError(t, err)
+	require.Less(t, time.Since(start), 5*time.Second)
+
+	// the responses after it are throttled
+	ok, err = sws.throttle(20)
+	require.True(t, ok)
+	require.ErrorIs(t, err, rpctypes.ErrGRPCWatchRateExceeded)
+}
+
+func TestWatcherCountsUserMetrics(t *testing.T) {
+	wc := newWatcherCounts(false)
+	require.True(t, wc.add("alice", 0))
+	require.Zero(t, testutil.CollectAndCount(userWatchers))
+	wc.remove("alice", 1)
+
+	wc = newWatcherCounts(true)
+	require.True(t, wc.add("alice", 2))
+	require.True(t, wc.add("alice", 2))
+	require.False(t, wc.add("alice", 2))
+	require.Equal(t, 2.0, testutil.ToFloat64(userWatchers.WithLabelValues("alice")))
+	wc.remove("alice", 1)
+	require.Equal(t, 1.0, testutil.ToFloat64(userWatchers.WithLabelValues("alice")))
+
+	// the label of a user without watchers is removed
+	wc.remove("alice", 1)
+	require.Zero(t, testutil.CollectAndCount(userWatchers))
+}
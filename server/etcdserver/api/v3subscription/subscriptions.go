@@ -0,0 +1,138 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package v3subscription manages durable watch subscriptions, which record
+// the last revision a named watcher acknowledged and hold compaction back at
+// it.
+package v3subscription
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"sync"
+
+	"go.uber.org/zap"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/server/v3/storage/schema"
+)
+
+var ErrSubscriptionNotFound = errors.New("subscription not found")
+
+// SubscriptionStore persists subscriptions to the backend. Stored
+// subscriptions are never modified, so they can be handed out as is.
+type SubscriptionStore struct {
+	lg   *zap.Logger
+	mu   sync.Mutex
+	subs map[string]*pb.Subscription
+
+	be schema.SubscriptionBackend
+}
+
+func NewSubscriptionStore(lg *zap.Logger, be schema.SubscriptionBackend) (*SubscriptionStore, error) {
+	if lg == nil {
+		lg = zap.NewNop()
+	}
+	ret := &SubscriptionStore{lg: lg, subs: make(map[string]*pb.Subscription), be: be}
+	if err := ret.restore(); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// Ack moves the subscription with the given name to rev and sets its max
+// lag, creating the subscription if it does not exist. A subscription never
+// moves back to an earlier revision.
+func (s *SubscriptionStore) Ack(name string, rev, maxLag int64) *pb.Subscription {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sub := &pb.Subscription{Name: name, Revision: rev, MaxLag: maxLag}
+	if old, ok := s.subs[name]; ok {
+		sub.Revision = max(old.Revision, rev)
+	}
+	s.subs[name] = sub
+	s.be.MustPutSubscription(sub)
+	return sub
+}
+
+// Delete removes the subscription with the given name from the store.
+func (s *SubscriptionStore) Delete(name string) (*pb.Subscription, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sub, ok := s.subs[name]
+	if !ok {
+		return nil, ErrSubscriptionNotFound
+	}
+	delete(s.subs, name)
+	s.be.MustDeleteSubscription(name)
+	return sub, nil
+}
+
+// Get returns the subscription with the given name.
+func (s *SubscriptionStore) Get(name string) (*pb.Subscription, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sub, ok := s.subs[name]
+	return sub, ok
+}
+
+// List returns all subscriptions, ordered by name.
+func (s *SubscriptionStore) List() []*pb.Subscription {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ret := make([]*pb.Subscription, 0, len(s.subs))
+	for _, sub := range s.subs {
+		ret = append(ret, sub)
+	}
+	slices.SortFunc(ret, func(a, b *pb.Subscription) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return ret
+}
+
+// Oldest returns the oldest revision a subscription resumes from, among the
+// subscriptions lagging no more than their max lag behind rev, or 0 if there
+// are none.
+func (s *SubscriptionStore) Oldest(rev int64) int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var oldest int64
+	for _, sub := range s.subs {
+		if sub.MaxLag <= 0 || rev-sub.Revision > sub.MaxLag {
+			continue
+		}
+		// the subscription resumes right after the acknowledged revision
+		if next := sub.Revision + 1; oldest == 0 || next < oldest {
+			oldest = next
+		}
+	}
+	return oldest
+}
+
+func (s *SubscriptionStore) restore() error {
+	subs, err := s.be.GetAllSubscriptions()
+	if err != nil {
+		return err
+	}
+	for _, sub := range subs {
+		s.subs[sub.Name] = sub
+	}
+	return nil
+}
@@ -75,12 +75,17 @@ func checkPutAuth(as auth.AuthStore, ai *auth.AuthInfo, lessor lease.Lessor, r *
 		return err
 	}
 
-	if err := checkLeasePuts(as, ai, lessor, lease.LeaseID(r.Lease)); err != nil {
-		// The specified lease is already attached with a key that cannot
-		// be written by this user. It means the user cannot revoke the
-		// lease so attaching the lease to the newly written key should
-		// be forbidden.
-		return err
+	// The shared lease of a put with a TTL holds keys of any user; it is
+	// assigned by the server from a range of IDs clients cannot grant, so it
+	// is not checked.
+	if r.Ttl == 0 {
+		if err := checkLeasePuts(as, ai, lessor, lease.LeaseID(r.Lease)); err != nil {
+			// The specified lease is already attached with a key that cannot
+			// be written by this user. It means the user cannot revoke the
+			// lease so attaching the lease to the newly written key should
+			// be forbidden.
+			return err
+		}
 	}
 
 	if r.PrevKv {
@@ -93,6 +98,14 @@ func checkPutAuth(as auth.AuthStore, ai *auth.AuthInfo, lessor lease.Lessor, r *
 	return nil
 }
 
+func checkIncrementAuth(as auth.AuthStore, ai *auth.AuthInfo, lessor lease.Lessor, r *pb.IncrementRequest) error {
+	// the new value is returned in the response, so reading the key is implied
+	if err := as.IsRangePermitted(ai, r.Key, nil); err != nil {
+		return err
+	}
+	return checkPutAuth(as, ai, lessor, &pb.PutRequest{Key: r.Key, Lease: r.Lease})
+}
+
 func (aa *authApplierV3) Range(r *pb.RangeRequest) (*pb.RangeResponse, *traceutil.Trace, error) {
 	if err := aa.as.IsRangePermitted(&aa.authInfo, r.Key, r.RangeEnd); err != nil {
 		return nil, nil, err
@@ -157,6 +170,14 @@ func checkTxnReqsPermission(as auth.AuthStore, ai *auth.AuthInfo, lessor lease.L
 			if err := checkPutAuth(as, ai, lessor, tv.RequestPut); err != nil {
 				return err
 			}
+		case *pb.RequestOp_RequestIncrement:
+			if tv.RequestIncrement == nil {
+				continue
+			}
+
+			if err := checkIncrementAuth(as, ai, lessor, tv.RequestIncrement); err != nil {
+				return err
+			}
 		case *pb.RequestOp_RequestDeleteRange:
 			if tv.RequestDeleteRange == nil {
 				continue
@@ -188,6 +209,17 @@ func checkTxnReqsPermission(as auth.AuthStore, ai *auth.AuthInfo, lessor lease.L
 	return nil
 }
 
+func (aa *authApplierV3) LeaseGrant(lc *pb.LeaseGrantRequest) (*pb.LeaseGrantResponse, error) {
+	// a child lease is revoked along with its parent without further checks,
+	// so granting one needs the same permission as revoking the parent
+	if lc.Parent != 0 {
+		if err := checkLeasePuts(aa.as, &aa.authInfo, aa.lessor, lease.LeaseID(lc.Parent)); err != nil {
+			return nil, err
+		}
+	}
+	return aa.applierV3.LeaseGrant(lc)
+}
+
 func (aa *authApplierV3) LeaseRevoke(lc *pb.LeaseRevokeRequest) (*pb.LeaseRevokeResponse, error) {
 	if err := checkLeasePuts(aa.as, &aa.authInfo, aa.lessor, lease.LeaseID(lc.ID)); err != nil {
 		return nil, err
@@ -195,6 +227,38 @@ func (aa *authApplierV3) LeaseRevoke(lc *pb.LeaseRevokeRequest) (*pb.LeaseRevoke
 	return aa.applierV3.LeaseRevoke(lc)
 }
 
+func (aa *authApplierV3) LeaseExpire(lc *pb.LeaseRevokeRequest) (*pb.LeaseRevokeResponse, error) {
+	if err := checkLeasePuts(aa.as, &aa.authInfo, aa.lessor, lease.LeaseID(lc.ID)); err != nil {
+		return nil, err
+	}
+	return aa.applierV3.LeaseExpire(lc)
+}
+
+func (aa *authApplierV3) LeaseRevokeBatch(lc *pb.LeaseRevokeBatchRequest) (*pb.LeaseRevokeBatchResponse, error) {
+	for _, id := range lc.IDs {
+		if err := checkLeasePuts(aa.as, &aa.authInfo, aa.lessor, lease.LeaseID(id)); err != nil {
+			return nil, err
+		}
+	}
+	return aa.applierV3.LeaseRevokeBatch(lc)
+}
+
+func (aa *authApplierV3) LeaseUpdateTTL(lc *pb.LeaseUpdateTTLRequest) (*pb.LeaseUpdateTTLResponse, error) {
+	if err := checkLeasePuts(aa.as, &aa.authInfo, aa.lessor, lease.LeaseID(lc.ID)); err != nil {
+		return nil, err
+	}
+	return aa.applierV3.LeaseUpdateTTL(lc)
+}
+
+func (aa *authApplierV3) Subscription(r *pb.SubscriptionRequest) (*pb.SubscriptionResponse, error) {
+	if r.Action != pb.SubscriptionRequest_LIST {
+		if err := aa.as.IsAdminPermitted(&aa.authInfo); err != nil {
+			return nil, err
+		}
+	}
+	return aa.applierV3.Subscription(r)
+}
+
 func checkLeasePuts(as auth.AuthStore, ai *auth.AuthInfo, lessor lease.Lessor, leaseID lease.LeaseID) error {
 	l := lessor.Lookup(leaseID)
 	if l != nil {
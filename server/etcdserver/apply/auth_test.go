@@ -31,6 +31,8 @@ import (
 	"go.etcd.io/etcd/server/v3/auth"
 	"go.etcd.io/etcd/server/v3/etcdserver/api/membership"
 	"go.etcd.io/etcd/server/v3/etcdserver/api/v3alarm"
+	"go.etcd.io/etcd/server/v3/etcdserver/api/v3hold"
+	"go.etcd.io/etcd/server/v3/etcdserver/api/v3subscription"
 	"go.etcd.io/etcd/server/v3/etcdserver/cindex"
 	"go.etcd.io/etcd/server/v3/lease"
 	"go.etcd.io/etcd/server/v3/storage/backend"
@@ -87,6 +89,10 @@ func defaultAuthApplierV3(t *testing.T) *authApplierV3 {
 	kv := mvcc.NewStore(lg, be, lessor, mvcc.StoreConfig{})
 	alarmStore, err := v3alarm.NewAlarmStore(lg, schema.NewAlarmBackend(lg, be))
 	require.NoError(t, err)
+	holdStore, err := v3hold.NewHoldStore(lg, schema.NewRevisionHoldBackend(lg, be))
+	require.NoError(t, err)
+	subStore, err := v3subscription.NewSubscriptionStore(lg, schema.NewSubscriptionBackend(lg, be))
+	require.NoError(t, err)
 
 	tp, err := auth.NewTokenProvider(lg, "simple", dummyIndexWaiter, 300*time.Second)
 	require.NoError(t, err)
@@ -103,6 +109,8 @@ func defaultAuthApplierV3(t *testing.T) *authApplierV3 {
 			Logger:                       lg,
 			KV:                           kv,
 			AlarmStore:                   alarmStore,
+			HoldStore:                    holdStore,
+			SubscriptionStore:            subStore,
 			ConsistentIndex:              consistentIndex,
 			AuthStore:                    authStore,
 			Lessor:                       lessor,
@@ -267,6 +275,21 @@ func TestAuthApplierV3_AdminPermission(t *testing.T) {
 			request:               &InternalRaftRequestWrapper{InternalRaftRequest: &pb.InternalRaftRequest{LeaseRevoke: &pb.LeaseRevokeRequest{}}},
 			adminPermissionNeeded: false,
 		},
+		{
+			name:                  "LeaseExpire does not need admin permission",
+			request:               &InternalRaftRequestWrapper{InternalRaftRequest: &pb.InternalRaftRequest{LeaseExpire: &pb.LeaseRevokeRequest{}}},
+			adminPermissionNeeded: false,
+		},
+		{
+			name:                  "LeaseRevokeBatch does not need admin permission",
+			request:               &InternalRaftRequestWrapper{InternalRaftRequest: &pb.InternalRaftRequest{LeaseRevokeBatch: &pb.LeaseRevokeBatchRequest{}}},
+			adminPermissionNeeded: false,
+		},
+		{
+			name:                  "LeaseUpdateTTL does not need admin permission",
+			request:               &InternalRaftRequestWrapper{InternalRaftRequest: &pb.InternalRaftRequest{LeaseUpdateTtl: &pb.LeaseUpdateTTLRequest{}}},
+			adminPermissionNeeded: false,
+		},
 		{
 			name:                  "Alarm does not need admin permission",
 			request:               &InternalRaftRequestWrapper{InternalRaftRequest: &pb.InternalRaftRequest{Alarm: &pb.AlarmRequest{}}},
@@ -701,6 +724,79 @@ func TestAuthApplierV3_LeaseRevoke(t *testing.T) {
 	require.Equal(t, err, auth.ErrPermissionDenied)
 }
 
+// TestAuthApplierV3_LeaseGrantChild verifies user cannot grant a child lease under a lease
+// attached with a key out of range, while revoking a lease does not check its child leases
+func TestAuthApplierV3_LeaseGrantChild(t *testing.T) {
+	authApplier := defaultAuthApplierV3(t)
+	mustCreateRolesAndEnableAuth(t, authApplier)
+
+	_, err := authApplier.LeaseGrant(&pb.LeaseGrantRequest{
+		TTL: lease.MaxLeaseTTL,
+		ID:  leaseID,
+	})
+	require.NoError(t, err)
+
+	// The user should be able to grant a child lease
+	setAuthInfo(authApplier, userWriteOnly)
+	_, err = authApplier.LeaseGrant(&pb.LeaseGrantRequest{
+		TTL:    lease.MaxLeaseTTL,
+		ID:     leaseID + 1,
+		Parent: leaseID,
+	})
+	require.NoError(t, err)
+
+	// Put a key under the child lease outside user's key range
+	setAuthInfo(authApplier, userRoot)
+	_, _, err = authApplier.Put(&pb.PutRequest{
+		Key:   []byte(keyOutsideRange),
+		Value: []byte("1"),
+		Lease: leaseID + 1,
+	})
+	require.NoError(t, err)
+
+	// The user should not be able to grant a child lease under it
+	setAuthInfo(authApplier, userWriteOnly)
+	_, err = authApplier.LeaseGrant(&pb.LeaseGrantRequest{
+		TTL:    lease.MaxLeaseTTL,
+		ID:     leaseID + 2,
+		Parent: leaseID + 1,
+	})
+	require.Equal(t, err, auth.ErrPermissionDenied)
+
+	// The user should still be able to revoke the parent lease
+	_, err = authApplier.LeaseRevoke(&pb.LeaseRevokeRequest{
+		ID: leaseID,
+	})
+	require.NoError(t, err)
+}
+
+// TestAuthApplierV3_Subscription verifies only the root can acknowledge or delete
+// a subscription, while any user can list them
+func TestAuthApplierV3_Subscription(t *testing.T) {
+	authApplier := defaultAuthApplierV3(t)
+	mustCreateRolesAndEnableAuth(t, authApplier)
+
+	setAuthInfo(authApplier, userWriteOnly)
+	_, err := authApplier.Subscription(&pb.SubscriptionRequest{Action: pb.SubscriptionRequest_ACK, Name: "sub"})
+	require.Equal(t, auth.ErrPermissionDenied, err)
+
+	setAuthInfo(authApplier, userRoot)
+	_, err = authApplier.Subscription(&pb.SubscriptionRequest{Action: pb.SubscriptionRequest_ACK, Name: "sub"})
+	require.NoError(t, err)
+
+	setAuthInfo(authApplier, userReadOnly)
+	resp, err := authApplier.Subscription(&pb.SubscriptionRequest{Action: pb.SubscriptionRequest_LIST})
+	require.NoError(t, err)
+	require.Len(t, resp.Subscriptions, 1)
+
+	_, err = authApplier.Subscription(&pb.SubscriptionRequest{Action: pb.SubscriptionRequest_DELETE, Name: "sub"})
+	require.Equal(t, auth.ErrPermissionDenied, err)
+
+	setAuthInfo(authApplier, userRoot)
+	_, err = authApplier.Subscription(&pb.SubscriptionRequest{Action: pb.SubscriptionRequest_DELETE, Name: "sub"})
+	require.NoError(t, err)
+}
+
 // TestAuthApplierV3_UserGet verifies UserGet can only be performed by the user itself or the root
 func TestAuthApplierV3_UserGet(t *testing.T) {
 	tcs := []struct {
@@ -16,6 +16,7 @@ package apply
 
 import (
 	"context"
+	"errors"
 
 	"github.com/Masterminds/semver/v3"
 	"go.uber.org/zap"
@@ -70,8 +71,43 @@ func (a *applierV3backend) Compaction(compaction *pb.CompactionRequest) (*pb.Com
 		traceutil.Field{Key: "revision", Value: compaction.Revision},
 	)
 
-	ch, err := a.options.KV.Compact(trace, compaction.Revision)
+	rev := compaction.Revision
+	prefixes := make([]mvcc.PrefixCompaction, len(compaction.Prefixes))
+	for i, p := range compaction.Prefixes {
+		prefixes[i] = mvcc.PrefixCompaction{Prefix: p.Prefix, Revision: p.Revision}
+	}
+	clamped := false
+	held := a.options.HoldStore.Oldest()
+	// subscriptions within their max lag keep the revisions they resume from
+	if sub := a.options.SubscriptionStore.Oldest(a.options.KV.Rev()); sub != 0 && (held == 0 || sub < held) {
+		held = sub
+	}
+	if held != 0 {
+		for i := range prefixes {
+			if prefixes[i].Revision > held {
+				prefixes[i].Revision, clamped = held, true
+			}
+		}
+		if rev > held {
+			rev, clamped = held, true
+		}
+		if clamped {
+			a.options.Logger.Info(
+				"clamped compaction to the oldest held revision",
+				zap.Int64("requested-revision", compaction.Revision),
+				zap.Int64("held-revision", held),
+			)
+			trace.Step("clamp to the oldest held revision", traceutil.Field{Key: "revision", Value: rev})
+		}
+	}
+
+	ch, err := a.options.KV.CompactPrefixes(trace, rev, prefixes)
 	if err != nil {
+		if clamped && errors.Is(err, mvcc.ErrCompacted) {
+			// everything up to the held revision is compacted already
+			resp.Header.Revision = a.options.KV.Rev()
+			return resp, ch, trace, nil
+		}
 		return nil, ch, nil, err
 	}
 	// get the current revision. which key to get is not important.
@@ -81,28 +117,115 @@ func (a *applierV3backend) Compaction(compaction *pb.CompactionRequest) (*pb.Com
 }
 
 func (a *applierV3backend) LeaseGrant(lc *pb.LeaseGrantRequest) (*pb.LeaseGrantResponse, error) {
-	l, err := a.options.Lessor.Grant(lease.LeaseID(lc.ID), lc.TTL)
+	var l *lease.Lease
+	var err error
+	if lc.Parent != 0 {
+		l, err = a.options.Lessor.GrantChild(lease.LeaseID(lc.ID), lease.LeaseID(lc.Parent), lc.TTL)
+	} else {
+		l, err = a.options.Lessor.Grant(lease.LeaseID(lc.ID), lc.TTL)
+	}
 	resp := &pb.LeaseGrantResponse{}
 	if err == nil {
 		resp.ID = int64(l.ID)
 		resp.TTL = l.TTL()
 		resp.Header = a.newHeader()
+		a.notifyLeaseEvents(&pb.LeaseEvent{
+			Type:     pb.LeaseEvent_GRANT,
+			ID:       resp.ID,
+			TTL:      resp.TTL,
+			Parent:   lc.Parent,
+			Revision: resp.Header.Revision,
+		})
 	}
 	return resp, err
 }
 
 func (a *applierV3backend) LeaseRevoke(lc *pb.LeaseRevokeRequest) (*pb.LeaseRevokeResponse, error) {
-	err := a.options.Lessor.Revoke(lease.LeaseID(lc.ID))
+	err := a.revokeLease(lease.LeaseID(lc.ID), pb.LeaseEvent_REVOKE)
 	return &pb.LeaseRevokeResponse{Header: a.newHeader()}, err
 }
 
+// LeaseExpire revokes a lease the same way LeaseRevoke does, but tells the
+// lease watchers that the lease expired.
+func (a *applierV3backend) LeaseExpire(lc *pb.LeaseRevokeRequest) (*pb.LeaseRevokeResponse, error) {
+	err := a.revokeLease(lease.LeaseID(lc.ID), pb.LeaseEvent_EXPIRE)
+	return &pb.LeaseRevokeResponse{Header: a.newHeader()}, err
+}
+
+func (a *applierV3backend) LeaseRevokeBatch(lc *pb.LeaseRevokeBatchRequest) (*pb.LeaseRevokeBatchResponse, error) {
+	resp := &pb.LeaseRevokeBatchResponse{}
+	for _, id := range lc.IDs {
+		err := a.revokeLease(lease.LeaseID(id), pb.LeaseEvent_REVOKE)
+		if errors.Is(err, lease.ErrLeaseNotFound) {
+			continue
+		}
+		if err != nil {
+			return resp, err
+		}
+		resp.Revoked = append(resp.Revoked, id)
+	}
+	resp.Header = a.newHeader()
+	return resp, nil
+}
+
+// revokeLease revokes the lease with the given ID along with its child leases,
+// and releases the revision holds attached to them. The lease watchers get an
+// event of type typ for each revoked lease, with the keys it had attached.
+func (a *applierV3backend) revokeLease(id lease.LeaseID, typ pb.LeaseEvent_EventType) error {
+	var ls []*lease.Lease
+	if l := a.options.Lessor.Lookup(id); l != nil {
+		ls = append(l.Descendants(), l)
+	}
+	// the keys are detached by the revoke, so gather them beforehand
+	events := make([]*pb.LeaseEvent, 0, len(ls))
+	for _, l := range ls {
+		ev := &pb.LeaseEvent{Type: typ, ID: int64(l.ID), Parent: int64(l.Parent())}
+		for _, k := range l.Keys() {
+			ev.Keys = append(ev.Keys, []byte(k))
+		}
+		events = append(events, ev)
+	}
+	if err := a.options.Lessor.Revoke(id); err != nil {
+		return err
+	}
+	rev := a.options.KV.Rev()
+	for _, ev := range events {
+		a.options.HoldStore.ReleaseLease(ev.ID)
+		ev.Revision = rev
+	}
+	a.notifyLeaseEvents(events...)
+	return nil
+}
+
+func (a *applierV3backend) notifyLeaseEvents(events ...*pb.LeaseEvent) {
+	if a.options.LeaseEvents != nil {
+		a.options.LeaseEvents.Notify(events)
+	}
+}
+
+func (a *applierV3backend) LeaseUpdateTTL(lc *pb.LeaseUpdateTTLRequest) (*pb.LeaseUpdateTTLResponse, error) {
+	l, err := a.options.Lessor.UpdateTTL(lease.LeaseID(lc.ID), lc.TTL)
+	resp := &pb.LeaseUpdateTTLResponse{}
+	if err == nil {
+		resp.ID = int64(l.ID)
+		resp.TTL = l.TTL()
+		resp.Header = a.newHeader()
+	}
+	return resp, err
+}
+
 func (a *applierV3backend) LeaseCheckpoint(lc *pb.LeaseCheckpointRequest) (*pb.LeaseCheckpointResponse, error) {
+	rev := a.options.KV.Rev()
+	events := make([]*pb.LeaseEvent, 0, len(lc.Checkpoints))
 	for _, c := range lc.Checkpoints {
 		err := a.options.Lessor.Checkpoint(lease.LeaseID(c.ID), c.Remaining_TTL)
 		if err != nil {
+			a.notifyLeaseEvents(events...)
 			return &pb.LeaseCheckpointResponse{Header: a.newHeader()}, err
 		}
+		events = append(events, &pb.LeaseEvent{Type: pb.LeaseEvent_CHECKPOINT, ID: c.ID, TTL: c.Remaining_TTL, Revision: rev})
 	}
+	a.notifyLeaseEvents(events...)
 	return &pb.LeaseCheckpointResponse{Header: a.newHeader()}, nil
 }
 
@@ -135,6 +258,79 @@ func (a *applierV3backend) Alarm(ar *pb.AlarmRequest) (*pb.AlarmResponse, error)
 	return resp, nil
 }
 
+func (a *applierV3backend) RevisionHold(r *pb.InternalRevisionHoldRequest) (*pb.RevisionHoldResponse, error) {
+	resp := &pb.RevisionHoldResponse{}
+
+	switch r.Request.Action {
+	case pb.RevisionHoldRequest_LIST:
+		resp.Holds = a.options.HoldStore.List()
+	case pb.RevisionHoldRequest_CREATE:
+		h := &pb.RevisionHold{
+			ID:         r.Request.ID,
+			Revision:   r.Request.Revision,
+			Lease:      r.Request.Lease,
+			CreateTime: r.CreateTime,
+		}
+		switch {
+		case h.Revision == 0:
+			h.Revision = a.options.KV.Rev()
+		case h.Revision < a.options.KV.FirstRev():
+			return nil, mvcc.ErrCompacted
+		case h.Revision > a.options.KV.Rev():
+			return nil, mvcc.ErrFutureRev
+		}
+		if h.Lease != 0 && a.options.Lessor.Lookup(lease.LeaseID(h.Lease)) == nil {
+			return nil, lease.ErrLeaseNotFound
+		}
+		if err := a.options.HoldStore.Create(h); err != nil {
+			return nil, err
+		}
+		resp.Holds = append(resp.Holds, h)
+	case pb.RevisionHoldRequest_RELEASE:
+		h, err := a.options.HoldStore.Release(r.Request.ID)
+		if err != nil {
+			return nil, err
+		}
+		resp.Holds = append(resp.Holds, h)
+	}
+	resp.Header = a.newHeader()
+	return resp, nil
+}
+
+func (a *applierV3backend) Subscription(r *pb.SubscriptionRequest) (*pb.SubscriptionResponse, error) {
+	resp := &pb.SubscriptionResponse{}
+
+	switch r.Action {
+	case pb.SubscriptionRequest_LIST:
+		for _, sub := range a.options.SubscriptionStore.List() {
+			if r.Name == "" || r.Name == sub.Name {
+				resp.Subscriptions = append(resp.Subscriptions, sub)
+			}
+		}
+	case pb.SubscriptionRequest_ACK:
+		rev := r.Revision
+		switch {
+		case rev == 0:
+			rev = a.options.KV.Rev()
+		case rev > a.options.KV.Rev():
+			return nil, mvcc.ErrFutureRev
+		}
+		if _, ok := a.options.SubscriptionStore.Get(r.Name); !ok && rev+1 < a.options.KV.FirstRev() {
+			// a new subscription cannot resume from compacted revisions
+			return nil, mvcc.ErrCompacted
+		}
+		resp.Subscriptions = append(resp.Subscriptions, a.options.SubscriptionStore.Ack(r.Name, rev, r.MaxLag))
+	case pb.SubscriptionRequest_DELETE:
+		sub, err := a.options.SubscriptionStore.Delete(r.Name)
+		if err != nil {
+			return nil, err
+		}
+		resp.Subscriptions = append(resp.Subscriptions, sub)
+	}
+	resp.Header = a.newHeader()
+	return resp, nil
+}
+
 func (a *applierV3backend) AuthEnable() (*pb.AuthEnableResponse, error) {
 	err := a.options.AuthStore.AuthEnable()
 	if err != nil {
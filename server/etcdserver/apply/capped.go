@@ -44,3 +44,11 @@ func (a *applierV3Capped) Txn(r *pb.TxnRequest, skipRangeExecution bool) (*pb.Tx
 func (a *applierV3Capped) LeaseGrant(_ *pb.LeaseGrantRequest) (*pb.LeaseGrantResponse, error) {
 	return nil, errors.ErrNoSpace
 }
+
+func (a *applierV3Capped) RevisionHold(r *pb.InternalRevisionHoldRequest) (*pb.RevisionHoldResponse, error) {
+	// new holds keep the space of old revisions from being reclaimed
+	if r.Request.Action == pb.RevisionHoldRequest_CREATE {
+		return nil, errors.ErrNoSpace
+	}
+	return a.applierV3.RevisionHold(r)
+}
@@ -27,6 +27,9 @@ import (
 	"go.etcd.io/etcd/server/v3/auth"
 	"go.etcd.io/etcd/server/v3/etcdserver/api/membership"
 	"go.etcd.io/etcd/server/v3/etcdserver/api/v3alarm"
+	"go.etcd.io/etcd/server/v3/etcdserver/api/v3hold"
+	"go.etcd.io/etcd/server/v3/etcdserver/api/v3leasewatch"
+	"go.etcd.io/etcd/server/v3/etcdserver/api/v3subscription"
 	"go.etcd.io/etcd/server/v3/etcdserver/cindex"
 	"go.etcd.io/etcd/server/v3/lease"
 	"go.etcd.io/etcd/server/v3/storage/backend"
@@ -55,11 +58,18 @@ type applierV3 interface {
 
 	LeaseGrant(lc *pb.LeaseGrantRequest) (*pb.LeaseGrantResponse, error)
 	LeaseRevoke(lc *pb.LeaseRevokeRequest) (*pb.LeaseRevokeResponse, error)
+	LeaseRevokeBatch(lc *pb.LeaseRevokeBatchRequest) (*pb.LeaseRevokeBatchResponse, error)
+	LeaseExpire(lc *pb.LeaseRevokeRequest) (*pb.LeaseRevokeResponse, error)
+	LeaseUpdateTTL(lc *pb.LeaseUpdateTTLRequest) (*pb.LeaseUpdateTTLResponse, error)
 
 	LeaseCheckpoint(lc *pb.LeaseCheckpointRequest) (*pb.LeaseCheckpointResponse, error)
 
 	Alarm(*pb.AlarmRequest) (*pb.AlarmResponse, error)
 
+	RevisionHold(r *pb.InternalRevisionHoldRequest) (*pb.RevisionHoldResponse, error)
+
+	Subscription(r *pb.SubscriptionRequest) (*pb.SubscriptionResponse, error)
+
 	Authenticate(r *pb.InternalAuthenticateRequest) (*pb.AuthenticateResponse, error)
 
 	AuthEnable() (*pb.AuthEnableResponse, error)
@@ -88,6 +98,9 @@ type ApplierOptions struct {
 	Logger                       *zap.Logger
 	KV                           mvcc.KV
 	AlarmStore                   *v3alarm.AlarmStore
+	HoldStore                    *v3hold.HoldStore
+	SubscriptionStore            *v3subscription.SubscriptionStore
+	LeaseEvents                  *v3leasewatch.Broadcaster
 	AuthStore                    auth.AuthStore
 	Lessor                       lease.Lessor
 	Cluster                      *membership.RaftCluster
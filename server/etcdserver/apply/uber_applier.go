@@ -149,12 +149,27 @@ func (a *uberApplier) dispatch(r *InternalRaftRequestWrapper, shouldApplyV3 memb
 	case r.LeaseRevoke != nil:
 		op = "LeaseRevoke"
 		ar.Resp, ar.Err = a.applyV3.LeaseRevoke(r.LeaseRevoke)
+	case r.LeaseExpire != nil:
+		op = "LeaseExpire"
+		ar.Resp, ar.Err = a.applyV3.LeaseExpire(r.LeaseExpire)
+	case r.LeaseRevokeBatch != nil:
+		op = "LeaseRevokeBatch"
+		ar.Resp, ar.Err = a.applyV3.LeaseRevokeBatch(r.LeaseRevokeBatch)
+	case r.LeaseUpdateTtl != nil:
+		op = "LeaseUpdateTTL"
+		ar.Resp, ar.Err = a.applyV3.LeaseUpdateTTL(r.LeaseUpdateTtl)
 	case r.LeaseCheckpoint != nil:
 		op = "LeaseCheckpoint"
 		ar.Resp, ar.Err = a.applyV3.LeaseCheckpoint(r.LeaseCheckpoint)
 	case r.Alarm != nil:
 		op = "Alarm"
 		ar.Resp, ar.Err = a.Alarm(r.Alarm)
+	case r.RevisionHold != nil:
+		op = "RevisionHold"
+		ar.Resp, ar.Err = a.applyV3.RevisionHold(r.RevisionHold)
+	case r.Subscription != nil:
+		op = "Subscription"
+		ar.Resp, ar.Err = a.applyV3.Subscription(r.Subscription)
 	case r.Authenticate != nil:
 		op = "Authenticate"
 		ar.Resp, ar.Err = a.applyV3.Authenticate(r.Authenticate)
@@ -22,11 +22,15 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap/zaptest"
 	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/protobuf/proto"
 
 	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
 	"go.etcd.io/etcd/server/v3/auth"
 	"go.etcd.io/etcd/server/v3/etcdserver/api/membership"
 	"go.etcd.io/etcd/server/v3/etcdserver/api/v3alarm"
+	"go.etcd.io/etcd/server/v3/etcdserver/api/v3hold"
+	"go.etcd.io/etcd/server/v3/etcdserver/api/v3leasewatch"
+	"go.etcd.io/etcd/server/v3/etcdserver/api/v3subscription"
 	"go.etcd.io/etcd/server/v3/etcdserver/cindex"
 	"go.etcd.io/etcd/server/v3/etcdserver/errors"
 	"go.etcd.io/etcd/server/v3/lease"
@@ -38,6 +42,10 @@ import (
 const memberID = 111195
 
 func defaultUberApplier(t *testing.T) UberApplier {
+	return NewUberApplier(defaultApplierOptions(t))
+}
+
+func defaultApplierOptions(t *testing.T) ApplierOptions {
 	lg := zaptest.NewLogger(t)
 	be, _ := betesting.NewDefaultTmpBackend(t)
 	t.Cleanup(func() {
@@ -51,6 +59,10 @@ func defaultUberApplier(t *testing.T) UberApplier {
 	kv := mvcc.NewStore(lg, be, lessor, mvcc.StoreConfig{})
 	alarmStore, err := v3alarm.NewAlarmStore(lg, schema.NewAlarmBackend(lg, be))
 	require.NoError(t, err)
+	holdStore, err := v3hold.NewHoldStore(lg, schema.NewRevisionHoldBackend(lg, be))
+	require.NoError(t, err)
+	subStore, err := v3subscription.NewSubscriptionStore(lg, schema.NewSubscriptionBackend(lg, be))
+	require.NoError(t, err)
 
 	tp, err := auth.NewTokenProvider(lg, "simple", dummyIndexWaiter, 300*time.Second)
 	require.NoError(t, err)
@@ -61,10 +73,12 @@ func defaultUberApplier(t *testing.T) UberApplier {
 		bcrypt.DefaultCost,
 	)
 	consistentIndex := cindex.NewConsistentIndex(be)
-	opts := ApplierOptions{
+	return ApplierOptions{
 		Logger:                       lg,
 		KV:                           kv,
 		AlarmStore:                   alarmStore,
+		HoldStore:                    holdStore,
+		SubscriptionStore:            subStore,
 		AuthStore:                    authStore,
 		Lessor:                       lessor,
 		Cluster:                      cluster,
@@ -76,7 +90,6 @@ func defaultUberApplier(t *testing.T) UberApplier {
 		QuotaBackendBytesCfg:         16 * 1024 * 1024, // 16MB
 		WarningApplyDuration:         time.Hour,
 	}
-	return NewUberApplier(opts)
 }
 
 // TestUberApplier_Alarm_Corrupt tests the applier returns ErrCorrupt after alarm CORRUPT is activated
@@ -121,6 +134,26 @@ func TestUberApplier_Alarm_Corrupt(t *testing.T) {
 			request:     &InternalRaftRequestWrapper{InternalRaftRequest: &pb.InternalRaftRequest{LeaseRevoke: &pb.LeaseRevokeRequest{}}},
 			expectError: errors.ErrCorrupt,
 		},
+		{
+			name:        "LeaseExpire request returns ErrCorrupt after alarm CORRUPT is activated",
+			request:     &InternalRaftRequestWrapper{InternalRaftRequest: &pb.InternalRaftRequest{LeaseExpire: &pb.LeaseRevokeRequest{}}},
+			expectError: errors.ErrCorrupt,
+		},
+		{
+			name:        "LeaseRevokeBatch request returns ErrCorrupt after alarm CORRUPT is activated",
+			request:     &InternalRaftRequestWrapper{InternalRaftRequest: &pb.InternalRaftRequest{LeaseRevokeBatch: &pb.LeaseRevokeBatchRequest{}}},
+			expectError: errors.ErrCorrupt,
+		},
+		{
+			name:        "LeaseUpdateTTL request returns ErrCorrupt after alarm CORRUPT is activated",
+			request:     &InternalRaftRequestWrapper{InternalRaftRequest: &pb.InternalRaftRequest{LeaseUpdateTtl: &pb.LeaseUpdateTTLRequest{}}},
+			expectError: errors.ErrCorrupt,
+		},
+		{
+			name:        "RevisionHold request returns ErrCorrupt after alarm CORRUPT is activated",
+			request:     &InternalRaftRequestWrapper{InternalRaftRequest: &pb.InternalRaftRequest{RevisionHold: &pb.InternalRevisionHoldRequest{Request: &pb.RevisionHoldRequest{}}}},
+			expectError: errors.ErrCorrupt,
+		},
 	}
 
 	ua := defaultUberApplier(t)
@@ -279,3 +312,190 @@ func TestUberApplier_Alarm_Deactivate(t *testing.T) {
 	require.NotNil(t, result)
 	assert.NoError(t, result.Err)
 }
+
+// TestUberApplier_RevisionHold_Compaction tests compactions are clamped to the oldest held revision
+func TestUberApplier_RevisionHold_Compaction(t *testing.T) {
+	ua := defaultUberApplier(t)
+	apply := func(r *pb.InternalRaftRequest) *Result {
+		r.Header = &pb.RequestHeader{}
+		result := ua.Apply(&InternalRaftRequestWrapper{InternalRaftRequest: r}, membership.ApplyBoth)
+		require.NotNil(t, result)
+		return result
+	}
+	holdRevision := func(rev, lease int64) {
+		result := apply(&pb.InternalRaftRequest{RevisionHold: &pb.InternalRevisionHoldRequest{
+			Request: &pb.RevisionHoldRequest{Action: pb.RevisionHoldRequest_CREATE, ID: rev, Revision: rev, Lease: lease},
+		}})
+		require.NoError(t, result.Err)
+	}
+	compact := func(rev int64) {
+		result := apply(&pb.InternalRaftRequest{Compaction: &pb.CompactionRequest{Revision: rev}})
+		require.NoError(t, result.Err)
+		<-result.Physc
+	}
+	rangeErr := func(rev int64) error {
+		return apply(&pb.InternalRaftRequest{Range: &pb.RangeRequest{Key: []byte(key), Revision: rev}}).Err
+	}
+	for range 5 {
+		require.NoError(t, apply(&pb.InternalRaftRequest{Put: &pb.PutRequest{Key: []byte(key)}}).Err)
+	}
+	require.NoError(t, apply(&pb.InternalRaftRequest{LeaseGrant: &pb.LeaseGrantRequest{ID: 1, TTL: 60}}).Err)
+	require.NoError(t, apply(&pb.InternalRaftRequest{LeaseGrant: &pb.LeaseGrantRequest{ID: 2, TTL: 60, Parent: 1}}).Err)
+	holdRevision(3, 2)
+	holdRevision(4, 0)
+
+	compact(5)
+	require.ErrorIs(t, rangeErr(2), mvcc.ErrCompacted)
+	require.NoError(t, rangeErr(3))
+
+	// holds attached to a lease, or to its child leases, are released with it
+	require.NoError(t, apply(&pb.InternalRaftRequest{LeaseRevoke: &pb.LeaseRevokeRequest{ID: 1}}).Err)
+	result := apply(&pb.InternalRaftRequest{RevisionHold: &pb.InternalRevisionHoldRequest{Request: &pb.RevisionHoldRequest{}}})
+	require.NoError(t, result.Err)
+	holds := result.Resp.(*pb.RevisionHoldResponse).Holds
+	require.Len(t, holds, 1)
+	assert.Equal(t, int64(4), holds[0].Revision)
+
+	compact(5)
+	require.ErrorIs(t, rangeErr(3), mvcc.ErrCompacted)
+	require.NoError(t, rangeErr(4))
+
+	// compacting up to the held revision again is a no-op
+	compact(5)
+
+	result = apply(&pb.InternalRaftRequest{RevisionHold: &pb.InternalRevisionHoldRequest{Request: &pb.RevisionHoldRequest{Action: pb.RevisionHoldRequest_CREATE, Revision: 3}}})
+	require.ErrorIs(t, result.Err, mvcc.ErrCompacted)
+	result = apply(&pb.InternalRaftRequest{RevisionHold: &pb.InternalRevisionHoldRequest{Request: &pb.RevisionHoldRequest{Action: pb.RevisionHoldRequest_CREATE, Lease: 1}}})
+	require.ErrorIs(t, result.Err, lease.ErrLeaseNotFound)
+	result = apply(&pb.InternalRaftRequest{RevisionHold: &pb.InternalRevisionHoldRequest{Request: &pb.RevisionHoldRequest{Action: pb.RevisionHoldRequest_CREATE, ID: 4}}})
+	require.ErrorIs(t, result.Err, v3hold.ErrHoldExists)
+
+	require.NoError(t, apply(&pb.InternalRaftRequest{RevisionHold: &pb.InternalRevisionHoldRequest{Request: &pb.RevisionHoldRequest{Action: pb.RevisionHoldRequest_RELEASE, ID: 4}}}).Err)
+	result = apply(&pb.InternalRaftRequest{RevisionHold: &pb.InternalRevisionHoldRequest{Request: &pb.RevisionHoldRequest{Action: pb.RevisionHoldRequest_RELEASE, ID: 4}}})
+	require.ErrorIs(t, result.Err, v3hold.ErrHoldNotFound)
+	compact(5)
+	require.ErrorIs(t, rangeErr(4), mvcc.ErrCompacted)
+}
+
+// TestUberApplier_LeaseEvents tests the lease watchers get the events of the
+// applied lease requests
+func TestUberApplier_LeaseEvents(t *testing.T) {
+	opts := defaultApplierOptions(t)
+	opts.LeaseEvents = v3leasewatch.NewBroadcaster()
+	ua := NewUberApplier(opts)
+	apply := func(r *pb.InternalRaftRequest) {
+		r.Header = &pb.RequestHeader{}
+		result := ua.Apply(&InternalRaftRequestWrapper{InternalRaftRequest: r}, membership.ApplyBoth)
+		require.NotNil(t, result)
+		require.NoError(t, result.Err)
+	}
+	all := opts.LeaseEvents.Watch(0, true)
+	parent := opts.LeaseEvents.Watch(1, false)
+
+	apply(&pb.InternalRaftRequest{LeaseGrant: &pb.LeaseGrantRequest{ID: 1, TTL: 60}})
+	apply(&pb.InternalRaftRequest{LeaseGrant: &pb.LeaseGrantRequest{ID: 2, TTL: 30, Parent: 1}})
+	apply(&pb.InternalRaftRequest{Put: &pb.PutRequest{Key: []byte(key), Lease: 2}})
+	apply(&pb.InternalRaftRequest{LeaseCheckpoint: &pb.LeaseCheckpointRequest{Checkpoints: []*pb.LeaseCheckpoint{{ID: 1, Remaining_TTL: 20}}}})
+	apply(&pb.InternalRaftRequest{LeaseExpire: &pb.LeaseRevokeRequest{ID: 1}})
+	apply(&pb.InternalRaftRequest{LeaseGrant: &pb.LeaseGrantRequest{ID: 3, TTL: 60}})
+	apply(&pb.InternalRaftRequest{LeaseRevoke: &pb.LeaseRevokeRequest{ID: 3}})
+	opts.LeaseEvents.Cancel(all)
+	opts.LeaseEvents.Cancel(parent)
+
+	var got []*pb.LeaseEvent
+	for evs := range all.Chan() {
+		got = append(got, evs...)
+	}
+	want := []*pb.LeaseEvent{
+		{Type: pb.LeaseEvent_GRANT, ID: 1, TTL: 60, Revision: 1},
+		{Type: pb.LeaseEvent_GRANT, ID: 2, TTL: 30, Parent: 1, Revision: 1},
+		{Type: pb.LeaseEvent_CHECKPOINT, ID: 1, TTL: 20, Revision: 2},
+		{Type: pb.LeaseEvent_EXPIRE, ID: 2, Parent: 1, Revision: 3, Keys: [][]byte{[]byte(key)}},
+		{Type: pb.LeaseEvent_EXPIRE, ID: 1, Revision: 3},
+		{Type: pb.LeaseEvent_GRANT, ID: 3, TTL: 60, Revision: 3},
+		{Type: pb.LeaseEvent_REVOKE, ID: 3, Revision: 3},
+	}
+	require.Len(t, got, len(want))
+	for i := range want {
+		assert.Truef(t, proto.Equal(want[i], got[i]), "event %d: want %v, got %v", i, want[i], got[i])
+	}
+
+	// the watcher of a single lease gets only its events, without keys
+	got = nil
+	for evs := range parent.Chan() {
+		got = append(got, evs...)
+	}
+	require.Len(t, got, 3)
+	assert.Equal(t, pb.LeaseEvent_GRANT, got[0].Type)
+	assert.Equal(t, pb.LeaseEvent_CHECKPOINT, got[1].Type)
+	assert.Equal(t, pb.LeaseEvent_EXPIRE, got[2].Type)
+	assert.Empty(t, got[2].Keys)
+}
+
+// TestUberApplier_Subscription_Compaction tests compactions are clamped to the
+// revision the oldest subscription within its max lag resumes from
+func TestUberApplier_Subscription_Compaction(t *testing.T) {
+	ua := defaultUberApplier(t)
+	apply := func(r *pb.InternalRaftRequest) *Result {
+		r.Header = &pb.RequestHeader{}
+		result := ua.Apply(&InternalRaftRequestWrapper{InternalRaftRequest: r}, membership.ApplyBoth)
+		require.NotNil(t, result)
+		return result
+	}
+	subscription := func(r *pb.SubscriptionRequest) *Result {
+		return apply(&pb.InternalRaftRequest{Subscription: r})
+	}
+	ack := func(name string, rev, maxLag int64) *pb.Subscription {
+		result := subscription(&pb.SubscriptionRequest{Action: pb.SubscriptionRequest_ACK, Name: name, Revision: rev, MaxLag: maxLag})
+		require.NoError(t, result.Err)
+		return result.Resp.(*pb.SubscriptionResponse).Subscriptions[0]
+	}
+	compact := func(rev int64) {
+		result := apply(&pb.InternalRaftRequest{Compaction: &pb.CompactionRequest{Revision: rev}})
+		require.NoError(t, result.Err)
+		<-result.Physc
+	}
+	rangeErr := func(rev int64) error {
+		return apply(&pb.InternalRaftRequest{Range: &pb.RangeRequest{Key: []byte(key), Revision: rev}}).Err
+	}
+	put := func(n int) {
+		for range n {
+			require.NoError(t, apply(&pb.InternalRaftRequest{Put: &pb.PutRequest{Key: []byte(key)}}).Err)
+		}
+	}
+	put(5)
+	ack("a", 2, 10)
+	ack("b", 4, 10)
+
+	compact(5)
+	require.ErrorIs(t, rangeErr(2), mvcc.ErrCompacted)
+	require.NoError(t, rangeErr(3))
+
+	// subscriptions never move back
+	assert.Equal(t, int64(4), ack("b", 3, 10).Revision)
+	assert.Equal(t, int64(6), ack("b", 0, 10).Revision)
+
+	result := subscription(&pb.SubscriptionRequest{Action: pb.SubscriptionRequest_LIST})
+	require.NoError(t, result.Err)
+	subs := result.Resp.(*pb.SubscriptionResponse).Subscriptions
+	require.Len(t, subs, 2)
+	assert.Equal(t, "a", subs[0].Name)
+	assert.Equal(t, "b", subs[1].Name)
+
+	// a subscription lagging more than its max lag no longer holds compaction back
+	put(10)
+	compact(6)
+	require.ErrorIs(t, rangeErr(5), mvcc.ErrCompacted)
+	require.NoError(t, rangeErr(6))
+
+	result = subscription(&pb.SubscriptionRequest{Action: pb.SubscriptionRequest_ACK, Name: "c", Revision: 2})
+	require.ErrorIs(t, result.Err, mvcc.ErrCompacted)
+	result = subscription(&pb.SubscriptionRequest{Action: pb.SubscriptionRequest_ACK, Name: "c", Revision: 100})
+	require.ErrorIs(t, result.Err, mvcc.ErrFutureRev)
+
+	require.NoError(t, subscription(&pb.SubscriptionRequest{Action: pb.SubscriptionRequest_DELETE, Name: "b"}).Err)
+	result = subscription(&pb.SubscriptionRequest{Action: pb.SubscriptionRequest_DELETE, Name: "b"})
+	require.ErrorIs(t, result.Err, v3subscription.ErrSubscriptionNotFound)
+	compact(10)
+	require.ErrorIs(t, rangeErr(9), mvcc.ErrCompacted)
+}
@@ -43,6 +43,10 @@ var (
 	ErrClusterVersionUnavailable   = errors.New("etcdserver: cluster version not found during downgrade")
 	ErrWrongDowngradeVersionFormat = errors.New("etcdserver: wrong downgrade target version format")
 	ErrKeyNotFound                 = errors.New("etcdserver: key not found")
+	ErrInvalidCounter              = errors.New("etcdserver: value is not a valid counter")
+	ErrCounterOverflow             = errors.New("etcdserver: counter overflow")
+	ErrInvalidContinueToken        = errors.New("etcdserver: invalid continue token")
+	ErrNotCapable                  = errors.New("etcdserver: not capable")
 )
 
 type DiscoveryError struct {
@@ -58,6 +58,9 @@ import (
 	"go.etcd.io/etcd/server/v3/etcdserver/api/v2store"
 	"go.etcd.io/etcd/server/v3/etcdserver/api/v3alarm"
 	"go.etcd.io/etcd/server/v3/etcdserver/api/v3compactor"
+	"go.etcd.io/etcd/server/v3/etcdserver/api/v3hold"
+	"go.etcd.io/etcd/server/v3/etcdserver/api/v3leasewatch"
+	"go.etcd.io/etcd/server/v3/etcdserver/api/v3subscription"
 	"go.etcd.io/etcd/server/v3/etcdserver/apply"
 	"go.etcd.io/etcd/server/v3/etcdserver/cindex"
 	"go.etcd.io/etcd/server/v3/etcdserver/errors"
@@ -84,6 +87,10 @@ const (
 	// follower to catch up.
 	DefaultSnapshotCatchUpEntries uint64 = 5000
 
+	// DefaultSubscriptionMaxLag is the number of revisions a subscription may
+	// fall behind before it stops holding back compaction.
+	DefaultSubscriptionMaxLag = 100000
+
 	StoreClusterPrefix = "/0"
 	StoreKeysPrefix    = "/1"
 
@@ -258,6 +265,10 @@ type EtcdServer struct {
 	beHooks    *serverstorage.BackendHooks
 	authStore  auth.AuthStore
 	alarmStore *v3alarm.AlarmStore
+	holdStore  *v3hold.HoldStore
+	subStore   *v3subscription.SubscriptionStore
+	// leaseEvents broadcasts the applied lease events to the lease watchers.
+	leaseEvents *v3leasewatch.Broadcaster
 
 	stats  *stats.ServerStats
 	lstats *stats.LeaderStats
@@ -313,7 +324,6 @@ func NewServer(cfg config.ServerConfig) (srv *EtcdServer, err error) {
 	sstats := stats.NewServerStats(cfg.Name, b.cluster.cl.String())
 	lstats := stats.NewLeaderStats(cfg.Logger, b.cluster.nodeID.String())
 
-	heartbeat := time.Duration(cfg.TickMs) * time.Millisecond
 	srv = &EtcdServer{
 		readych:               make(chan struct{}),
 		Cfg:                   cfg,
@@ -333,6 +343,7 @@ func NewServer(cfg config.ServerConfig) (srv *EtcdServer, err error) {
 		consistIndex:          b.storage.backend.ci,
 		firstCommitInTerm:     notify.NewNotifier(),
 		clusterVersionChanged: notify.NewNotifier(),
+		leaseEvents:           v3leasewatch.NewBroadcaster(),
 	}
 
 	addFeatureGateMetrics(cfg.ServerFeatureGate, serverFeatureEnabled)
@@ -341,14 +352,14 @@ func NewServer(cfg config.ServerConfig) (srv *EtcdServer, err error) {
 
 	srv.be = b.storage.backend.be
 	srv.beHooks = b.storage.backend.beHooks
-	minTTL := time.Duration((3*cfg.ElectionTicks)/2) * heartbeat
 
 	// always recover lessor before kv. When we recover the mvcc.KV it will reattach keys to its leases.
 	// If we recover mvcc.KV first, it will attach the keys to the wrong lessor before it recovers.
 	srv.lessor = lease.NewLessor(srv.Logger(), srv.be, srv.cluster, lease.LessorConfig{
-		MinLeaseTTL:                int64(math.Ceil(minTTL.Seconds())),
+		MinLeaseTTL:                minLeaseTTL(cfg),
 		CheckpointInterval:         cfg.LeaseCheckpointInterval,
 		CheckpointPersist:          cfg.ServerFeatureGate.Enabled(features.LeaseCheckpointPersist),
+		PromoteJitter:              cfg.LeasePromoteJitter,
 		ExpiredLeasesRetryInterval: srv.Cfg.ReqTimeout(),
 	})
 
@@ -380,8 +391,8 @@ func NewServer(cfg config.ServerConfig) (srv *EtcdServer, err error) {
 			newSrv.kv.Close()
 		}
 	}()
-	if num := cfg.AutoCompactionRetention; num != 0 {
-		srv.compactor, err = v3compactor.New(cfg.Logger, cfg.AutoCompactionMode, num, srv.kv, srv)
+	if num := cfg.AutoCompactionRetention; num != 0 || len(cfg.AutoCompactionPrefixRetention) != 0 {
+		srv.compactor, err = v3compactor.New(cfg.Logger, cfg.AutoCompactionMode, num, cfg.AutoCompactionPrefixRetention, srv.kv, srv)
 		if err != nil {
 			return nil, err
 		}
@@ -391,6 +402,12 @@ func NewServer(cfg config.ServerConfig) (srv *EtcdServer, err error) {
 	if err = srv.restoreAlarms(); err != nil {
 		return nil, err
 	}
+	if err = srv.restoreRevisionHolds(); err != nil {
+		return nil, err
+	}
+	if err = srv.restoreSubscriptions(); err != nil {
+		return nil, err
+	}
 	srv.uberApply = srv.NewUberApplier()
 
 	if srv.FeatureEnabled(features.LeaseCheckpoint) {
@@ -444,6 +461,13 @@ func NewServer(cfg config.ServerConfig) (srv *EtcdServer, err error) {
 	return srv, nil
 }
 
+// minLeaseTTL returns the minimum TTL in seconds of the leases granted by the
+// server. It keeps a lease from expiring while a new leader is elected.
+func minLeaseTTL(cfg config.ServerConfig) int64 {
+	minTTL := time.Duration((3*cfg.ElectionTicks)/2) * time.Duration(cfg.TickMs) * time.Millisecond
+	return int64(math.Ceil(minTTL.Seconds()))
+}
+
 func (s *EtcdServer) Logger() *zap.Logger {
 	s.lgMu.RLock()
 	l := s.lg
@@ -878,10 +902,11 @@ func (s *EtcdServer) revokeExpiredLeases(leases []*lease.Lease) {
 			f := func(lid int64) {
 				s.GoAttach(func() {
 					ctx := s.authStore.WithRoot(s.ctx)
-					_, lerr := s.LeaseRevoke(ctx, &pb.LeaseRevokeRequest{ID: lid})
+					_, lerr := s.leaseExpire(ctx, &pb.LeaseRevokeRequest{ID: lid})
 					if lerr == nil {
 						leaseExpired.Inc()
-					} else {
+					} else if !errorspkg.Is(lerr, lease.ErrLeaseNotFound) {
+						// a child lease may have been revoked along with its parent
 						lg.Warn(
 							"failed to revoke lease",
 							zap.String("lease-id", fmt.Sprintf("%016x", lid)),
@@ -1095,6 +1120,22 @@ func (s *EtcdServer) applySnapshot(ep *etcdProgress, toApply *toApply) {
 
 	lg.Info("restored alarm store")
 
+	lg.Info("restoring revision hold store")
+
+	if err := s.restoreRevisionHolds(); err != nil {
+		lg.Panic("failed to restore revision hold store", zap.Error(err))
+	}
+
+	lg.Info("restored revision hold store")
+
+	lg.Info("restoring subscription store")
+
+	if err := s.restoreSubscriptions(); err != nil {
+		lg.Panic("failed to restore subscription store", zap.Error(err))
+	}
+
+	lg.Info("restored subscription store")
+
 	if s.authStore != nil {
 		lg.Info("restoring auth store")
 
@@ -1143,6 +1184,9 @@ func (s *EtcdServer) NewUberApplier() apply.UberApplier {
 		Logger:                       s.lg,
 		KV:                           s.KV(),
 		AlarmStore:                   s.alarmStore,
+		HoldStore:                    s.holdStore,
+		SubscriptionStore:            s.subStore,
+		LeaseEvents:                  s.leaseEvents,
 		AuthStore:                    s.authStore,
 		Lessor:                       s.lessor,
 		Cluster:                      s.cluster,
@@ -2390,6 +2434,24 @@ func (s *EtcdServer) restoreAlarms() error {
 	return nil
 }
 
+func (s *EtcdServer) restoreRevisionHolds() error {
+	hs, err := v3hold.NewHoldStore(s.lg, schema.NewRevisionHoldBackend(s.lg, s.be))
+	if err != nil {
+		return err
+	}
+	s.holdStore = hs
+	return nil
+}
+
+func (s *EtcdServer) restoreSubscriptions() error {
+	ss, err := v3subscription.NewSubscriptionStore(s.lg, schema.NewSubscriptionBackend(s.lg, s.be))
+	if err != nil {
+		return err
+	}
+	s.subStore = ss
+	return nil
+}
+
 // GoAttach creates a goroutine on a given function and tracks it using
 // the etcdserver waitgroup.
 // The passed function should interrupt on s.StoppingNotify().
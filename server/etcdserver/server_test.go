@@ -29,6 +29,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/Masterminds/semver/v3"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/prometheus/client_golang/prometheus"
@@ -42,6 +43,7 @@ import (
 
 	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
 	"go.etcd.io/etcd/api/v3/membershippb"
+	"go.etcd.io/etcd/api/v3/version"
 	"go.etcd.io/etcd/client/pkg/v3/fileutil"
 	"go.etcd.io/etcd/client/pkg/v3/testutil"
 	"go.etcd.io/etcd/client/pkg/v3/types"
@@ -1222,6 +1224,92 @@ func TestUpdateVersionV3(t *testing.T) {
 	assert.Equal(t, &membershippb.ClusterVersionSetRequest{Ver: ver}, r.ClusterVersionSet)
 }
 
+func TestCheckClusterVersion(t *testing.T) {
+	lg := zaptest.NewLogger(t)
+	srv := &EtcdServer{lgMu: new(sync.RWMutex), lg: lg, cluster: membership.NewCluster(lg)}
+	_, err := srv.Put(t.Context(), &pb.PutRequest{Key: []byte("foo"), Ttl: 10})
+	require.ErrorIs(t, err, errors.ErrNotCapable)
+	_, err = srv.RevisionHold(t.Context(), &pb.RevisionHoldRequest{Action: pb.RevisionHoldRequest_CREATE})
+	require.ErrorIs(t, err, errors.ErrNotCapable)
+	_, err = srv.Subscription(t.Context(), &pb.SubscriptionRequest{Action: pb.SubscriptionRequest_ACK, Name: "foo"})
+	require.ErrorIs(t, err, errors.ErrNotCapable)
+	_, err = srv.LeaseUpdateTTL(t.Context(), &pb.LeaseUpdateTTLRequest{ID: 1, TTL: 10})
+	require.ErrorIs(t, err, errors.ErrNotCapable)
+	_, err = srv.LeaseRevokeBatch(t.Context(), &pb.LeaseRevokeBatchRequest{IDs: []int64{1}})
+	require.ErrorIs(t, err, errors.ErrNotCapable)
+	_, err = srv.LeaseGrant(t.Context(), &pb.LeaseGrantRequest{ID: 2, TTL: 10, Parent: 1})
+	require.ErrorIs(t, err, errors.ErrNotCapable)
+	_, err = srv.Compact(t.Context(), &pb.CompactionRequest{Revision: 1, Prefixes: []*pb.PrefixCompaction{{Prefix: []byte("a/"), Revision: 1}}})
+	require.ErrorIs(t, err, errors.ErrNotCapable)
+
+	srv.cluster.SetVersion(semver.New(3, 7, 0, "", ""), func(*zap.Logger, *semver.Version) {}, membership.ApplyV2storeOnly)
+	require.ErrorIs(t, srv.checkClusterVersion(&version.V3_8), errors.ErrNotCapable)
+	srv.cluster.SetVersion(semver.New(3, 8, 0, "", ""), func(*zap.Logger, *semver.Version) {}, membership.ApplyV2storeOnly)
+	require.NoError(t, srv.checkClusterVersion(&version.V3_8))
+}
+
+func TestTxnIncrementClusterVersion(t *testing.T) {
+	lg := zaptest.NewLogger(t)
+	srv := &EtcdServer{lgMu: new(sync.RWMutex), lg: lg, cluster: membership.NewCluster(lg)}
+	srv.cluster.SetVersion(semver.New(3, 7, 0, "", ""), func(*zap.Logger, *semver.Version) {}, membership.ApplyV2storeOnly)
+
+	inc := &pb.RequestOp{Request: &pb.RequestOp_RequestIncrement{RequestIncrement: &pb.IncrementRequest{Key: []byte("foo"), Delta: 1}}}
+	_, err := srv.Txn(t.Context(), &pb.TxnRequest{Success: []*pb.RequestOp{inc}})
+	require.ErrorIs(t, err, errors.ErrNotCapable)
+	nested := &pb.RequestOp{Request: &pb.RequestOp_RequestTxn{RequestTxn: &pb.TxnRequest{Failure: []*pb.RequestOp{inc}}}}
+	_, err = srv.Txn(t.Context(), &pb.TxnRequest{Failure: []*pb.RequestOp{nested}})
+	require.ErrorIs(t, err, errors.ErrNotCapable)
+}
+
+func TestTxnAggregateCompareClusterVersion(t *testing.T) {
+	lg := zaptest.NewLogger(t)
+	srv := &EtcdServer{lgMu: new(sync.RWMutex), lg: lg, cluster: membership.NewCluster(lg)}
+	srv.cluster.SetVersion(semver.New(3, 7, 0, "", ""), func(*zap.Logger, *semver.Version) {}, membership.ApplyV2storeOnly)
+
+	put := &pb.RequestOp{Request: &pb.RequestOp_RequestPut{RequestPut: &pb.PutRequest{Key: []byte("foo")}}}
+	for _, target := range []pb.Compare_CompareTarget{pb.Compare_COUNT, pb.Compare_MAX_CREATE, pb.Compare_MAX_MOD} {
+		cmp := &pb.Compare{Key: []byte("foo"), RangeEnd: []byte("fop"), Target: target}
+		_, err := srv.Txn(t.Context(), &pb.TxnRequest{Compare: []*pb.Compare{cmp}, Success: []*pb.RequestOp{put}})
+		require.ErrorIs(t, err, errors.ErrNotCapable)
+		nested := &pb.RequestOp{Request: &pb.RequestOp_RequestTxn{RequestTxn: &pb.TxnRequest{Compare: []*pb.Compare{cmp}, Success: []*pb.RequestOp{put}}}}
+		_, err = srv.Txn(t.Context(), &pb.TxnRequest{Success: []*pb.RequestOp{nested}})
+		require.ErrorIs(t, err, errors.ErrNotCapable)
+	}
+}
+
+func TestLeaseRevokeBatchLen(t *testing.T) {
+	lg := zaptest.NewLogger(t)
+	be, _ := betesting.NewDefaultTmpBackend(t)
+	defer betesting.Close(t, be)
+	le := lease.NewLessor(lg, be, nil, lease.LessorConfig{MinLeaseTTL: 1})
+	defer le.Stop()
+	srv := &EtcdServer{lgMu: new(sync.RWMutex), lg: lg, lessor: le}
+
+	// the keys of a lease count along with the keys of its child leases
+	_, err := le.Grant(1, 10)
+	require.NoError(t, err)
+	_, err = le.GrantChild(2, 1, 10)
+	require.NoError(t, err)
+	for i := 0; i < maxLeaseRevokeBatchKeys; i++ {
+		require.NoError(t, le.Attach(2, []lease.LeaseItem{{Key: fmt.Sprintf("foo%d", i)}}))
+	}
+	_, err = le.Grant(3, 10)
+	require.NoError(t, err)
+	require.NoError(t, le.Attach(3, []lease.LeaseItem{{Key: "bar"}}))
+
+	assert.Equal(t, 0, srv.leaseRevokeBatchLen(nil))
+	assert.Equal(t, 1, srv.leaseRevokeBatchLen([]int64{1, 3}))
+	// a lease over the key limit is still revoked on its own
+	assert.Equal(t, 1, srv.leaseRevokeBatchLen([]int64{3, 1}))
+	assert.Equal(t, 2, srv.leaseRevokeBatchLen([]int64{3, 4}))
+
+	ids := make([]int64, maxLeaseRevokeBatchLeases+1)
+	for i := range ids {
+		ids[i] = int64(i + 100)
+	}
+	assert.Equal(t, maxLeaseRevokeBatchLeases, srv.leaseRevokeBatchLen(ids))
+}
+
 func TestStopNotify(t *testing.T) {
 	s := &EtcdServer{
 		lgMu: new(sync.RWMutex),
@@ -38,6 +38,8 @@ func firstOpKey(ops []*pb.RequestOp) string {
 			key = string(op.RequestRange.GetKey())
 		case *pb.RequestOp_RequestDeleteRange:
 			key = string(op.RequestDeleteRange.GetKey())
+		case *pb.RequestOp_RequestIncrement:
+			key = string(op.RequestIncrement.GetKey())
 		}
 		if key != "" {
 			return key
@@ -56,6 +58,8 @@ func firstOpType(ops []*pb.RequestOp) string {
 			return "range"
 		case *pb.RequestOp_RequestDeleteRange:
 			return "delete_range"
+		case *pb.RequestOp_RequestIncrement:
+			return "increment"
 		case *pb.RequestOp_RequestTxn:
 			return "txn"
 		}
@@ -0,0 +1,189 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package txn
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"hash/fnv"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	"go.etcd.io/etcd/server/v3/etcdserver/errors"
+	"go.etcd.io/etcd/server/v3/storage/mvcc"
+)
+
+const continueTokenVersion = 2
+
+// rangeCursor is the position a paginated range resumes from. It holds the
+// revision the first page was read at, the sort order of the range and the
+// last key-value pair returned, of which only the key and the sort target
+// field are set.
+type rangeCursor struct {
+	revision int64
+	target   pb.RangeRequest_SortTarget
+	order    pb.RangeRequest_SortOrder
+	last     *mvccpb.KeyValue
+}
+
+// encodeContinueToken returns a token to resume the range after the given
+// key-value pair. The token is encoded as:
+//
+//	version | revision | sort target | sort order | range hash | key length | key | sort value
+//
+// where the range hash binds the token to the range and filters of the
+// request, and the sort value is a varint for VERSION, CREATE and MOD sorts
+// and empty otherwise. The value of the last key of a VALUE sort is not in
+// the token, it is read again at the revision of the token.
+func encodeContinueToken(revision int64, r *pb.RangeRequest, last *mvccpb.KeyValue) []byte {
+	token := []byte{continueTokenVersion}
+	token = binary.AppendUvarint(token, uint64(revision))
+	token = append(token, byte(r.SortTarget), byte(SortOrder(r)))
+	token = binary.BigEndian.AppendUint64(token, rangeHash(r))
+	token = binary.AppendUvarint(token, uint64(len(last.Key)))
+	token = append(token, last.Key...)
+	switch r.SortTarget {
+	case pb.RangeRequest_VERSION:
+		token = binary.AppendVarint(token, last.Version)
+	case pb.RangeRequest_CREATE:
+		token = binary.AppendVarint(token, last.CreateRevision)
+	case pb.RangeRequest_MOD:
+		token = binary.AppendVarint(token, last.ModRevision)
+	}
+	return token
+}
+
+// rangeHash hashes the fields of the request that select the key-value pairs
+// of the range, so that a continue token is only used with the range and
+// filters it was returned for.
+func rangeHash(r *pb.RangeRequest) uint64 {
+	h := fnv.New64a()
+	for _, b := range [][]byte{r.Key, r.RangeEnd, r.ValuePrefix} {
+		h.Write(binary.AppendUvarint(nil, uint64(len(b))))
+		h.Write(b)
+	}
+	for _, v := range []int64{
+		r.MinModRevision, r.MaxModRevision, r.MinCreateRevision, r.MaxCreateRevision,
+		r.MinValueSize, r.MaxValueSize, int64(r.LeaseFilter),
+	} {
+		h.Write(binary.AppendVarint(nil, v))
+	}
+	return h.Sum64()
+}
+
+// decodeContinueToken returns the cursor encoded in the continue token of
+// the request, or nil if the request does not have one. It fails if the token
+// is malformed or does not match the revision, sort order, range or filters of
+// the request.
+func decodeContinueToken(r *pb.RangeRequest) (*rangeCursor, error) {
+	token := r.ContinueToken
+	if len(token) == 0 {
+		return nil, nil
+	}
+	if token[0] != continueTokenVersion {
+		return nil, errors.ErrInvalidContinueToken
+	}
+	token = token[1:]
+
+	rev, n := binary.Uvarint(token)
+	if n <= 0 || len(token) < n+10 {
+		return nil, errors.ErrInvalidContinueToken
+	}
+	c := &rangeCursor{
+		revision: int64(rev),
+		target:   pb.RangeRequest_SortTarget(token[n]),
+		order:    pb.RangeRequest_SortOrder(token[n+1]),
+		last:     &mvccpb.KeyValue{},
+	}
+	hash := binary.BigEndian.Uint64(token[n+2:])
+	token = token[n+10:]
+	if c.revision <= 0 || (r.Revision != 0 && r.Revision != c.revision) ||
+		c.target != r.SortTarget || c.order != SortOrder(r) || hash != rangeHash(r) {
+		return nil, errors.ErrInvalidContinueToken
+	}
+
+	keyLen, n := binary.Uvarint(token)
+	if n <= 0 || uint64(len(token)-n) < keyLen {
+		return nil, errors.ErrInvalidContinueToken
+	}
+	c.last.Key = token[n : n+int(keyLen)]
+	token = token[n+int(keyLen):]
+
+	var field *int64
+	switch c.target {
+	case pb.RangeRequest_KEY, pb.RangeRequest_VALUE:
+	case pb.RangeRequest_VERSION:
+		field = &c.last.Version
+	case pb.RangeRequest_CREATE:
+		field = &c.last.CreateRevision
+	case pb.RangeRequest_MOD:
+		field = &c.last.ModRevision
+	default:
+		return nil, errors.ErrInvalidContinueToken
+	}
+	if field != nil {
+		if *field, n = binary.Varint(token); n <= 0 {
+			return nil, errors.ErrInvalidContinueToken
+		}
+		token = token[n:]
+	}
+	if len(token) != 0 {
+		return nil, errors.ErrInvalidContinueToken
+	}
+	return c, nil
+}
+
+// resolve reads the value of the last key of a VALUE sort at the revision of
+// the cursor, as the value is not kept in the continue token.
+func (c *rangeCursor) resolve(ctx context.Context, txnRead mvcc.TxnRead) error {
+	if c.target != pb.RangeRequest_VALUE {
+		return nil
+	}
+	rr, err := txnRead.Range(ctx, c.last.Key, nil, mvcc.RangeOptions{Rev: c.revision})
+	if err != nil {
+		return err
+	}
+	if len(rr.KVs) == 0 {
+		return errors.ErrInvalidContinueToken
+	}
+	c.last.Value = rr.KVs[0].Value
+	return nil
+}
+
+// startKey returns the first key to read from to resume the range. Results
+// sorted by key in ascending order resume right after the last key returned,
+// other sort orders need to read, filter and sort the whole range again for
+// every page, so paginating them costs a full range read per page.
+func (c *rangeCursor) startKey(key []byte) []byte {
+	if !IsDefaultOrdering(c.target, c.order) {
+		return key
+	}
+	next := append(bytes.Clone(c.last.Key), 0)
+	if bytes.Compare(next, key) > 0 {
+		return next
+	}
+	return key
+}
+
+// prune removes the key-value pairs up to and including the cursor in the
+// sort order of the range, so they are neither returned nor counted again.
+func (c *rangeCursor) prune(rr *mvcc.RangeResult) {
+	n := len(rr.KVs)
+	pruneKVs(rr, func(kv *mvccpb.KeyValue) bool {
+		return compareKVs(c.target, c.order, kv, c.last) <= 0
+	})
+	rr.Count -= n - len(rr.KVs)
+}
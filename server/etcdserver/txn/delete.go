@@ -18,6 +18,7 @@ import (
 	"context"
 
 	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
 
 	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
 	"go.etcd.io/etcd/api/v3/mvccpb"
@@ -56,6 +57,35 @@ func deleteRange(ctx context.Context, txnWrite mvcc.TxnWrite, dr *pb.DeleteRange
 	return resp, nil
 }
 
+// DeleteBatchEnd returns the end of the next batch of the batched delete dr,
+// starting at key. A batch has at most dr.BatchSize keys and at least one.
+// Applying it writes a tombstone of every key to the backend and notifies the
+// watchers of each key with the previous key-value pair, so the batch also
+// stops before the key that would make the total size of its keys and previous
+// key-value pairs exceed maxBytes, keeping a batch as costly as a request of
+// maxBytes at most. If the batch reaches the end of the range, the range end of
+// dr is returned and more is false, so that the batch also covers the keys
+// created in the meantime.
+func DeleteBatchEnd(ctx context.Context, lg *zap.Logger, kv mvcc.KV, dr *pb.DeleteRangeRequest, key []byte, maxBytes int) (end []byte, more bool, err error) {
+	rr := &pb.RangeRequest{Key: key, RangeEnd: dr.RangeEnd, Limit: dr.BatchSize}
+	resp, _, err := Range(ctx, lg, kv, rr, false)
+	if err != nil {
+		return nil, false, err
+	}
+	size := 0
+	for i, kvp := range resp.Kvs {
+		size += len(kvp.Key) + proto.Size(kvp)
+		if i > 0 && maxBytes > 0 && size > maxBytes {
+			return kvp.Key, true, nil
+		}
+	}
+	if !resp.More {
+		return dr.RangeEnd, false, nil
+	}
+	last := resp.Kvs[len(resp.Kvs)-1].Key
+	return append(append([]byte{}, last...), 0), true, nil
+}
+
 // mkGteRange determines if the range end is a >= range. This works around grpc
 // sending empty byte strings as nil; >= is encoded in the range end as '\0'.
 // If it is a GTE range, then []byte{} is returned to indicate the empty byte
@@ -0,0 +1,58 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package txn
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/pkg/v3/traceutil"
+	"go.etcd.io/etcd/server/v3/storage/mvcc"
+)
+
+// KeyHistory returns the key-value pairs of the revisions of a key retained
+// in the KV, within the bounds of the request.
+func KeyHistory(ctx context.Context, lg *zap.Logger, kv mvcc.KV, r *pb.KeyHistoryRequest) (*pb.KeyHistoryResponse, error) {
+	ctx, trace := traceutil.EnsureTrace(ctx, lg, "key_history")
+	txnRead := kv.Read(mvcc.ConcurrentReadTxMode, trace)
+	defer txnRead.End()
+
+	ho := mvcc.HistoryOptions{
+		Rev:        r.Revision,
+		MinRev:     r.MinModRevision,
+		MaxRev:     r.MaxModRevision,
+		Limit:      r.Limit,
+		Descending: r.SortOrder == pb.RangeRequest_DESCEND,
+	}
+	rr, err := txnRead.History(ctx, r.Key, ho)
+	if err != nil {
+		return nil, err
+	}
+	if r.KeysOnly {
+		for _, kv := range rr.KVs {
+			kv.Value = nil
+		}
+	}
+	resp := &pb.KeyHistoryResponse{
+		Header: &pb.ResponseHeader{Revision: rr.Rev},
+		Kvs:    rr.KVs,
+		More:   len(rr.KVs) < rr.Count,
+		Count:  int64(rr.Count),
+	}
+	trace.Step("assemble the response")
+	return resp, nil
+}
@@ -0,0 +1,113 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package txn
+
+import (
+	"context"
+	"encoding/binary"
+	"strconv"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/pkg/v3/traceutil"
+	"go.etcd.io/etcd/server/v3/etcdserver/errors"
+	"go.etcd.io/etcd/server/v3/lease"
+	"go.etcd.io/etcd/server/v3/storage/mvcc"
+)
+
+func increment(ctx context.Context, txnWrite mvcc.TxnWrite, r *pb.IncrementRequest) (*pb.IncrementResponse, error) {
+	trace := traceutil.Get(ctx)
+	prevKV, err := txnWrite.Range(ctx, r.Key, nil, mvcc.RangeOptions{})
+	if err != nil {
+		return nil, err
+	}
+	val, leaseID, err := nextCounter(prevKV, r)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.IncrementResponse{Header: &pb.ResponseHeader{}, Value: val}
+	if r.PrevKv && len(prevKV.KVs) != 0 {
+		resp.PrevKv = prevKV.KVs[0]
+	}
+	resp.Header.Revision = txnWrite.Put(r.Key, encodeCounter(r.Encoding, val), leaseID)
+	trace.AddField(traceutil.Field{Key: "response_revision", Value: resp.Header.Revision})
+	return resp, nil
+}
+
+// checkIncrement verifies that the increment can be applied on top of the given
+// read view, so that the write phase of the txn cannot fail on a bad counter.
+func checkIncrement(rv mvcc.ReadView, lessor lease.Lessor, r *pb.IncrementRequest) error {
+	if leaseID := lease.LeaseID(r.Lease); leaseID != lease.NoLease {
+		if l := lessor.Lookup(leaseID); l == nil {
+			return lease.ErrLeaseNotFound
+		}
+	}
+	prevKV, err := rv.Range(context.TODO(), r.Key, nil, mvcc.RangeOptions{})
+	if err != nil {
+		return err
+	}
+	_, _, err = nextCounter(prevKV, r)
+	return err
+}
+
+// nextCounter returns the counter value and the lease to store for the key
+// after applying the increment to its previous key-value pair, if any.
+func nextCounter(prevKV *mvcc.RangeResult, r *pb.IncrementRequest) (int64, lease.LeaseID, error) {
+	var cur int64
+	leaseID := lease.LeaseID(r.Lease)
+	if len(prevKV.KVs) != 0 {
+		var err error
+		if cur, err = decodeCounter(r.Encoding, prevKV.KVs[0].Value); err != nil {
+			return 0, lease.NoLease, err
+		}
+		if r.IgnoreLease {
+			leaseID = lease.LeaseID(prevKV.KVs[0].Lease)
+		}
+	} else if r.IgnoreLease {
+		// ignore_lease flag expects previous key-value pair
+		return 0, lease.NoLease, errors.ErrKeyNotFound
+	}
+
+	next := cur + r.Delta
+	if (r.Delta > 0 && next < cur) || (r.Delta < 0 && next > cur) {
+		return 0, lease.NoLease, errors.ErrCounterOverflow
+	}
+	return next, leaseID, nil
+}
+
+func decodeCounter(enc pb.IncrementRequest_Encoding, v []byte) (int64, error) {
+	switch enc {
+	case pb.IncrementRequest_DECIMAL:
+		n, err := strconv.ParseInt(string(v), 10, 64)
+		if err != nil {
+			return 0, errors.ErrInvalidCounter
+		}
+		return n, nil
+	case pb.IncrementRequest_BIG_ENDIAN:
+		if len(v) != 8 {
+			return 0, errors.ErrInvalidCounter
+		}
+		return int64(binary.BigEndian.Uint64(v)), nil
+	default:
+		return 0, errors.ErrInvalidCounter
+	}
+}
+
+func encodeCounter(enc pb.IncrementRequest_Encoding, n int64) []byte {
+	if enc == pb.IncrementRequest_BIG_ENDIAN {
+		return binary.BigEndian.AppendUint64(nil, uint64(n))
+	}
+	return strconv.AppendInt(nil, n, 10)
+}
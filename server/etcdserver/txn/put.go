@@ -16,6 +16,7 @@ package txn
 
 import (
 	"context"
+	"time"
 
 	"go.uber.org/zap"
 	"google.golang.org/protobuf/proto"
@@ -36,16 +37,19 @@ func Put(ctx context.Context, lg *zap.Logger, lessor lease.Lessor, kv mvcc.KV, p
 	if err != nil {
 		return nil, trace, err
 	}
+	if err = grantTTLLease(lessor, p); err != nil {
+		return nil, trace, err
+	}
 	txnWrite := kv.Write(trace)
 	defer txnWrite.End()
 	prevKV, err := checkAndGetPrevKV(trace, txnWrite, p)
 	if err != nil {
 		return nil, trace, err
 	}
-	return put(ctx, txnWrite, p, prevKV), trace, nil
+	return put(ctx, txnWrite, lessor, p, prevKV), trace, nil
 }
 
-func put(ctx context.Context, txnWrite mvcc.TxnWrite, p *pb.PutRequest, prevKV *mvcc.RangeResult) *pb.PutResponse {
+func put(ctx context.Context, txnWrite mvcc.TxnWrite, lessor lease.Lessor, p *pb.PutRequest, prevKV *mvcc.RangeResult) *pb.PutResponse {
 	trace := traceutil.Get(ctx)
 	resp := &pb.PutResponse{}
 	resp.Header = &pb.ResponseHeader{}
@@ -62,6 +66,9 @@ func put(ctx context.Context, txnWrite mvcc.TxnWrite, p *pb.PutRequest, prevKV *
 			resp.PrevKv = prevKV.KVs[0]
 		}
 	}
+	if p.Ttl != 0 {
+		resp.Expiry = ttlLeaseExpiry(lessor, leaseID)
+	}
 
 	resp.Header.Revision = txnWrite.Put(p.Key, val, leaseID)
 	trace.AddField(traceutil.Field{Key: "response_revision", Value: resp.Header.Revision})
@@ -80,13 +87,37 @@ func checkPut(trace *traceutil.Trace, txnWrite mvcc.ReadView, lessor lease.Lesso
 func checkLease(lessor lease.Lessor, p *pb.PutRequest) error {
 	leaseID := lease.LeaseID(p.Lease)
 	if leaseID != lease.NoLease {
-		if l := lessor.Lookup(leaseID); l == nil {
+		// the shared lease of a put with a TTL is granted on demand
+		if l := lessor.Lookup(leaseID); l == nil && p.Ttl == 0 {
 			return lease.ErrLeaseNotFound
 		}
 	}
 	return nil
 }
 
+// grantTTLLease grants the shared lease assigned to a put with a TTL, if it
+// does not exist yet. It must be called outside of a write txn, as granting
+// a lease persists it to the backend.
+func grantTTLLease(lessor lease.Lessor, p *pb.PutRequest) error {
+	if p.Ttl == 0 || lessor.Lookup(lease.LeaseID(p.Lease)) != nil {
+		return nil
+	}
+	_, err := lessor.Grant(lease.LeaseID(p.Lease), p.Ttl)
+	return err
+}
+
+// ttlLeaseExpiry returns the unix time from which the keys attached to the
+// given shared TTL lease may expire. Only the primary lessor tracks the expiry
+// of a lease; other members report the expiry the lease was granted with,
+// which the primary can only have extended.
+func ttlLeaseExpiry(lessor lease.Lessor, id lease.LeaseID) int64 {
+	l := lessor.Lookup(id)
+	if l == nil || l.Demoted() {
+		return lease.TTLLeaseExpiry(id)
+	}
+	return time.Now().Add(l.Remaining()).Unix()
+}
+
 func checkAndGetPrevKV(trace *traceutil.Trace, txnWrite mvcc.ReadView, p *pb.PutRequest) (prevKV *mvcc.RangeResult, err error) {
 	prevKV, err = getPrevKV(trace, txnWrite, p)
 	if err != nil {
@@ -16,12 +16,14 @@ package txn
 
 import (
 	"bytes"
+	"cmp"
 	"context"
 	"math"
-	"sort"
+	"slices"
 	"time"
 
 	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
 
 	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
 	"go.etcd.io/etcd/api/v3/mvccpb"
@@ -29,21 +31,6 @@ import (
 	"go.etcd.io/etcd/server/v3/storage/mvcc"
 )
 
-// Count returns the number of keys in [key, rangeEnd) at the given revision.
-// A revision of 0 reads at the latest revision.
-func Count(ctx context.Context, lg *zap.Logger, kv mvcc.KV, key, rangeEnd []byte, revision int64) (int64, error) {
-	resp, _, err := Range(ctx, lg, kv, &pb.RangeRequest{
-		Key:       key,
-		RangeEnd:  rangeEnd,
-		Revision:  revision,
-		CountOnly: true,
-	}, false)
-	if err != nil {
-		return 0, err
-	}
-	return resp.Count, nil
-}
-
 func Range(ctx context.Context, lg *zap.Logger, kv mvcc.KV, r *pb.RangeRequest, withTotalCount bool) (resp *pb.RangeResponse, trace *traceutil.Trace, err error) {
 	ctx, trace = traceutil.EnsureTrace(ctx, lg, "range")
 	defer func(start time.Time) {
@@ -56,28 +43,80 @@ func Range(ctx context.Context, lg *zap.Logger, kv mvcc.KV, r *pb.RangeRequest,
 	return resp, trace, err
 }
 
+// RangeChunk is like Range, but returns at most as many key-value pairs as
+// fit in maxBytes, and at least one. If the result had to be cut, the response
+// has more set and a continue token to read the next chunk from.
+func RangeChunk(ctx context.Context, lg *zap.Logger, kv mvcc.KV, r *pb.RangeRequest, maxBytes int) (*pb.RangeResponse, error) {
+	resp, _, err := Range(ctx, lg, kv, r, false)
+	if err != nil {
+		return nil, err
+	}
+	size := 0
+	for i, kvp := range resp.Kvs {
+		size += proto.Size(kvp)
+		if i == 0 || size <= maxBytes {
+			continue
+		}
+		rev := r.Revision
+		if cursor, _ := decodeContinueToken(r); cursor != nil {
+			rev = cursor.revision
+		}
+		if rev <= 0 {
+			rev = resp.Header.Revision
+		}
+		resp.Kvs = resp.Kvs[:i]
+		resp.More = true
+		resp.ContinueToken = encodeContinueToken(rev, r, resp.Kvs[i-1])
+		break
+	}
+	return resp, nil
+}
+
 func executeRange(ctx context.Context, lg *zap.Logger, txnRead mvcc.TxnRead, r *pb.RangeRequest, withTotalCount bool) (*pb.RangeResponse, error) {
 	trace := traceutil.Get(ctx)
 
+	cursor, err := decodeContinueToken(r)
+	if err != nil {
+		return nil, err
+	}
+	key, rev := r.Key, r.Revision
+	if cursor != nil {
+		if err = cursor.resolve(ctx, txnRead); err != nil {
+			return nil, err
+		}
+		key, rev = cursor.startKey(r.Key), cursor.revision
+	}
+
 	limit := rangeLimit(r)
 	ro := mvcc.RangeOptions{
 		Limit:          limit,
-		Rev:            r.Revision,
+		Rev:            rev,
 		CountOnly:      r.CountOnly,
-		FastKeysOnly:   r.KeysOnly && r.SortTarget != pb.RangeRequest_VALUE,
+		FastKeysOnly:   r.KeysOnly && r.SortTarget != pb.RangeRequest_VALUE && !HasValueFilters(r),
 		WithTotalCount: withTotalCount,
 	}
 
-	rr, err := txnRead.Range(ctx, r.Key, mkGteRange(r.RangeEnd), ro)
+	rr, err := txnRead.Range(ctx, key, mkGteRange(r.RangeEnd), ro)
 	if err != nil {
 		return nil, err
 	}
 
+	if cursor != nil {
+		cursor.prune(rr)
+	}
 	filterRangeResults(rr, r)
 	sortRangeResults(rr, r, lg)
 	trace.Step("filter and sort the key-value pairs")
 
+	var token []byte
+	if r.Limit > 0 && len(rr.KVs) > int(r.Limit) {
+		if rev <= 0 {
+			rev = rr.Rev
+		}
+		token = encodeContinueToken(rev, r, rr.KVs[r.Limit-1])
+	}
 	resp := asembleRangeResponse(rr, r)
+	resp.ContinueToken = token
 	trace.Step("assemble the response")
 
 	return resp, nil
@@ -85,7 +124,7 @@ func executeRange(ctx context.Context, lg *zap.Logger, txnRead mvcc.TxnRead, r *
 
 func rangeLimit(r *pb.RangeRequest) int64 {
 	limit := r.Limit
-	if !IsDefaultOrdering(r.SortTarget, r.SortOrder) || HasRevisionFilters(r) {
+	if !IsDefaultOrdering(r.SortTarget, SortOrder(r)) || HasRevisionFilters(r) || HasValueFilters(r) {
 		limit = 0
 	}
 	if limit > 0 && limit < math.MaxInt64 {
@@ -102,11 +141,28 @@ func IsDefaultOrdering(sortTarget pb.RangeRequest_SortTarget, sortOrder pb.Range
 		(sortTarget == pb.RangeRequest_KEY && sortOrder == pb.RangeRequest_ASCEND)
 }
 
+// SortOrder returns the order the results of the request are sorted in.
+// Sorting on a target other than the key is in ascending order by default.
+func SortOrder(r *pb.RangeRequest) pb.RangeRequest_SortOrder {
+	if r.SortOrder == pb.RangeRequest_NONE {
+		return pb.RangeRequest_ASCEND
+	}
+	return r.SortOrder
+}
+
 func HasRevisionFilters(r *pb.RangeRequest) bool {
 	return r.MinModRevision != 0 || r.MaxModRevision != 0 ||
 		r.MinCreateRevision != 0 || r.MaxCreateRevision != 0
 }
 
+// HasValueFilters returns true if the request filters keys by their value or
+// by the lease attached to them. Such filters require the full key-value
+// pairs to be read from the backend.
+func HasValueFilters(r *pb.RangeRequest) bool {
+	return len(r.ValuePrefix) != 0 || r.MinValueSize != 0 || r.MaxValueSize != 0 ||
+		r.LeaseFilter != pb.RangeRequest_ANY
+}
+
 func filterRangeResults(rr *mvcc.RangeResult, r *pb.RangeRequest) {
 	if r.MaxModRevision != 0 {
 		pruneKVs(rr, func(kv *mvccpb.KeyValue) bool { return kv.ModRevision > r.MaxModRevision })
@@ -120,37 +176,63 @@ func filterRangeResults(rr *mvcc.RangeResult, r *pb.RangeRequest) {
 	if r.MinCreateRevision != 0 {
 		pruneKVs(rr, func(kv *mvccpb.KeyValue) bool { return kv.CreateRevision < r.MinCreateRevision })
 	}
+	if len(r.ValuePrefix) != 0 {
+		pruneKVs(rr, func(kv *mvccpb.KeyValue) bool { return !bytes.HasPrefix(kv.Value, r.ValuePrefix) })
+	}
+	if r.MinValueSize != 0 {
+		pruneKVs(rr, func(kv *mvccpb.KeyValue) bool { return int64(len(kv.Value)) < r.MinValueSize })
+	}
+	if r.MaxValueSize != 0 {
+		pruneKVs(rr, func(kv *mvccpb.KeyValue) bool { return int64(len(kv.Value)) > r.MaxValueSize })
+	}
+	switch r.LeaseFilter {
+	case pb.RangeRequest_ATTACHED:
+		pruneKVs(rr, func(kv *mvccpb.KeyValue) bool { return kv.Lease == 0 })
+	case pb.RangeRequest_DETACHED:
+		pruneKVs(rr, func(kv *mvccpb.KeyValue) bool { return kv.Lease != 0 })
+	}
 }
 
 func sortRangeResults(rr *mvcc.RangeResult, r *pb.RangeRequest, lg *zap.Logger) {
-	sortOrder := r.SortOrder
-	if r.SortTarget != pb.RangeRequest_KEY && sortOrder == pb.RangeRequest_NONE {
-		sortOrder = pb.RangeRequest_ASCEND
-	}
-
-	if !IsDefaultOrdering(r.SortTarget, sortOrder) {
-		var sorter sort.Interface
-		switch {
-		case r.SortTarget == pb.RangeRequest_KEY:
-			sorter = &kvSortByKey{&kvSort{rr.KVs}}
-		case r.SortTarget == pb.RangeRequest_VERSION:
-			sorter = &kvSortByVersion{&kvSort{rr.KVs}}
-		case r.SortTarget == pb.RangeRequest_CREATE:
-			sorter = &kvSortByCreate{&kvSort{rr.KVs}}
-		case r.SortTarget == pb.RangeRequest_MOD:
-			sorter = &kvSortByMod{&kvSort{rr.KVs}}
-		case r.SortTarget == pb.RangeRequest_VALUE:
-			sorter = &kvSortByValue{&kvSort{rr.KVs}}
-		default:
-			lg.Panic("unexpected sort target", zap.Int32("sort-target", int32(r.SortTarget)))
-		}
-		switch {
-		case sortOrder == pb.RangeRequest_ASCEND:
-			sort.Sort(sorter)
-		case sortOrder == pb.RangeRequest_DESCEND:
-			sort.Sort(sort.Reverse(sorter))
-		}
+	sortOrder := SortOrder(r)
+	if IsDefaultOrdering(r.SortTarget, sortOrder) {
+		return
+	}
+	switch r.SortTarget {
+	case pb.RangeRequest_KEY, pb.RangeRequest_VERSION, pb.RangeRequest_CREATE, pb.RangeRequest_MOD, pb.RangeRequest_VALUE:
+	default:
+		lg.Panic("unexpected sort target", zap.Int32("sort-target", int32(r.SortTarget)))
+	}
+	slices.SortFunc(rr.KVs, func(a, b *mvccpb.KeyValue) int {
+		return compareKVs(r.SortTarget, sortOrder, a, b)
+	})
+}
+
+// compareKVs compares key-value pairs in the given sort order of the target.
+// Ties are broken by key in ascending order, which keeps the key order of the
+// store for equal targets and gives sorted results a stable order to paginate
+// over.
+func compareKVs(target pb.RangeRequest_SortTarget, order pb.RangeRequest_SortOrder, a, b *mvccpb.KeyValue) int {
+	var c int
+	switch target {
+	case pb.RangeRequest_KEY:
+		c = bytes.Compare(a.Key, b.Key)
+	case pb.RangeRequest_VERSION:
+		c = cmp.Compare(a.Version, b.Version)
+	case pb.RangeRequest_CREATE:
+		c = cmp.Compare(a.CreateRevision, b.CreateRevision)
+	case pb.RangeRequest_MOD:
+		c = cmp.Compare(a.ModRevision, b.ModRevision)
+	case pb.RangeRequest_VALUE:
+		c = bytes.Compare(a.Value, b.Value)
 	}
+	if order == pb.RangeRequest_DESCEND {
+		c = -c
+	}
+	if c != 0 {
+		return c
+	}
+	return bytes.Compare(a.Key, b.Key)
 }
 
 func asembleRangeResponse(rr *mvcc.RangeResult, r *pb.RangeRequest) *pb.RangeResponse {
@@ -172,12 +254,20 @@ func asembleRangeResponse(rr *mvcc.RangeResult, r *pb.RangeRequest) *pb.RangeRes
 }
 
 func checkRange(rv mvcc.ReadView, req *pb.RangeRequest) error {
+	cursor, err := decodeContinueToken(req)
+	if err != nil {
+		return err
+	}
+	rev := req.Revision
+	if cursor != nil {
+		rev = cursor.revision
+	}
 	switch {
-	case req.Revision == 0:
+	case rev == 0:
 		return nil
-	case req.Revision > rv.Rev():
+	case rev > rv.Rev():
 		return mvcc.ErrFutureRev
-	case req.Revision < rv.FirstRev():
+	case rev < rv.FirstRev():
 		return mvcc.ErrCompacted
 	}
 	return nil
@@ -193,42 +283,3 @@ func pruneKVs(rr *mvcc.RangeResult, isPrunable func(*mvccpb.KeyValue) bool) {
 	}
 	rr.KVs = rr.KVs[:j]
 }
-
-type kvSort struct{ kvs []*mvccpb.KeyValue }
-
-func (s *kvSort) Swap(i, j int) {
-	t := s.kvs[i]
-	s.kvs[i] = s.kvs[j]
-	s.kvs[j] = t
-}
-func (s *kvSort) Len() int { return len(s.kvs) }
-
-type kvSortByKey struct{ *kvSort }
-
-func (s *kvSortByKey) Less(i, j int) bool {
-	return bytes.Compare(s.kvs[i].Key, s.kvs[j].Key) < 0
-}
-
-type kvSortByVersion struct{ *kvSort }
-
-func (s *kvSortByVersion) Less(i, j int) bool {
-	return (s.kvs[i].Version - s.kvs[j].Version) < 0
-}
-
-type kvSortByCreate struct{ *kvSort }
-
-func (s *kvSortByCreate) Less(i, j int) bool {
-	return (s.kvs[i].CreateRevision - s.kvs[j].CreateRevision) < 0
-}
-
-type kvSortByMod struct{ *kvSort }
-
-func (s *kvSortByMod) Less(i, j int) bool {
-	return (s.kvs[i].ModRevision - s.kvs[j].ModRevision) < 0
-}
-
-type kvSortByValue struct{ *kvSort }
-
-func (s *kvSortByValue) Less(i, j int) bool {
-	return bytes.Compare(s.kvs[i].Value, s.kvs[j].Value) < 0
-}
@@ -64,11 +64,14 @@ func Txn(ctx context.Context, lg *zap.Logger, rt *pb.TxnRequest, txnModeWriteWit
 	var txnWrite mvcc.TxnWrite
 	if isWrite {
 		txnRead.End()
+		if _, err = grantTTLLeases(lessor, rt, txnPath); err != nil {
+			return nil, nil, err
+		}
 		txnWrite = kv.Write(trace)
 	} else {
 		txnWrite = mvcc.NewReadOnlyTxnWrite(txnRead)
 	}
-	txnResp, err = txn(ctx, lg, txnWrite, rt, isWrite, txnPath, skipRangeExecution)
+	txnResp, err = txn(ctx, lg, txnWrite, lessor, rt, isWrite, txnPath, skipRangeExecution)
 	txnWrite.End()
 
 	trace.AddField(
@@ -78,9 +81,9 @@ func Txn(ctx context.Context, lg *zap.Logger, rt *pb.TxnRequest, txnModeWriteWit
 	return txnResp, trace, err
 }
 
-func txn(ctx context.Context, lg *zap.Logger, txnWrite mvcc.TxnWrite, rt *pb.TxnRequest, isWrite bool, txnPath []bool, skipRangeExecution bool) (*pb.TxnResponse, error) {
+func txn(ctx context.Context, lg *zap.Logger, txnWrite mvcc.TxnWrite, lessor lease.Lessor, rt *pb.TxnRequest, isWrite bool, txnPath []bool, skipRangeExecution bool) (*pb.TxnResponse, error) {
 	txnResp, _ := newTxnResp(rt, txnPath)
-	_, err := executeTxn(ctx, lg, txnWrite, rt, txnPath, txnResp, skipRangeExecution)
+	_, err := executeTxn(ctx, lg, txnWrite, lessor, rt, txnPath, txnResp, skipRangeExecution)
 	if err != nil {
 		if isWrite {
 			// CAUTION: When a txn performing write operations starts, we always expect it to be successful.
@@ -121,6 +124,8 @@ func newTxnResp(rt *pb.TxnRequest, txnPath []bool) (txnResp *pb.TxnResponse, txn
 			resps[i] = &pb.ResponseOp{Response: &pb.ResponseOp_ResponsePut{}}
 		case *pb.RequestOp_RequestDeleteRange:
 			resps[i] = &pb.ResponseOp{Response: &pb.ResponseOp_ResponseDeleteRange{}}
+		case *pb.RequestOp_RequestIncrement:
+			resps[i] = &pb.ResponseOp{Response: &pb.ResponseOp_ResponseIncrement{}}
 		case *pb.RequestOp_RequestTxn:
 			resp, txns := newTxnResp(tv.RequestTxn, txnPath[1:])
 			resps[i] = &pb.ResponseOp{Response: &pb.ResponseOp_ResponseTxn{ResponseTxn: resp}}
@@ -132,7 +137,7 @@ func newTxnResp(rt *pb.TxnRequest, txnPath []bool) (txnResp *pb.TxnResponse, txn
 	return txnResp, txnCount
 }
 
-func executeTxn(ctx context.Context, lg *zap.Logger, txnWrite mvcc.TxnWrite, rt *pb.TxnRequest, txnPath []bool, tresp *pb.TxnResponse, skipRangeExecution bool) (txns int, err error) {
+func executeTxn(ctx context.Context, lg *zap.Logger, txnWrite mvcc.TxnWrite, lessor lease.Lessor, rt *pb.TxnRequest, txnPath []bool, tresp *pb.TxnResponse, skipRangeExecution bool) (txns int, err error) {
 	trace := traceutil.Get(ctx)
 	reqs := rt.Success
 	if !txnPath[0] {
@@ -173,7 +178,7 @@ func executeTxn(ctx context.Context, lg *zap.Logger, txnWrite mvcc.TxnWrite, rt
 			if err != nil {
 				return 0, fmt.Errorf("applyTxn: failed to get prevKV on put: %w", err)
 			}
-			resp := put(ctx, txnWrite, tv.RequestPut, prevKV)
+			resp := put(ctx, txnWrite, lessor, tv.RequestPut, prevKV)
 			respi.(*pb.ResponseOp_ResponsePut).ResponsePut = resp
 			trace.StopSubTrace()
 		case *pb.RequestOp_RequestDeleteRange:
@@ -182,9 +187,19 @@ func executeTxn(ctx context.Context, lg *zap.Logger, txnWrite mvcc.TxnWrite, rt
 				return 0, fmt.Errorf("applyTxn: failed DeleteRange: %w", err)
 			}
 			respi.(*pb.ResponseOp_ResponseDeleteRange).ResponseDeleteRange = resp
+		case *pb.RequestOp_RequestIncrement:
+			trace.StartSubTrace(
+				traceutil.Field{Key: "req_type", Value: "increment"},
+				traceutil.Field{Key: "key", Value: string(tv.RequestIncrement.Key)})
+			resp, err := increment(ctx, txnWrite, tv.RequestIncrement)
+			if err != nil {
+				return 0, fmt.Errorf("applyTxn: failed Increment: %w", err)
+			}
+			respi.(*pb.ResponseOp_ResponseIncrement).ResponseIncrement = resp
+			trace.StopSubTrace()
 		case *pb.RequestOp_RequestTxn:
 			resp := respi.(*pb.ResponseOp_ResponseTxn).ResponseTxn
-			applyTxns, err := executeTxn(ctx, lg, txnWrite, tv.RequestTxn, txnPath[1:], resp, skipRangeExecution)
+			applyTxns, err := executeTxn(ctx, lg, txnWrite, lessor, tv.RequestTxn, txnPath[1:], resp, skipRangeExecution)
 			if err != nil {
 				// don't wrap the error. It's a recursive call and err should be already wrapped
 				return 0, err
@@ -213,6 +228,8 @@ func checkTxn(trace *traceutil.Trace, rv mvcc.ReadView, rt *pb.TxnRequest, lesso
 		case *pb.RequestOp_RequestPut:
 			err = checkPut(trace, rv, lessor, tv.RequestPut)
 		case *pb.RequestOp_RequestDeleteRange:
+		case *pb.RequestOp_RequestIncrement:
+			err = checkIncrement(rv, lessor, tv.RequestIncrement)
 		case *pb.RequestOp_RequestTxn:
 			txns, err = checkTxn(trace, rv, tv.RequestTxn, lessor, txnPath[1:])
 			txnCount += txns + 1
@@ -227,6 +244,32 @@ func checkTxn(trace *traceutil.Trace, rv mvcc.ReadView, rt *pb.TxnRequest, lesso
 	return txnCount, nil
 }
 
+// grantTTLLeases grants the shared leases of the puts with a TTL on the
+// txn path that will be executed.
+func grantTTLLeases(lessor lease.Lessor, rt *pb.TxnRequest, txnPath []bool) (int, error) {
+	txnCount := 0
+	reqs := rt.Success
+	if !txnPath[0] {
+		reqs = rt.Failure
+	}
+	for _, req := range reqs {
+		switch tv := req.Request.(type) {
+		case *pb.RequestOp_RequestPut:
+			if err := grantTTLLease(lessor, tv.RequestPut); err != nil {
+				return 0, err
+			}
+		case *pb.RequestOp_RequestTxn:
+			txns, err := grantTTLLeases(lessor, tv.RequestTxn, txnPath[1:])
+			if err != nil {
+				return 0, err
+			}
+			txnCount += txns + 1
+			txnPath = txnPath[txns+1:]
+		}
+	}
+	return txnCount, nil
+}
+
 func compareInt64(a, b int64) int {
 	switch {
 	case a < b:
@@ -271,6 +314,9 @@ func applyCompare(rv mvcc.ReadView, c *pb.Compare) bool {
 	// * rewrite rules for common patterns:
 	//	ex. "[a, b) createrev > 0" => "limit 1 /\ kvs > 0"
 	// * caching
+	if isAggregateCompare(c) {
+		return applyAggregateCompare(rv, c)
+	}
 	rr, err := rv.Range(context.TODO(), c.Key, mkGteRange(c.RangeEnd), mvcc.RangeOptions{})
 	if err != nil {
 		return false
@@ -291,6 +337,51 @@ func applyCompare(rv mvcc.ReadView, c *pb.Compare) bool {
 	return true
 }
 
+func isAggregateCompare(c *pb.Compare) bool {
+	switch c.Target {
+	case pb.Compare_COUNT, pb.Compare_MAX_CREATE, pb.Compare_MAX_MOD:
+		return true
+	}
+	return false
+}
+
+// applyAggregateCompare applies a compare whose target is aggregated over all
+// keys in the range, rather than checked against each key individually.
+func applyAggregateCompare(rv mvcc.ReadView, c *pb.Compare) bool {
+	var actual, target int64
+	switch c.Target {
+	case pb.Compare_COUNT:
+		rr, err := rv.Range(context.TODO(), c.Key, mkGteRange(c.RangeEnd), mvcc.RangeOptions{CountOnly: true})
+		if err != nil {
+			return false
+		}
+		actual = int64(rr.Count)
+		if tv, _ := c.TargetUnion.(*pb.Compare_Count); tv != nil {
+			target = tv.Count
+		}
+	case pb.Compare_MAX_CREATE, pb.Compare_MAX_MOD:
+		// Only revisions are compared, so the range can be served from the index.
+		rr, err := rv.Range(context.TODO(), c.Key, mkGteRange(c.RangeEnd), mvcc.RangeOptions{FastKeysOnly: true})
+		if err != nil {
+			return false
+		}
+		for _, kv := range rr.KVs {
+			rev := kv.ModRevision
+			if c.Target == pb.Compare_MAX_CREATE {
+				rev = kv.CreateRevision
+			}
+			actual = max(actual, rev)
+		}
+		switch tv := c.TargetUnion.(type) {
+		case *pb.Compare_MaxCreateRevision:
+			target = tv.MaxCreateRevision
+		case *pb.Compare_MaxModRevision:
+			target = tv.MaxModRevision
+		}
+	}
+	return compareResult(c.Result, compareInt64(actual, target))
+}
+
 func compareKV(c *pb.Compare, ckv *mvccpb.KeyValue) bool {
 	var result int
 	rev := int64(0)
@@ -322,7 +413,11 @@ func compareKV(c *pb.Compare, ckv *mvccpb.KeyValue) bool {
 		}
 		result = compareInt64(ckv.Lease, rev)
 	}
-	switch c.Result {
+	return compareResult(c.Result, result)
+}
+
+func compareResult(r pb.Compare_CompareResult, result int) bool {
+	switch r {
 	case pb.Compare_EQUAL:
 		return result == 0
 	case pb.Compare_NOT_EQUAL:
@@ -15,10 +15,12 @@
 package txn
 
 import (
+	"bytes"
 	"context"
 	"crypto/sha256"
 	"io"
 	"os"
+	"slices"
 	"strings"
 	"testing"
 
@@ -27,7 +29,9 @@ import (
 	"go.uber.org/zap/zaptest"
 
 	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/api/v3/mvccpb"
 	"go.etcd.io/etcd/pkg/v3/traceutil"
+	"go.etcd.io/etcd/server/v3/etcdserver/errors"
 	"go.etcd.io/etcd/server/v3/lease"
 	betesting "go.etcd.io/etcd/server/v3/storage/backend/testing"
 	"go.etcd.io/etcd/server/v3/storage/mvcc"
@@ -163,6 +167,57 @@ var putTestCases = []testCase{
 	},
 }
 
+var incrementTestCases = []testCase{
+	{
+		name: "Increment without previous key should succeed",
+		op: &pb.RequestOp{
+			Request: &pb.RequestOp_RequestIncrement{
+				RequestIncrement: &pb.IncrementRequest{
+					Key:   []byte("counter"),
+					Delta: 1,
+				},
+			},
+		},
+	},
+	{
+		name:  "Increment of non counter value should fail",
+		setup: testSetup{key: []byte("counter")},
+		op: &pb.RequestOp{
+			Request: &pb.RequestOp_RequestIncrement{
+				RequestIncrement: &pb.IncrementRequest{
+					Key:   []byte("counter"),
+					Delta: 1,
+				},
+			},
+		},
+		expectError: "etcdserver: value is not a valid counter",
+	},
+	{
+		name: "Increment with missing lease should fail",
+		op: &pb.RequestOp{
+			Request: &pb.RequestOp_RequestIncrement{
+				RequestIncrement: &pb.IncrementRequest{
+					Key:   []byte("counter"),
+					Lease: 123,
+				},
+			},
+		},
+		expectError: "lease not found",
+	},
+	{
+		name: "Increment with ignore lease without previous key should fail",
+		op: &pb.RequestOp{
+			Request: &pb.RequestOp_RequestIncrement{
+				RequestIncrement: &pb.IncrementRequest{
+					Key:         []byte("counter"),
+					IgnoreLease: true,
+				},
+			},
+		},
+		expectError: "etcdserver: key not found",
+	},
+}
+
 func TestCheckTxn(t *testing.T) {
 	type txnTestCase struct {
 		name        string
@@ -171,7 +226,7 @@ func TestCheckTxn(t *testing.T) {
 		expectError string
 	}
 	testCases := []txnTestCase{}
-	for _, tc := range append(rangeTestCases, putTestCases...) {
+	for _, tc := range slices.Concat(rangeTestCases, putTestCases, incrementTestCases) {
 		testCases = append(testCases, txnTestCase{
 			name:  tc.name,
 			setup: tc.setup,
@@ -276,6 +331,505 @@ func TestCheckRange(t *testing.T) {
 	}
 }
 
+func TestRangeValueFilters(t *testing.T) {
+	s, lessor := setup(t, testSetup{})
+	lessor.Grant(1, 0)
+	s.Put([]byte("a"), []byte("foo"), lease.NoLease)
+	s.Put([]byte("b"), []byte("foobar"), lease.NoLease)
+	s.Put([]byte("c"), []byte("bar"), lease.NoLease)
+	s.Put([]byte("d"), []byte("foo"), 1)
+
+	tests := []struct {
+		name     string
+		req      *pb.RangeRequest
+		wantKeys []string
+		wantMore bool
+	}{
+		{
+			name:     "value prefix",
+			req:      &pb.RangeRequest{ValuePrefix: []byte("foo")},
+			wantKeys: []string{"a", "b", "d"},
+		},
+		{
+			name:     "value prefix with keys only",
+			req:      &pb.RangeRequest{ValuePrefix: []byte("foo"), KeysOnly: true},
+			wantKeys: []string{"a", "b", "d"},
+		},
+		{
+			name:     "value equal",
+			req:      &pb.RangeRequest{ValuePrefix: []byte("foo"), MaxValueSize: 3},
+			wantKeys: []string{"a", "d"},
+		},
+		{
+			name:     "min value size",
+			req:      &pb.RangeRequest{MinValueSize: 4},
+			wantKeys: []string{"b"},
+		},
+		{
+			name:     "lease attached",
+			req:      &pb.RangeRequest{LeaseFilter: pb.RangeRequest_ATTACHED},
+			wantKeys: []string{"d"},
+		},
+		{
+			name:     "lease detached",
+			req:      &pb.RangeRequest{LeaseFilter: pb.RangeRequest_DETACHED},
+			wantKeys: []string{"a", "b", "c"},
+		},
+		{
+			name:     "limit applies after filtering",
+			req:      &pb.RangeRequest{ValuePrefix: []byte("foo"), Limit: 2},
+			wantKeys: []string{"a", "b"},
+			wantMore: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.req.Key = []byte("a")
+			tc.req.RangeEnd = []byte("z")
+			resp, _, err := Range(t.Context(), zaptest.NewLogger(t), s, tc.req, true)
+			require.NoError(t, err)
+
+			var keys []string
+			for _, kv := range resp.Kvs {
+				keys = append(keys, string(kv.Key))
+				if tc.req.KeysOnly {
+					assert.Empty(t, kv.Value)
+				}
+			}
+			assert.Equal(t, tc.wantKeys, keys)
+			assert.Equal(t, tc.wantMore, resp.More)
+			// count is not affected by filters
+			assert.Equal(t, int64(4), resp.Count)
+		})
+	}
+}
+
+func TestRangeContinueToken(t *testing.T) {
+	tests := []struct {
+		name     string
+		req      *pb.RangeRequest
+		wantKeys []string
+	}{
+		{
+			name:     "key",
+			req:      &pb.RangeRequest{},
+			wantKeys: []string{"a", "b", "c", "d"},
+		},
+		{
+			name:     "key descend",
+			req:      &pb.RangeRequest{SortTarget: pb.RangeRequest_KEY, SortOrder: pb.RangeRequest_DESCEND},
+			wantKeys: []string{"d", "c", "b", "a"},
+		},
+		{
+			name:     "version",
+			req:      &pb.RangeRequest{SortTarget: pb.RangeRequest_VERSION},
+			wantKeys: []string{"b", "c", "d", "a"},
+		},
+		{
+			name:     "create descend",
+			req:      &pb.RangeRequest{SortTarget: pb.RangeRequest_CREATE, SortOrder: pb.RangeRequest_DESCEND},
+			wantKeys: []string{"c", "d", "a", "b"},
+		},
+		{
+			name:     "value with keys only",
+			req:      &pb.RangeRequest{SortTarget: pb.RangeRequest_VALUE, SortOrder: pb.RangeRequest_ASCEND, KeysOnly: true},
+			wantKeys: []string{"c", "d", "b", "a"},
+		},
+		{
+			name:     "value filter",
+			req:      &pb.RangeRequest{MaxValueSize: 1, ValuePrefix: []byte("1")},
+			wantKeys: []string{"c", "d"},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			s, _ := setup(t, testSetup{})
+			s.Put([]byte("b"), []byte("2"), lease.NoLease)
+			s.Put([]byte("a"), []byte("3"), lease.NoLease)
+			s.Put([]byte("d"), []byte("1"), lease.NoLease)
+			s.Put([]byte("c"), []byte("1"), lease.NoLease)
+			s.Put([]byte("a"), []byte("4"), lease.NoLease)
+
+			tc.req.Key = []byte("a")
+			tc.req.RangeEnd = []byte("z")
+			tc.req.Limit = 1
+
+			var keys []string
+			for i := 0; ; i++ {
+				resp, _, err := Range(t.Context(), zaptest.NewLogger(t), s, tc.req, true)
+				require.NoError(t, err)
+				for _, kv := range resp.Kvs {
+					keys = append(keys, string(kv.Key))
+				}
+				if i == 0 {
+					// later pages are read at the revision of the first one
+					s.Put([]byte("aa"), []byte("1"), lease.NoLease)
+				}
+				require.Equal(t, resp.More, len(resp.ContinueToken) != 0)
+				if !resp.More {
+					break
+				}
+				tc.req.ContinueToken = resp.ContinueToken
+			}
+			assert.Equal(t, tc.wantKeys, keys)
+		})
+	}
+}
+
+func TestRangeContinueTokenCount(t *testing.T) {
+	s, _ := setup(t, testSetup{})
+	for _, key := range []string{"a", "b", "c"} {
+		s.Put([]byte(key), []byte("1"), lease.NoLease)
+	}
+
+	for _, order := range []pb.RangeRequest_SortOrder{pb.RangeRequest_NONE, pb.RangeRequest_DESCEND} {
+		req := &pb.RangeRequest{Key: []byte("a"), RangeEnd: []byte("z"), Limit: 1, SortOrder: order}
+		resp, _, err := Range(t.Context(), zaptest.NewLogger(t), s, req, true)
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), resp.Count)
+
+		req.ContinueToken = resp.ContinueToken
+		resp, _, err = Range(t.Context(), zaptest.NewLogger(t), s, req, true)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), resp.Count)
+	}
+}
+
+func TestRangeContinueTokenValueSort(t *testing.T) {
+	s, _ := setup(t, testSetup{})
+	s.Put([]byte("a"), bytes.Repeat([]byte("x"), 1000), lease.NoLease)
+	s.Put([]byte("b"), []byte("y"), lease.NoLease)
+
+	req := &pb.RangeRequest{Key: []byte("a"), RangeEnd: []byte("z"), Limit: 1, SortTarget: pb.RangeRequest_VALUE}
+	resp, _, err := Range(t.Context(), zaptest.NewLogger(t), s, req, true)
+	require.NoError(t, err)
+	require.Equal(t, "a", string(resp.Kvs[0].Key))
+	// the value of the last key is not copied into the token
+	assert.Less(t, len(resp.ContinueToken), 100)
+
+	req.ContinueToken = resp.ContinueToken
+	resp, _, err = Range(t.Context(), zaptest.NewLogger(t), s, req, true)
+	require.NoError(t, err)
+	require.Len(t, resp.Kvs, 1)
+	assert.Equal(t, "b", string(resp.Kvs[0].Key))
+	assert.False(t, resp.More)
+}
+
+func TestRangeInvalidContinueToken(t *testing.T) {
+	s, _ := setup(t, testSetup{})
+	for _, key := range []string{"a", "b", "c"} {
+		s.Put([]byte(key), []byte("1"), lease.NoLease)
+	}
+	resp, _, err := Range(t.Context(), zaptest.NewLogger(t), s, &pb.RangeRequest{Key: []byte("a"), RangeEnd: []byte("z"), Limit: 1}, true)
+	require.NoError(t, err)
+	token := resp.ContinueToken
+
+	tests := []struct {
+		name string
+		req  *pb.RangeRequest
+	}{
+		{
+			name: "malformed",
+			req:  &pb.RangeRequest{ContinueToken: []byte("foo")},
+		},
+		{
+			name: "truncated",
+			req:  &pb.RangeRequest{ContinueToken: token[:len(token)-1]},
+		},
+		{
+			name: "different sort order",
+			req:  &pb.RangeRequest{ContinueToken: token, SortOrder: pb.RangeRequest_DESCEND},
+		},
+		{
+			name: "different revision",
+			req:  &pb.RangeRequest{ContinueToken: token, Revision: 2},
+		},
+		{
+			name: "different range",
+			req:  &pb.RangeRequest{ContinueToken: token, Key: []byte("b"), RangeEnd: []byte("z")},
+		},
+		{
+			name: "different filter",
+			req:  &pb.RangeRequest{ContinueToken: token, ValuePrefix: []byte("1")},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.req.Key == nil {
+				tc.req.Key = []byte("a")
+				tc.req.RangeEnd = []byte("z")
+			}
+			_, _, err := Range(t.Context(), zaptest.NewLogger(t), s, tc.req, true)
+			require.ErrorIs(t, err, errors.ErrInvalidContinueToken)
+
+			txn := &pb.TxnRequest{Success: []*pb.RequestOp{{Request: &pb.RequestOp_RequestRange{RequestRange: tc.req}}}}
+			_, _, err = Txn(t.Context(), zaptest.NewLogger(t), txn, false, s, &lease.FakeLessor{}, false)
+			require.ErrorIs(t, err, errors.ErrInvalidContinueToken)
+		})
+	}
+}
+
+func TestRangeChunk(t *testing.T) {
+	s, _ := setup(t, testSetup{})
+	for _, key := range []string{"a", "b", "c", "d", "e"} {
+		s.Put([]byte(key), []byte(strings.Repeat("x", 100)), lease.NoLease)
+	}
+
+	tests := []struct {
+		name       string
+		maxBytes   int
+		wantChunks [][]string
+	}{
+		{
+			name:       "fits",
+			maxBytes:   1000,
+			wantChunks: [][]string{{"a", "b", "c"}, {"d", "e"}},
+		},
+		{
+			name:       "cut to budget",
+			maxBytes:   250,
+			wantChunks: [][]string{{"a", "b"}, {"c", "d"}, {"e"}},
+		},
+		{
+			name:       "at least one key",
+			maxBytes:   1,
+			wantChunks: [][]string{{"a"}, {"b"}, {"c"}, {"d"}, {"e"}},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			req := &pb.RangeRequest{Key: []byte("a"), RangeEnd: []byte("z"), Limit: 3}
+			var chunks [][]string
+			for {
+				resp, err := RangeChunk(t.Context(), zaptest.NewLogger(t), s, req, tc.maxBytes)
+				require.NoError(t, err)
+				var keys []string
+				for _, kv := range resp.Kvs {
+					keys = append(keys, string(kv.Key))
+				}
+				chunks = append(chunks, keys)
+				require.Equal(t, resp.More, len(resp.ContinueToken) != 0)
+				if !resp.More {
+					break
+				}
+				req.ContinueToken = resp.ContinueToken
+			}
+			assert.Equal(t, tc.wantChunks, chunks)
+		})
+	}
+}
+
+func TestDeleteBatchEnd(t *testing.T) {
+	s, _ := setup(t, testSetup{})
+	for _, key := range []string{"a", "b", "c", "d", "e"} {
+		s.Put([]byte(key), []byte("x"), lease.NoLease)
+	}
+	// the previous value of c weighs on the cost of deleting it
+	s.Put([]byte("c"), bytes.Repeat([]byte("x"), 100), lease.NoLease)
+
+	tests := []struct {
+		name      string
+		batchSize int64
+		maxBytes  int
+		wantEnds  []string
+	}{
+		{
+			name:      "by keys",
+			batchSize: 2,
+			wantEnds:  []string{"b\x00", "d\x00", "z"},
+		},
+		{
+			name:      "by bytes",
+			batchSize: 10,
+			maxBytes:  40,
+			wantEnds:  []string{"c", "d", "z"},
+		},
+		{
+			name:      "at least one key",
+			batchSize: 10,
+			maxBytes:  1,
+			wantEnds:  []string{"b", "c", "d", "e", "z"},
+		},
+		{
+			name:      "single batch",
+			batchSize: 5,
+			wantEnds:  []string{"z"},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			dr := &pb.DeleteRangeRequest{Key: []byte("a"), RangeEnd: []byte("z"), BatchSize: tc.batchSize}
+			var ends []string
+			key := dr.Key
+			for {
+				end, more, err := DeleteBatchEnd(t.Context(), zaptest.NewLogger(t), s, dr, key, tc.maxBytes)
+				require.NoError(t, err)
+				ends = append(ends, string(end))
+				if !more {
+					break
+				}
+				key = end
+			}
+			assert.Equal(t, tc.wantEnds, ends)
+		})
+	}
+}
+
+func TestKeyHistory(t *testing.T) {
+	s, _ := setup(t, testSetup{})
+	s.Put([]byte("foo"), []byte("bar0"), lease.NoLease)
+	s.Put([]byte("foo"), []byte("bar1"), lease.NoLease)
+	s.DeleteRange([]byte("foo"), nil)
+	s.Put([]byte("foo"), []byte("bar2"), lease.NoLease)
+
+	resp, err := KeyHistory(t.Context(), zaptest.NewLogger(t), s, &pb.KeyHistoryRequest{Key: []byte("foo"), Limit: 3, SortOrder: pb.RangeRequest_DESCEND, KeysOnly: true})
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), resp.Header.Revision)
+	assert.Equal(t, int64(4), resp.Count)
+	assert.True(t, resp.More)
+	require.Len(t, resp.Kvs, 3)
+	for i, wrev := range []int64{5, 4, 3} {
+		assert.Equal(t, wrev, resp.Kvs[i].ModRevision)
+		assert.Empty(t, resp.Kvs[i].Value)
+	}
+	assert.Equal(t, int64(0), resp.Kvs[1].Version, "deletion must be returned with version 0")
+
+	resp, err = KeyHistory(t.Context(), zaptest.NewLogger(t), s, &pb.KeyHistoryRequest{Key: []byte("foo"), MinModRevision: 3, MaxModRevision: 3})
+	require.NoError(t, err)
+	assert.False(t, resp.More)
+	require.Len(t, resp.Kvs, 1)
+	assert.Equal(t, []byte("bar1"), resp.Kvs[0].Value)
+}
+
+func TestIncrement(t *testing.T) {
+	s, lessor := setup(t, testSetup{lease: 1})
+	s.Put([]byte("max"), []byte("9223372036854775807"), lease.NoLease)
+
+	increment := func(r *pb.IncrementRequest) (*pb.IncrementResponse, error) {
+		txn := &pb.TxnRequest{Success: []*pb.RequestOp{{Request: &pb.RequestOp_RequestIncrement{RequestIncrement: r}}}}
+		resp, _, err := Txn(t.Context(), zaptest.NewLogger(t), txn, false, s, lessor, false)
+		if err != nil {
+			return nil, err
+		}
+		return resp.Responses[0].GetResponseIncrement(), nil
+	}
+	get := func(key string) *mvccpb.KeyValue {
+		rr, err := s.Range(t.Context(), []byte(key), nil, mvcc.RangeOptions{})
+		require.NoError(t, err)
+		require.Len(t, rr.KVs, 1)
+		return rr.KVs[0]
+	}
+
+	resp, err := increment(&pb.IncrementRequest{Key: []byte("a"), Delta: 5, Lease: 1})
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), resp.Value)
+	assert.Nil(t, resp.PrevKv)
+
+	resp, err = increment(&pb.IncrementRequest{Key: []byte("a"), Delta: -7, IgnoreLease: true, PrevKv: true})
+	require.NoError(t, err)
+	assert.Equal(t, int64(-2), resp.Value)
+	assert.Equal(t, "5", string(resp.PrevKv.Value))
+	kv := get("a")
+	assert.Equal(t, "-2", string(kv.Value))
+	assert.Equal(t, int64(1), kv.Lease)
+	assert.Equal(t, resp.Header.Revision, kv.ModRevision)
+
+	resp, err = increment(&pb.IncrementRequest{Key: []byte("b"), Delta: 258, Encoding: pb.IncrementRequest_BIG_ENDIAN})
+	require.NoError(t, err)
+	assert.Equal(t, int64(258), resp.Value)
+	assert.Equal(t, []byte{0, 0, 0, 0, 0, 0, 1, 2}, get("b").Value)
+
+	_, err = increment(&pb.IncrementRequest{Key: []byte("a"), Delta: 1, Encoding: pb.IncrementRequest_BIG_ENDIAN})
+	require.ErrorIs(t, err, errors.ErrInvalidCounter)
+
+	_, err = increment(&pb.IncrementRequest{Key: []byte("max"), Delta: 1})
+	require.ErrorIs(t, err, errors.ErrCounterOverflow)
+	assert.Equal(t, "9223372036854775807", string(get("max").Value))
+}
+
+func TestPutTTL(t *testing.T) {
+	s, lessor := setup(t, testSetup{})
+	id, ttl := lease.TTLLease(60, 0)
+
+	resp, _, err := Put(t.Context(), zaptest.NewLogger(t), lessor, s, &pb.PutRequest{Key: []byte("a"), Lease: int64(id), Ttl: ttl})
+	require.NoError(t, err)
+	assert.Equal(t, lease.TTLLeaseExpiry(id), resp.Expiry)
+	assert.NotNil(t, lessor.Lookup(id))
+
+	// keys expiring in the same second share the lease
+	txn := &pb.TxnRequest{Success: []*pb.RequestOp{{Request: &pb.RequestOp_RequestPut{RequestPut: &pb.PutRequest{Key: []byte("b"), Lease: int64(id), Ttl: ttl}}}}}
+	txnResp, _, err := Txn(t.Context(), zaptest.NewLogger(t), txn, false, s, lessor, false)
+	require.NoError(t, err)
+	assert.Equal(t, resp.Expiry, txnResp.Responses[0].GetResponsePut().Expiry)
+
+	rr, err := s.Range(t.Context(), []byte("a"), []byte("c"), mvcc.RangeOptions{})
+	require.NoError(t, err)
+	require.Len(t, rr.KVs, 2)
+	for _, kv := range rr.KVs {
+		assert.Equal(t, int64(id), kv.Lease)
+	}
+}
+
+func TestAggregateCompare(t *testing.T) {
+	s, _ := setup(t, testSetup{})
+	s.Put([]byte("a"), []byte("1"), lease.NoLease) // rev 2
+	s.Put([]byte("b"), []byte("1"), lease.NoLease) // rev 3
+	s.Put([]byte("a"), []byte("2"), lease.NoLease) // rev 4
+
+	tests := []struct {
+		name string
+		cmp  *pb.Compare
+		want bool
+	}{
+		{
+			name: "count equal",
+			cmp:  &pb.Compare{Target: pb.Compare_COUNT, Result: pb.Compare_EQUAL, TargetUnion: &pb.Compare_Count{Count: 2}},
+			want: true,
+		},
+		{
+			name: "count less",
+			cmp:  &pb.Compare{Target: pb.Compare_COUNT, Result: pb.Compare_LESS, TargetUnion: &pb.Compare_Count{Count: 2}},
+			want: false,
+		},
+		{
+			name: "max mod greater",
+			cmp:  &pb.Compare{Target: pb.Compare_MAX_MOD, Result: pb.Compare_GREATER, TargetUnion: &pb.Compare_MaxModRevision{MaxModRevision: 3}},
+			want: true,
+		},
+		{
+			name: "max mod equal",
+			cmp:  &pb.Compare{Target: pb.Compare_MAX_MOD, Result: pb.Compare_EQUAL, TargetUnion: &pb.Compare_MaxModRevision{MaxModRevision: 4}},
+			want: true,
+		},
+		{
+			name: "max create equal",
+			cmp:  &pb.Compare{Target: pb.Compare_MAX_CREATE, Result: pb.Compare_EQUAL, TargetUnion: &pb.Compare_MaxCreateRevision{MaxCreateRevision: 3}},
+			want: true,
+		},
+		{
+			name: "empty range counts zero",
+			cmp:  &pb.Compare{Key: []byte("x"), RangeEnd: []byte("y"), Target: pb.Compare_COUNT, Result: pb.Compare_EQUAL, TargetUnion: &pb.Compare_Count{Count: 0}},
+			want: true,
+		},
+		{
+			name: "empty range max mod is zero",
+			cmp:  &pb.Compare{Key: []byte("x"), RangeEnd: []byte("y"), Target: pb.Compare_MAX_MOD, Result: pb.Compare_LESS, TargetUnion: &pb.Compare_MaxModRevision{MaxModRevision: 1}},
+			want: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.cmp.Key == nil {
+				tc.cmp.Key = []byte("a")
+				tc.cmp.RangeEnd = []byte("z")
+			}
+			txn := s.Read(mvcc.ConcurrentReadTxMode, traceutil.TODO())
+			defer txn.End()
+			assert.Equal(t, tc.want, applyCompare(txn, tc.cmp))
+		})
+	}
+}
+
 func setup(t *testing.T, setup testSetup) (mvcc.KV, lease.Lessor) {
 	b, _ := betesting.NewDefaultTmpBackend(t)
 	t.Cleanup(func() {
@@ -55,7 +55,7 @@ func isConnectedSince(transport rafthttp.Transporter, since time.Time, remote ty
 }
 
 // exceedsRequestLimit checks if the committed index is too far ahead of the applied index.
-// LeaseRevoke requests are prioritized to ensure timely lease expiration,
+// LeaseRevoke, LeaseRevokeBatch and LeaseExpire requests are prioritized to ensure timely lease expiration,
 // which helps mitigate pressure on the cluster.
 func exceedsRequestLimit(appliedIndex, committedIndex uint64, r *pb.InternalRaftRequest, enablePriority bool) bool {
 	if committedIndex <= appliedIndex+maxNormalGap {
@@ -70,7 +70,7 @@ func exceedsRequestLimit(appliedIndex, committedIndex uint64, r *pb.InternalRaft
 }
 
 func isPriorityRequest(r *pb.InternalRaftRequest) bool {
-	return r != nil && r.LeaseRevoke != nil
+	return r != nil && (r.LeaseRevoke != nil || r.LeaseRevokeBatch != nil || r.LeaseExpire != nil)
 }
 
 // numConnectedSince counts how many members are connected to the local member
@@ -15,6 +15,7 @@
 package etcdserver
 
 import (
+	"bytes"
 	"context"
 	"encoding/base64"
 	errorspkg "errors"
@@ -22,6 +23,7 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/Masterminds/semver/v3"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
@@ -55,11 +57,20 @@ const (
 	// The timeout for the node to catch up its applied index, and is used in
 	// lease related operations, such as LeaseRenew and LeaseTimeToLive.
 	applyTimeout = time.Second
+
+	// maxLeaseLeasesKeys is the maximum number of attached keys LeaseLeases
+	// lists per lease.
+	maxLeaseLeasesKeys = 1000
+	// maxLeaseRevokeBatchLeases and maxLeaseRevokeBatchKeys bound the number
+	// of leases and attached keys revoked in a single raft entry.
+	maxLeaseRevokeBatchLeases = 1000
+	maxLeaseRevokeBatchKeys   = 10000
 )
 
 type RaftKV interface {
 	Range(ctx context.Context, r *pb.RangeRequest) (*pb.RangeResponse, error)
 	RangeStream(r *pb.RangeRequest, rs pb.KV_RangeStreamServer) error
+	KeyHistory(ctx context.Context, r *pb.KeyHistoryRequest) (*pb.KeyHistoryResponse, error)
 	Put(ctx context.Context, r *pb.PutRequest) (*pb.PutResponse, error)
 	DeleteRange(ctx context.Context, r *pb.DeleteRangeRequest) (*pb.DeleteRangeResponse, error)
 	Txn(ctx context.Context, r *pb.TxnRequest) (*pb.TxnResponse, error)
@@ -71,6 +82,10 @@ type Lessor interface {
 	LeaseGrant(ctx context.Context, r *pb.LeaseGrantRequest) (*pb.LeaseGrantResponse, error)
 	// LeaseRevoke sends LeaseRevoke request to raft and toApply it after committed.
 	LeaseRevoke(ctx context.Context, r *pb.LeaseRevokeRequest) (*pb.LeaseRevokeResponse, error)
+	// LeaseRevokeBatch sends LeaseRevokeBatch request to raft and toApply it after committed.
+	LeaseRevokeBatch(ctx context.Context, r *pb.LeaseRevokeBatchRequest) (*pb.LeaseRevokeBatchResponse, error)
+	// LeaseUpdateTTL sends LeaseUpdateTTL request to raft and toApply it after committed.
+	LeaseUpdateTTL(ctx context.Context, r *pb.LeaseUpdateTTLRequest) (*pb.LeaseUpdateTTLResponse, error)
 
 	// LeaseRenew renews the lease with given ID. The renewed TTL is returned. Or an error
 	// is returned.
@@ -81,6 +96,10 @@ type Lessor interface {
 
 	// LeaseLeases lists all leases.
 	LeaseLeases(ctx context.Context, r *pb.LeaseLeasesRequest) (*pb.LeaseLeasesResponse, error)
+
+	// LeaseWatch streams the lease events applied by this member until the
+	// stream is closed.
+	LeaseWatch(r *pb.LeaseWatchRequest, ls pb.Lease_LeaseWatchServer) error
 }
 
 type Authenticator interface {
@@ -194,6 +213,12 @@ func (s *EtcdServer) RangeStream(r *pb.RangeRequest, rs pb.KV_RangeStreamServer)
 	return err
 }
 
+// rangeStream sends the range in chunks of about MaxRequestBytes. Every chunk
+// is read in its own read transaction, which is ended before the chunk is sent,
+// so a slow reader applies backpressure to the stream without holding a read
+// transaction open. Chunks are chained with continue tokens, which pin the
+// revision of the first chunk, and every chunk but the final one carries the
+// token as resume token for the client to resume a broken stream from.
 func (s *EtcdServer) rangeStream(ctx context.Context, r *pb.RangeRequest, rs pb.KV_RangeStreamServer) error {
 	if r.CountOnly {
 		resp, _, err := txn.Range(ctx, s.Logger(), s.KV(), r, false)
@@ -211,85 +236,118 @@ func (s *EtcdServer) rangeStream(ctx context.Context, r *pb.RangeRequest, rs pb.
 	if totalLimit == 0 {
 		totalLimit = math.MaxInt64
 	}
-	r.Limit = initialStreamChunkLimit
-	if r.Limit > totalLimit {
-		r.Limit = totalLimit
-	}
+	r.Limit = min(initialStreamChunkLimit, totalLimit)
 
+	targetSize := int(s.Cfg.MaxRequestBytes)
 	count := int64(0)
 	var headerRev int64
 	for {
 		// gofail: var beforeRangeStreamChunk struct{}
-		resp, _, err := txn.Range(ctx, s.Logger(), s.KV(), r, false)
+		resp, err := txn.RangeChunk(ctx, s.Logger(), s.KV(), r, targetSize)
 		if err != nil {
 			return err
 		}
 		// headerRev should represent the latest store revision at the moment
 		// the server starts handling the client request, and remain stable for
-		// the whole response stream.
-		//
-		// If the client did not explicitly pin a revision (r.Revision == 0),
-		// we pin it here to that same initial latest revision, rather than
-		// advancing it for each subsequent range response in the stream.
-		// As a result, writes committed after the stream begins are not reflected
-		// in later range responses of this stream.
+		// the whole response stream. Later chunks are read at the revision
+		// pinned by the continue token of the first one, so writes committed
+		// after the stream begins are not reflected in them.
 		if headerRev == 0 {
 			headerRev = resp.Header.Revision
-			if r.Revision == 0 {
-				r.Revision = headerRev
-			}
 		}
 		count += int64(len(resp.Kvs))
 
-		var nextKey []byte
-		if resp.More {
-			nextKey = append(resp.Kvs[len(resp.Kvs)-1].Key, '\x00')
-		}
 		out := &pb.RangeResponse{Kvs: resp.Kvs}
 		done := !resp.More || count == totalLimit
-		if done {
-			out.Header = &pb.ResponseHeader{Revision: headerRev}
-			out.More = resp.More
-			out.Count = count
-			if resp.More {
-				remaining, cerr := txn.Count(ctx, s.Logger(), s.KV(), nextKey, r.RangeEnd, r.Revision)
-				if cerr != nil {
-					return cerr
-				}
-				out.Count += remaining
+		if !done {
+			if err := rs.Send(&pb.RangeStreamResponse{RangeResponse: out, ResumeToken: resp.ContinueToken}); err != nil {
+				return err
 			}
-		}
-		if err := rs.Send(&pb.RangeStreamResponse{RangeResponse: out}); err != nil {
-			return err
-		}
-		if done {
-			return nil
+			r.ContinueToken = resp.ContinueToken
+			r.Limit = nextChunkLimit(len(resp.Kvs), proto.Size(out), targetSize)
+			r.Limit = min(r.Limit, totalLimit-count)
+			continue
 		}
 
-		r.Key = nextKey
-		r.Limit = adjustChunkLimit(r.Limit, proto.Size(resp), int(s.Cfg.MaxRequestBytes))
-		r.Limit = min(r.Limit, totalLimit-count)
+		out.Header = &pb.ResponseHeader{Revision: headerRev}
+		out.More = resp.More
+		out.Count = count
+		out.ContinueToken = resp.ContinueToken
+		if resp.More {
+			remaining, _, err := txn.Range(ctx, s.Logger(), s.KV(), &pb.RangeRequest{
+				Key:           r.Key,
+				RangeEnd:      r.RangeEnd,
+				Revision:      r.Revision,
+				CountOnly:     true,
+				ContinueToken: resp.ContinueToken,
+			}, false)
+			if err != nil {
+				return err
+			}
+			out.Count += remaining.Count
+		}
+		return rs.Send(&pb.RangeStreamResponse{RangeResponse: out})
 	}
 }
 
 const initialStreamChunkLimit = 10
 
-// adjustChunkLimit picks the next chunk's Limit so each chunk lands near
-// the target size: too small wastes round-trips, too large produces
-// oversized chunks. Doubling/halving only outside [0.5x, 2x] avoids
-// thrashing when responses sit near the boundary. Always returns >= 1,
-// since Limit=0 means "unlimited" in txn.Range.
-func adjustChunkLimit(lastLimit int64, lastSize, targetSize int) int64 {
-	switch {
-	case lastSize < targetSize/2:
-		lastLimit *= 2
-	case lastSize > targetSize*2:
-		lastLimit /= 2
+// nextChunkLimit picks the next chunk's Limit so that it fills the target
+// size given the size of the n key-value pairs of the last chunk. Chunks
+// overshooting the target are cut by txn.RangeChunk, so the estimate only
+// saves reading more keys than fit. Always returns >= 1, since Limit=0 means
+// "unlimited" in txn.Range.
+func nextChunkLimit(n, size, targetSize int) int64 {
+	if size == 0 {
+		return initialStreamChunkLimit
 	}
-	if lastLimit == 0 {
-		lastLimit = 1
+	return max(int64(targetSize)*int64(n)/int64(size), 1)
+}
+
+func (s *EtcdServer) KeyHistory(ctx context.Context, r *pb.KeyHistoryRequest) (*pb.KeyHistoryResponse, error) {
+	var span trace.Span
+	ctx, span = traceutil.Tracer.Start(ctx, "key_history", trace.WithAttributes(
+		attribute.String("key", string(r.GetKey())),
+		attribute.Int64("rev", r.GetRevision()),
+		attribute.Int64("limit", r.GetLimit()),
+	))
+	defer span.End()
+
+	ctx, trace := traceutil.EnsureTrace(ctx, s.Logger(), "key_history",
+		traceutil.Field{Key: "key", Value: string(r.Key)},
+	)
+
+	var resp *pb.KeyHistoryResponse
+	var err error
+	defer func(start time.Time) {
+		if resp != nil {
+			trace.AddField(
+				traceutil.Field{Key: "response_count", Value: len(resp.Kvs)},
+				traceutil.Field{Key: "response_revision", Value: resp.Header.Revision},
+			)
+		}
+		trace.LogIfLong(traceThreshold)
+		success := err == nil
+		requestDurationSec.WithLabelValues("KeyHistory", strconv.FormatBool(success)).Observe(time.Since(start).Seconds())
+	}(time.Now())
+
+	if !r.Serializable {
+		err = s.read.LinearizableReadNotify(ctx)
+		trace.Step("agreement among raft nodes before linearized reading")
+		if err != nil {
+			return nil, err
+		}
+	}
+	chk := func(ai *auth.AuthInfo) error {
+		return s.authStore.IsRangePermitted(ai, r.Key, nil)
+	}
+
+	get := func() { resp, err = txn.KeyHistory(ctx, s.Logger(), s.KV(), r) }
+	if serr := s.doSerialize(ctx, chk, get); serr != nil {
+		err = serr
+		return nil, err
 	}
-	return lastLimit
+	return resp, err
 }
 
 func (s *EtcdServer) Put(ctx context.Context, r *pb.PutRequest) (*pb.PutResponse, error) {
@@ -300,6 +358,9 @@ func (s *EtcdServer) Put(ctx context.Context, r *pb.PutRequest) (*pb.PutResponse
 	defer span.End()
 
 	ctx = context.WithValue(ctx, traceutil.StartTimeKey{}, time.Now())
+	if err := s.assignTTLLease(r); err != nil {
+		return nil, err
+	}
 	resp, err := s.raftRequest(ctx, &pb.InternalRaftRequest{Put: r})
 	if err != nil {
 		return nil, err
@@ -312,9 +373,13 @@ func (s *EtcdServer) DeleteRange(ctx context.Context, r *pb.DeleteRangeRequest)
 	ctx, span = traceutil.Tracer.Start(ctx, "delete_range", trace.WithAttributes(
 		attribute.String("range_begin", string(r.GetKey())),
 		attribute.String("range_end", string(r.GetRangeEnd())),
+		attribute.Int64("batch_size", r.GetBatchSize()),
 	))
 	defer span.End()
 
+	if r.BatchSize > 0 {
+		return s.deleteRangeInBatches(ctx, r)
+	}
 	resp, err := s.raftRequest(ctx, &pb.InternalRaftRequest{DeleteRange: r})
 	if err != nil {
 		return nil, err
@@ -322,6 +387,67 @@ func (s *EtcdServer) DeleteRange(ctx context.Context, r *pb.DeleteRangeRequest)
 	return resp.(*pb.DeleteRangeResponse), nil
 }
 
+// deleteRangeInBatches deletes the range of r in batches, each proposed in its
+// own raft entry once the previous one is applied. The batches are cut from
+// the local store, and the last one always extends to the end of the range.
+// If r.Atomic is set, the range is deleted in a single entry if it fits in one
+// batch, and the request fails otherwise. The delete stops early after
+// r.MaxBatches batches, or when ctx is done between batches; since deleted keys
+// are not deleted again, it is resumed by deleting from the returned next key,
+// or from the start of the range again, to the same range end.
+func (s *EtcdServer) deleteRangeInBatches(ctx context.Context, r *pb.DeleteRangeRequest) (*pb.DeleteRangeResponse, error) {
+	lg := s.Logger()
+	resp := &pb.DeleteRangeResponse{Header: &pb.ResponseHeader{}}
+	chk := func(ai *auth.AuthInfo) error {
+		return s.authStore.IsDeleteRangePermitted(ai, r.Key, r.RangeEnd)
+	}
+	for key, batch := r.Key, int64(1); ; batch++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		var (
+			end  []byte
+			more bool
+			err  error
+		)
+		get := func() { end, more, err = txn.DeleteBatchEnd(ctx, lg, s.KV(), r, key, int(s.Cfg.MaxRequestBytes)) }
+		if serr := s.doSerialize(ctx, chk, get); serr != nil {
+			return nil, serr
+		}
+		if err != nil {
+			return nil, err
+		}
+		if r.Atomic && more {
+			return nil, errors.ErrRequestTooLarge
+		}
+
+		result, err := s.raftRequest(ctx, &pb.InternalRaftRequest{DeleteRange: &pb.DeleteRangeRequest{Key: key, RangeEnd: end}})
+		if err != nil {
+			return nil, err
+		}
+		bresp := result.(*pb.DeleteRangeResponse)
+		resp.Header = bresp.Header
+		resp.Deleted += bresp.Deleted
+		lg.Debug(
+			"deleted batch of range",
+			zap.String("range-begin", string(r.Key)),
+			zap.String("range-end", string(r.RangeEnd)),
+			zap.Int64("batch", batch),
+			zap.Int64("batch-deleted", bresp.Deleted),
+			zap.Int64("total-deleted", resp.Deleted),
+			zap.Int64("revision", bresp.Header.Revision),
+		)
+		if !more {
+			return resp, nil
+		}
+		if batch == r.MaxBatches {
+			resp.NextKey = end
+			return resp, nil
+		}
+		key = end
+	}
+}
+
 func (s *EtcdServer) Txn(ctx context.Context, r *pb.TxnRequest) (*pb.TxnResponse, error) {
 	readOnly := txn.IsTxnReadonly(r)
 
@@ -372,6 +498,16 @@ func (s *EtcdServer) Txn(ctx context.Context, r *pb.TxnRequest) (*pb.TxnResponse
 	}
 
 	ctx = context.WithValue(ctx, traceutil.StartTimeKey{}, time.Now())
+	// members before 3.8 crash on the increments they cannot apply, and
+	// evaluate the aggregate compares to a different branch
+	if hasIncrement(r) || hasAggregateCompare(r) {
+		if err := s.checkClusterVersion(&version.V3_8); err != nil {
+			return nil, err
+		}
+	}
+	if err := s.assignTTLLeases(r); err != nil {
+		return nil, err
+	}
 	resp, err := s.raftRequest(ctx, &pb.InternalRaftRequest{Txn: r})
 	if err != nil {
 		return nil, err
@@ -379,6 +515,86 @@ func (s *EtcdServer) Txn(ctx context.Context, r *pb.TxnRequest) (*pb.TxnResponse
 	return resp.(*pb.TxnResponse), nil
 }
 
+// assignTTLLease replaces the TTL of a put with the shared lease of its expiry
+// window. The lease is picked before proposing, so that every member applies
+// the put to the same lease regardless of when it applies it. Members before
+// 3.8 ignore the TTL, so puts with a TTL are rejected until the whole cluster
+// is upgraded.
+func (s *EtcdServer) assignTTLLease(r *pb.PutRequest) error {
+	if r.Ttl <= 0 {
+		return nil
+	}
+	if err := s.checkClusterVersion(&version.V3_8); err != nil {
+		return err
+	}
+	id, ttl := lease.TTLLease(r.Ttl, minLeaseTTL(s.Cfg))
+	r.Lease, r.Ttl = int64(id), ttl
+	return nil
+}
+
+func (s *EtcdServer) assignTTLLeases(r *pb.TxnRequest) error {
+	for _, reqs := range [][]*pb.RequestOp{r.Success, r.Failure} {
+		for _, req := range reqs {
+			var err error
+			switch tv := req.Request.(type) {
+			case *pb.RequestOp_RequestPut:
+				err = s.assignTTLLease(tv.RequestPut)
+			case *pb.RequestOp_RequestTxn:
+				err = s.assignTTLLeases(tv.RequestTxn)
+			}
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// hasIncrement returns whether the txn or any of its nested txns increments a key.
+func hasIncrement(r *pb.TxnRequest) bool {
+	for _, reqs := range [][]*pb.RequestOp{r.Success, r.Failure} {
+		for _, req := range reqs {
+			switch tv := req.Request.(type) {
+			case *pb.RequestOp_RequestIncrement:
+				return true
+			case *pb.RequestOp_RequestTxn:
+				if hasIncrement(tv.RequestTxn) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// hasAggregateCompare returns whether the txn or any of its nested txns
+// compares a target aggregated over a range of keys.
+func hasAggregateCompare(r *pb.TxnRequest) bool {
+	for _, c := range r.Compare {
+		switch c.Target {
+		case pb.Compare_COUNT, pb.Compare_MAX_CREATE, pb.Compare_MAX_MOD:
+			return true
+		}
+	}
+	for _, reqs := range [][]*pb.RequestOp{r.Success, r.Failure} {
+		for _, req := range reqs {
+			if tv, ok := req.Request.(*pb.RequestOp_RequestTxn); ok && hasAggregateCompare(tv.RequestTxn) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// checkClusterVersion rejects a request that members before version v cannot
+// apply, so that it is not proposed while the cluster is being upgraded.
+func (s *EtcdServer) checkClusterVersion(v *semver.Version) error {
+	if cv := s.ClusterVersion(); cv == nil || cv.LessThan(v) {
+		return errors.ErrNotCapable
+	}
+	return nil
+}
+
 func (s *EtcdServer) Compact(ctx context.Context, r *pb.CompactionRequest) (*pb.CompactionResponse, error) {
 	var span trace.Span
 	ctx, span = traceutil.Tracer.Start(ctx, "compact", trace.WithAttributes(
@@ -387,6 +603,14 @@ func (s *EtcdServer) Compact(ctx context.Context, r *pb.CompactionRequest) (*pb.
 	))
 	defer span.End()
 
+	// members before 3.8 ignore the prefixes and compact all keys up to the
+	// revision, so their history would differ
+	if len(r.Prefixes) != 0 {
+		if err := s.checkClusterVersion(&version.V3_8); err != nil {
+			return nil, err
+		}
+	}
+
 	startTime := time.Now()
 	ctx, trace := traceutil.EnsureTrace(ctx, s.Logger(), "compact")
 	result, err := s.processInternalRaftRequestOnce(ctx, &pb.InternalRaftRequest{Compaction: r})
@@ -432,10 +656,25 @@ func (s *EtcdServer) Compact(ctx context.Context, r *pb.CompactionRequest) (*pb.
 }
 
 func (s *EtcdServer) LeaseGrant(ctx context.Context, r *pb.LeaseGrantRequest) (*pb.LeaseGrantResponse, error) {
+	// the shared leases of keys put with a TTL are only granted by the server,
+	// and cannot have child leases
+	if lease.IsTTLLeaseID(lease.LeaseID(r.ID)) || lease.IsTTLLeaseID(lease.LeaseID(r.Parent)) {
+		return nil, lease.ErrLeaseIDReserved
+	}
+	// members before 3.8 would grant a child lease without its parent
+	if r.Parent != 0 {
+		if err := s.checkClusterVersion(&version.V3_8); err != nil {
+			return nil, err
+		}
+	}
 	// no id given? choose one
 	for r.ID == int64(lease.NoLease) {
 		// only use positive int64 id's
 		r.ID = int64(s.reqIDGen.Next() & ((1 << 63) - 1))
+		if lease.IsTTLLeaseID(lease.LeaseID(r.ID)) {
+			// keep out of the IDs reserved for TTL leases
+			r.ID &^= 1 << 62
+		}
 	}
 	var span trace.Span
 	ctx, span = traceutil.Tracer.Start(ctx, "lease_grant", trace.WithAttributes(
@@ -485,7 +724,115 @@ func (s *EtcdServer) LeaseRevoke(ctx context.Context, r *pb.LeaseRevokeRequest)
 	return resp.(*pb.LeaseRevokeResponse), nil
 }
 
+// leaseExpire revokes an expired lease. Members before 3.8 do not know the
+// LeaseExpire request, so the lease is revoked with a LeaseRevoke request
+// until the whole cluster is upgraded.
+func (s *EtcdServer) leaseExpire(ctx context.Context, r *pb.LeaseRevokeRequest) (*pb.LeaseRevokeResponse, error) {
+	if s.checkClusterVersion(&version.V3_8) != nil {
+		return s.LeaseRevoke(ctx, r)
+	}
+
+	var span trace.Span
+	ctx, span = traceutil.Tracer.Start(ctx, "lease_expire", trace.WithAttributes(
+		attribute.Int64("id", r.GetID()),
+	))
+	defer span.End()
+
+	resp, err := s.raftRequest(ctx, &pb.InternalRaftRequest{LeaseExpire: r})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*pb.LeaseRevokeResponse), nil
+}
+
+func (s *EtcdServer) LeaseRevokeBatch(ctx context.Context, r *pb.LeaseRevokeBatchRequest) (*pb.LeaseRevokeBatchResponse, error) {
+	// members before 3.8 cannot apply batch revokes
+	if err := s.checkClusterVersion(&version.V3_8); err != nil {
+		return nil, err
+	}
+	var span trace.Span
+	ctx, span = traceutil.Tracer.Start(ctx, "lease_revoke_batch", trace.WithAttributes(
+		attribute.Int("count", len(r.GetIDs())),
+	))
+	defer span.End()
+
+	if err := s.requireAuthInfo(ctx); err != nil {
+		return nil, err
+	}
+
+	// large batches are revoked over several raft entries, each proposed once
+	// the previous one is applied
+	resp := &pb.LeaseRevokeBatchResponse{}
+	for ids := r.IDs; ; {
+		n := s.leaseRevokeBatchLen(ids)
+		result, err := s.raftRequest(ctx, &pb.InternalRaftRequest{LeaseRevokeBatch: &pb.LeaseRevokeBatchRequest{IDs: ids[:n]}})
+		if err != nil {
+			return nil, err
+		}
+		bresp := result.(*pb.LeaseRevokeBatchResponse)
+		resp.Header = bresp.Header
+		resp.Revoked = append(resp.Revoked, bresp.Revoked...)
+		if ids = ids[n:]; len(ids) == 0 {
+			return resp, nil
+		}
+	}
+}
+
+// leaseRevokeBatchLen returns how many of the given leases are revoked in the
+// next raft entry. The entry holds at least one lease, and is otherwise bounded
+// by the number of leases and of keys attached to them and their child leases,
+// as found in the local lessor.
+func (s *EtcdServer) leaseRevokeBatchLen(ids []int64) int {
+	keys := 0
+	for i, id := range ids {
+		if i == maxLeaseRevokeBatchLeases {
+			return i
+		}
+		if l := s.lessor.Lookup(lease.LeaseID(id)); l != nil {
+			for _, d := range append(l.Descendants(), l) {
+				keys += len(d.Keys())
+			}
+		}
+		if i > 0 && keys > maxLeaseRevokeBatchKeys {
+			return i
+		}
+	}
+	return len(ids)
+}
+
+func (s *EtcdServer) LeaseUpdateTTL(ctx context.Context, r *pb.LeaseUpdateTTLRequest) (*pb.LeaseUpdateTTLResponse, error) {
+	// the shared TTL leases are grouped by expiry, so their TTL is fixed
+	if lease.IsTTLLeaseID(lease.LeaseID(r.ID)) {
+		return nil, lease.ErrLeaseIDReserved
+	}
+	// members before 3.8 cannot apply TTL updates
+	if err := s.checkClusterVersion(&version.V3_8); err != nil {
+		return nil, err
+	}
+	var span trace.Span
+	ctx, span = traceutil.Tracer.Start(ctx, "lease_update_ttl", trace.WithAttributes(
+		attribute.Int64("id", r.GetID()),
+		attribute.Int64("ttl", r.GetTTL()),
+	))
+	defer span.End()
+
+	if err := s.requireAuthInfo(ctx); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.raftRequest(ctx, &pb.InternalRaftRequest{LeaseUpdateTtl: r})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*pb.LeaseUpdateTTLResponse), nil
+}
+
 func (s *EtcdServer) LeaseRenew(ctx context.Context, id lease.LeaseID) (int64, error) {
+	// the shared TTL leases are kept alive by no one, or their keys would
+	// never expire
+	if lease.IsTTLLeaseID(id) {
+		return -1, lease.ErrLeaseIDReserved
+	}
 	var span trace.Span
 	ctx, span = traceutil.Tracer.Start(ctx, "lease_renew", trace.WithAttributes(
 		attribute.Int64("id", int64(id)),
@@ -649,12 +996,13 @@ func (s *EtcdServer) leaseTimeToLive(ctx context.Context, r *pb.LeaseTimeToLiveR
 		// TODO: fill out ResponseHeader
 		resp := &pb.LeaseTimeToLiveResponse{Header: &pb.ResponseHeader{}, ID: r.ID, TTL: int64(le.Remaining().Seconds()), GrantedTTL: le.TTL()}
 		if r.Keys {
-			ks := le.Keys()
+			ks, more := le.KeysPage(string(r.KeysFrom), r.KeysLimit)
 			kbs := make([][]byte, len(ks))
 			for i := range ks {
 				kbs[i] = []byte(ks[i])
 			}
 			resp.Keys = kbs
+			resp.MoreKeys = more
 		}
 
 		// The leasor could be demoted if leader changed during lookup.
@@ -679,7 +1027,7 @@ func (s *EtcdServer) leaseTimeToLive(ctx context.Context, r *pb.LeaseTimeToLiveR
 		}
 		for _, url := range leader.PeerURLs {
 			lurl := url + leasehttp.LeaseInternalPrefix
-			resp, err := leasehttp.TimeToLiveHTTP(cctx, lease.LeaseID(r.ID), r.Keys, lurl, s.peerRt)
+			resp, err := leasehttp.TimeToLiveHTTP(cctx, r, lurl, s.peerRt)
 			if err == nil {
 				return resp.LeaseTimeToLiveResponse, nil
 			}
@@ -733,8 +1081,11 @@ func (s *EtcdServer) newHeader() *pb.ResponseHeader {
 }
 
 // LeaseLeases is really ListLeases !???
-func (s *EtcdServer) LeaseLeases(ctx context.Context, _ *pb.LeaseLeasesRequest) (*pb.LeaseLeasesResponse, error) {
+func (s *EtcdServer) LeaseLeases(ctx context.Context, r *pb.LeaseLeasesRequest) (*pb.LeaseLeasesResponse, error) {
 	ls := s.lessor.Leases()
+	if len(r.Key) != 0 {
+		ls = leasesInRange(ls, r.Key, r.RangeEnd)
+	}
 
 	if err := s.checkLeaseLeases(ctx, ls); err != nil {
 		return nil, err
@@ -743,10 +1094,101 @@ func (s *EtcdServer) LeaseLeases(ctx context.Context, _ *pb.LeaseLeasesRequest)
 	lss := make([]*pb.LeaseStatus, len(ls))
 	for i := range ls {
 		lss[i] = &pb.LeaseStatus{ID: int64(ls[i].ID)}
+		if r.Keys {
+			limit := r.KeysLimit
+			if limit <= 0 || limit > maxLeaseLeasesKeys {
+				limit = maxLeaseLeasesKeys
+			}
+			ks, more := ls[i].KeysPage("", limit)
+			lss[i].Keys = make([][]byte, len(ks))
+			for j := range ks {
+				lss[i].Keys[j] = []byte(ks[j])
+			}
+			lss[i].MoreKeys = more
+		}
 	}
 	return &pb.LeaseLeasesResponse{Header: s.newHeader(), Leases: lss}, nil
 }
 
+func (s *EtcdServer) LeaseWatch(r *pb.LeaseWatchRequest, ls pb.Lease_LeaseWatchServer) error {
+	ctx := ls.Context()
+	var span trace.Span
+	ctx, span = traceutil.Tracer.Start(ctx, "lease_watch", trace.WithAttributes(
+		attribute.Int64("id", r.GetID()),
+		attribute.Bool("keys", r.GetKeys()),
+	))
+	defer span.End()
+
+	if err := s.checkLeaseWatch(ctx); err != nil {
+		return err
+	}
+
+	w := s.leaseEvents.Watch(r.ID, r.Keys)
+	defer s.leaseEvents.Cancel(w)
+	for {
+		select {
+		case evs, ok := <-w.Chan():
+			if !ok {
+				return w.Err()
+			}
+			resp := &pb.LeaseWatchResponse{
+				Header: &pb.ResponseHeader{Revision: evs[len(evs)-1].Revision},
+				Events: evs,
+			}
+			if err := ls.Send(resp); err != nil {
+				return err
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-s.stopping:
+			return errors.ErrStopped
+		}
+	}
+}
+
+// checkLeaseWatch only lets admins watch leases once auth is enabled. The
+// keys a watched lease gets attached are not known up front, so they cannot
+// be checked against the permissions of the user.
+func (s *EtcdServer) checkLeaseWatch(ctx context.Context) error {
+	if !s.AuthStore().IsAuthEnabled() {
+		return nil
+	}
+	authInfo, err := s.AuthInfoFromCtx(ctx)
+	if err != nil {
+		return err
+	}
+	if authInfo == nil {
+		return auth.ErrUserEmpty
+	}
+	return s.AuthStore().IsAdminPermitted(authInfo)
+}
+
+// leasesInRange returns the leases with at least one attached key in the range
+// [key, end). An empty end selects key alone, and "\x00" every key from key on.
+func leasesInRange(ls []*lease.Lease, key, end []byte) []*lease.Lease {
+	var ret []*lease.Lease
+	for _, l := range ls {
+		for _, k := range l.Keys() {
+			if keyInRange([]byte(k), key, end) {
+				ret = append(ret, l)
+				break
+			}
+		}
+	}
+	return ret
+}
+
+func keyInRange(k, key, end []byte) bool {
+	switch {
+	case len(end) == 0:
+		return bytes.Equal(k, key)
+	case bytes.Equal(end, []byte{0}):
+		return bytes.Compare(k, key) >= 0
+	default:
+		return bytes.Compare(k, key) >= 0 && bytes.Compare(k, end) < 0
+	}
+}
+
 func (s *EtcdServer) checkLeaseLeases(ctx context.Context, leases []*lease.Lease) error {
 	rev := s.AuthStore().Revision()
 
@@ -809,6 +1251,56 @@ func (s *EtcdServer) Alarm(ctx context.Context, r *pb.AlarmRequest) (*pb.AlarmRe
 	return resp.(*pb.AlarmResponse), nil
 }
 
+func (s *EtcdServer) RevisionHold(ctx context.Context, r *pb.RevisionHoldRequest) (*pb.RevisionHoldResponse, error) {
+	// members before 3.8 cannot apply revision holds
+	if err := s.checkClusterVersion(&version.V3_8); err != nil {
+		return nil, err
+	}
+	ir := &pb.InternalRevisionHoldRequest{Request: r}
+	if r.Action == pb.RevisionHoldRequest_CREATE {
+		// no id given? choose one
+		for r.ID == 0 {
+			// only use positive int64 id's
+			r.ID = int64(s.reqIDGen.Next() & ((1 << 63) - 1))
+		}
+		// the creation time is chosen by the proposing member so that all
+		// members persist the same hold.
+		ir.CreateTime = time.Now().Unix()
+	}
+	resp, err := s.raftRequest(ctx, &pb.InternalRaftRequest{RevisionHold: ir})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*pb.RevisionHoldResponse), nil
+}
+
+func (s *EtcdServer) Subscription(ctx context.Context, r *pb.SubscriptionRequest) (*pb.SubscriptionResponse, error) {
+	// members before 3.8 cannot apply subscription requests
+	if err := s.checkClusterVersion(&version.V3_8); err != nil {
+		return nil, err
+	}
+	if r.Action == pb.SubscriptionRequest_ACK && r.MaxLag == 0 {
+		// the proposing member fills in its configured max lag so that all
+		// members persist the same subscription.
+		r.MaxLag = s.Cfg.SubscriptionMaxLag
+	}
+	resp, err := s.raftRequest(ctx, &pb.InternalRaftRequest{Subscription: r})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*pb.SubscriptionResponse), nil
+}
+
+// SubscriptionRevision returns the last revision acknowledged by the
+// subscription with the given name.
+func (s *EtcdServer) SubscriptionRevision(name string) (int64, bool) {
+	sub, ok := s.subStore.Get(name)
+	if !ok {
+		return 0, false
+	}
+	return sub.Revision, true
+}
+
 func (s *EtcdServer) AuthEnable(ctx context.Context, r *pb.AuthEnableRequest) (*pb.AuthEnableResponse, error) {
 	resp, err := s.raftRequest(ctx, &pb.InternalRaftRequest{AuthEnable: r})
 	if err != nil {
@@ -1148,6 +1640,12 @@ func getRequestType(r *pb.InternalRaftRequest) string {
 		return "LeaseGrant"
 	case r.LeaseRevoke != nil:
 		return "LeaseRevoke"
+	case r.LeaseRevokeBatch != nil:
+		return "LeaseRevokeBatch"
+	case r.LeaseExpire != nil:
+		return "LeaseExpire"
+	case r.LeaseUpdateTtl != nil:
+		return "LeaseUpdateTTL"
 	case r.LeaseCheckpoint != nil:
 		return "LeaseCheckpoint"
 	case r.Alarm != nil:
@@ -58,6 +58,7 @@ const (
 	// LeaseCheckpoint enables leader to send regular checkpoints to other members to prevent reset of remaining TTL on leader change.
 	// owner: @serathius
 	// alpha: v3.6
+	// beta: v3.8
 	// main PR: https://github.com/etcd-io/etcd/pull/13508
 	LeaseCheckpoint featuregate.Feature = "LeaseCheckpoint"
 	// LeaseCheckpointPersist enables persisting remainingTTL to prevent indefinite auto-renewal of long lived leases. Always enabled in v3.6. Should be used to ensure smooth upgrade from v3.5 clusters with this feature enabled.
@@ -91,7 +92,7 @@ var DefaultEtcdServerFeatureGates = map[featuregate.Feature]featuregate.FeatureS
 	InitialCorruptCheck:          {Default: false, PreRelease: featuregate.Alpha},
 	CompactHashCheck:             {Default: false, PreRelease: featuregate.Alpha},
 	TxnModeWriteWithSharedBuffer: {Default: true, PreRelease: featuregate.Beta},
-	LeaseCheckpoint:              {Default: false, PreRelease: featuregate.Alpha},
+	LeaseCheckpoint:              {Default: true, PreRelease: featuregate.Beta},
 	LeaseCheckpointPersist:       {Default: false, PreRelease: featuregate.Alpha},
 	SetMemberLocalAddr:           {Default: false, PreRelease: featuregate.Alpha},
 	FastLeaseKeepAlive:           {Default: true, PreRelease: featuregate.Beta},
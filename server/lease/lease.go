@@ -16,6 +16,7 @@ package lease
 
 import (
 	"math"
+	"sort"
 	"sync"
 	"time"
 
@@ -33,18 +34,23 @@ type Lease struct {
 	// expiry is time when lease should expire. no expiration when expiry.IsZero() is true
 	expiry time.Time
 
-	// mu protects concurrent accesses to itemSet
-	mu      sync.RWMutex
-	itemSet map[LeaseItem]struct{}
-	revokec chan struct{}
+	// parent is the lease this lease is revoked along with, if any
+	parent LeaseID
+
+	// mu protects concurrent accesses to itemSet and children
+	mu       sync.RWMutex
+	itemSet  map[LeaseItem]struct{}
+	children map[LeaseID]*Lease
+	revokec  chan struct{}
 }
 
 func NewLease(id LeaseID, ttl int64) *Lease {
 	return &Lease{
-		ID:      id,
-		ttl:     ttl,
-		itemSet: make(map[LeaseItem]struct{}),
-		revokec: make(chan struct{}),
+		ID:       id,
+		ttl:      ttl,
+		itemSet:  make(map[LeaseItem]struct{}),
+		children: make(map[LeaseID]*Lease),
+		revokec:  make(chan struct{}),
 	}
 }
 
@@ -53,7 +59,7 @@ func (l *Lease) expired() bool {
 }
 
 func (l *Lease) persistTo(b backend.Backend) {
-	lpb := leasepb.Lease{ID: int64(l.ID), TTL: l.ttl, RemainingTTL: l.remainingTTL}
+	lpb := leasepb.Lease{ID: int64(l.ID), TTL: l.ttl, RemainingTTL: l.remainingTTL, Parent: int64(l.parent)}
 	tx := b.BatchTx()
 	tx.LockInsideApply()
 	defer tx.Unlock()
@@ -65,6 +71,31 @@ func (l *Lease) TTL() int64 {
 	return l.ttl
 }
 
+// Parent returns the ID of the parent lease, or NoLease if the lease has no parent.
+func (l *Lease) Parent() LeaseID {
+	return l.parent
+}
+
+// Descendants returns the child leases of the lease and, recursively, their
+// own children. Every lease comes after its children, and the children of a
+// lease are ordered by ID, so the leases are in the same order on all members.
+func (l *Lease) Descendants() []*Lease {
+	l.mu.RLock()
+	children := make([]*Lease, 0, len(l.children))
+	for _, c := range l.children {
+		children = append(children, c)
+	}
+	l.mu.RUnlock()
+	sort.Slice(children, func(i, j int) bool { return children[i].ID < children[j].ID })
+
+	var ls []*Lease
+	for _, c := range children {
+		ls = append(ls, c.Descendants()...)
+		ls = append(ls, c)
+	}
+	return ls
+}
+
 // SetLeaseItem sets the given lease item, this func is thread-safe
 func (l *Lease) SetLeaseItem(item LeaseItem) {
 	l.mu.Lock()
@@ -113,6 +144,19 @@ func (l *Lease) Keys() []string {
 	return keys
 }
 
+// KeysPage returns up to limit keys attached to the lease in ascending order,
+// starting at from, and whether there are more keys past them. If limit is not
+// positive, all the keys from there on are returned.
+func (l *Lease) KeysPage(from string, limit int64) ([]string, bool) {
+	keys := l.Keys()
+	sort.Strings(keys)
+	keys = keys[sort.SearchStrings(keys, from):]
+	if limit > 0 && int64(len(keys)) > limit {
+		return keys[:limit], true
+	}
+	return keys, false
+}
+
 // Remaining returns the remaining time of the lease.
 func (l *Lease) Remaining() time.Duration {
 	l.expiryMu.RLock()
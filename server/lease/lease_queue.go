@@ -60,6 +60,8 @@ func (pq *LeaseQueue) Pop() any {
 
 // LeaseExpiredNotifier is a queue used to notify lessor to revoke expired lease.
 // Only save one item for a lease, `Register` will update time of the corresponding lease.
+// The lessor also schedules lease checkpoints with one, so that a lease renewed
+// or checkpointed again is not checkpointed more than once.
 type LeaseExpiredNotifier struct {
 	m     map[LeaseID]*LeaseWithTime
 	queue LeaseQueue
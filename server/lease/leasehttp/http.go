@@ -136,12 +136,13 @@ func (h *leaseHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 			},
 		}
 		if lreq.LeaseTimeToLiveRequest.Keys {
-			ks := l.Keys()
+			ks, more := l.KeysPage(string(lreq.LeaseTimeToLiveRequest.KeysFrom), lreq.LeaseTimeToLiveRequest.KeysLimit)
 			kbs := make([][]byte, len(ks))
 			for i := range ks {
 				kbs[i] = []byte(ks[i])
 			}
 			resp.LeaseTimeToLiveResponse.Keys = kbs
+			resp.LeaseTimeToLiveResponse.MoreKeys = more
 		}
 
 		// The leasor could be demoted if leader changed during lookup.
@@ -221,13 +222,10 @@ func RenewHTTP(ctx context.Context, id lease.LeaseID, url string, rt http.RoundT
 }
 
 // TimeToLiveHTTP retrieves lease information of the given lease ID.
-func TimeToLiveHTTP(ctx context.Context, id lease.LeaseID, keys bool, url string, rt http.RoundTripper) (*leasepb.LeaseInternalResponse, error) {
+func TimeToLiveHTTP(ctx context.Context, r *pb.LeaseTimeToLiveRequest, url string, rt http.RoundTripper) (*leasepb.LeaseInternalResponse, error) {
 	// will post lreq protobuf to leader
 	lreq, err := proto.Marshal(&leasepb.LeaseInternalRequest{
-		LeaseTimeToLiveRequest: &pb.LeaseTimeToLiveRequest{
-			ID:   int64(id),
-			Keys: keys,
-		},
+		LeaseTimeToLiveRequest: r,
 	})
 	if err != nil {
 		return nil, err
@@ -271,7 +269,7 @@ func TimeToLiveHTTP(ctx context.Context, id lease.LeaseID, keys bool, url string
 	if err := proto.Unmarshal(b, lresp); err != nil {
 		return nil, fmt.Errorf(`lease: %w. data = "%s"`, err, string(b))
 	}
-	if lresp.LeaseTimeToLiveResponse.ID != int64(id) {
+	if lresp.LeaseTimeToLiveResponse.ID != r.ID {
 		return nil, fmt.Errorf("lease: TTL id mismatch")
 	}
 	return lresp, nil
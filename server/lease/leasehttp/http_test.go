@@ -22,6 +22,7 @@ import (
 
 	"go.uber.org/zap/zaptest"
 
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
 	"go.etcd.io/etcd/server/v3/lease"
 	betesting "go.etcd.io/etcd/server/v3/storage/backend/testing"
 )
@@ -65,7 +66,7 @@ func TestTimeToLiveHTTP(t *testing.T) {
 	ts := httptest.NewServer(NewHandler(le, waitReady))
 	defer ts.Close()
 
-	resp, err := TimeToLiveHTTP(t.Context(), l.ID, true, ts.URL+LeaseInternalPrefix, http.DefaultTransport)
+	resp, err := TimeToLiveHTTP(t.Context(), &pb.LeaseTimeToLiveRequest{ID: int64(l.ID), Keys: true}, ts.URL+LeaseInternalPrefix, http.DefaultTransport)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -86,7 +87,7 @@ func TestRenewHTTPTimeout(t *testing.T) {
 
 func TestTimeToLiveHTTPTimeout(t *testing.T) {
 	testApplyTimeout(t, func(l *lease.Lease, serverURL string) error {
-		_, err := TimeToLiveHTTP(t.Context(), l.ID, true, serverURL+LeaseInternalPrefix, http.DefaultTransport)
+		_, err := TimeToLiveHTTP(t.Context(), &pb.LeaseTimeToLiveRequest{ID: int64(l.ID), Keys: true}, serverURL+LeaseInternalPrefix, http.DefaultTransport)
 		return err
 	})
 }
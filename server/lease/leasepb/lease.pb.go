@@ -28,6 +28,7 @@ type Lease struct {
 	ID            int64                  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
 	TTL           int64                  `protobuf:"varint,2,opt,name=TTL,proto3" json:"TTL,omitempty"`
 	RemainingTTL  int64                  `protobuf:"varint,3,opt,name=RemainingTTL,proto3" json:"RemainingTTL,omitempty"`
+	Parent        int64                  `protobuf:"varint,4,opt,name=Parent,proto3" json:"Parent,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -83,6 +84,13 @@ func (x *Lease) GetRemainingTTL() int64 {
 	return 0
 }
 
+func (x *Lease) GetParent() int64 {
+	if x != nil {
+		return x.Parent
+	}
+	return 0
+}
+
 type LeaseInternalRequest struct {
 	state                  protoimpl.MessageState               `protogen:"open.v1"`
 	LeaseTimeToLiveRequest *etcdserverpb.LeaseTimeToLiveRequest `protobuf:"bytes,1,opt,name=LeaseTimeToLiveRequest,proto3" json:"LeaseTimeToLiveRequest,omitempty"`
@@ -175,11 +183,12 @@ var File_lease_proto protoreflect.FileDescriptor
 
 const file_lease_proto_rawDesc = "" +
 	"\n" +
-	"\vlease.proto\x12\aleasepb\x1a\x1fetcd/api/etcdserverpb/rpc.proto\"M\n" +
+	"\vlease.proto\x12\aleasepb\x1a\x1fetcd/api/etcdserverpb/rpc.proto\"e\n" +
 	"\x05Lease\x12\x0e\n" +
 	"\x02ID\x18\x01 \x01(\x03R\x02ID\x12\x10\n" +
 	"\x03TTL\x18\x02 \x01(\x03R\x03TTL\x12\"\n" +
-	"\fRemainingTTL\x18\x03 \x01(\x03R\fRemainingTTL\"t\n" +
+	"\fRemainingTTL\x18\x03 \x01(\x03R\fRemainingTTL\x12\x16\n" +
+	"\x06Parent\x18\x04 \x01(\x03R\x06Parent\"t\n" +
 	"\x14LeaseInternalRequest\x12\\\n" +
 	"\x16LeaseTimeToLiveRequest\x18\x01 \x01(\v2$.etcdserverpb.LeaseTimeToLiveRequestR\x16LeaseTimeToLiveRequest\"x\n" +
 	"\x15LeaseInternalResponse\x12_\n" +
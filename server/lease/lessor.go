@@ -15,10 +15,10 @@
 package lease
 
 import (
-	"container/heap"
 	"context"
 	"errors"
 	"math"
+	"math/rand/v2"
 	"sort"
 	"sync"
 	"time"
@@ -39,6 +39,15 @@ const NoLease = LeaseID(0)
 // MaxLeaseTTL is the maximum lease TTL value
 const MaxLeaseTTL = 9000000000
 
+// ttlLeaseIDPrefix marks the IDs of the shared leases backing keys put with a
+// TTL in the top bits selected by ttlLeaseIDMask. The remaining bits hold the
+// unix time the lease expires at. The prefix leaves out the IDs with all top
+// bits set, like -1 and math.MaxInt64, which clients may use for their leases.
+const (
+	ttlLeaseIDPrefix = LeaseID(0x7fe) << 52
+	ttlLeaseIDMask   = LeaseID(-1) << 52
+)
+
 var (
 	forever = time.Time{}
 
@@ -61,6 +70,7 @@ var (
 	ErrLeaseNotFound    = errors.New("lease not found")
 	ErrLeaseExists      = errors.New("lease already exists")
 	ErrLeaseTTLTooLarge = errors.New("too large lease TTL")
+	ErrLeaseIDReserved  = errors.New("lease ID is reserved")
 )
 
 // TxnDelete is a TxnWrite that only permits deletes. Defined here
@@ -90,11 +100,19 @@ type Lessor interface {
 
 	// Grant grants a lease that expires at least after TTL seconds.
 	Grant(id LeaseID, ttl int64) (*Lease, error)
-	// Revoke revokes a lease with given ID. The item attached to the
-	// given lease will be removed. If the ID does not exist, an error
-	// will be returned.
+	// GrantChild grants a lease like Grant, as a child of the lease with the
+	// given parent ID. A child lease is revoked along with its parent. If the
+	// parent does not exist, an error will be returned.
+	GrantChild(id, parent LeaseID, ttl int64) (*Lease, error)
+	// Revoke revokes a lease with given ID, along with its child leases.
+	// The item attached to the given lease will be removed. If the ID does
+	// not exist, an error will be returned.
 	Revoke(id LeaseID) error
 
+	// UpdateTTL changes the TTL of a lease with given ID and renews the lease
+	// with it. If the ID does not exist, an error will be returned.
+	UpdateTTL(id LeaseID, ttl int64) (*Lease, error)
+
 	// Checkpoint applies the remainingTTL of a lease. The remainingTTL is used in Promote to set
 	// the expiry of leases to less than the full TTL when possible.
 	Checkpoint(id LeaseID, remainingTTL int64) error
@@ -113,7 +131,8 @@ type Lessor interface {
 
 	// Promote promotes the lessor to be the primary lessor. Primary lessor manages
 	// the expiration and renew of leases.
-	// Newly promoted lessor renew the TTL of all lease to extend + previous TTL.
+	// Newly promoted lessor renew the TTL of all lease to extend + previous TTL,
+	// plus a random share of the configured jitter window.
 	Promote(extend time.Duration)
 
 	// Demote demotes the lessor from being the primary lessor.
@@ -151,8 +170,10 @@ type lessor struct {
 
 	leaseMap             map[LeaseID]*Lease
 	leaseExpiredNotifier *LeaseExpiredNotifier
-	leaseCheckpointHeap  LeaseQueue
-	itemMap              map[LeaseItem]LeaseID
+	// leaseCheckpoints holds the next checkpoint time of the leases, with at
+	// most one scheduled checkpoint per lease.
+	leaseCheckpoints *LeaseExpiredNotifier
+	itemMap          map[LeaseItem]LeaseID
 
 	// When a lease expires, the lessor will delete the
 	// leased range (or key) by the RangeDeleter.
@@ -187,6 +208,8 @@ type lessor struct {
 	expiredLeaseRetryInterval time.Duration
 	// whether lessor should always persist remaining TTL (always enabled in v3.6).
 	checkpointPersist bool
+	// the window the expiries of the leases are spread over on promotion
+	promoteJitter time.Duration
 	// cluster is used to adapt lessor logic based on cluster version
 	cluster cluster
 }
@@ -201,6 +224,10 @@ type LessorConfig struct {
 	CheckpointInterval         time.Duration
 	ExpiredLeasesRetryInterval time.Duration
 	CheckpointPersist          bool
+	// PromoteJitter spreads the expiries of the leases over a random window
+	// of up to this duration when the lessor is promoted, so that the leases
+	// kept alive through a leader change do not all expire at once.
+	PromoteJitter time.Duration
 
 	leaseRevokeRate int
 }
@@ -226,13 +253,14 @@ func newLessor(lg *zap.Logger, b backend.Backend, cluster cluster, cfg LessorCon
 		leaseMap:                  make(map[LeaseID]*Lease),
 		itemMap:                   make(map[LeaseItem]LeaseID),
 		leaseExpiredNotifier:      newLeaseExpiredNotifier(),
-		leaseCheckpointHeap:       make(LeaseQueue, 0),
+		leaseCheckpoints:          newLeaseExpiredNotifier(),
 		b:                         b,
 		minLeaseTTL:               cfg.MinLeaseTTL,
 		leaseRevokeRate:           leaseRevokeRate,
 		checkpointInterval:        checkpointInterval,
 		expiredLeaseRetryInterval: expiredLeaseRetryInterval,
 		checkpointPersist:         cfg.CheckpointPersist,
+		promoteJitter:             cfg.PromoteJitter,
 		// expiredC is a small buffered chan to avoid unnecessary blocking.
 		expiredC: make(chan []*Lease, 16),
 		stopC:    make(chan struct{}),
@@ -279,6 +307,17 @@ func (le *lessor) SetCheckpointer(cp Checkpointer) {
 }
 
 func (le *lessor) Grant(id LeaseID, ttl int64) (*Lease, error) {
+	return le.grant(id, NoLease, ttl)
+}
+
+func (le *lessor) GrantChild(id, parent LeaseID, ttl int64) (*Lease, error) {
+	if parent == NoLease {
+		return nil, ErrLeaseNotFound
+	}
+	return le.grant(id, parent, ttl)
+}
+
+func (le *lessor) grant(id, parent LeaseID, ttl int64) (*Lease, error) {
 	if id == NoLease {
 		return nil, ErrLeaseNotFound
 	}
@@ -298,6 +337,14 @@ func (le *lessor) Grant(id LeaseID, ttl int64) (*Lease, error) {
 		return nil, ErrLeaseExists
 	}
 
+	var p *Lease
+	if parent != NoLease {
+		if p = le.leaseMap[parent]; p == nil {
+			return nil, ErrLeaseNotFound
+		}
+		l.parent = parent
+	}
+
 	if l.ttl < le.minLeaseTTL {
 		l.ttl = le.minLeaseTTL
 	}
@@ -309,6 +356,11 @@ func (le *lessor) Grant(id LeaseID, ttl int64) (*Lease, error) {
 	}
 
 	le.leaseMap[id] = l
+	if p != nil {
+		p.mu.Lock()
+		p.children[id] = l
+		p.mu.Unlock()
+	}
 	l.persistTo(le.b)
 
 	leaseTotalTTLs.Observe(float64(l.ttl))
@@ -323,6 +375,41 @@ func (le *lessor) Grant(id LeaseID, ttl int64) (*Lease, error) {
 	return l, nil
 }
 
+// TTLLease returns the ID of the shared lease that a key put with the given
+// TTL should be attached to, and the TTL to grant that lease with if it does
+// not exist yet. The TTL is raised to minLeaseTTL, like the TTL of any lease.
+func TTLLease(ttl, minLeaseTTL int64) (LeaseID, int64) {
+	return ttlLease(time.Now(), max(ttl, minLeaseTTL))
+}
+
+// ttlLease groups keys by the second they expire in. The lease is granted
+// until the end of that second, so that every key sharing it lives for at
+// least its own TTL.
+func ttlLease(now time.Time, ttl int64) (LeaseID, int64) {
+	expiry := now.Add(time.Duration(ttl)*time.Second + time.Second - 1).Unix()
+	return TTLLeaseID(expiry), expiry - now.Unix()
+}
+
+// TTLLeaseID returns the ID of the shared lease for keys expiring at the given unix time.
+func TTLLeaseID(expiry int64) LeaseID {
+	return ttlLeaseIDPrefix | LeaseID(expiry)
+}
+
+// IsTTLLeaseID returns whether id is in the range of IDs reserved for the
+// shared TTL leases. Leases in that range can only be granted by the server.
+func IsTTLLeaseID(id LeaseID) bool {
+	return id&ttlLeaseIDMask == ttlLeaseIDPrefix
+}
+
+// TTLLeaseExpiry returns the unix time a shared TTL lease expires at, or 0 if
+// id is not a TTL lease.
+func TTLLeaseExpiry(id LeaseID) int64 {
+	if !IsTTLLeaseID(id) {
+		return 0
+	}
+	return int64(id &^ ttlLeaseIDMask)
+}
+
 func (le *lessor) Revoke(id LeaseID) error {
 	le.mu.Lock()
 
@@ -332,7 +419,13 @@ func (le *lessor) Revoke(id LeaseID) error {
 		return ErrLeaseNotFound
 	}
 
-	defer close(l.revokec)
+	// the child leases are revoked in the same transaction, before the lease
+	ls := append(l.Descendants(), l)
+	defer func() {
+		for _, rl := range ls {
+			close(rl.revokec)
+		}
+	}()
 	// unlock before doing external work
 	le.mu.Unlock()
 
@@ -342,28 +435,67 @@ func (le *lessor) Revoke(id LeaseID) error {
 
 	txn := le.rd()
 
-	// sort keys so deletes are in same order among all members,
-	// otherwise the backend hashes will be different
-	keys := l.Keys()
-	sort.StringSlice(keys).Sort()
-	for _, key := range keys {
-		txn.DeleteRange([]byte(key), nil)
+	for _, rl := range ls {
+		// sort keys so deletes are in same order among all members,
+		// otherwise the backend hashes will be different
+		keys := rl.Keys()
+		sort.StringSlice(keys).Sort()
+		for _, key := range keys {
+			txn.DeleteRange([]byte(key), nil)
+		}
 	}
 
 	le.mu.Lock()
 	defer le.mu.Unlock()
-	delete(le.leaseMap, l.ID)
-	// lease deletion needs to be in the same backend transaction with the
-	// kv deletion. Or we might end up with not executing the revoke or not
-	// deleting the keys if etcdserver fails in between.
-	schema.UnsafeDeleteLease(le.b.BatchTx(), &leasepb.Lease{ID: int64(l.ID)})
+	if p := le.leaseMap[l.parent]; p != nil {
+		p.mu.Lock()
+		delete(p.children, l.ID)
+		p.mu.Unlock()
+	}
+	for _, rl := range ls {
+		delete(le.leaseMap, rl.ID)
+		// lease deletion needs to be in the same backend transaction with the
+		// kv deletion. Or we might end up with not executing the revoke or not
+		// deleting the keys if etcdserver fails in between.
+		schema.UnsafeDeleteLease(le.b.BatchTx(), &leasepb.Lease{ID: int64(rl.ID)})
+	}
 
 	txn.End()
 
-	leaseRevoked.Inc()
+	leaseRevoked.Add(float64(len(ls)))
 	return nil
 }
 
+func (le *lessor) UpdateTTL(id LeaseID, ttl int64) (*Lease, error) {
+	if ttl > MaxLeaseTTL {
+		return nil, ErrLeaseTTLTooLarge
+	}
+
+	le.mu.Lock()
+	defer le.mu.Unlock()
+
+	l := le.leaseMap[id]
+	if l == nil {
+		return nil, ErrLeaseNotFound
+	}
+
+	l.ttl = max(ttl, le.minLeaseTTL)
+	// the lease starts over with the new TTL, so a checkpointed remaining
+	// TTL of the old one no longer applies
+	l.remainingTTL = 0
+	l.persistTo(le.b)
+
+	leaseTotalTTLs.Observe(float64(l.ttl))
+
+	if le.isPrimary() {
+		l.refresh(0)
+		item := &LeaseWithTime{id: l.ID, time: l.expiry}
+		le.leaseExpiredNotifier.RegisterOrUpdate(item)
+		le.scheduleCheckpointIfNeeded(l)
+	}
+	return l, nil
+}
+
 func (le *lessor) Checkpoint(id LeaseID, remainingTTL int64) error {
 	le.mu.Lock()
 	defer le.mu.Unlock()
@@ -483,9 +615,15 @@ func (le *lessor) Promote(extend time.Duration) {
 
 	le.demotec = make(chan struct{})
 
-	// refresh the expiries of all leases.
+	// refresh the expiries of all leases, each extended by its own share of
+	// the jitter window.
+	extends := make(map[LeaseID]time.Duration, len(le.leaseMap))
 	for _, l := range le.leaseMap {
-		l.refresh(extend)
+		extends[l.ID] = extend
+		if le.promoteJitter > 0 {
+			extends[l.ID] += rand.N(le.promoteJitter)
+		}
+		l.refresh(extends[l.ID])
 		item := &LeaseWithTime{id: l.ID, time: l.expiry}
 		le.leaseExpiredNotifier.RegisterOrUpdate(item)
 		le.scheduleCheckpointIfNeeded(l)
@@ -524,7 +662,7 @@ func (le *lessor) Promote(extend time.Duration) {
 		rateDelay -= float64(remaining - baseWindow)
 		delay := time.Duration(rateDelay)
 		nextWindow = baseWindow + delay
-		l.refresh(delay + extend)
+		l.refresh(delay + extends[l.ID])
 		item := &LeaseWithTime{id: l.ID, time: l.expiry}
 		le.leaseExpiredNotifier.RegisterOrUpdate(item)
 		le.scheduleCheckpointIfNeeded(l)
@@ -687,7 +825,7 @@ func (le *lessor) checkpointScheduledLeases() {
 }
 
 func (le *lessor) clearScheduledLeasesCheckpoints() {
-	le.leaseCheckpointHeap = make(LeaseQueue, 0)
+	le.leaseCheckpoints = newLeaseExpiredNotifier()
 }
 
 func (le *lessor) clearLeaseExpiredNotifier() {
@@ -762,7 +900,7 @@ func (le *lessor) scheduleCheckpointIfNeeded(lease *Lease) {
 				zap.Duration("intervalSeconds", le.checkpointInterval),
 			)
 		}
-		heap.Push(&le.leaseCheckpointHeap, &LeaseWithTime{
+		le.leaseCheckpoints.RegisterOrUpdate(&LeaseWithTime{
 			id:   lease.ID,
 			time: time.Now().Add(le.checkpointInterval),
 		})
@@ -776,12 +914,12 @@ func (le *lessor) findDueScheduledCheckpoints(checkpointLimit int) []*pb.LeaseCh
 
 	now := time.Now()
 	var cps []*pb.LeaseCheckpoint
-	for le.leaseCheckpointHeap.Len() > 0 && len(cps) < checkpointLimit {
-		lt := le.leaseCheckpointHeap[0]
+	for le.leaseCheckpoints.Len() > 0 && len(cps) < checkpointLimit {
+		lt := le.leaseCheckpoints.Peek()
 		if lt.time.After(now) /* lt.time: next checkpoint time */ {
 			return cps
 		}
-		heap.Pop(&le.leaseCheckpointHeap)
+		le.leaseCheckpoints.Unregister()
 		var l *Lease
 		var ok bool
 		if l, ok = le.leaseMap[lt.id]; !ok {
@@ -818,18 +956,25 @@ func (le *lessor) initAndRecover() {
 			lpb.TTL = le.minLeaseTTL
 		}
 		le.leaseMap[ID] = &Lease{
-			ID:  ID,
-			ttl: lpb.TTL,
+			ID:     ID,
+			ttl:    lpb.TTL,
+			parent: LeaseID(lpb.Parent),
 			// itemSet will be filled in when recover key-value pairs
 			// set expiry to forever, refresh when promoted
 			itemSet:      make(map[LeaseItem]struct{}),
+			children:     make(map[LeaseID]*Lease),
 			expiry:       forever,
 			revokec:      make(chan struct{}),
 			remainingTTL: lpb.RemainingTTL,
 		}
 	}
+	for _, l := range le.leaseMap {
+		if p := le.leaseMap[l.parent]; p != nil {
+			p.children[l.ID] = l
+		}
+	}
 	le.leaseExpiredNotifier.Init()
-	heap.Init(&le.leaseCheckpointHeap)
+	le.leaseCheckpoints.Init()
 
 	le.b.ForceCommit()
 }
@@ -849,8 +994,19 @@ func (fl *FakeLessor) Grant(id LeaseID, ttl int64) (*Lease, error) {
 	return nil, nil
 }
 
+func (fl *FakeLessor) GrantChild(id, parent LeaseID, ttl int64) (*Lease, error) {
+	if _, ok := fl.LeaseSet[parent]; !ok {
+		return nil, ErrLeaseNotFound
+	}
+	return fl.Grant(id, ttl)
+}
+
 func (fl *FakeLessor) Revoke(id LeaseID) error { return nil }
 
+func (fl *FakeLessor) UpdateTTL(id LeaseID, ttl int64) (*Lease, error) {
+	return nil, nil
+}
+
 func (fl *FakeLessor) Checkpoint(id LeaseID, remainingTTL int64) error { return nil }
 
 func (fl *FakeLessor) Attach(id LeaseID, items []LeaseItem) error { return nil }
@@ -18,6 +18,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math"
 	"os"
 	"path/filepath"
 	"reflect"
@@ -209,6 +210,81 @@ func TestLessorRevoke(t *testing.T) {
 	}
 }
 
+// TestLessorGrantChild ensures a child lease is revoked along with its parent.
+func TestLessorGrantChild(t *testing.T) {
+	lg := zap.NewNop()
+	dir, be := NewTestBackend(t)
+	defer os.RemoveAll(dir)
+	defer be.Close()
+
+	le := newLessor(lg, be, clusterLatest(), LessorConfig{MinLeaseTTL: minLeaseTTL})
+	defer le.Stop()
+	var fd *fakeDeleter
+	le.SetRangeDeleter(func() TxnDelete {
+		fd = newFakeDeleter(be)
+		return fd
+	})
+
+	if _, err := le.GrantChild(2, 1, 100); !errors.Is(err, ErrLeaseNotFound) {
+		t.Fatalf("err = %v, want %v", err, ErrLeaseNotFound)
+	}
+	for _, g := range []struct{ id, parent LeaseID }{{1, NoLease}, {2, 1}, {3, 2}, {4, 1}, {5, NoLease}} {
+		var err error
+		if g.parent == NoLease {
+			_, err = le.Grant(g.id, 100)
+		} else {
+			_, err = le.GrantChild(g.id, g.parent, 100)
+		}
+		if err != nil {
+			t.Fatalf("could not grant lease %d (%v)", g.id, err)
+		}
+		if err = le.Attach(g.id, []LeaseItem{{fmt.Sprintf("foo%d", g.id)}}); err != nil {
+			t.Fatalf("failed to attach items to the lease: %v", err)
+		}
+	}
+
+	// the linkage is recovered from the backend
+	nle := newLessor(lg, be, clusterLatest(), LessorConfig{MinLeaseTTL: minLeaseTTL})
+	defer nle.Stop()
+	var ids []LeaseID
+	for _, dl := range nle.Lookup(1).Descendants() {
+		ids = append(ids, dl.ID)
+	}
+	if wids := []LeaseID{3, 2, 4}; !reflect.DeepEqual(ids, wids) {
+		t.Errorf("recovered descendants = %v, want %v", ids, wids)
+	}
+
+	if err := le.Revoke(2); err != nil {
+		t.Fatal("failed to revoke lease:", err)
+	}
+	if wdeleted := []string{"foo3_", "foo2_"}; !reflect.DeepEqual(fd.deleted, wdeleted) {
+		t.Errorf("deleted= %v, want %v", fd.deleted, wdeleted)
+	}
+	if err := le.Revoke(1); err != nil {
+		t.Fatal("failed to revoke lease:", err)
+	}
+	if wdeleted := []string{"foo4_", "foo1_"}; !reflect.DeepEqual(fd.deleted, wdeleted) {
+		t.Errorf("deleted= %v, want %v", fd.deleted, wdeleted)
+	}
+	for id := LeaseID(1); id <= 4; id++ {
+		if le.Lookup(id) != nil {
+			t.Errorf("got revoked lease %x", id)
+		}
+	}
+	if le.Lookup(5) == nil {
+		t.Errorf("lease 5 is revoked, want it kept")
+	}
+
+	tx := be.BatchTx()
+	tx.Lock()
+	defer tx.Unlock()
+	for id := int64(1); id <= 4; id++ {
+		if lpb := schema.MustUnsafeGetLease(tx, id); lpb != nil {
+			t.Errorf("lpb = %s, want nil", lpb.String())
+		}
+	}
+}
+
 func renew(t *testing.T, le *lessor, id LeaseID) int64 {
 	ch := make(chan int64, 1)
 	errch := make(chan error, 1)
@@ -263,6 +339,53 @@ func TestLessorRenew(t *testing.T) {
 	}
 }
 
+func TestLessorUpdateTTL(t *testing.T) {
+	lg := zap.NewNop()
+	dir, be := NewTestBackend(t)
+	defer be.Close()
+	defer os.RemoveAll(dir)
+
+	le := newLessor(lg, be, clusterLatest(), LessorConfig{MinLeaseTTL: minLeaseTTL})
+	defer le.Stop()
+	le.Promote(0)
+
+	l, err := le.Grant(1, minLeaseTTL)
+	if err != nil {
+		t.Fatalf("failed to grant lease (%v)", err)
+	}
+	if err = le.Attach(l.ID, []LeaseItem{{Key: "foo"}}); err != nil {
+		t.Fatalf("failed to attach item (%v)", err)
+	}
+
+	if _, err = le.UpdateTTL(l.ID, 100); err != nil {
+		t.Fatalf("failed to update ttl (%v)", err)
+	}
+	l = le.Lookup(l.ID)
+	if l.TTL() != 100 {
+		t.Errorf("ttl = %d, want 100", l.TTL())
+	}
+	if l.Remaining() < 99*time.Second {
+		t.Errorf("failed to renew the lease with the new ttl")
+	}
+	if got := l.Keys(); len(got) != 1 || got[0] != "foo" {
+		t.Errorf("keys = %v, want [foo]", got)
+	}
+
+	// the new ttl is persisted
+	nle := newLessor(lg, be, clusterLatest(), LessorConfig{MinLeaseTTL: minLeaseTTL})
+	defer nle.Stop()
+	if nl := nle.Lookup(l.ID); nl == nil || nl.TTL() != 100 {
+		t.Errorf("recovered lease = %v, want ttl 100", nl)
+	}
+
+	if _, err = le.UpdateTTL(l.ID, MaxLeaseTTL+1); !errors.Is(err, ErrLeaseTTLTooLarge) {
+		t.Errorf("err = %v, want %v", err, ErrLeaseTTLTooLarge)
+	}
+	if _, err = le.UpdateTTL(2, 100); !errors.Is(err, ErrLeaseNotFound) {
+		t.Errorf("err = %v, want %v", err, ErrLeaseNotFound)
+	}
+}
+
 func TestLessorRenewWithCheckpointer(t *testing.T) {
 	lg := zap.NewNop()
 	dir, be := NewTestBackend(t)
@@ -545,6 +668,53 @@ func TestLessorMaxTTL(t *testing.T) {
 	}
 }
 
+func TestTTLLease(t *testing.T) {
+	tests := []struct {
+		now        time.Time
+		ttl        int64
+		wantExpiry int64
+		wantTTL    int64
+	}{
+		{now: time.Unix(100, 0), ttl: 10, wantExpiry: 110, wantTTL: 10},
+		{now: time.Unix(100, 1), ttl: 10, wantExpiry: 111, wantTTL: 11},
+		{now: time.Unix(100, 999999999), ttl: 1, wantExpiry: 102, wantTTL: 2},
+	}
+	for _, tt := range tests {
+		id, ttl := ttlLease(tt.now, tt.ttl)
+		if ttl != tt.wantTTL {
+			t.Errorf("ttlLease(%v, %d) ttl = %d, want %d", tt.now, tt.ttl, ttl, tt.wantTTL)
+		}
+		if expiry := TTLLeaseExpiry(id); expiry != tt.wantExpiry {
+			t.Errorf("ttlLease(%v, %d) expiry = %d, want %d", tt.now, tt.ttl, expiry, tt.wantExpiry)
+		}
+		if id <= 0 {
+			t.Errorf("ttlLease(%v, %d) id = %d, want positive id", tt.now, tt.ttl, id)
+		}
+	}
+
+	if expiry := TTLLeaseExpiry(LeaseID(1)); expiry != 0 {
+		t.Errorf("TTLLeaseExpiry(1) = %d, want 0", expiry)
+	}
+	for _, id := range []LeaseID{-1, math.MaxInt64, math.MinInt64} {
+		if IsTTLLeaseID(id) {
+			t.Errorf("IsTTLLeaseID(%d) = true, want false", id)
+		}
+	}
+}
+
+func TestTTLLeaseMinTTL(t *testing.T) {
+	id, ttl := TTLLease(1, 5)
+	if ttl < 5 {
+		t.Errorf("ttl = %d, want at least the minimum lease TTL", ttl)
+	}
+	if expiry := TTLLeaseExpiry(id); expiry-ttl > time.Now().Unix() {
+		t.Errorf("expiry = %d, want at most now + %d", expiry, ttl)
+	}
+	if !IsTTLLeaseID(id) {
+		t.Errorf("IsTTLLeaseID(%d) = false, want true", id)
+	}
+}
+
 func TestLessorCheckpointScheduling(t *testing.T) {
 	lg := zap.NewNop()
 
@@ -601,6 +771,85 @@ func TestLessorCheckpointsRestoredOnPromote(t *testing.T) {
 	}
 }
 
+func TestLessorPromoteJitter(t *testing.T) {
+	lg := zap.NewNop()
+	dir, be := NewTestBackend(t)
+	defer os.RemoveAll(dir)
+	defer be.Close()
+
+	jitter := 5 * time.Second
+	le := newLessor(lg, be, clusterLatest(), LessorConfig{MinLeaseTTL: minLeaseTTL, PromoteJitter: jitter})
+	defer le.Stop()
+	var leases []*Lease
+	for i := 1; i <= 20; i++ {
+		l, err := le.Grant(LeaseID(i), 10)
+		if err != nil {
+			t.Fatal(err)
+		}
+		leases = append(leases, l)
+	}
+	le.Promote(time.Second)
+
+	spread := false
+	for _, l := range leases {
+		remaining := l.Remaining()
+		if remaining <= 10*time.Second || remaining > 11*time.Second+jitter {
+			t.Fatalf("expected lease %d to expire within (11s, %v], but got %v", l.ID, 11*time.Second+jitter, remaining)
+		}
+		if remaining > 12*time.Second {
+			spread = true
+		}
+	}
+	if !spread {
+		t.Fatal("expected the expiries of the leases to be spread over the jitter window")
+	}
+}
+
+func TestLessorCheckpointScheduledOncePerLease(t *testing.T) {
+	lg := zap.NewNop()
+	dir, be := NewTestBackend(t)
+	defer os.RemoveAll(dir)
+	defer be.Close()
+
+	le := newLessor(lg, be, clusterLatest(), LessorConfig{MinLeaseTTL: minLeaseTTL, CheckpointInterval: time.Minute})
+	defer le.Stop()
+	le.SetCheckpointer(func(ctx context.Context, lc *pb.LeaseCheckpointRequest) error { return nil })
+	le.Promote(0)
+	// granting a lease on the primary schedules its first checkpoint
+	if _, err := le.Grant(1, 3600); err != nil {
+		t.Fatal(err)
+	}
+	checkScheduled := func(want int) {
+		t.Helper()
+		le.mu.RLock()
+		defer le.mu.RUnlock()
+		if n := le.leaseCheckpoints.Len(); n != want {
+			t.Fatalf("expected %d scheduled checkpoints, got %d", want, n)
+		}
+	}
+	checkScheduled(1)
+
+	// applying a checkpoint, updating the TTL and promoting again each
+	// schedule the next checkpoint of the lease
+	for i := 0; i < 10; i++ {
+		if err := le.Checkpoint(1, 3000); err != nil {
+			t.Fatal(err)
+		}
+	}
+	checkScheduled(1)
+	if _, err := le.UpdateTTL(1, 7200); err != nil {
+		t.Fatal(err)
+	}
+	checkScheduled(1)
+	le.Promote(0)
+	checkScheduled(1)
+
+	if _, err := le.Grant(2, 3600); err != nil {
+		t.Fatal(err)
+	}
+	checkScheduled(2)
+}
+
 func TestLessorCheckpointPersistenceAfterRestart(t *testing.T) {
 	const ttl int64 = 10
 	const checkpointTTL int64 = 5
@@ -18,8 +18,6 @@ import (
 	"context"
 
 	grpc "google.golang.org/grpc"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 
 	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
 )
@@ -50,6 +48,31 @@ func (s *kvs2kvc) Compact(ctx context.Context, in *pb.CompactionRequest, opts ..
 	return s.kvs.Compact(ctx, in)
 }
 
+func (s *kvs2kvc) KeyHistory(ctx context.Context, in *pb.KeyHistoryRequest, opts ...grpc.CallOption) (*pb.KeyHistoryResponse, error) {
+	return s.kvs.KeyHistory(ctx, in)
+}
+
 func (s *kvs2kvc) RangeStream(ctx context.Context, in *pb.RangeRequest, opts ...grpc.CallOption) (pb.KV_RangeStreamClient, error) {
-	return nil, status.Error(codes.Unimplemented, "RangeStream is not supported by the KV client adapter")
+	cs := newPipeStream(ctx, func(ss chanServerStream) error {
+		return s.kvs.RangeStream(in, &rs2rcServerStream{ss})
+	})
+	return &rs2rcClientStream{cs}, nil
+}
+
+// rs2rcClientStream implements KV_RangeStreamClient
+type rs2rcClientStream struct{ chanClientStream }
+
+// rs2rcServerStream implements KV_RangeStreamServer
+type rs2rcServerStream struct{ chanServerStream }
+
+func (s *rs2rcClientStream) Recv() (*pb.RangeStreamResponse, error) {
+	var v any
+	if err := s.RecvMsg(&v); err != nil { //nolint:staticcheck // TODO: remove for a supported version
+		return nil, err
+	}
+	return v.(*pb.RangeStreamResponse), nil
+}
+
+func (s *rs2rcServerStream) Send(rr *pb.RangeStreamResponse) error {
+	return s.SendMsg(rr) //nolint:staticcheck // TODO: remove for a supported version
 }
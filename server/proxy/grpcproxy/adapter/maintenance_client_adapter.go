@@ -56,6 +56,18 @@ func (s *mts2mtc) Downgrade(ctx context.Context, r *pb.DowngradeRequest, opts ..
 	return s.mts.Downgrade(ctx, r)
 }
 
+func (s *mts2mtc) RevisionHold(ctx context.Context, r *pb.RevisionHoldRequest, opts ...grpc.CallOption) (*pb.RevisionHoldResponse, error) {
+	return s.mts.RevisionHold(ctx, r)
+}
+
+func (s *mts2mtc) CompactionPolicy(ctx context.Context, r *pb.CompactionPolicyRequest, opts ...grpc.CallOption) (*pb.CompactionPolicyResponse, error) {
+	return s.mts.CompactionPolicy(ctx, r)
+}
+
+func (s *mts2mtc) Watchers(ctx context.Context, r *pb.WatchersRequest, opts ...grpc.CallOption) (*pb.WatchersResponse, error) {
+	return s.mts.Watchers(ctx, r)
+}
+
 func (s *mts2mtc) Snapshot(ctx context.Context, in *pb.SnapshotRequest, opts ...grpc.CallOption) (pb.Maintenance_SnapshotClient, error) {
 	cs := newPipeStream(ctx, func(ss chanServerStream) error {
 		return s.mts.Snapshot(in, &ss2scServerStream{ss})
@@ -38,6 +38,10 @@ func (s *ws2wc) Watch(ctx context.Context, opts ...grpc.CallOption) (pb.Watch_Wa
 	return &ws2wcClientStream{cs}, nil
 }
 
+func (s *ws2wc) Subscription(ctx context.Context, r *pb.SubscriptionRequest, opts ...grpc.CallOption) (*pb.SubscriptionResponse, error) {
+	return s.wserv.Subscription(ctx, r)
+}
+
 // ws2wcClientStream implements Watch_WatchClient
 type ws2wcClientStream struct{ chanClientStream }
 
@@ -18,8 +18,6 @@ import (
 	"context"
 	"errors"
 
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 
 	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
@@ -76,7 +74,30 @@ func (p *kvProxy) Range(ctx context.Context, r *pb.RangeRequest) (*pb.RangeRespo
 }
 
 func (p *kvProxy) RangeStream(r *pb.RangeRequest, rs pb.KV_RangeStreamServer) error {
-	return status.Error(codes.Unimplemented, "RangeStream is not supported by the gRPC proxy")
+	ctx, cancel := context.WithCancel(rs.Context())
+	stream, err := p.kv.GetStream(ctx, string(r.Key), rangeRequestToOpts(r)...)
+	if err != nil {
+		cancel()
+		return err
+	}
+	defer func() {
+		// unblock and drain the stream if the client went away mid-stream
+		cancel()
+		for range stream {
+		}
+	}()
+	for resp := range stream {
+		if err := resp.Err(); err != nil {
+			return err
+		}
+		if err := rs.Send(&pb.RangeStreamResponse{
+			RangeResponse: resp.RangeResponse,
+			ResumeToken:   resp.ResumeToken(),
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func (p *kvProxy) Put(ctx context.Context, r *pb.PutRequest) (*pb.PutResponse, error) {
@@ -100,6 +121,8 @@ func (p *kvProxy) txnToCache(reqs []*pb.RequestOp, resps []*pb.ResponseOp) {
 		switch tv := resps[i].Response.(type) {
 		case *pb.ResponseOp_ResponsePut:
 			p.cache.Invalidate(reqs[i].GetRequestPut().Key, nil)
+		case *pb.ResponseOp_ResponseIncrement:
+			p.cache.Invalidate(reqs[i].GetRequestIncrement().Key, nil)
 		case *pb.ResponseOp_ResponseDeleteRange:
 			rdr := reqs[i].GetRequestDeleteRange()
 			p.cache.Invalidate(rdr.Key, rdr.RangeEnd)
@@ -151,6 +174,11 @@ func (p *kvProxy) Compact(ctx context.Context, r *pb.CompactionRequest) (*pb.Com
 	return (*pb.CompactionResponse)(resp), err
 }
 
+func (p *kvProxy) KeyHistory(ctx context.Context, r *pb.KeyHistoryRequest) (*pb.KeyHistoryResponse, error) {
+	resp, err := p.kv.KeyHistory(ctx, string(r.Key), keyHistoryRequestToOpts(r)...)
+	return (*pb.KeyHistoryResponse)(resp), err
+}
+
 func requestOpToOp(union *pb.RequestOp) clientv3.Op {
 	switch tv := union.Request.(type) {
 	case *pb.RequestOp_RequestRange:
@@ -165,6 +193,10 @@ func requestOpToOp(union *pb.RequestOp) clientv3.Op {
 		if tv.RequestDeleteRange != nil {
 			return DelRequestToOp(tv.RequestDeleteRange)
 		}
+	case *pb.RequestOp_RequestIncrement:
+		if tv.RequestIncrement != nil {
+			return IncrementRequestToOp(tv.RequestIncrement)
+		}
 	case *pb.RequestOp_RequestTxn:
 		if tv.RequestTxn != nil {
 			return TxnRequestToOp(tv.RequestTxn)
@@ -174,6 +206,10 @@ func requestOpToOp(union *pb.RequestOp) clientv3.Op {
 }
 
 func RangeRequestToOp(r *pb.RangeRequest) clientv3.Op {
+	return clientv3.OpGet(string(r.Key), rangeRequestToOpts(r)...)
+}
+
+func rangeRequestToOpts(r *pb.RangeRequest) []clientv3.OpOption {
 	var opts []clientv3.OpOption
 	if len(r.RangeEnd) != 0 {
 		opts = append(opts, clientv3.WithRange(string(r.RangeEnd)))
@@ -188,6 +224,15 @@ func RangeRequestToOp(r *pb.RangeRequest) clientv3.Op {
 	opts = append(opts, clientv3.WithMinCreateRev(r.MinCreateRevision))
 	opts = append(opts, clientv3.WithMaxModRev(r.MaxModRevision))
 	opts = append(opts, clientv3.WithMinModRev(r.MinModRevision))
+	if len(r.ValuePrefix) != 0 {
+		opts = append(opts, clientv3.WithValuePrefix(string(r.ValuePrefix)))
+	}
+	opts = append(opts, clientv3.WithMinValueSize(r.MinValueSize))
+	opts = append(opts, clientv3.WithMaxValueSize(r.MaxValueSize))
+	opts = append(opts, clientv3.WithLeaseFilter(clientv3.LeaseFilter(r.LeaseFilter)))
+	if len(r.ContinueToken) != 0 {
+		opts = append(opts, clientv3.WithContinue(r.ContinueToken))
+	}
 	if r.CountOnly {
 		opts = append(opts, clientv3.WithCountOnly())
 	}
@@ -197,8 +242,23 @@ func RangeRequestToOp(r *pb.RangeRequest) clientv3.Op {
 	if r.Serializable {
 		opts = append(opts, clientv3.WithSerializable())
 	}
+	return opts
+}
 
-	return clientv3.OpGet(string(r.Key), opts...)
+func keyHistoryRequestToOpts(r *pb.KeyHistoryRequest) []clientv3.OpOption {
+	var opts []clientv3.OpOption
+	opts = append(opts, clientv3.WithRev(r.Revision))
+	opts = append(opts, clientv3.WithLimit(r.Limit))
+	opts = append(opts, clientv3.WithSort(clientv3.SortByModRevision, clientv3.SortOrder(r.SortOrder)))
+	opts = append(opts, clientv3.WithMaxModRev(r.MaxModRevision))
+	opts = append(opts, clientv3.WithMinModRev(r.MinModRevision))
+	if r.KeysOnly {
+		opts = append(opts, clientv3.WithKeysOnly())
+	}
+	if r.Serializable {
+		opts = append(opts, clientv3.WithSerializable())
+	}
+	return opts
 }
 
 func PutRequestToOp(r *pb.PutRequest) clientv3.Op {
@@ -213,6 +273,9 @@ func PutRequestToOp(r *pb.PutRequest) clientv3.Op {
 	if r.PrevKv {
 		opts = append(opts, clientv3.WithPrevKV())
 	}
+	if r.Ttl != 0 {
+		opts = append(opts, clientv3.WithTTL(r.Ttl))
+	}
 	return clientv3.OpPut(string(r.Key), string(r.Value), opts...)
 }
 
@@ -224,9 +287,31 @@ func DelRequestToOp(r *pb.DeleteRangeRequest) clientv3.Op {
 	if r.PrevKv {
 		opts = append(opts, clientv3.WithPrevKV())
 	}
+	if r.BatchSize != 0 {
+		opts = append(opts, clientv3.WithBatchSize(r.BatchSize))
+	}
+	if r.Atomic {
+		opts = append(opts, clientv3.WithAtomicBatch())
+	}
+	if r.MaxBatches != 0 {
+		opts = append(opts, clientv3.WithMaxBatches(r.MaxBatches))
+	}
 	return clientv3.OpDelete(string(r.Key), opts...)
 }
 
+func IncrementRequestToOp(r *pb.IncrementRequest) clientv3.Op {
+	var opts []clientv3.OpOption
+	opts = append(opts, clientv3.WithLease(clientv3.LeaseID(r.Lease)))
+	opts = append(opts, clientv3.WithCounterEncoding(clientv3.CounterEncoding(r.Encoding)))
+	if r.IgnoreLease {
+		opts = append(opts, clientv3.WithIgnoreLease())
+	}
+	if r.PrevKv {
+		opts = append(opts, clientv3.WithPrevKV())
+	}
+	return clientv3.OpIncrement(string(r.Key), r.Delta, opts...)
+}
+
 func TxnRequestToOp(r *pb.TxnRequest) clientv3.Op {
 	cmps := make([]clientv3.Cmp, len(r.Compare))
 	thenops := make([]clientv3.Op, len(r.Success))
@@ -95,16 +95,34 @@ func (lp *leaseProxy) LeaseRevoke(ctx context.Context, rr *pb.LeaseRevokeRequest
 	return (*pb.LeaseRevokeResponse)(r), nil
 }
 
+func (lp *leaseProxy) LeaseRevokeBatch(ctx context.Context, rr *pb.LeaseRevokeBatchRequest) (*pb.LeaseRevokeBatchResponse, error) {
+	ids := make([]clientv3.LeaseID, len(rr.IDs))
+	for i, id := range rr.IDs {
+		ids[i] = clientv3.LeaseID(id)
+	}
+	r, err := lp.lessor.RevokeBatch(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	lp.leader.gotLeader()
+	return (*pb.LeaseRevokeBatchResponse)(r), nil
+}
+
+func (lp *leaseProxy) LeaseUpdateTTL(ctx context.Context, rr *pb.LeaseUpdateTTLRequest) (*pb.LeaseUpdateTTLResponse, error) {
+	r, err := lp.lessor.UpdateTTL(ctx, clientv3.LeaseID(rr.ID), rr.TTL)
+	if err != nil {
+		return nil, err
+	}
+	lp.leader.gotLeader()
+	return (*pb.LeaseUpdateTTLResponse)(r), nil
+}
+
 func (lp *leaseProxy) LeaseTimeToLive(ctx context.Context, rr *pb.LeaseTimeToLiveRequest) (*pb.LeaseTimeToLiveResponse, error) {
-	var (
-		r   *clientv3.LeaseTimeToLiveResponse
-		err error
-	)
+	var opts []clientv3.LeaseOption
 	if rr.Keys {
-		r, err = lp.lessor.TimeToLive(ctx, clientv3.LeaseID(rr.ID), clientv3.WithAttachedKeys())
-	} else {
-		r, err = lp.lessor.TimeToLive(ctx, clientv3.LeaseID(rr.ID))
+		opts = append(opts, clientv3.WithAttachedKeys(), clientv3.WithAttachedKeysLimit(rr.KeysLimit), clientv3.WithAttachedKeysFrom(string(rr.KeysFrom)))
 	}
+	r, err := lp.lessor.TimeToLive(ctx, clientv3.LeaseID(rr.ID), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -114,18 +132,26 @@ func (lp *leaseProxy) LeaseTimeToLive(ctx context.Context, rr *pb.LeaseTimeToLiv
 		TTL:        r.TTL,
 		GrantedTTL: r.GrantedTTL,
 		Keys:       r.Keys,
+		MoreKeys:   r.MoreKeys,
 	}
 	return rp, err
 }
 
 func (lp *leaseProxy) LeaseLeases(ctx context.Context, rr *pb.LeaseLeasesRequest) (*pb.LeaseLeasesResponse, error) {
-	r, err := lp.lessor.Leases(ctx)
+	var opts []clientv3.LeaseOption
+	if len(rr.Key) != 0 {
+		opts = append(opts, clientv3.WithAttachedKeyRange(string(rr.Key), string(rr.RangeEnd)))
+	}
+	if rr.Keys {
+		opts = append(opts, clientv3.WithAttachedKeys())
+	}
+	r, err := lp.lessor.Leases(ctx, opts...)
 	if err != nil {
 		return nil, err
 	}
 	leases := make([]*pb.LeaseStatus, len(r.Leases))
 	for i := range r.Leases {
-		leases[i] = &pb.LeaseStatus{ID: int64(r.Leases[i].ID)}
+		leases[i] = &pb.LeaseStatus{ID: int64(r.Leases[i].ID), Keys: r.Leases[i].Keys}
 	}
 	rp := &pb.LeaseLeasesResponse{
 		Header: r.ResponseHeader,
@@ -134,6 +160,37 @@ func (lp *leaseProxy) LeaseLeases(ctx context.Context, rr *pb.LeaseLeasesRequest
 	return rp, err
 }
 
+func (lp *leaseProxy) LeaseWatch(rr *pb.LeaseWatchRequest, stream pb.Lease_LeaseWatchServer) error {
+	var opts []clientv3.LeaseOption
+	if rr.Keys {
+		opts = append(opts, clientv3.WithAttachedKeys())
+	}
+	ctx, cancel := context.WithCancel(stream.Context())
+	wch, err := lp.lessor.WatchLeases(ctx, clientv3.LeaseID(rr.ID), opts...)
+	if err != nil {
+		cancel()
+		return err
+	}
+	defer func() {
+		// unblock and drain the watch if the client went away
+		cancel()
+		for range wch {
+		}
+	}()
+	for resp := range wch {
+		if err := resp.Err(); err != nil {
+			return err
+		}
+		if err := stream.Send(&pb.LeaseWatchResponse{
+			Header: resp.ResponseHeader,
+			Events: resp.Events,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (lp *leaseProxy) LeaseKeepAlive(stream pb.Lease_LeaseKeepAliveServer) error {
 	lp.mu.Lock()
 	select {
@@ -88,3 +88,15 @@ func (mp *maintenanceProxy) MoveLeader(ctx context.Context, r *pb.MoveLeaderRequ
 func (mp *maintenanceProxy) Downgrade(ctx context.Context, r *pb.DowngradeRequest) (*pb.DowngradeResponse, error) {
 	return mp.maintenanceClient.Downgrade(ctx, r)
 }
+
+func (mp *maintenanceProxy) RevisionHold(ctx context.Context, r *pb.RevisionHoldRequest) (*pb.RevisionHoldResponse, error) {
+	return mp.maintenanceClient.RevisionHold(ctx, r)
+}
+
+func (mp *maintenanceProxy) CompactionPolicy(ctx context.Context, r *pb.CompactionPolicyRequest) (*pb.CompactionPolicyResponse, error) {
+	return mp.maintenanceClient.CompactionPolicy(ctx, r)
+}
+
+func (mp *maintenanceProxy) Watchers(ctx context.Context, r *pb.WatchersRequest) (*pb.WatchersResponse, error) {
+	return mp.maintenanceClient.Watchers(ctx, r)
+}
@@ -31,6 +31,7 @@ import (
 
 type watchProxy struct {
 	cw  clientv3.Watcher
+	wc  pb.WatchClient
 	ctx context.Context
 
 	leader *leader
@@ -55,6 +56,7 @@ func NewWatchProxy(ctx context.Context, lg *zap.Logger, c *clientv3.Client) (pb.
 	cctx, cancel := context.WithCancel(ctx)
 	wp := &watchProxy{
 		cw:     c.Watcher,
+		wc:     pb.NewWatchClient(c.ActiveConnection()),
 		ctx:    cctx,
 		leader: newLeader(cctx, c.Watcher),
 
@@ -105,6 +107,7 @@ func (wp *watchProxy) Watch(stream pb.Watch_WatchServer) (err error) {
 		ctx:      ctx,
 		cancel:   cancel,
 		kv:       wp.kv,
+		wc:       wp.wc,
 		lg:       wp.lg,
 	}
 
@@ -185,9 +188,13 @@ type watchProxyStream struct {
 
 	ctx    context.Context
 	cancel context.CancelFunc
+	// wg waits for the watchers posting the responses they held back.
+	wg sync.WaitGroup
 
 	// kv is used for permission checking
 	kv clientv3.KV
+	// wc resolves the revisions of watch subscriptions
+	wc pb.WatchClient
 	lg *zap.Logger
 }
 
@@ -206,6 +213,7 @@ func (wps *watchProxyStream) close() {
 	wps.mu.Unlock()
 
 	wg.Wait()
+	wps.wg.Wait()
 
 	close(wps.watchCh)
 }
@@ -228,6 +236,38 @@ func (wps *watchProxyStream) checkPermissionForWatch(key, rangeEnd []byte) error
 	return err
 }
 
+// checkPermissionForWatchRanges checks the permission for the key range and
+// all additional key ranges of a watch create request.
+func (wps *watchProxyStream) checkPermissionForWatchRanges(cr *pb.WatchCreateRequest) error {
+	if err := wps.checkPermissionForWatch(cr.Key, cr.RangeEnd); err != nil {
+		return err
+	}
+	for _, r := range cr.Ranges {
+		if err := wps.checkPermissionForWatch(r.Key, r.RangeEnd); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (wp *watchProxy) Subscription(ctx context.Context, r *pb.SubscriptionRequest) (*pb.SubscriptionResponse, error) {
+	return wp.wc.Subscription(ctx, r)
+}
+
+// subscriptionRevision returns the revision a watch on the given subscription
+// resumes from, or 0 if the subscription does not exist.
+func (wps *watchProxyStream) subscriptionRevision(name string) (int64, error) {
+	ctx := withClientAuthToken(wps.ctx, wps.stream.Context())
+	resp, err := wps.wc.Subscription(ctx, &pb.SubscriptionRequest{Action: pb.SubscriptionRequest_LIST, Name: name})
+	if err != nil {
+		return 0, err
+	}
+	if len(resp.Subscriptions) == 0 {
+		return 0, nil
+	}
+	return resp.Subscriptions[0].Revision + 1, nil
+}
+
 func (wps *watchProxyStream) recvLoop() error {
 	for {
 		req, err := wps.stream.Recv()
@@ -249,7 +289,7 @@ func (wps *watchProxyStream) recvLoop() error {
 				continue
 			}
 
-			if err := wps.checkPermissionForWatch(cr.Key, cr.RangeEnd); err != nil {
+			if err := wps.checkPermissionForWatchRanges(cr); err != nil {
 				wps.watchCh <- &pb.WatchResponse{
 					Header:       &pb.ResponseHeader{},
 					WatchId:      clientv3.InvalidWatchID,
@@ -260,16 +300,34 @@ func (wps *watchProxyStream) recvLoop() error {
 				continue
 			}
 
+			if cr.StartRevision == 0 && cr.Subscription != "" {
+				rev, err := wps.subscriptionRevision(cr.Subscription)
+				if err != nil {
+					wps.watchCh <- &pb.WatchResponse{
+						Header:       &pb.ResponseHeader{},
+						WatchId:      clientv3.InvalidWatchID,
+						Created:      true,
+						Canceled:     true,
+						CancelReason: err.Error(),
+					}
+					continue
+				}
+				cr.StartRevision = rev
+			}
+
+			// the events watched by the proxy carry their previous key-value pairs
+			filters := append(v3rpc.FiltersFromRequest(cr), v3rpc.PrevKVFiltersFromRequest(cr)...)
 			wps.mu.Lock()
 			w := &watcher{
-				wr:  watchRange{string(cr.Key), string(cr.RangeEnd)},
+				wr:  newWatchRange(cr),
 				id:  wps.nextWatcherID,
 				wps: wps,
 
 				nextrev:  cr.StartRevision,
 				progress: cr.ProgressNotify,
 				prevKV:   cr.PrevKv,
-				filters:  v3rpc.FiltersFromRequest(cr),
+				coalesce: cr.Coalesce,
+				filters:  filters,
 			}
 			if !w.wr.valid() {
 				w.post(&pb.WatchResponse{
@@ -328,5 +386,10 @@ func (wps *watchProxyStream) delete(id int64) {
 		WatchId:  id,
 		Canceled: true,
 	}
+	if w.coalesce {
+		// follow the responses the watcher held back
+		w.postCoalesced(resp)
+		return
+	}
 	wps.watchCh <- resp
 }
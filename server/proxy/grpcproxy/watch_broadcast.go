@@ -62,6 +62,9 @@ func newWatchBroadcast(lg *zap.Logger, wp *watchProxy, w *watcher, update func(*
 			clientv3.WithPrevKV(),
 			clientv3.WithCreatedNotify(),
 		}
+		if ranges := w.wr.keyRanges(); len(ranges) != 0 {
+			opts = append(opts, clientv3.WithRanges(ranges...))
+		}
 
 		cctx = withClientAuthToken(cctx, w.wps.stream.Context())
 
@@ -15,6 +15,9 @@
 package grpcproxy
 
 import (
+	"strconv"
+	"strings"
+	"sync"
 	"time"
 
 	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
@@ -25,10 +28,58 @@ import (
 
 type watchRange struct {
 	key, end string
+	// ranges holds the additional key ranges of the watch as a sequence of
+	// quoted keys and range ends, so that watchRange stays comparable.
+	ranges string
+}
+
+func newWatchRange(cr *pb.WatchCreateRequest) watchRange {
+	wr := watchRange{key: string(cr.Key), end: string(cr.RangeEnd)}
+	var sb strings.Builder
+	for _, r := range cr.Ranges {
+		sb.WriteString(strconv.Quote(string(r.Key)))
+		sb.WriteString(strconv.Quote(string(r.RangeEnd)))
+	}
+	wr.ranges = sb.String()
+	return wr
+}
+
+// keyRanges returns the additional key ranges of the watch.
+func (wr *watchRange) keyRanges() []clientv3.KeyRange {
+	var ranges []clientv3.KeyRange
+	for s := wr.ranges; len(s) != 0; {
+		key, end := unquotePrefix(&s), unquotePrefix(&s)
+		ranges = append(ranges, clientv3.KeyRange{Key: key, End: end})
+	}
+	return ranges
+}
+
+// unquotePrefix unquotes the quoted string at the start of s and advances s
+// past it.
+func unquotePrefix(s *string) string {
+	q, err := strconv.QuotedPrefix(*s)
+	if err != nil {
+		panic(err)
+	}
+	*s = (*s)[len(q):]
+	u, _ := strconv.Unquote(q)
+	return u
 }
 
 func (wr *watchRange) valid() bool {
-	return len(wr.end) == 0 || wr.end > wr.key || (wr.end[0] == 0 && len(wr.end) == 1)
+	if !validRange(wr.key, wr.end) {
+		return false
+	}
+	for _, r := range wr.keyRanges() {
+		if !validRange(r.Key, r.End) {
+			return false
+		}
+	}
+	return true
+}
+
+func validRange(key, end string) bool {
+	return len(end) == 0 || end > key || (end[0] == 0 && len(end) == 1)
 }
 
 type watcher struct {
@@ -38,6 +89,7 @@ type watcher struct {
 	filters  []mvcc.FilterFunc
 	progress bool
 	prevKV   bool
+	coalesce bool
 
 	// id is the id returned to the client on its watch stream.
 	id int64
@@ -48,6 +100,15 @@ type watcher struct {
 
 	// wps is the parent.
 	wps *watchProxyStream
+
+	// behindMu protects behind and flushing.
+	behindMu sync.Mutex
+	// behind holds the responses of a coalescing watcher left to post once
+	// its proxy stream catches up, with the events of consecutive responses
+	// coalesced.
+	behind []*pb.WatchResponse
+	// flushing is set while a goroutine posts the responses behind.
+	flushing bool
 }
 
 // send filters out repeated events by discarding revisions older
@@ -76,7 +137,48 @@ func (w *watcher) send(wr clientv3.WatchResponse) {
 		// txn can have multiple events with the same rev.
 		// If w.nextrev updates here, it would skip events in the same txn.
 		lastRev = ev.Kv.ModRevision
+		events = append(events, ev)
+	}
+
+	if lastRev >= w.nextrev {
+		w.nextrev = lastRev + 1
+	}
+
+	// only responses catching up on the store span several revisions. The
+	// events are coalesced before they are filtered, like on the server.
+	coalesced := false
+	if w.coalesce {
+		events, coalesced = mvcc.CoalesceEvents(events)
+	}
+	events = w.filter(events)
+
+	// all events are filtered out?
+	if !wr.IsProgressNotify() && !wr.Created && len(events) == 0 && wr.CompactRevision == 0 {
+		return
+	}
+
+	w.lastHeader = wr.Header.Clone()
+	resp := &pb.WatchResponse{
+		Header:          w.lastHeader.Clone(),
+		Created:         wr.Created,
+		CompactRevision: wr.CompactRevision,
+		Canceled:        wr.Canceled,
+		Coalesced:       coalesced,
+		WatchId:         w.id,
+		Events:          events,
+	}
+	if w.coalesce {
+		w.postCoalesced(resp)
+		return
+	}
+	w.post(resp)
+}
 
+// filter returns the events not filtered out by the watcher, without their
+// previous key-value pairs unless the watcher asked for them.
+func (w *watcher) filter(evs []*mvccpb.Event) []*mvccpb.Event {
+	events := evs[:0]
+	for _, ev := range evs {
 		filtered := false
 		for _, filter := range w.filters {
 			if filter(ev) {
@@ -98,25 +200,66 @@ func (w *watcher) send(wr clientv3.WatchResponse) {
 		}
 		events = append(events, ev)
 	}
+	return events
+}
 
-	if lastRev >= w.nextrev {
-		w.nextrev = lastRev + 1
+// postCoalesced puts a watch response of a coalescing watcher on its proxy
+// stream channel. Rather than wait for a proxy stream falling behind, the
+// response is held back and the events of the responses after it are
+// coalesced with its own until the stream catches up.
+func (w *watcher) postCoalesced(wr *pb.WatchResponse) {
+	w.behindMu.Lock()
+	defer w.behindMu.Unlock()
+	if !w.flushing {
+		select {
+		case w.wps.watchCh <- wr:
+			return
+		default:
+		}
+		w.flushing = true
+		w.behind = append(w.behind, wr)
+		w.wps.wg.Add(1)
+		go w.flushBehind()
+		return
 	}
-
-	// all events are filtered out?
-	if !wr.IsProgressNotify() && !wr.Created && len(events) == 0 && wr.CompactRevision == 0 {
+	if n := len(w.behind); n > 0 && mergeable(w.behind[n-1]) && mergeable(wr) {
+		last := w.behind[n-1]
+		events, coalesced := mvcc.CoalesceEvents(append(last.Events, wr.Events...))
+		last.Header = wr.Header
+		last.Events = events
+		last.Coalesced = last.Coalesced || wr.Coalesced || coalesced
 		return
 	}
+	w.behind = append(w.behind, wr)
+}
 
-	w.lastHeader = wr.Header.Clone()
-	w.post(&pb.WatchResponse{
-		Header:          w.lastHeader.Clone(),
-		Created:         wr.Created,
-		CompactRevision: wr.CompactRevision,
-		Canceled:        wr.Canceled,
-		WatchId:         w.id,
-		Events:          events,
-	})
+// mergeable returns whether the events of wr may be coalesced with those of
+// other responses.
+func mergeable(wr *pb.WatchResponse) bool {
+	return !wr.Created && !wr.Canceled && wr.CompactRevision == 0
+}
+
+// flushBehind posts the responses held back by postCoalesced, in order,
+// until there are none left or the proxy stream is closed.
+func (w *watcher) flushBehind() {
+	defer w.wps.wg.Done()
+	for {
+		w.behindMu.Lock()
+		if len(w.behind) == 0 {
+			w.flushing = false
+			w.behindMu.Unlock()
+			return
+		}
+		wr := w.behind[0]
+		w.behind = w.behind[1:]
+		w.behindMu.Unlock()
+
+		select {
+		case w.wps.watchCh <- wr:
+		case <-w.wps.ctx.Done():
+			return
+		}
+	}
 }
 
 // post puts a watch response on the watcher's proxy stream channel
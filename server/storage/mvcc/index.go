@@ -26,9 +26,11 @@ type index interface {
 	Range(key, end []byte, atRev int64, limit int, withTotalCount bool) (keys [][]byte, modifies, creates []Revision, versions []int64, totalCount int)
 	Revisions(key, end []byte, atRev int64, limit int, withTotalCount bool) ([]Revision, int)
 	CountRevisions(key, end []byte, atRev int64) int
+	History(key []byte, minRev, maxRev int64) []Revision
 	Put(key []byte, rev Revision)
 	Tombstone(key []byte, rev Revision) error
 	Compact(rev int64) map[Revision]struct{}
+	CompactPrefixes(rev int64, prefixes prefixCompactions) (available, dropped map[Revision]struct{})
 	Keep(rev int64) map[Revision]struct{}
 	Equal(b index) bool
 
@@ -79,6 +81,17 @@ func (ti *treeIndex) unsafeGet(key []byte, atRev int64) (modified, created Revis
 	return keyi.get(ti.lg, atRev)
 }
 
+// History returns the revisions of key in [minRev, maxRev] in ascending order.
+func (ti *treeIndex) History(key []byte, minRev, maxRev int64) []Revision {
+	ti.RLock()
+	defer ti.RUnlock()
+	keyi := ti.keyIndex(&keyIndex{key: key})
+	if keyi == nil {
+		return nil
+	}
+	return keyi.history(ti.lg, minRev, maxRev)
+}
+
 func (ti *treeIndex) KeyIndex(keyi *keyIndex) *keyIndex {
 	ti.RLock()
 	defer ti.RUnlock()
@@ -226,6 +239,47 @@ func (ti *treeIndex) Compact(rev int64) map[Revision]struct{} {
 	return available
 }
 
+// CompactPrefixes is like Compact, but compacts the keys under the prefixes
+// up to the revision of the longest prefix they match. It also returns the
+// revisions greater than rev removed from the index.
+func (ti *treeIndex) CompactPrefixes(rev int64, prefixes prefixCompactions) (available, dropped map[Revision]struct{}) {
+	available, dropped = make(map[Revision]struct{}), make(map[Revision]struct{})
+	ti.lg.Info("compact tree index", zap.Int64("revision", rev), zap.Int("prefixes", len(prefixes)))
+	ti.Lock()
+	clone := ti.tree.Clone()
+	ti.Unlock()
+
+	clone.Ascend(func(keyi *keyIndex) bool {
+		atRev := prefixes.keyRev(keyi.key, rev)
+		ti.Lock()
+		var revs []Revision
+		if atRev > rev {
+			for _, g := range keyi.generations {
+				for _, r := range g.revs {
+					if r.Main > rev && r.Main <= atRev {
+						revs = append(revs, r)
+					}
+				}
+			}
+		}
+		keyi.compact(ti.lg, atRev, available)
+		for _, r := range revs {
+			if _, ok := available[r]; !ok {
+				dropped[r] = struct{}{}
+			}
+		}
+		if keyi.isEmpty() {
+			_, ok := ti.tree.Delete(keyi)
+			if !ok {
+				ti.lg.Panic("failed to delete during compaction")
+			}
+		}
+		ti.Unlock()
+		return true
+	})
+	return available, dropped
+}
+
 // Keep finds all revisions to be kept for a Compaction at the given rev.
 func (ti *treeIndex) Keep(rev int64) map[Revision]struct{} {
 	available := make(map[Revision]struct{})
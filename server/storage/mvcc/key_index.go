@@ -209,6 +209,19 @@ func (ki *keyIndex) since(lg *zap.Logger, rev int64) []Revision {
 	return revs
 }
 
+// history returns the revisions in [minRev, maxRev] in ascending order. Like
+// since, it only returns the revision with the largest sub revision of each
+// main revision.
+func (ki *keyIndex) history(lg *zap.Logger, minRev, maxRev int64) []Revision {
+	revs := ki.since(lg, minRev)
+	for i, r := range revs {
+		if r.Main > maxRev {
+			return revs[:i]
+		}
+	}
+	return revs
+}
+
 // compact compacts a keyIndex by removing the versions with smaller or equal
 // revision than the given atRev except the largest one.
 // If a generation becomes empty during compaction, it will be removed.
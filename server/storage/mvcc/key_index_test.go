@@ -177,6 +177,44 @@ func TestKeyIndexSince(t *testing.T) {
 	}
 }
 
+func TestKeyIndexHistory(t *testing.T) {
+	ki := newTestKeyIndex(zaptest.NewLogger(t))
+	ki.compact(zaptest.NewLogger(t), 4, make(map[Revision]struct{}))
+
+	allRevs := []Revision{
+		{Main: 4},
+		{Main: 6},
+		{Main: 8},
+		{Main: 10},
+		{Main: 12},
+		{Main: 14},
+		{Main: 15, Sub: 1},
+		{Main: 16},
+	}
+	tests := []struct {
+		minRev, maxRev int64
+
+		wrevs []Revision
+	}{
+		{0, 16, allRevs},
+		{0, 100, allRevs},
+		{0, 3, allRevs[:0]},
+		{0, 4, allRevs[:1]},
+		{5, 11, allRevs[1:4]},
+		{6, 12, allRevs[1:5]},
+		{15, 15, allRevs[6:7]},
+		{16, 16, allRevs[7:]},
+		{17, 20, nil},
+	}
+
+	for i, tt := range tests {
+		revs := ki.history(zaptest.NewLogger(t), tt.minRev, tt.maxRev)
+		if !reflect.DeepEqual(revs, tt.wrevs) {
+			t.Errorf("#%d: revs = %+v, want %+v", i, revs, tt.wrevs)
+		}
+	}
+}
+
 func TestKeyIndexPut(t *testing.T) {
 	ki := &keyIndex{key: []byte("foo")}
 	ki.put(zaptest.NewLogger(t), 5, 0)
@@ -31,6 +31,20 @@ type RangeOptions struct {
 	WithTotalCount bool
 }
 
+// HistoryOptions bounds the revisions of a key returned by History.
+type HistoryOptions struct {
+	// Rev is the revision to read the history at. If Rev <= 0, the history is
+	// read at the current revision.
+	Rev int64
+	// MinRev and MaxRev bound the returned revisions. A bound of 0 is no bound.
+	MinRev int64
+	MaxRev int64
+	// Limit limits the number of revisions returned.
+	Limit int64
+	// Descending returns the newest revisions first.
+	Descending bool
+}
+
 type RangeResult struct {
 	KVs   []*mvccpb.KeyValue
 	Rev   int64
@@ -43,6 +57,11 @@ type ReadView interface {
 	// revision.
 	FirstRev() int64
 
+	// CompactRev returns the revision the keys in the range [key, end) are
+	// compacted up to. It is higher than the first revision for ranges with
+	// keys under prefixes compacted past it.
+	CompactRev(key, end []byte) int64
+
 	// Rev returns the revision of the KV at the time of opening the txn.
 	Rev() int64
 
@@ -55,6 +74,13 @@ type ReadView interface {
 	// Limit limits the number of keys returned.
 	// If the required rev is compacted, ErrCompacted will be returned.
 	Range(ctx context.Context, key, end []byte, ro RangeOptions) (r *RangeResult, err error)
+
+	// History gets the key-value pairs of every revision of key retained in
+	// the KV up to ho.Rev, oldest first unless ho.Descending is set.
+	// A revision deleting the key is returned with version 0 and no value.
+	// The returned count is the number of revisions within the bounds of ho.
+	// If the required rev is compacted, ErrCompacted will be returned.
+	History(ctx context.Context, key []byte, ho HistoryOptions) (r *RangeResult, err error)
 }
 
 // TxnRead represents a read-only transaction with operations that will not
@@ -127,6 +153,15 @@ type KV interface {
 	// Compact frees all superseded keys with revisions less than rev.
 	Compact(trace *traceutil.Trace, rev int64) (<-chan struct{}, error)
 
+	// CompactPrefixes is like Compact, but frees the superseded keys under
+	// each prefix with revisions less than the revision of the longest prefix
+	// they match instead. Keys are never compacted back to a lower revision,
+	// and prefixes compacted past rev before but left out now are compacted
+	// like the keys around them from then on. The hash kept for corruption
+	// checks covers the revisions up to the highest compacted one, so it is
+	// stored whenever any prefix is compacted further.
+	CompactPrefixes(trace *traceutil.Trace, rev int64, prefixes []PrefixCompaction) (<-chan struct{}, error)
+
 	// Commit commits outstanding txns into the underlying backend.
 	Commit()
 
@@ -146,4 +181,7 @@ type Watchable interface {
 	// NewWatchStream returns a WatchStream that can be used to
 	// watch events happened or happening on the KV.
 	NewWatchStream() WatchStream
+
+	// Watchers returns the watchers of all watch streams of the KV.
+	Watchers() []WatcherInfo
 }
@@ -635,6 +635,72 @@ func TestKVCompactBad(t *testing.T) {
 	}
 }
 
+func TestKVHistory(t *testing.T) {
+	b, _ := betesting.NewDefaultTmpBackend(t)
+	s := NewStore(zaptest.NewLogger(t), b, &lease.FakeLessor{}, StoreConfig{})
+	defer cleanup(s, b)
+
+	s.Put([]byte("foo"), []byte("bar0"), lease.NoLease)
+	s.Put([]byte("foo1"), []byte("bar"), lease.NoLease)
+	s.Put([]byte("foo"), []byte("bar1"), lease.NoLease)
+	s.DeleteRange([]byte("foo"), nil)
+	s.Put([]byte("foo"), []byte("bar2"), lease.NoLease)
+
+	kvs := []*mvccpb.KeyValue{
+		{Key: []byte("foo"), Value: []byte("bar0"), CreateRevision: 2, ModRevision: 2, Version: 1},
+		{Key: []byte("foo"), Value: []byte("bar1"), CreateRevision: 2, ModRevision: 4, Version: 2},
+		{Key: []byte("foo"), ModRevision: 5},
+		{Key: []byte("foo"), Value: []byte("bar2"), CreateRevision: 6, ModRevision: 6, Version: 1},
+	}
+	tests := []struct {
+		name string
+		ho   HistoryOptions
+
+		wkvs   []*mvccpb.KeyValue
+		wcount int
+	}{
+		{"all", HistoryOptions{}, kvs, 4},
+		{"at revision", HistoryOptions{Rev: 5}, kvs[:3], 3},
+		{"min revision", HistoryOptions{MinRev: 3}, kvs[1:], 3},
+		{"max revision", HistoryOptions{MaxRev: 4}, kvs[:2], 2},
+		{"limit", HistoryOptions{Limit: 2}, kvs[:2], 4},
+		{"descending", HistoryOptions{Descending: true, Limit: 2}, []*mvccpb.KeyValue{kvs[3], kvs[2]}, 4},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := s.History(t.Context(), []byte("foo"), tt.ho)
+			if err != nil {
+				t.Fatalf("unexpected history error %v", err)
+			}
+			if !cmp.Equal(r.KVs, tt.wkvs, protocmp.Transform()) {
+				t.Errorf("kvs = %+v, want %+v", r.KVs, tt.wkvs)
+			}
+			if r.Count != tt.wcount {
+				t.Errorf("count = %d, want %d", r.Count, tt.wcount)
+			}
+		})
+	}
+
+	if _, err := s.History(t.Context(), []byte("foo"), HistoryOptions{Rev: 7}); !errors.Is(err, ErrFutureRev) {
+		t.Errorf("history error = %v, want %v", err, ErrFutureRev)
+	}
+	done, err := s.Compact(traceutil.TODO(), 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	<-done
+	if _, err := s.History(t.Context(), []byte("foo"), HistoryOptions{Rev: 3}); !errors.Is(err, ErrCompacted) {
+		t.Errorf("history error = %v, want %v", err, ErrCompacted)
+	}
+	r, err := s.History(t.Context(), []byte("foo"), HistoryOptions{})
+	if err != nil {
+		t.Fatalf("unexpected history error %v", err)
+	}
+	if !cmp.Equal(r.KVs, kvs[1:], protocmp.Transform()) {
+		t.Errorf("kvs after compaction = %+v, want %+v", r.KVs, kvs[1:])
+	}
+}
+
 func TestKVHash(t *testing.T) {
 	hashes := make([]uint32, 3)
 
@@ -29,6 +29,12 @@ func (rv *readView) FirstRev() int64 {
 	return tr.FirstRev()
 }
 
+func (rv *readView) CompactRev(key, end []byte) int64 {
+	tr := rv.kv.Read(SharedBufReadTxMode, traceutil.TODO())
+	defer tr.End()
+	return tr.CompactRev(key, end)
+}
+
 func (rv *readView) Rev() int64 {
 	tr := rv.kv.Read(SharedBufReadTxMode, traceutil.TODO())
 	defer tr.End()
@@ -41,6 +47,12 @@ func (rv *readView) Range(ctx context.Context, key, end []byte, ro RangeOptions)
 	return tr.Range(ctx, key, end, ro)
 }
 
+func (rv *readView) History(ctx context.Context, key []byte, ho HistoryOptions) (r *RangeResult, err error) {
+	tr := rv.kv.Read(ConcurrentReadTxMode, traceutil.TODO())
+	defer tr.End()
+	return tr.History(ctx, key, ho)
+}
+
 type writeView struct{ kv KV }
 
 func (wv *writeView) DeleteRange(key, end []byte) (n, rev int64) {
@@ -19,6 +19,7 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"slices"
 	"sync"
 	"time"
 
@@ -64,7 +65,7 @@ type store struct {
 
 	le lease.Lessor
 
-	// revMuLock protects currentRev and compactMainRev.
+	// revMuLock protects currentRev, compactMainRev and compactPrefixes.
 	// Locked at end of write txn and released after write txn unlock lock.
 	// Locked before locking read txn and released after locking.
 	revMu sync.RWMutex
@@ -72,6 +73,8 @@ type store struct {
 	currentRev int64
 	// compactMainRev is the main revision of the last compaction.
 	compactMainRev int64
+	// compactPrefixes are the prefixes compacted past compactMainRev.
+	compactPrefixes prefixCompactions
 
 	fifoSched schedule.Scheduler
 
@@ -170,7 +173,11 @@ func (s *store) hashByRev(rev int64) (hash KeyValueHash, currentRev int64, err e
 
 	s.mu.RLock()
 	s.revMu.RLock()
-	compactRev, currentRev = s.compactMainRev, s.currentRev
+	// revisions of keys under compacted prefixes may be removed up to the
+	// highest compacted revision at any time, so they are left out. The hash
+	// stored by a compaction is computed against the same revision, see
+	// scheduleCompaction.
+	compactRev, currentRev = s.compactPrefixes.maxRev(s.compactMainRev), s.currentRev
 	s.revMu.RUnlock()
 
 	if rev > 0 && rev < compactRev {
@@ -194,23 +201,61 @@ func (s *store) hashByRev(rev int64) (hash KeyValueHash, currentRev int64, err e
 	return hash, currentRev, err
 }
 
-func (s *store) updateCompactRev(rev int64) (<-chan struct{}, int64, error) {
+// CompactRevisions returns the revision the keys not under any compacted
+// prefix were last compacted up to, and the prefixes compacted past it.
+func (s *store) CompactRevisions() (int64, []PrefixCompaction) {
+	s.revMu.RLock()
+	defer s.revMu.RUnlock()
+	return s.compactMainRev, slices.Clone(s.compactPrefixes)
+}
+
+// compactRev returns the highest revision a key in the range [key, end) may
+// be compacted up to. It must be called with mu or revMu held.
+func (s *store) compactRev(key, end []byte) int64 {
+	return s.compactPrefixes.rangeRev(key, end, s.compactMainRev)
+}
+
+// compaction is a compaction of the store from the previous compaction
+// revision and prefixes.
+type compaction struct {
+	rev          int64
+	prefixes     prefixCompactions
+	prevRev      int64
+	prevPrefixes prefixCompactions
+}
+
+// hashRev returns the highest revision compacted by the compaction. The hash
+// of a compaction covers the revisions up to it, so that the keys under every
+// prefix are checked whichever prefixes hold back the compaction revision.
+func (c compaction) hashRev() int64 { return c.prefixes.maxRev(c.rev) }
+
+// prevHashRev returns the highest revision compacted before the compaction.
+func (c compaction) prevHashRev() int64 { return c.prevPrefixes.maxRev(c.prevRev) }
+
+func (s *store) updateCompactRev(rev int64, prefixes []PrefixCompaction) (<-chan struct{}, compaction, error) {
 	s.revMu.Lock()
-	if rev <= s.compactMainRev {
+	c := compaction{prevRev: s.compactMainRev, prevPrefixes: s.compactPrefixes}
+	c.rev, c.prefixes = mergePrefixCompactions(c.prevRev, c.prevPrefixes, rev, prefixes)
+	if c.rev == c.prevRev && c.prefixes.equal(c.prevPrefixes) {
 		ch := make(chan struct{})
 		f := schedule.NewJob("kvstore_updateCompactRev_compactBarrier", func(ctx context.Context) { s.compactBarrier(ctx, ch) })
 		s.fifoSched.Schedule(f)
 		s.revMu.Unlock()
-		return ch, 0, ErrCompacted
+		return ch, compaction{}, ErrCompacted
 	}
-	if rev > s.currentRev {
+	if c.prefixes.maxRev(c.rev) > s.currentRev {
 		s.revMu.Unlock()
-		return nil, 0, ErrFutureRev
+		return nil, compaction{}, ErrFutureRev
 	}
-	compactMainRev := s.compactMainRev
-	s.compactMainRev = rev
+	s.compactMainRev, s.compactPrefixes = c.rev, c.prefixes
 
-	SetScheduledCompact(s.b.BatchTx(), rev)
+	tx := s.b.BatchTx()
+	tx.LockInsideApply()
+	UnsafeSetScheduledCompact(tx, c.rev)
+	if len(c.prefixes) != 0 || len(c.prevPrefixes) != 0 {
+		UnsafeSetScheduledCompactPrefixes(tx, c.prefixes)
+	}
+	tx.Unlock()
 	// ensure that desired compaction is persisted
 	// gofail: var compactBeforeCommitScheduledCompact struct{}
 	s.b.ForceCommit()
@@ -218,7 +263,7 @@ func (s *store) updateCompactRev(rev int64) (<-chan struct{}, int64, error) {
 
 	s.revMu.Unlock()
 
-	return nil, compactMainRev, nil
+	return nil, c, nil
 }
 
 // checkPrevCompactionCompleted checks whether the previous scheduled compaction is completed.
@@ -228,17 +273,20 @@ func (s *store) checkPrevCompactionCompleted() bool {
 	defer tx.RUnlock()
 	scheduledCompact, scheduledCompactFound := UnsafeReadScheduledCompact(tx)
 	finishedCompact, finishedCompactFound := UnsafeReadFinishedCompact(tx)
-	return scheduledCompact == finishedCompact && scheduledCompactFound == finishedCompactFound
+	scheduledPrefixes := prefixCompactions(UnsafeReadScheduledCompactPrefixes(tx))
+	finishedPrefixes := prefixCompactions(UnsafeReadFinishedCompactPrefixes(tx))
+	return scheduledCompact == finishedCompact && scheduledCompactFound == finishedCompactFound &&
+		scheduledPrefixes.equal(finishedPrefixes)
 }
 
-func (s *store) compact(trace *traceutil.Trace, rev, prevCompactRev int64, prevCompactionCompleted bool) <-chan struct{} {
+func (s *store) compact(trace *traceutil.Trace, c compaction, prevCompactionCompleted bool) <-chan struct{} {
 	ch := make(chan struct{})
 	j := schedule.NewJob("kvstore_compact", func(ctx context.Context) {
 		if ctx.Err() != nil {
 			s.compactBarrier(ctx, ch)
 			return
 		}
-		hash, err := s.scheduleCompaction(rev, prevCompactRev)
+		hash, err := s.scheduleCompaction(c)
 		if err != nil {
 			s.lg.Warn("Failed compaction", zap.Error(err))
 			s.compactBarrier(context.TODO(), ch)
@@ -246,9 +294,11 @@ func (s *store) compact(trace *traceutil.Trace, rev, prevCompactRev int64, prevC
 		}
 		// Only store the hash value if the previous hash is completed, i.e. this compaction
 		// hashes every revision from last compaction. For more details, see #15919.
-		if prevCompactionCompleted {
+		// Compactions of prefixes not raising the highest compacted revision hash
+		// no revision.
+		if prevCompactionCompleted && c.hashRev() > c.prevHashRev() {
 			s.hashes.Store(hash)
-		} else {
+		} else if !prevCompactionCompleted {
 			s.lg.Info("previous compaction was interrupted, skip storing compaction hash value")
 		}
 		close(ch)
@@ -259,20 +309,24 @@ func (s *store) compact(trace *traceutil.Trace, rev, prevCompactRev int64, prevC
 	return ch
 }
 
-func (s *store) compactLockfree(rev int64) (<-chan struct{}, error) {
+func (s *store) compactLockfree(rev int64, prefixes []PrefixCompaction) (<-chan struct{}, error) {
 	prevCompactionCompleted := s.checkPrevCompactionCompleted()
-	ch, prevCompactRev, err := s.updateCompactRev(rev)
+	ch, c, err := s.updateCompactRev(rev, prefixes)
 	if err != nil {
 		return ch, err
 	}
 
-	return s.compact(traceutil.TODO(), rev, prevCompactRev, prevCompactionCompleted), nil
+	return s.compact(traceutil.TODO(), c, prevCompactionCompleted), nil
 }
 
 func (s *store) Compact(trace *traceutil.Trace, rev int64) (<-chan struct{}, error) {
+	return s.CompactPrefixes(trace, rev, nil)
+}
+
+func (s *store) CompactPrefixes(trace *traceutil.Trace, rev int64, prefixes []PrefixCompaction) (<-chan struct{}, error) {
 	s.mu.Lock()
 	prevCompactionCompleted := s.checkPrevCompactionCompleted()
-	ch, prevCompactRev, err := s.updateCompactRev(rev)
+	ch, c, err := s.updateCompactRev(rev, prefixes)
 	trace.Step("check and update compact revision")
 	if err != nil {
 		s.mu.Unlock()
@@ -280,7 +334,7 @@ func (s *store) Compact(trace *traceutil.Trace, rev int64) (<-chan struct{}, err
 	}
 	s.mu.Unlock()
 
-	return s.compact(trace, rev, prevCompactRev, prevCompactionCompleted), nil
+	return s.compact(trace, c, prevCompactionCompleted), nil
 }
 
 func (s *store) Commit() {
@@ -304,6 +358,7 @@ func (s *store) Restore(b backend.Backend) error {
 		s.revMu.Lock()
 		s.currentRev = 1
 		s.compactMainRev = -1
+		s.compactPrefixes = nil
 		s.revMu.Unlock()
 	}
 
@@ -331,6 +386,7 @@ func (s *store) restore() error {
 	if found {
 		s.revMu.Lock()
 		s.compactMainRev = finishedCompact
+		s.compactPrefixes = UnsafeReadFinishedCompactPrefixes(tx)
 
 		s.lg.Info(
 			"restored last compact revision",
@@ -340,6 +396,7 @@ func (s *store) restore() error {
 		s.revMu.Unlock()
 	}
 	scheduledCompact, _ := UnsafeReadScheduledCompact(tx)
+	scheduledPrefixes := UnsafeReadScheduledCompactPrefixes(tx)
 	// index keys concurrently as they're loaded in from tx
 	keysGauge.Set(0)
 	rkvc, revc := restoreIntoIndex(s.lg, s.kvindex)
@@ -386,9 +443,8 @@ func (s *store) restore() error {
 		s.revMu.Unlock()
 	}
 
-	if scheduledCompact <= s.compactMainRev {
-		scheduledCompact = 0
-	}
+	resumeCompaction := scheduledCompact != 0 &&
+		(scheduledCompact > s.compactMainRev || !s.compactPrefixes.equal(scheduledPrefixes))
 
 	for key, lid := range keyToLease {
 		if s.le == nil {
@@ -408,8 +464,8 @@ func (s *store) restore() error {
 
 	s.lg.Info("kvstore restored", zap.Int64("current-rev", s.currentRev))
 
-	if scheduledCompact != 0 {
-		if _, err := s.compactLockfree(scheduledCompact); err != nil {
+	if resumeCompaction {
+		if _, err := s.compactLockfree(scheduledCompact, scheduledPrefixes); err != nil {
 			s.lg.Warn("compaction encountered error",
 				zap.Int64("scheduled-compact-revision", scheduledCompact),
 				zap.Error(err),
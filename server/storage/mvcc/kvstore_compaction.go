@@ -15,8 +15,10 @@
 package mvcc
 
 import (
+	"bytes"
 	"encoding/binary"
 	"fmt"
+	"slices"
 	"time"
 
 	humanize "github.com/dustin/go-humanize"
@@ -25,9 +27,156 @@ import (
 	"go.etcd.io/etcd/server/v3/storage/schema"
 )
 
-func (s *store) scheduleCompaction(compactMainRev, prevCompactRev int64) (KeyValueHash, error) {
+// PrefixCompaction compacts the keys under Prefix up to Revision instead of
+// the compaction revision of the store. An empty prefix matches every key.
+type PrefixCompaction struct {
+	Prefix   []byte
+	Revision int64
+}
+
+// prefixCompactions are the prefixes compacted past the compaction revision
+// of the store, sorted by prefix. A key is compacted up to the revision of the
+// longest prefix it matches.
+type prefixCompactions []PrefixCompaction
+
+// keyRev returns the revision key is compacted up to, given the compaction
+// revision rev of the keys not under any prefix.
+func (pcs prefixCompactions) keyRev(key []byte, rev int64) int64 {
+	if i := pcs.longest(key, nil); i != -1 {
+		return pcs[i].Revision
+	}
+	return rev
+}
+
+// rangeRev returns the highest revision a key in the range [key, end) may be
+// compacted up to, given the compaction revision rev of the keys not under
+// any prefix. An empty end means all keys greater than or equal to key.
+func (pcs prefixCompactions) rangeRev(key, end []byte, rev int64) int64 {
+	if len(pcs) == 0 {
+		return rev
+	}
+	var container []byte
+	if i := pcs.longest(key, end); i != -1 {
+		container, rev = pcs[i].Prefix, pcs[i].Revision
+	}
+	for _, pc := range pcs {
+		// prefixes containing the whole range are shadowed by the longest one
+		if container != nil && bytes.HasPrefix(container, pc.Prefix) {
+			continue
+		}
+		if pc.intersects(key, end) {
+			rev = max(rev, pc.Revision)
+		}
+	}
+	return rev
+}
+
+// longest returns the index of the longest prefix containing the whole range
+// [key, end), or -1 if there is none.
+func (pcs prefixCompactions) longest(key, end []byte) int {
+	idx := -1
+	for i, pc := range pcs {
+		if !bytes.HasPrefix(key, pc.Prefix) {
+			continue
+		}
+		if end != nil {
+			pend := prefixEnd(pc.Prefix)
+			if pend != nil && (len(end) == 0 || bytes.Compare(end, pend) > 0) {
+				continue
+			}
+		}
+		if idx == -1 || len(pc.Prefix) > len(pcs[idx].Prefix) {
+			idx = i
+		}
+	}
+	return idx
+}
+
+// maxRev returns the highest revision any key is compacted up to, given the
+// compaction revision rev of the keys not under any prefix.
+func (pcs prefixCompactions) maxRev(rev int64) int64 {
+	for _, pc := range pcs {
+		rev = max(rev, pc.Revision)
+	}
+	return rev
+}
+
+func (pc PrefixCompaction) intersects(key, end []byte) bool {
+	if end == nil {
+		return bytes.HasPrefix(key, pc.Prefix)
+	}
+	if len(end) != 0 && bytes.Compare(pc.Prefix, end) >= 0 {
+		return false
+	}
+	pend := prefixEnd(pc.Prefix)
+	return pend == nil || bytes.Compare(key, pend) < 0
+}
+
+// prefixEnd returns the end of the range of keys under prefix, or nil if the
+// range has no end.
+func prefixEnd(prefix []byte) []byte {
+	for i := len(prefix) - 1; i >= 0; i-- {
+		if prefix[i] < 0xff {
+			end := slices.Clone(prefix[:i+1])
+			end[i]++
+			return end
+		}
+	}
+	return nil
+}
+
+// mergePrefixCompactions returns the compaction revision and prefixes of the
+// store after a compaction of the keys not under any of the requested prefixes
+// up to reqRev and the keys under them up to the revision of the longest one
+// they match. Keys never move back to a lower compaction revision, and the
+// prefixes not requested follow the requested compaction of their keys.
+func mergePrefixCompactions(rev int64, pcs prefixCompactions, reqRev int64, req []PrefixCompaction) (int64, prefixCompactions) {
+	reqs := prefixCompactions(req)
+	newRev := max(rev, reqRev)
+	var merged prefixCompactions
+	for _, pc := range append(slices.Clone(pcs), reqs...) {
+		if slices.ContainsFunc(merged, func(m PrefixCompaction) bool { return bytes.Equal(m.Prefix, pc.Prefix) }) {
+			continue
+		}
+		merged = append(merged, PrefixCompaction{
+			Prefix:   pc.Prefix,
+			Revision: max(newRev, pcs.keyRev(pc.Prefix, rev), reqs.keyRev(pc.Prefix, reqRev)),
+		})
+	}
+	slices.SortFunc(merged, func(a, b PrefixCompaction) int { return bytes.Compare(a.Prefix, b.Prefix) })
+	// drop the prefixes compacted like the keys around them
+	var ret prefixCompactions
+	for i, pc := range merged {
+		parentRev := newRev
+		if j := merged[:i].longest(pc.Prefix, nil); j != -1 {
+			parentRev = merged[j].Revision
+		}
+		if pc.Revision != parentRev {
+			ret = append(ret, pc)
+		}
+	}
+	return newRev, ret
+}
+
+func (pcs prefixCompactions) equal(other prefixCompactions) bool {
+	return slices.EqualFunc(pcs, other, func(a, b PrefixCompaction) bool {
+		return bytes.Equal(a.Prefix, b.Prefix) && a.Revision == b.Revision
+	})
+}
+
+func (s *store) scheduleCompaction(c compaction) (KeyValueHash, error) {
+	compactMainRev, prefixes := c.rev, c.prefixes
 	totalStart := time.Now()
-	keep := s.kvindex.Compact(compactMainRev)
+	var keep, drop, hashKeep map[Revision]struct{}
+	if len(prefixes) == 0 {
+		keep = s.kvindex.Compact(compactMainRev)
+		hashKeep = keep
+	} else {
+		// hash the revisions a member yet to run this compaction keeps in
+		// hashByRev, so that the hashes of all members can be compared
+		hashKeep = s.kvindex.Keep(c.hashRev())
+		keep, drop = s.kvindex.CompactPrefixes(compactMainRev, prefixes)
+	}
 	indexCompactionPauseMs.Observe(float64(time.Since(totalStart) / time.Millisecond))
 
 	totalStart = time.Now()
@@ -36,11 +185,12 @@ func (s *store) scheduleCompaction(compactMainRev, prevCompactRev int64) (KeyVal
 	defer func() { dbCompactionKeysCounter.Add(float64(keyCompactions)) }()
 	defer func() { dbCompactionLast.Set(float64(time.Now().Unix())) }()
 
+	// revisions past compactMainRev are only compacted for keys under prefixes
 	end := make([]byte, 8)
-	binary.BigEndian.PutUint64(end, uint64(compactMainRev+1))
+	binary.BigEndian.PutUint64(end, uint64(c.hashRev()+1))
 
 	batchNum := s.cfg.CompactionBatchLimit
-	h := newKVHasher(prevCompactRev, compactMainRev, keep)
+	h := newKVHasher(c.prevHashRev(), c.hashRev(), hashKeep)
 	last := make([]byte, 8+1+8)
 	for {
 		var rev Revision
@@ -53,7 +203,9 @@ func (s *store) scheduleCompaction(compactMainRev, prevCompactRev int64) (KeyVal
 		keys, values := tx.UnsafeRange(schema.Key, last, end, int64(batchNum))
 		for i := range keys {
 			rev = BytesToRev(keys[i])
-			if _, ok := keep[rev]; !ok {
+			_, kept := keep[rev]
+			_, dropped := drop[rev]
+			if (rev.Main <= compactMainRev && !kept) || dropped {
 				tx.UnsafeDelete(schema.Key, keys[i])
 				keyCompactions++
 			}
@@ -63,6 +215,9 @@ func (s *store) scheduleCompaction(compactMainRev, prevCompactRev int64) (KeyVal
 		if len(keys) < batchNum {
 			// gofail: var compactBeforeSetFinishedCompact struct{}
 			UnsafeSetFinishedCompact(tx, compactMainRev)
+			if len(prefixes) != 0 || len(c.prevPrefixes) != 0 {
+				UnsafeSetFinishedCompactPrefixes(tx, prefixes)
+			}
 			tx.Unlock()
 			dbCompactionPauseMs.Observe(float64(time.Since(start) / time.Millisecond))
 			// gofail: var compactAfterSetFinishedCompact struct{}
@@ -71,6 +226,7 @@ func (s *store) scheduleCompaction(compactMainRev, prevCompactRev int64) (KeyVal
 			s.lg.Info(
 				"finished scheduled compaction",
 				zap.Int64("compact-revision", compactMainRev),
+				zap.Int("compact-prefixes", len(prefixes)),
 				zap.Duration("took", time.Since(totalStart)),
 				zap.Int("number-of-keys-compacted", keyCompactions),
 				zap.Uint32("hash", hash.Hash),
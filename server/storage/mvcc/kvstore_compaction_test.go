@@ -15,6 +15,7 @@
 package mvcc
 
 import (
+	"errors"
 	"reflect"
 	"testing"
 	"time"
@@ -82,7 +83,7 @@ func TestScheduleCompaction(t *testing.T) {
 		}
 		tx.Unlock()
 
-		_, err := s.scheduleCompaction(tt.rev, 0)
+		_, err := s.scheduleCompaction(compaction{rev: tt.rev})
 		if err != nil {
 			t.Error(err)
 		}
@@ -147,3 +148,203 @@ func TestCompactAllAndRestore(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestCompactPrefixesAndRestore(t *testing.T) {
+	b, _ := betesting.NewDefaultTmpBackend(t)
+	s0 := NewStore(zaptest.NewLogger(t), b, &lease.FakeLessor{}, StoreConfig{})
+	defer b.Close()
+
+	s0.Put([]byte("a/x"), []byte("v1"), lease.NoLease)
+	s0.Put([]byte("b"), []byte("v1"), lease.NoLease)
+	s0.Put([]byte("a/x"), []byte("v2"), lease.NoLease)
+	s0.Put([]byte("b"), []byte("v2"), lease.NoLease)
+
+	// keep the history of the keys under a/ since revision 2, and of all
+	// other keys since revision 4
+	done, err := s0.CompactPrefixes(traceutil.TODO(), 2, []PrefixCompaction{
+		{Prefix: []byte(""), Revision: 4},
+		{Prefix: []byte("a/"), Revision: 2},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case <-done:
+	case <-time.After(10 * time.Second):
+		t.Fatal("timeout waiting for compaction to finish")
+	}
+
+	check := func(s *store) {
+		tests := []struct {
+			key, end []byte
+			rev      int64
+			wvalue   string
+			werr     error
+		}{
+			{key: []byte("a/x"), rev: 2, wvalue: "v1"},
+			{key: []byte("a/x"), rev: 1, werr: ErrCompacted},
+			{key: []byte("b"), rev: 4, wvalue: "v1"},
+			{key: []byte("b"), rev: 3, werr: ErrCompacted},
+			{key: []byte("a/"), end: []byte("a0"), rev: 2, wvalue: "v1"},
+			{key: []byte(""), end: []byte{}, rev: 3, werr: ErrCompacted},
+		}
+		for i, tt := range tests {
+			r, err := s.Range(t.Context(), tt.key, tt.end, RangeOptions{Rev: tt.rev})
+			if !errors.Is(err, tt.werr) {
+				t.Fatalf("#%d: err = %v, want %v", i, err, tt.werr)
+			}
+			if err != nil {
+				continue
+			}
+			if len(r.KVs) != 1 || string(r.KVs[0].Value) != tt.wvalue {
+				t.Errorf("#%d: kvs = %+v, want value %q", i, r.KVs, tt.wvalue)
+			}
+		}
+	}
+	check(s0)
+	if err = s0.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	s1 := NewStore(zaptest.NewLogger(t), b, &lease.FakeLessor{}, StoreConfig{})
+	check(s1)
+	rev, prefixes := s1.CompactRevisions()
+	if rev != 2 || !reflect.DeepEqual(prefixes, []PrefixCompaction{{Prefix: []byte(""), Revision: 4}, {Prefix: []byte("a/"), Revision: 2}}) {
+		t.Errorf("compact revisions = %d, %+v", rev, prefixes)
+	}
+
+	// compact the keys under a/ past all other keys
+	s1.Put([]byte("a/x"), []byte("v3"), lease.NoLease)
+	done, err = s1.CompactPrefixes(traceutil.TODO(), 2, []PrefixCompaction{
+		{Prefix: []byte(""), Revision: 4},
+		{Prefix: []byte("a/"), Revision: 6},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	<-done
+	if _, err = s1.Range(t.Context(), []byte("a/x"), nil, RangeOptions{Rev: 5}); !errors.Is(err, ErrCompacted) {
+		t.Errorf("err = %v, want %v", err, ErrCompacted)
+	}
+	r, err := s1.Range(t.Context(), []byte("b"), nil, RangeOptions{Rev: 4})
+	if err != nil || len(r.KVs) != 1 {
+		t.Errorf("kvs = %+v, err = %v, want one kv", r, err)
+	}
+	// the revisions of a/x before revision 6 are removed from the backend
+	tx := b.ReadTx()
+	tx.RLock()
+	keys, _ := tx.UnsafeRange(schema.Key, newTestRevBytes(Revision{Main: 1}), newTestRevBytes(Revision{Main: 6}), 0)
+	tx.RUnlock()
+	if len(keys) != 2 {
+		t.Errorf("len(keys) = %d, want 2", len(keys))
+	}
+	if err = s1.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCompactPrefixesHash(t *testing.T) {
+	b, _ := betesting.NewDefaultTmpBackend(t)
+	s := NewStore(zaptest.NewLogger(t), b, &lease.FakeLessor{}, StoreConfig{})
+	defer cleanup(s, b)
+
+	compact := func(rev int64) {
+		t.Helper()
+		// the keys under a/ keep their whole history, which holds the
+		// revision compacted for all prefixes at 0
+		done, err := s.CompactPrefixes(traceutil.TODO(), 0, []PrefixCompaction{
+			{Prefix: []byte(""), Revision: rev},
+			{Prefix: []byte("a/"), Revision: 0},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		<-done
+	}
+	for _, rev := range []int64{4, 7} {
+		s.Put([]byte("a/x"), []byte("v1"), lease.NoLease)
+		s.Put([]byte("b"), []byte("v1"), lease.NoLease)
+		s.Put([]byte("b"), []byte("v2"), lease.NoLease)
+		// a member that has not compacted yet hashes the store at the
+		// highest compacted revision
+		want, _, err := s.hashByRev(rev)
+		if err != nil {
+			t.Fatal(err)
+		}
+		compact(rev)
+		hashes := s.HashStorage().Hashes()
+		if len(hashes) == 0 {
+			t.Fatalf("no hash stored after compacting %d", rev)
+		}
+		if got := hashes[len(hashes)-1]; got != want {
+			t.Errorf("hash after compacting %d = %+v, want %+v", rev, got, want)
+		}
+	}
+}
+
+func TestMergePrefixCompactions(t *testing.T) {
+	pc := func(prefix string, rev int64) PrefixCompaction {
+		return PrefixCompaction{Prefix: []byte(prefix), Revision: rev}
+	}
+	tests := []struct {
+		name   string
+		rev    int64
+		pcs    prefixCompactions
+		reqRev int64
+		req    []PrefixCompaction
+
+		wrev int64
+		wpcs prefixCompactions
+	}{
+		{
+			name:   "no prefixes",
+			rev:    2,
+			reqRev: 5,
+			wrev:   5,
+		},
+		{
+			name:   "prefixes ahead of the store",
+			rev:    2,
+			reqRev: 3,
+			req:    []PrefixCompaction{pc("", 6), pc("a/", 3)},
+			wrev:   3,
+			wpcs:   prefixCompactions{pc("", 6), pc("a/", 3)},
+		},
+		{
+			name:   "keys never move back",
+			rev:    3,
+			pcs:    prefixCompactions{pc("", 6), pc("a/", 3)},
+			reqRev: 4,
+			req:    []PrefixCompaction{pc("", 5), pc("a/", 4)},
+			wrev:   4,
+			wpcs:   prefixCompactions{pc("", 6), pc("a/", 4)},
+		},
+		{
+			name:   "prefixes not requested follow their keys",
+			rev:    3,
+			pcs:    prefixCompactions{pc("", 6), pc("a/", 3), pc("a/b/", 8)},
+			reqRev: 4,
+			req:    []PrefixCompaction{pc("", 7), pc("a/", 4)},
+			wrev:   4,
+			wpcs:   prefixCompactions{pc("", 7), pc("a/", 4), pc("a/b/", 8)},
+		},
+		{
+			name:   "prefixes caught up with their parent are dropped",
+			rev:    3,
+			pcs:    prefixCompactions{pc("", 6), pc("a/", 3)},
+			reqRev: 6,
+			wrev:   6,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rev, pcs := mergePrefixCompactions(tt.rev, tt.pcs, tt.reqRev, tt.req)
+			if rev != tt.wrev {
+				t.Errorf("rev = %d, want %d", rev, tt.wrev)
+			}
+			if !pcs.equal(tt.wpcs) {
+				t.Errorf("prefixes = %+v, want %+v", pcs, tt.wpcs)
+			}
+		})
+	}
+}
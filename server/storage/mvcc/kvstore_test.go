@@ -342,6 +342,8 @@ func TestStoreCompact(t *testing.T) {
 	key2 := newTestRevBytes(Revision{Main: 2})
 	b.tx.rangeRespc <- rangeResp{[][]byte{}, [][]byte{}}
 	b.tx.rangeRespc <- rangeResp{[][]byte{}, [][]byte{}}
+	b.tx.rangeRespc <- rangeResp{[][]byte{}, [][]byte{}}
+	b.tx.rangeRespc <- rangeResp{[][]byte{}, [][]byte{}}
 	b.tx.rangeRespc <- rangeResp{[][]byte{key1, key2}, [][]byte{[]byte("alice"), []byte("bob")}}
 
 	s.Compact(traceutil.TODO(), 3)
@@ -355,6 +357,8 @@ func TestStoreCompact(t *testing.T) {
 	wact := []testutil.Action{
 		{Name: "range", Params: []any{schema.Meta, schema.ScheduledCompactKeyName, []uint8(nil), int64(0)}},
 		{Name: "range", Params: []any{schema.Meta, schema.FinishedCompactKeyName, []uint8(nil), int64(0)}},
+		{Name: "range", Params: []any{schema.Meta, schema.ScheduledCompactPrefixesKeyName, []uint8(nil), int64(0)}},
+		{Name: "range", Params: []any{schema.Meta, schema.FinishedCompactPrefixesKeyName, []uint8(nil), int64(0)}},
 		{Name: "put", Params: []any{schema.Meta, schema.ScheduledCompactKeyName, newTestRevBytes(Revision{Main: 3})}},
 		{Name: "range", Params: []any{schema.Key, make([]byte, 17), end, int64(10000)}},
 		{Name: "delete", Params: []any{schema.Key, key2}},
@@ -399,7 +403,9 @@ func TestStoreRestore(t *testing.T) {
 		t.Fatal(err)
 	}
 	b.tx.rangeRespc <- rangeResp{[][]byte{schema.FinishedCompactKeyName}, [][]byte{newTestRevBytes(Revision{Main: 3})}}
+	b.tx.rangeRespc <- rangeResp{nil, nil}
 	b.tx.rangeRespc <- rangeResp{[][]byte{schema.ScheduledCompactKeyName}, [][]byte{newTestRevBytes(Revision{Main: 3})}}
+	b.tx.rangeRespc <- rangeResp{nil, nil}
 
 	b.tx.rangeRespc <- rangeResp{[][]byte{putkey, delkey}, [][]byte{putkvb, delkvb}}
 	b.tx.rangeRespc <- rangeResp{nil, nil}
@@ -414,7 +420,9 @@ func TestStoreRestore(t *testing.T) {
 	}
 	wact := []testutil.Action{
 		{Name: "range", Params: []any{schema.Meta, schema.FinishedCompactKeyName, []byte(nil), int64(0)}},
+		{Name: "range", Params: []any{schema.Meta, schema.FinishedCompactPrefixesKeyName, []byte(nil), int64(0)}},
 		{Name: "range", Params: []any{schema.Meta, schema.ScheduledCompactKeyName, []byte(nil), int64(0)}},
+		{Name: "range", Params: []any{schema.Meta, schema.ScheduledCompactPrefixesKeyName, []byte(nil), int64(0)}},
 		{Name: "range", Params: []any{schema.Key, newTestRevBytes(Revision{Main: 1}), newTestRevBytes(Revision{Main: math.MaxInt64, Sub: math.MaxInt64}), int64(restoreChunkKeys)}},
 	}
 	if g := b.tx.Action(); !reflect.DeepEqual(g, wact) {
@@ -916,7 +924,7 @@ func newTestBucketKeyBytes(rev BucketKey) []byte {
 func newFakeStore(lg *zap.Logger) *store {
 	b := &fakeBackend{&fakeBatchTx{
 		Recorder:   &testutil.RecorderBuffered{},
-		rangeRespc: make(chan rangeResp, 5),
+		rangeRespc: make(chan rangeResp, 6),
 	}}
 	s := &store{
 		cfg: StoreConfig{
@@ -1058,6 +1066,12 @@ func (i *fakeIndex) Range(key, end []byte, atRev int64, limit int, withTotalCoun
 	return r.keys, r.revs, r.creates, r.versions, r.total
 }
 
+func (i *fakeIndex) History(key []byte, minRev, maxRev int64) []Revision {
+	i.Recorder.Record(testutil.Action{Name: "history", Params: []any{key, minRev, maxRev}})
+	r := <-i.indexRangeEventsRespc
+	return r.revs
+}
+
 func (i *fakeIndex) Put(key []byte, rev Revision) {
 	i.Recorder.Record(testutil.Action{Name: "put", Params: []any{key, rev}})
 }
@@ -1078,6 +1092,11 @@ func (i *fakeIndex) Compact(rev int64) map[Revision]struct{} {
 	return <-i.indexCompactRespc
 }
 
+func (i *fakeIndex) CompactPrefixes(rev int64, prefixes prefixCompactions) (map[Revision]struct{}, map[Revision]struct{}) {
+	i.Recorder.Record(testutil.Action{Name: "compactPrefixes", Params: []any{rev, prefixes}})
+	return <-i.indexCompactRespc, nil
+}
+
 func (i *fakeIndex) Keep(rev int64) map[Revision]struct{} {
 	i.Recorder.Record(testutil.Action{Name: "keep", Params: []any{rev}})
 	return <-i.indexCompactRespc
@@ -17,6 +17,7 @@ package mvcc
 import (
 	"context"
 	"fmt"
+	"slices"
 
 	"go.uber.org/zap"
 	"google.golang.org/protobuf/proto"
@@ -66,10 +67,81 @@ func (s *store) Read(mode ReadTxMode, trace *traceutil.Trace) TxnRead {
 func (tr *storeTxnCommon) FirstRev() int64 { return tr.firstRev }
 func (tr *storeTxnCommon) Rev() int64      { return tr.rev }
 
+func (tr *storeTxnCommon) CompactRev(key, end []byte) int64 { return tr.s.compactRev(key, end) }
+
 func (tr *storeTxnCommon) Range(ctx context.Context, key, end []byte, ro RangeOptions) (r *RangeResult, err error) {
 	return tr.rangeKeys(ctx, key, end, tr.Rev(), ro)
 }
 
+func (tr *storeTxnCommon) History(ctx context.Context, key []byte, ho HistoryOptions) (r *RangeResult, err error) {
+	return tr.history(ctx, key, tr.Rev(), ho)
+}
+
+func (tr *storeTxnCommon) history(ctx context.Context, key []byte, curRev int64, ho HistoryOptions) (*RangeResult, error) {
+	rev := ho.Rev
+	if rev > curRev {
+		return &RangeResult{KVs: nil, Count: -1, Rev: curRev}, ErrFutureRev
+	}
+	if rev <= 0 {
+		rev = curRev
+	}
+	if rev < tr.s.compactRev(key, nil) {
+		return &RangeResult{KVs: nil, Count: -1, Rev: 0}, ErrCompacted
+	}
+	maxRev := rev
+	if ho.MaxRev > 0 {
+		maxRev = min(maxRev, ho.MaxRev)
+	}
+	revs := tr.s.kvindex.History(key, ho.MinRev, maxRev)
+	tr.trace.Step("history from in-memory index tree")
+	total := len(revs)
+	if ho.Descending {
+		slices.Reverse(revs)
+	}
+	if ho.Limit > 0 && int(ho.Limit) < len(revs) {
+		revs = revs[:ho.Limit]
+	}
+
+	kvs := make([]*mvccpb.KeyValue, len(revs))
+	start, end := NewRevBytes(), NewRevBytes()
+	for i, r := range revs {
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("history: context cancelled: %w", ctx.Err())
+		default:
+		}
+
+		// the range also matches the revision if it is a tombstone
+		start = RevToBytes(r, start)
+		end = RevToBytes(Revision{Main: r.Main, Sub: r.Sub + 1}, end)
+		ks, vs := tr.tx.UnsafeRange(schema.Key, start, end, 0)
+		if len(vs) != 1 {
+			tr.s.lg.Fatal(
+				"history failed to find revision pair",
+				zap.Int64("revision-main", r.Main),
+				zap.Int64("revision-sub", r.Sub),
+				zap.Int64("revision-current", curRev),
+				zap.Binary("key", key),
+				zap.Int("len-values", len(vs)),
+			)
+		}
+		kv := &mvccpb.KeyValue{}
+		if err := proto.Unmarshal(vs[0], kv); err != nil {
+			tr.s.lg.Fatal(
+				"failed to unmarshal mvccpb.KeyValue",
+				zap.Error(err),
+			)
+		}
+		if isTombstone(ks[0]) {
+			// a tombstone only stores the key
+			kv.ModRevision = r.Main
+		}
+		kvs[i] = kv
+	}
+	tr.trace.Step("history from bolt db")
+	return &RangeResult{KVs: kvs, Count: total, Rev: curRev}, nil
+}
+
 func (tr *storeTxnCommon) rangeKeys(ctx context.Context, key, end []byte, curRev int64, ro RangeOptions) (*RangeResult, error) {
 	rev := ro.Rev
 	if rev > curRev {
@@ -78,7 +150,7 @@ func (tr *storeTxnCommon) rangeKeys(ctx context.Context, key, end []byte, curRev
 	if rev <= 0 {
 		rev = curRev
 	}
-	if rev < tr.s.compactMainRev {
+	if rev < tr.s.compactRev(key, end) {
 		return &RangeResult{KVs: nil, Count: -1, Rev: 0}, ErrCompacted
 	}
 	if ro.CountOnly {
@@ -194,6 +266,14 @@ func (tw *storeTxnWrite) Range(ctx context.Context, key, end []byte, ro RangeOpt
 	return tw.rangeKeys(ctx, key, end, rev, ro)
 }
 
+func (tw *storeTxnWrite) History(ctx context.Context, key []byte, ho HistoryOptions) (r *RangeResult, err error) {
+	rev := tw.beginRev
+	if len(tw.changes) > 0 {
+		rev++
+	}
+	return tw.history(ctx, key, rev, ho)
+}
+
 func (tw *storeTxnWrite) DeleteRange(key, end []byte) (int64, int64) {
 	if n := tw.deleteRange(key, end); n != 0 || len(tw.changes) > 0 {
 		return n, tw.beginRev + 1
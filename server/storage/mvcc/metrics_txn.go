@@ -41,6 +41,11 @@ func (tw *metricsTxnWrite) Range(ctx context.Context, key, end []byte, ro RangeO
 	return tw.TxnWrite.Range(ctx, key, end, ro)
 }
 
+func (tw *metricsTxnWrite) History(ctx context.Context, key []byte, ho HistoryOptions) (*RangeResult, error) {
+	tw.ranges++
+	return tw.TxnWrite.History(ctx, key, ho)
+}
+
 func (tw *metricsTxnWrite) DeleteRange(key, end []byte) (n, rev int64) {
 	tw.deletes++
 	return tw.TxnWrite.DeleteRange(key, end)
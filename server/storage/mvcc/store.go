@@ -15,6 +15,8 @@
 package mvcc
 
 import (
+	"encoding/binary"
+
 	"go.etcd.io/etcd/server/v3/storage/backend"
 	"go.etcd.io/etcd/server/v3/storage/schema"
 )
@@ -58,3 +60,58 @@ func UnsafeSetFinishedCompact(tx backend.UnsafeWriter, value int64) {
 	rbytes = RevToBytes(Revision{Main: value}, rbytes)
 	tx.UnsafePut(schema.Meta, schema.FinishedCompactKeyName, rbytes)
 }
+
+// UnsafeReadScheduledCompactPrefixes returns the prefixes scheduled to be
+// compacted past the scheduled compaction revision.
+func UnsafeReadScheduledCompactPrefixes(tx backend.UnsafeReader) []PrefixCompaction {
+	return unsafeReadCompactPrefixes(tx, schema.ScheduledCompactPrefixesKeyName)
+}
+
+// UnsafeReadFinishedCompactPrefixes returns the prefixes compacted past the
+// finished compaction revision.
+func UnsafeReadFinishedCompactPrefixes(tx backend.UnsafeReader) []PrefixCompaction {
+	return unsafeReadCompactPrefixes(tx, schema.FinishedCompactPrefixesKeyName)
+}
+
+func UnsafeSetScheduledCompactPrefixes(tx backend.UnsafeReadWriter, prefixes []PrefixCompaction) {
+	unsafeSetCompactPrefixes(tx, schema.ScheduledCompactPrefixesKeyName, prefixes)
+}
+
+func UnsafeSetFinishedCompactPrefixes(tx backend.UnsafeReadWriter, prefixes []PrefixCompaction) {
+	unsafeSetCompactPrefixes(tx, schema.FinishedCompactPrefixesKeyName, prefixes)
+}
+
+func unsafeReadCompactPrefixes(tx backend.UnsafeReader, key []byte) []PrefixCompaction {
+	_, vs := tx.UnsafeRange(schema.Meta, key, nil, 0)
+	if len(vs) == 0 {
+		return nil
+	}
+	var prefixes []PrefixCompaction
+	for v := vs[0]; len(v) != 0; {
+		rev := int64(binary.BigEndian.Uint64(v))
+		n, l := binary.Uvarint(v[8:])
+		prefix := v[8+l : 8+l+int(n)]
+		prefixes = append(prefixes, PrefixCompaction{Prefix: prefix, Revision: rev})
+		v = v[8+l+int(n):]
+	}
+	return prefixes
+}
+
+// unsafeSetCompactPrefixes stores the prefixes as a sequence of revisions
+// followed by the length-prefixed prefix. The key is left out when there are
+// no prefixes, so stores never compacting prefixes stay unchanged.
+func unsafeSetCompactPrefixes(tx backend.UnsafeReadWriter, key []byte, prefixes []PrefixCompaction) {
+	if len(prefixes) == 0 {
+		if ks, _ := tx.UnsafeRange(schema.Meta, key, nil, 0); len(ks) != 0 {
+			tx.UnsafeDelete(schema.Meta, key)
+		}
+		return
+	}
+	var v []byte
+	for _, pc := range prefixes {
+		v = binary.BigEndian.AppendUint64(v, uint64(pc.Revision))
+		v = binary.AppendUvarint(v, uint64(len(pc.Prefix)))
+		v = append(v, pc.Prefix...)
+	}
+	tx.UnsafePut(schema.Meta, key, v)
+}
@@ -15,6 +15,9 @@
 package mvcc
 
 import (
+	"cmp"
+	"slices"
+	"strings"
 	"sync"
 	"time"
 
@@ -47,7 +50,7 @@ var (
 func ChanBufLen() int { return chanBufLen }
 
 type watchable interface {
-	watch(key, end []byte, startRev int64, id WatchID, ch chan<- WatchResponse, fcs ...FilterFunc) (*watcher, cancelFunc)
+	watch(ranges []KeyRange, startRev int64, id WatchID, ch chan<- WatchResponse, opts WatchOptions) (*watcher, cancelFunc)
 	progress(w *watcher)
 	progressAll(watchers map[WatchID]*watcher) bool
 	rev() int64
@@ -125,15 +128,17 @@ func (s *watchableStore) NewWatchStream() WatchStream {
 	}
 }
 
-func (s *watchableStore) watch(key, end []byte, startRev int64, id WatchID, ch chan<- WatchResponse, fcs ...FilterFunc) (*watcher, cancelFunc) {
+func (s *watchableStore) watch(ranges []KeyRange, startRev int64, id WatchID, ch chan<- WatchResponse, opts WatchOptions) (*watcher, cancelFunc) {
 	wa := &watcher{
-		key:      key,
-		end:      end,
-		startRev: startRev,
-		minRev:   startRev,
-		id:       id,
-		ch:       ch,
-		fcs:      fcs,
+		ranges:     normalizeRanges(ranges),
+		startRev:   startRev,
+		minRev:     startRev,
+		id:         id,
+		ch:         ch,
+		fcs:        opts.Filters,
+		coalesce:   opts.Coalesce,
+		user:       opts.User,
+		remoteAddr: opts.RemoteAddr,
 	}
 
 	s.mu.Lock()
@@ -295,14 +300,15 @@ func (s *watchableStore) moveVictims() (moved int) {
 		for w, eb := range wb {
 			// watcher has observed the store up to, but not including, w.minRev
 			rev := w.minRev - 1
-			if !w.send(WatchResponse{WatchID: w.id, Events: eb.evs, Revision: rev}) {
+			evs, coalesced := w.catchUpEvents(eb.evs)
+			if !w.send(WatchResponse{WatchID: w.id, Events: evs, Revision: rev, Coalesced: coalesced, Committed: eb.committed}) {
 				if newVictim == nil {
 					newVictim = make(watcherBatch)
 				}
 				newVictim[w] = eb
 				continue
 			}
-			pendingEventsGauge.Add(float64(len(eb.evs)))
+			pendingEventsGauge.Add(float64(len(evs)))
 			moved++
 		}
 
@@ -315,7 +321,7 @@ func (s *watchableStore) moveVictims() (moved int) {
 				// couldn't send watch response; stays victim
 				continue
 			}
-			w.victim = false
+			w.clearVictim()
 			if eb.moreRev != 0 {
 				w.minRev = eb.moreRev
 			}
@@ -360,7 +366,13 @@ func (s *watchableStore) syncWatchers() int {
 	// find min revision index, and these revisions can be used to
 	// query the backend store of key-value pairs
 	curRev := s.store.currentRev
-	compactionRev := s.store.compactMainRev
+	compactionRev := func(w *watcher) int64 {
+		var rev int64
+		for _, r := range w.ranges {
+			rev = max(rev, s.store.compactRev(r.Key, r.End))
+		}
+		return rev
+	}
 
 	wg, minRev := s.unsynced.choose(maxWatchersPerSync, curRev, compactionRev)
 	evs := rangeEvents(s.store.lg, s.store.b, minRev, curRev+1, wg)
@@ -368,7 +380,7 @@ func (s *watchableStore) syncWatchers() int {
 	victims := make(watcherBatch)
 	wb := newWatcherBatch(wg, evs)
 	for w := range wg.watchers {
-		if w.minRev < compactionRev {
+		if w.minRev < compactionRev(w) {
 			// Skip the watcher that failed to send compacted watch response due to w.ch is full.
 			// Next retry of syncWatchers would try to resend the compacted watch response to w.ch
 			continue
@@ -387,10 +399,11 @@ func (s *watchableStore) syncWatchers() int {
 			w.minRev = eb.moreRev
 		}
 
-		if w.send(WatchResponse{WatchID: w.id, Events: eb.evs, Revision: curRev}) {
-			pendingEventsGauge.Add(float64(len(eb.evs)))
+		evs, coalesced := w.catchUpEvents(eb.evs)
+		if w.send(WatchResponse{WatchID: w.id, Events: evs, Revision: curRev, Coalesced: coalesced}) {
+			pendingEventsGauge.Add(float64(len(evs)))
 		} else {
-			w.victim = true
+			w.setVictim()
 		}
 
 		if w.victim {
@@ -468,6 +481,7 @@ func kvsToEvents(lg *zap.Logger, c contains, revs, vals [][]byte) (evs []*mvccpb
 // notify notifies the fact that given event at the given rev just happened to
 // watchers that watch on the key of the event.
 func (s *watchableStore) notify(rev int64, evs []*mvccpb.Event) {
+	now := time.Now()
 	victim := make(watcherBatch)
 	for w, eb := range newWatcherBatch(&s.synced, evs) {
 		if eb.revs != 1 {
@@ -476,11 +490,12 @@ func (s *watchableStore) notify(rev int64, evs []*mvccpb.Event) {
 				zap.Int("number-of-revisions", eb.revs),
 			)
 		}
-		if w.send(WatchResponse{WatchID: w.id, Events: eb.evs, Revision: rev}) {
+		if w.send(WatchResponse{WatchID: w.id, Events: eb.evs, Revision: rev, Committed: now}) {
 			pendingEventsGauge.Add(float64(len(eb.evs)))
 		} else {
 			// move slow watcher to victims
-			w.victim = true
+			w.setVictim()
+			eb.committed = now
 			victim[w] = eb
 			s.synced.delete(w)
 			slowWatcherGauge.Inc()
@@ -504,6 +519,53 @@ func (s *watchableStore) addVictim(victim watcherBatch) {
 	}
 }
 
+func (s *watchableStore) Watchers() []WatcherInfo {
+	curRev := s.rev()
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var ret []WatcherInfo
+	add := func(w *watcher, synced bool, pending int) {
+		rev := w.minRev - 1
+		if synced {
+			// synced watchers are only moved along by events on their keys
+			rev = max(rev, curRev)
+		}
+		ret = append(ret, WatcherInfo{
+			ID:            w.id,
+			Ranges:        w.ranges,
+			StartRev:      w.startRev,
+			Rev:           rev,
+			Synced:        synced,
+			PendingEvents: pending,
+			Victim:        w.victim,
+			VictimTime:    w.victimDuration(),
+			User:          w.user,
+			RemoteAddr:    w.remoteAddr,
+		})
+	}
+	for w := range s.synced.watchers {
+		add(w, true, 0)
+	}
+	for w := range s.unsynced.watchers {
+		add(w, false, 0)
+	}
+	// victims being retried by moveVictims are left out until they are
+	// back in a group or a victim batch
+	for _, wb := range s.victims {
+		for w, eb := range wb {
+			add(w, false, len(eb.evs))
+		}
+	}
+	slices.SortFunc(ret, func(a, b WatcherInfo) int {
+		if c := strings.Compare(a.RemoteAddr, b.RemoteAddr); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.ID, b.ID)
+	})
+	return ret
+}
+
 func (s *watchableStore) rev() int64 { return s.store.Rev() }
 
 func (s *watchableStore) progress(w *watcher) {
@@ -543,14 +605,16 @@ func (s *watchableStore) progressIfSync(watchers map[WatchID]*watcher, responseW
 }
 
 type watcher struct {
-	// the watcher key
-	key []byte
-	// end indicates the end of the range to watch.
-	// If end is set, the watcher is on a range.
-	end []byte
+	// ranges are the keys and ranges of keys the watcher watches. They are
+	// sorted and do not overlap.
+	ranges []KeyRange
 
 	// victim is set when ch is blocked and undergoing victim processing
 	victim bool
+	// victimSince is when the watcher last became a victim, and victimTime
+	// the time it spent as victim before
+	victimSince time.Time
+	victimTime  time.Duration
 
 	// compacted is set when the watcher is removed because of compaction
 	compacted bool
@@ -569,9 +633,62 @@ type watcher struct {
 	id     WatchID
 
 	fcs []FilterFunc
+	// coalesce is set when the watcher only needs the latest event of each
+	// key it catches up on
+	coalesce bool
 	// a chan to send out the watch response.
 	// The chan might be shared with other watchers.
 	ch chan<- WatchResponse
+
+	// user and remoteAddr describe the client of the watcher
+	user       string
+	remoteAddr string
+}
+
+func (w *watcher) setVictim() {
+	w.victim = true
+	w.victimSince = time.Now()
+}
+
+func (w *watcher) clearVictim() {
+	w.victim = false
+	w.victimTime += time.Since(w.victimSince)
+}
+
+// victimDuration returns the total time the watcher spent as victim.
+func (w *watcher) victimDuration() time.Duration {
+	if w.victim {
+		return w.victimTime + time.Since(w.victimSince)
+	}
+	return w.victimTime
+}
+
+// catchUpEvents returns the events of evs a watcher catching up on them
+// sends, and whether events were left out of them by coalescing.
+func (w *watcher) catchUpEvents(evs []*mvccpb.Event) ([]*mvccpb.Event, bool) {
+	if !w.coalesce {
+		return evs, false
+	}
+	return CoalesceEvents(evs)
+}
+
+// CoalesceEvents returns the latest event of each key of evs, in the order of
+// evs, and whether any event was left out.
+func CoalesceEvents(evs []*mvccpb.Event) ([]*mvccpb.Event, bool) {
+	last := make(map[string]int, len(evs))
+	for i, ev := range evs {
+		last[string(ev.Kv.Key)] = i
+	}
+	if len(last) == len(evs) {
+		return evs, false
+	}
+	latest := make([]*mvccpb.Event, 0, len(last))
+	for i, ev := range evs {
+		if last[string(ev.Kv.Key)] == i {
+			latest = append(latest, ev)
+		}
+	}
+	return latest, true
 }
 
 func (w *watcher) send(wr WatchResponse) bool {
@@ -340,6 +340,115 @@ func TestSyncWatchers(t *testing.T) {
 	}
 }
 
+// TestSyncWatchersFairness tests a watch stream with many unsynced watchers
+// does not keep the watchers of other streams from being synced.
+func TestSyncWatchersFairness(t *testing.T) {
+	b, _ := betesting.NewDefaultTmpBackend(t)
+	s := newWatchableStore(zaptest.NewLogger(t), b, &lease.FakeLessor{}, StoreConfig{})
+	defer cleanup(s, b)
+
+	testKey := []byte("foo")
+	s.Put(testKey, []byte("bar"), lease.NoLease)
+	noisy := s.NewWatchStream()
+	defer noisy.Close()
+	for i := 0; i < 4*maxWatchersPerSync; i++ {
+		_, err := noisy.Watch(t.Context(), 0, testKey, nil, 1)
+		require.NoError(t, err)
+	}
+	quiet := s.NewWatchStream()
+	defer quiet.Close()
+	watcherN := 10
+	for i := 0; i < watcherN; i++ {
+		_, err := quiet.Watch(t.Context(), 0, testKey, nil, 1)
+		require.NoError(t, err)
+	}
+
+	s.syncWatchers()
+	require.Len(t, quiet.(*watchStream).ch, watcherN)
+}
+
+func TestSyncWatchersCoalesce(t *testing.T) {
+	b, _ := betesting.NewDefaultTmpBackend(t)
+	s := newWatchableStore(zaptest.NewLogger(t), b, &lease.FakeLessor{}, StoreConfig{})
+	defer cleanup(s, b)
+
+	s.Put([]byte("foo"), []byte("v1"), lease.NoLease)
+	s.Put([]byte("bar"), []byte("v1"), lease.NoLease)
+	s.Put([]byte("foo"), []byte("v2"), lease.NoLease)
+	s.DeleteRange([]byte("bar"), nil)
+	s.Put([]byte("foo"), []byte("v3"), lease.NoLease)
+
+	w := s.NewWatchStream()
+	defer w.Close()
+	ranges := []KeyRange{{Key: []byte("bar")}, {Key: []byte("foo")}}
+	_, err := w.WatchRanges(t.Context(), 0, ranges, 1, WatchOptions{Coalesce: true})
+	require.NoError(t, err)
+	_, err = w.WatchRanges(t.Context(), 1, ranges, 1, WatchOptions{})
+	require.NoError(t, err)
+	s.syncWatchers()
+
+	got := make(map[WatchID]WatchResponse)
+	for range 2 {
+		wr := <-w.Chan()
+		got[wr.WatchID] = wr
+	}
+	var revs []int64
+	for _, ev := range got[0].Events {
+		revs = append(revs, ev.Kv.ModRevision)
+	}
+	assert.Equal(t, []int64{5, 6}, revs, "latest events of bar and foo")
+	assert.True(t, got[0].Coalesced)
+	assert.Len(t, got[1].Events, 5)
+	assert.False(t, got[1].Coalesced)
+}
+
+func TestWatchers(t *testing.T) {
+	oldChanBufLen := chanBufLen
+	defer func() { chanBufLen = oldChanBufLen }()
+	chanBufLen = 1
+
+	b, _ := betesting.NewDefaultTmpBackend(t)
+	s := newWatchableStore(zaptest.NewLogger(t), b, &lease.FakeLessor{}, StoreConfig{})
+	defer cleanup(s, b)
+
+	s.Put([]byte("foo"), []byte("v1"), lease.NoLease)
+
+	synced := s.NewWatchStream()
+	defer synced.Close()
+	_, err := synced.Watch(t.Context(), 0, []byte("baz"), nil, 0)
+	require.NoError(t, err)
+
+	slow := s.NewWatchStream()
+	defer slow.Close()
+	_, err = slow.WatchRanges(t.Context(), 0, []KeyRange{{Key: []byte("foo")}}, 0, WatchOptions{User: "root", RemoteAddr: "10.0.0.2:2379"})
+	require.NoError(t, err)
+
+	unsynced := s.NewWatchStream()
+	defer unsynced.Close()
+	ranges := []KeyRange{{Key: []byte("a"), End: []byte("c")}}
+	_, err = unsynced.WatchRanges(t.Context(), 0, ranges, 1, WatchOptions{RemoteAddr: "10.0.0.1:2379"})
+	require.NoError(t, err)
+
+	// the first event fills the chan of the slow stream, making the watcher
+	// a victim on the second one
+	s.Put([]byte("foo"), []byte("v2"), lease.NoLease)
+	s.Put([]byte("foo"), []byte("v3"), lease.NoLease)
+
+	ws := s.Watchers()
+	require.Len(t, ws, 3)
+	assert.Equal(t, WatcherInfo{ID: 0, Ranges: []KeyRange{{Key: []byte("baz")}}, Rev: 4, Synced: true}, ws[0])
+	assert.Equal(t, WatcherInfo{ID: 0, Ranges: ranges, StartRev: 1, Rev: 0, RemoteAddr: "10.0.0.1:2379"}, ws[1])
+
+	victim := ws[2]
+	assert.True(t, victim.Victim)
+	assert.False(t, victim.Synced)
+	assert.Equal(t, 1, victim.PendingEvents)
+	assert.Equal(t, int64(4), victim.Rev)
+	assert.Positive(t, victim.VictimTime)
+	assert.Equal(t, "root", victim.User)
+	assert.Equal(t, "10.0.0.2:2379", victim.RemoteAddr)
+}
+
 func TestRangeEvents(t *testing.T) {
 	b, _ := betesting.NewDefaultTmpBackend(t)
 	lg := zaptest.NewLogger(t)
@@ -774,7 +883,7 @@ func TestNewMapwatcherToEventMap(t *testing.T) {
 	k0, k1, k2 := []byte("foo0"), []byte("foo1"), []byte("foo2")
 	v0, v1, v2 := []byte("bar0"), []byte("bar1"), []byte("bar2")
 
-	ws := []*watcher{{key: k0}, {key: k1}, {key: k2}}
+	ws := []*watcher{{ranges: []KeyRange{{Key: k0}}}, {ranges: []KeyRange{{Key: k1}}}, {ranges: []KeyRange{{Key: k2}}}}
 
 	evs := []*mvccpb.Event{
 		{
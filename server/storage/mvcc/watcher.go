@@ -19,6 +19,7 @@ import (
 	"context"
 	"errors"
 	"sync"
+	"time"
 
 	"go.opentelemetry.io/otel/trace"
 
@@ -34,9 +35,66 @@ var (
 
 type WatchID int64
 
+// KeyRange is a key watched by a watcher, or a range of keys [Key, End) if
+// End is not nil. An empty End is the range of all keys from Key on.
+type KeyRange struct {
+	Key []byte
+	End []byte
+}
+
+// rangeEnd returns the end of the range, or the key following Key for a
+// single key.
+func (r KeyRange) rangeEnd() []byte {
+	if r.End == nil {
+		return append(append([]byte{}, r.Key...), 0)
+	}
+	return r.End
+}
+
+// overlaps is whether o, which does not start before r, shares keys with r.
+func (r KeyRange) overlaps(o KeyRange) bool {
+	end := r.rangeEnd()
+	return len(end) == 0 || bytes.Compare(o.Key, end) < 0
+}
+
 // FilterFunc returns true if the given event should be filtered out.
 type FilterFunc func(e *mvccpb.Event) bool
 
+// WatchOptions configure how a watcher sends the events it watches.
+type WatchOptions struct {
+	// Filters filter out the events the watcher is not interested in.
+	Filters []FilterFunc
+	// Coalesce collapses the events a watcher falling behind catches up on
+	// to the latest event of each key.
+	Coalesce bool
+	// User and RemoteAddr describe the client of the watcher. They are only
+	// reported by Watchers.
+	User       string
+	RemoteAddr string
+}
+
+// WatcherInfo describes a watcher and how far it caught up with the store.
+type WatcherInfo struct {
+	ID     WatchID
+	Ranges []KeyRange
+	// StartRev is the revision the watcher started from, or 0 if it started
+	// at the current revision.
+	StartRev int64
+	// Rev is the revision up to which the events of the watcher were sent to
+	// its stream or are pending.
+	Rev int64
+	// Synced is set when the watcher caught up with the store.
+	Synced bool
+	// PendingEvents is the number of events held back while the watcher is a
+	// victim of its blocked stream.
+	PendingEvents int
+	Victim        bool
+	// VictimTime is the total time the watcher spent as victim.
+	VictimTime time.Duration
+	User       string
+	RemoteAddr string
+}
+
 type WatchStream interface {
 	// Watch creates a watcher. The watcher watches the events happening or
 	// happened on the given key or range [key, end) from the given startRev.
@@ -50,6 +108,11 @@ type WatchStream interface {
 	// an auto-generated watch ID is returned.
 	Watch(ctx context.Context, id WatchID, key, end []byte, startRev int64, fcs ...FilterFunc) (WatchID, error)
 
+	// WatchRanges is like Watch, but the watcher watches all the given keys
+	// and ranges, and sends their events as configured by opts. The events
+	// are sent under the one watch ID, ordered by revision.
+	WatchRanges(ctx context.Context, id WatchID, ranges []KeyRange, startRev int64, opts WatchOptions) (WatchID, error)
+
 	// Chan returns a chan. All watch response will be sent to the returned chan.
 	Chan() <-chan WatchResponse
 
@@ -95,6 +158,14 @@ type WatchResponse struct {
 
 	// CompactRevision is set when the watcher is cancelled due to compaction.
 	CompactRevision int64
+
+	// Coalesced is set when earlier events of the keys of Events were left
+	// out of the response by a coalescing watcher.
+	Coalesced bool
+
+	// Committed is the time the revision of Events was committed at. It is
+	// zero if the events were read back from the backend.
+	Committed time.Time
 }
 
 // watchStream contains a collection of watchers that share
@@ -113,11 +184,22 @@ type watchStream struct {
 
 // Watch creates a new watcher in the stream and returns its WatchID.
 func (ws *watchStream) Watch(ctx context.Context, id WatchID, key, end []byte, startRev int64, fcs ...FilterFunc) (WatchID, error) {
-	// prevent wrong range where key >= end lexicographically
-	// watch request with 'WithFromKey' has empty-byte range end
-	if len(end) != 0 && bytes.Compare(key, end) != -1 {
+	return ws.WatchRanges(ctx, id, []KeyRange{{Key: key, End: end}}, startRev, WatchOptions{Filters: fcs})
+}
+
+// WatchRanges creates a new watcher on the given ranges in the stream and
+// returns its WatchID.
+func (ws *watchStream) WatchRanges(ctx context.Context, id WatchID, ranges []KeyRange, startRev int64, opts WatchOptions) (WatchID, error) {
+	if len(ranges) == 0 {
 		return -1, ErrEmptyWatcherRange
 	}
+	for _, r := range ranges {
+		// prevent wrong range where key >= end lexicographically
+		// watch request with 'WithFromKey' has empty-byte range end
+		if len(r.End) != 0 && bytes.Compare(r.Key, r.End) != -1 {
+			return -1, ErrEmptyWatcherRange
+		}
+	}
 
 	ws.mu.Lock()
 	defer ws.mu.Unlock()
@@ -135,7 +217,7 @@ func (ws *watchStream) Watch(ctx context.Context, id WatchID, key, end []byte, s
 		return -1, ErrWatcherDuplicateID
 	}
 
-	w, c := ws.watchable.watch(key, end, startRev, id, ws.ch, fcs...)
+	w, c := ws.watchable.watch(ranges, startRev, id, ws.ch, opts)
 
 	span := trace.SpanFromContext(ctx)
 	ws.cancels[id] = func() {
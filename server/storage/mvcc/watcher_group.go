@@ -15,8 +15,11 @@
 package mvcc
 
 import (
+	"bytes"
 	"fmt"
 	"math"
+	"slices"
+	"time"
 
 	"go.etcd.io/etcd/api/v3/mvccpb"
 	"go.etcd.io/etcd/pkg/v3/adt"
@@ -34,6 +37,8 @@ type eventBatch struct {
 	revs int
 	// moreRev is first revision with more events following this batch
 	moreRev int64
+	// committed is the time the revision of evs was committed at, if known
+	committed time.Time
 }
 
 func (eb *eventBatch) add(ev *mvccpb.Event) {
@@ -121,23 +126,22 @@ func (w watcherSet) delete(wa *watcher) {
 
 type watcherSetByKey map[string]watcherSet
 
-func (w watcherSetByKey) add(wa *watcher) {
-	set := w[string(wa.key)]
+func (w watcherSetByKey) add(key string, wa *watcher) {
+	set := w[key]
 	if set == nil {
 		set = make(watcherSet)
-		w[string(wa.key)] = set
+		w[key] = set
 	}
 	set.add(wa)
 }
 
-func (w watcherSetByKey) delete(wa *watcher) bool {
-	k := string(wa.key)
-	if v, ok := w[k]; ok {
+func (w watcherSetByKey) delete(key string, wa *watcher) bool {
+	if v, ok := w[key]; ok {
 		if _, ok := v[wa]; ok {
 			delete(v, wa)
 			if len(v) == 0 {
 				// remove the set; nothing left
-				delete(w, k)
+				delete(w, key)
 			}
 			return true
 		}
@@ -166,13 +170,19 @@ func newWatcherGroup() watcherGroup {
 // add puts a watcher in the group.
 func (wg *watcherGroup) add(wa *watcher) {
 	wg.watchers.add(wa)
-	if wa.end == nil {
-		wg.keyWatchers.add(wa)
+	for _, r := range wa.ranges {
+		wg.addRange(wa, r)
+	}
+}
+
+func (wg *watcherGroup) addRange(wa *watcher, r KeyRange) {
+	if r.End == nil {
+		wg.keyWatchers.add(string(r.Key), wa)
 		return
 	}
 
 	// interval already registered?
-	ivl := adt.NewStringAffineInterval(string(wa.key), string(wa.end))
+	ivl := adt.NewStringAffineInterval(string(r.Key), string(r.End))
 	if iv := wg.ranges.Find(ivl); iv != nil {
 		iv.Val.(watcherSet).add(wa)
 		return
@@ -199,12 +209,20 @@ func (wg *watcherGroup) delete(wa *watcher) bool {
 		return false
 	}
 	wg.watchers.delete(wa)
-	if wa.end == nil {
-		wg.keyWatchers.delete(wa)
+	ok := true
+	for _, r := range wa.ranges {
+		ok = wg.deleteRange(wa, r) && ok
+	}
+	return ok
+}
+
+func (wg *watcherGroup) deleteRange(wa *watcher, r KeyRange) bool {
+	if r.End == nil {
+		wg.keyWatchers.delete(string(r.Key), wa)
 		return true
 	}
 
-	ivl := adt.NewStringAffineInterval(string(wa.key), string(wa.end))
+	ivl := adt.NewStringAffineInterval(string(r.Key), string(r.End))
 	iv := wg.ranges.Find(ivl)
 	if iv == nil {
 		return false
@@ -222,23 +240,37 @@ func (wg *watcherGroup) delete(wa *watcher) bool {
 	return true
 }
 
-// choose selects watchers from the watcher group to update
-func (wg *watcherGroup) choose(maxWatchers int, curRev, compactRev int64) (*watcherGroup, int64) {
+// choose selects at most maxWatchers watchers from the watcher group to update.
+// The watchers are taken from their watch streams in turn, so that a stream
+// with many watchers does not starve the other streams. compactRev returns the
+// revision the keys watched by a watcher are compacted up to.
+func (wg *watcherGroup) choose(maxWatchers int, curRev int64, compactRev func(w *watcher) int64) (*watcherGroup, int64) {
 	if len(wg.watchers) < maxWatchers {
 		return wg, wg.chooseAll(curRev, compactRev)
 	}
-	ret := newWatcherGroup()
+	streams := make(map[chan<- WatchResponse][]*watcher)
 	for w := range wg.watchers {
-		if maxWatchers <= 0 {
-			break
+		streams[w.ch] = append(streams[w.ch], w)
+	}
+	ret := newWatcherGroup()
+	for maxWatchers > 0 && len(streams) > 0 {
+		for ch, ws := range streams {
+			if maxWatchers <= 0 {
+				break
+			}
+			maxWatchers--
+			ret.add(ws[len(ws)-1])
+			if len(ws) == 1 {
+				delete(streams, ch)
+			} else {
+				streams[ch] = ws[:len(ws)-1]
+			}
 		}
-		maxWatchers--
-		ret.add(w)
 	}
 	return &ret, ret.chooseAll(curRev, compactRev)
 }
 
-func (wg *watcherGroup) chooseAll(curRev, compactRev int64) int64 {
+func (wg *watcherGroup) chooseAll(curRev int64, compactRev func(w *watcher) int64) int64 {
 	minRev := int64(math.MaxInt64)
 	for w := range wg.watchers {
 		if w.minRev > curRev {
@@ -252,9 +284,9 @@ func (wg *watcherGroup) chooseAll(curRev, compactRev int64) int64 {
 			// mark 'restore' done, since it's chosen
 			w.restore = false
 		}
-		if w.minRev < compactRev {
+		if crev := compactRev(w); w.minRev < crev {
 			select {
-			case w.ch <- WatchResponse{WatchID: w.id, CompactRevision: compactRev}:
+			case w.ch <- WatchResponse{WatchID: w.id, CompactRevision: crev}:
 				w.compacted = true
 				wg.delete(w)
 			default:
@@ -293,3 +325,29 @@ func (wg *watcherGroup) watcherSetByKey(key string) watcherSet {
 	}
 	return ret
 }
+
+// normalizeRanges sorts the given ranges and merges the overlapping ones, so
+// that every key is in at most one of the returned ranges. This keeps a
+// watcher from being found twice for the same key in a watcher group.
+func normalizeRanges(ranges []KeyRange) []KeyRange {
+	if len(ranges) == 1 {
+		return ranges
+	}
+	rs := slices.Clone(ranges)
+	slices.SortFunc(rs, func(a, b KeyRange) int { return bytes.Compare(a.Key, b.Key) })
+	var ret []KeyRange
+	for _, r := range rs {
+		n := len(ret)
+		if n == 0 || !ret[n-1].overlaps(r) {
+			ret = append(ret, r)
+			continue
+		}
+		prev := ret[n-1]
+		end := prev.rangeEnd()
+		if rend := r.rangeEnd(); len(end) != 0 && (len(rend) == 0 || bytes.Compare(rend, end) > 0) {
+			end = rend
+		}
+		ret[n-1] = KeyRange{Key: prev.Key, End: end}
+	}
+	return ret
+}
@@ -29,6 +29,7 @@ import (
 
 	"go.etcd.io/etcd/api/v3/mvccpb"
 	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.etcd.io/etcd/pkg/v3/traceutil"
 	"go.etcd.io/etcd/server/v3/lease"
 	betesting "go.etcd.io/etcd/server/v3/storage/backend/testing"
 )
@@ -269,6 +270,94 @@ func TestWatcherWatchWrongRange(t *testing.T) {
 	}
 }
 
+// TestWatcherWatchRanges tests that a watcher on several ranges receives the
+// events of all of them once, in revision order, whether synced or not.
+func TestWatcherWatchRanges(t *testing.T) {
+	b, _ := betesting.NewDefaultTmpBackend(t)
+	s := New(zaptest.NewLogger(t), b, &lease.FakeLessor{}, StoreConfig{})
+	defer cleanup(s, b)
+
+	w := s.NewWatchStream()
+	defer w.Close()
+
+	ranges := []KeyRange{
+		{Key: []byte("b"), End: []byte("c")},
+		{Key: []byte("a"), End: []byte("b")},
+		{Key: []byte("bar")},
+		{Key: []byte("key")},
+	}
+	put := func(keys ...string) {
+		txn := s.Write(traceutil.TODO())
+		for _, k := range keys {
+			txn.Put([]byte(k), []byte("v"), lease.NoLease)
+		}
+		txn.End()
+	}
+	put("a1")
+	put("other", "bar")
+	put("key")
+
+	// unsynced from revision 2, then synced
+	id, err := w.WatchRanges(t.Context(), 0, ranges, 2, WatchOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	put("b1", "other", "a2")
+
+	want := []string{"a1@2", "bar@3", "key@4", "b1@5", "a2@5"}
+	var got []string
+	for len(got) < len(want) {
+		select {
+		case resp := <-w.Chan():
+			if resp.WatchID != id {
+				t.Fatalf("watch id = %d, want %d", resp.WatchID, id)
+			}
+			for _, ev := range resp.Events {
+				got = append(got, fmt.Sprintf("%s@%d", ev.Kv.Key, ev.Kv.ModRevision))
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("timed out, got %v", got)
+		}
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("events = %v, want %v", got, want)
+	}
+	select {
+	case resp := <-w.Chan():
+		t.Fatalf("unexpected response %+v", resp)
+	default:
+	}
+}
+
+func TestNormalizeRanges(t *testing.T) {
+	r := func(key, end string) KeyRange {
+		kr := KeyRange{Key: []byte(key)}
+		if end != "-" {
+			kr.End = []byte(end)
+		}
+		return kr
+	}
+	tests := []struct {
+		name   string
+		ranges []KeyRange
+		want   []KeyRange
+	}{
+		{"single key", []KeyRange{r("a", "-")}, []KeyRange{r("a", "-")}},
+		{"disjoint", []KeyRange{r("c", "d"), r("a", "-"), r("a0", "b")}, []KeyRange{r("a", "-"), r("a0", "b"), r("c", "d")}},
+		{"overlapping", []KeyRange{r("a", "c"), r("b", "d")}, []KeyRange{r("a", "d")}},
+		{"contained", []KeyRange{r("a", "d"), r("b", "c"), r("b", "-")}, []KeyRange{r("a", "d")}},
+		{"duplicate keys", []KeyRange{r("a", "-"), r("a", "-")}, []KeyRange{r("a", "a\x00")}},
+		{"from key", []KeyRange{r("b", ""), r("a", "c"), r("d", "e")}, []KeyRange{r("a", "")}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := normalizeRanges(tt.ranges); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("normalizeRanges() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestWatchDeleteRange(t *testing.T) {
 	b, tmpPath := betesting.NewDefaultTmpBackend(t)
 	s := New(zaptest.NewLogger(t), b, &lease.FakeLessor{}, StoreConfig{})
@@ -148,10 +148,16 @@ func (b *BackendQuota) Cost(v any) int {
 
 func costPut(r *pb.PutRequest) int { return kvOverhead + len(r.Key) + len(r.Value) }
 
+// costIncrement assumes the largest encoding of an int64 counter value.
+func costIncrement(r *pb.IncrementRequest) int { return kvOverhead + len(r.Key) + 20 }
+
 func costTxnReq(u *pb.RequestOp) int {
 	if r := u.GetRequestPut(); r != nil {
 		return costPut(r)
 	}
+	if r := u.GetRequestIncrement(); r != nil {
+		return costIncrement(r)
+	}
 	if t := u.GetRequestTxn(); t != nil {
 		return costTxn(t)
 	}
@@ -27,6 +27,9 @@ var (
 	leaseBucketName = []byte("lease")
 	alarmBucketName = []byte("alarm")
 
+	revisionHoldBucketName = []byte("revisionHold")
+	subscriptionBucketName = []byte("subscription")
+
 	clusterBucketName = []byte("cluster")
 
 	membersBucketName        = []byte("members")
@@ -46,6 +49,9 @@ var (
 	Alarm   = backend.Bucket(bucket{id: 4, name: alarmBucketName, safeRangeBucket: false})
 	Cluster = backend.Bucket(bucket{id: 5, name: clusterBucketName, safeRangeBucket: false})
 
+	RevisionHold = backend.Bucket(bucket{id: 6, name: revisionHoldBucketName, safeRangeBucket: false})
+	Subscription = backend.Bucket(bucket{id: 7, name: subscriptionBucketName, safeRangeBucket: false})
+
 	Members        = backend.Bucket(bucket{id: 10, name: membersBucketName, safeRangeBucket: false})
 	MembersRemoved = backend.Bucket(bucket{id: 11, name: membersRemovedBucketName, safeRangeBucket: false})
 
@@ -55,7 +61,7 @@ var (
 
 	Test = backend.Bucket(bucket{id: 100, name: testBucketName, safeRangeBucket: false})
 
-	AllBuckets = []backend.Bucket{Key, Meta, Lease, Alarm, Cluster, Members, MembersRemoved, Auth, AuthUsers, AuthRoles}
+	AllBuckets = []backend.Bucket{Key, Meta, Lease, Alarm, Cluster, RevisionHold, Subscription, Members, MembersRemoved, Auth, AuthUsers, AuthRoles}
 )
 
 type bucket struct {
@@ -83,6 +89,9 @@ var (
 	ClusterDowngradeKeyName      = []byte("downgrade")
 	// Since v3.6
 	MetaStorageVersionName = []byte("storageVersion")
+	// Since v3.8
+	ScheduledCompactPrefixesKeyName = []byte("scheduledCompactPrefixes")
+	FinishedCompactPrefixesKeyName  = []byte("finishedCompactPrefixes")
 	// Before adding new meta key please update server/etcdserver/version
 )
 
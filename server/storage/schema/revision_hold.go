@@ -0,0 +1,87 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"encoding/binary"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+
+	"go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/server/v3/storage/backend"
+)
+
+type RevisionHoldBackend interface {
+	MustPutRevisionHold(hold *etcdserverpb.RevisionHold)
+	MustDeleteRevisionHold(hold *etcdserverpb.RevisionHold)
+	GetAllRevisionHolds() ([]*etcdserverpb.RevisionHold, error)
+}
+
+type revisionHoldBackend struct {
+	lg *zap.Logger
+	be backend.Backend
+}
+
+func NewRevisionHoldBackend(lg *zap.Logger, be backend.Backend) RevisionHoldBackend {
+	return &revisionHoldBackend{
+		lg: lg,
+		be: be,
+	}
+}
+
+func (s *revisionHoldBackend) MustPutRevisionHold(hold *etcdserverpb.RevisionHold) {
+	v, err := proto.Marshal(hold)
+	if err != nil {
+		s.lg.Panic("failed to marshal revision hold", zap.Error(err))
+	}
+
+	tx := s.be.BatchTx()
+	tx.LockInsideApply()
+	defer tx.Unlock()
+	// the bucket is only created with the first hold, so that the backend of
+	// a cluster never using holds stays the same
+	tx.UnsafeCreateBucket(RevisionHold)
+	tx.UnsafePut(RevisionHold, revisionHoldIDToBytes(hold.ID), v)
+}
+
+func (s *revisionHoldBackend) MustDeleteRevisionHold(hold *etcdserverpb.RevisionHold) {
+	tx := s.be.BatchTx()
+	tx.LockInsideApply()
+	defer tx.Unlock()
+	tx.UnsafeDelete(RevisionHold, revisionHoldIDToBytes(hold.ID))
+}
+
+func (s *revisionHoldBackend) GetAllRevisionHolds() ([]*etcdserverpb.RevisionHold, error) {
+	tx := s.be.ReadTx()
+	tx.RLock()
+	defer tx.RUnlock()
+	var hs []*etcdserverpb.RevisionHold
+	err := tx.UnsafeForEach(RevisionHold, func(k, v []byte) error {
+		var h etcdserverpb.RevisionHold
+		if err := proto.Unmarshal(v, &h); err != nil {
+			return err
+		}
+		hs = append(hs, &h)
+		return nil
+	})
+	return hs, err
+}
+
+func revisionHoldIDToBytes(id int64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, uint64(id))
+	return b
+}
@@ -0,0 +1,79 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+
+	"go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/server/v3/storage/backend"
+)
+
+type SubscriptionBackend interface {
+	MustPutSubscription(sub *etcdserverpb.Subscription)
+	MustDeleteSubscription(name string)
+	GetAllSubscriptions() ([]*etcdserverpb.Subscription, error)
+}
+
+type subscriptionBackend struct {
+	lg *zap.Logger
+	be backend.Backend
+}
+
+func NewSubscriptionBackend(lg *zap.Logger, be backend.Backend) SubscriptionBackend {
+	return &subscriptionBackend{
+		lg: lg,
+		be: be,
+	}
+}
+
+func (s *subscriptionBackend) MustPutSubscription(sub *etcdserverpb.Subscription) {
+	v, err := proto.Marshal(sub)
+	if err != nil {
+		s.lg.Panic("failed to marshal subscription", zap.Error(err))
+	}
+
+	tx := s.be.BatchTx()
+	tx.LockInsideApply()
+	defer tx.Unlock()
+	// like for revision holds, the bucket is only created with the first
+	// subscription
+	tx.UnsafeCreateBucket(Subscription)
+	tx.UnsafePut(Subscription, []byte(sub.Name), v)
+}
+
+func (s *subscriptionBackend) MustDeleteSubscription(name string) {
+	tx := s.be.BatchTx()
+	tx.LockInsideApply()
+	defer tx.Unlock()
+	tx.UnsafeDelete(Subscription, []byte(name))
+}
+
+func (s *subscriptionBackend) GetAllSubscriptions() ([]*etcdserverpb.Subscription, error) {
+	tx := s.be.ReadTx()
+	tx.RLock()
+	defer tx.RUnlock()
+	var subs []*etcdserverpb.Subscription
+	err := tx.UnsafeForEach(Subscription, func(k, v []byte) error {
+		var sub etcdserverpb.Subscription
+		if err := proto.Unmarshal(v, &sub); err != nil {
+			return err
+		}
+		subs = append(subs, &sub)
+		return nil
+	})
+	return subs, err
+}
@@ -174,6 +174,14 @@ type ClusterConfig struct {
 	DisableStrictReconfigCheck  bool
 	CorruptCheckTime            time.Duration
 	Metrics                     string
+
+	AutoCompactionMode            string
+	AutoCompactionRetention       time.Duration
+	AutoCompactionPrefixRetention map[string]time.Duration
+
+	WatchMaxWatchersPerStream int
+	WatchMaxWatchersPerUser   int
+	WatchMaxEventsPerSecond   int
 }
 
 type Cluster struct {
@@ -287,6 +295,14 @@ func (c *Cluster) MustNewMember(t testutil.TB) *Member {
 			DisableStrictReconfigCheck:  c.Cfg.DisableStrictReconfigCheck,
 			CorruptCheckTime:            c.Cfg.CorruptCheckTime,
 			Metrics:                     c.Cfg.Metrics,
+
+			AutoCompactionMode:            c.Cfg.AutoCompactionMode,
+			AutoCompactionRetention:       c.Cfg.AutoCompactionRetention,
+			AutoCompactionPrefixRetention: c.Cfg.AutoCompactionPrefixRetention,
+
+			WatchMaxWatchersPerStream: c.Cfg.WatchMaxWatchersPerStream,
+			WatchMaxWatchersPerUser:   c.Cfg.WatchMaxWatchersPerUser,
+			WatchMaxEventsPerSecond:   c.Cfg.WatchMaxEventsPerSecond,
 		})
 	return m
 }
@@ -617,6 +633,14 @@ type MemberConfig struct {
 	DisableStrictReconfigCheck  bool
 	CorruptCheckTime            time.Duration
 	Metrics                     string
+
+	AutoCompactionMode            string
+	AutoCompactionRetention       time.Duration
+	AutoCompactionPrefixRetention map[string]time.Duration
+
+	WatchMaxWatchersPerStream int
+	WatchMaxWatchersPerUser   int
+	WatchMaxEventsPerSecond   int
 }
 
 // MustNewMember return an inited member with the given name. If peerTLS is
@@ -716,8 +740,15 @@ func MustNewMember(t testutil.TB, mcfg MemberConfig) *Member {
 	m.UseBridge = mcfg.UseBridge
 	m.UseTCP = mcfg.UseTCP
 	m.LeaseCheckpointInterval = mcfg.LeaseCheckpointInterval
+	m.AutoCompactionMode = mcfg.AutoCompactionMode
+	m.AutoCompactionRetention = mcfg.AutoCompactionRetention
+	m.AutoCompactionPrefixRetention = mcfg.AutoCompactionPrefixRetention
 
 	m.WatchProgressNotifyInterval = mcfg.WatchProgressNotifyInterval
+	m.SubscriptionMaxLag = etcdserver.DefaultSubscriptionMaxLag
+	m.WatchMaxWatchersPerStream = mcfg.WatchMaxWatchersPerStream
+	m.WatchMaxWatchersPerUser = mcfg.WatchMaxWatchersPerUser
+	m.WatchMaxEventsPerSecond = mcfg.WatchMaxEventsPerSecond
 
 	m.InitialCorruptCheck = true
 	if mcfg.CorruptCheckTime > time.Duration(0) {
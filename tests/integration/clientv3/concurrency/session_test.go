@@ -95,3 +95,30 @@ func TestSessionCtx(t *testing.T) {
 	}
 	assert.Equal(t, childCtx.Err(), context.Canceled)
 }
+
+func TestSessionParent(t *testing.T) {
+	cli, err := integration.NewClient(t, clientv3.Config{Endpoints: exampleEndpoints()})
+	require.NoError(t, err)
+	defer cli.Close()
+
+	parent, err := concurrency.NewSession(cli)
+	require.NoError(t, err)
+	child, err := concurrency.NewSession(cli, concurrency.WithParent(parent))
+	require.NoError(t, err)
+	defer child.Close()
+	assert.NotEqual(t, parent.Lease(), child.Lease())
+
+	_, err = cli.Put(t.Context(), "session-parent-key", "bar", clientv3.WithLease(child.Lease()))
+	require.NoError(t, err)
+
+	// closing the parent session ends the child session
+	require.NoError(t, parent.Close())
+	select {
+	case <-child.Done():
+	case <-time.After(5 * time.Second):
+		t.Fatal("child session did not end along with its parent")
+	}
+	resp, err := cli.Get(t.Context(), "session-parent-key")
+	require.NoError(t, err)
+	assert.Empty(t, resp.Kvs)
+}
@@ -24,18 +24,22 @@ import (
 	"slices"
 	"strconv"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
 	"go.etcd.io/etcd/api/v3/mvccpb"
 	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
 	"go.etcd.io/etcd/api/v3/version"
 	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.etcd.io/etcd/server/v3/lease"
 	"go.etcd.io/etcd/tests/v3/framework/integration"
 )
 
@@ -174,6 +178,154 @@ func TestKVPutWithIgnoreLease(t *testing.T) {
 	}
 }
 
+func TestKVIncrement(t *testing.T) {
+	integration.BeforeTest(t)
+
+	clus := integration.NewCluster(t, &integration.ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	kv := clus.RandClient()
+	ctx := t.Context()
+
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range 10 {
+				_, err := kv.Do(ctx, clientv3.OpIncrement("counter", 1))
+				assert.NoError(t, err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	resp, err := kv.Do(ctx, clientv3.OpIncrement("counter", -1, clientv3.WithPrevKV()))
+	require.NoError(t, err)
+	require.Equal(t, int64(99), resp.Increment().Value)
+	require.Equal(t, "100", string(resp.Increment().PrevKv.Value))
+
+	gresp, err := kv.Get(ctx, "counter")
+	require.NoError(t, err)
+	require.Equal(t, "99", string(gresp.Kvs[0].Value))
+	require.Equal(t, resp.Increment().Header.Revision, gresp.Kvs[0].ModRevision)
+
+	tresp, err := kv.Txn(ctx).
+		If(clientv3.Compare(clientv3.Value("counter"), "=", "99")).
+		Then(clientv3.OpIncrement("counter", 1), clientv3.OpIncrement("other", 2)).
+		Commit()
+	require.NoError(t, err)
+	require.True(t, tresp.Succeeded)
+	require.Equal(t, int64(100), tresp.Responses[0].GetResponseIncrement().Value)
+	require.Equal(t, int64(2), tresp.Responses[1].GetResponseIncrement().Value)
+
+	_, err = kv.Put(ctx, "foo", "bar")
+	require.NoError(t, err)
+	_, err = kv.Do(ctx, clientv3.OpIncrement("foo", 1))
+	require.ErrorIs(t, err, rpctypes.ErrInvalidCounter)
+
+	_, err = kv.Txn(ctx).Then(clientv3.OpPut("counter", "1"), clientv3.OpIncrement("counter", 1)).Commit()
+	require.ErrorIs(t, err, rpctypes.ErrDuplicateKey)
+}
+
+func TestKVPutTTL(t *testing.T) {
+	integration.BeforeTest(t)
+
+	clus := integration.NewCluster(t, &integration.ClusterConfig{Size: 3})
+	defer clus.Terminate(t)
+
+	kv := clus.RandClient()
+	ctx := t.Context()
+
+	now := time.Now().Unix()
+	resp, err := kv.Put(ctx, "foo", "bar", clientv3.WithTTL(60))
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, resp.Expiry, now+60)
+
+	gresp, err := kv.Get(ctx, "foo")
+	require.NoError(t, err)
+	require.NotZero(t, gresp.Kvs[0].Lease)
+
+	ttlResp, err := kv.TimeToLive(ctx, clientv3.LeaseID(gresp.Kvs[0].Lease), clientv3.WithAttachedKeys())
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{[]byte("foo")}, ttlResp.Keys)
+
+	_, err = kv.Put(ctx, "foo", "bar", clientv3.WithTTL(60), clientv3.WithLease(1))
+	require.ErrorIs(t, err, rpctypes.ErrLeaseProvided)
+
+	// the shared lease IDs cannot be granted ahead of time by a client
+	_, err = integration.ToGRPC(kv).Lease.LeaseGrant(ctx, &pb.LeaseGrantRequest{ID: int64(lease.TTLLeaseID(now + 120)), TTL: lease.MaxLeaseTTL})
+	require.ErrorIs(t, err, rpctypes.ErrGRPCLeaseIDReserved)
+	// nor have child leases
+	_, err = integration.ToGRPC(kv).Lease.LeaseGrant(ctx, &pb.LeaseGrantRequest{TTL: 60, Parent: gresp.Kvs[0].Lease})
+	require.ErrorIs(t, err, rpctypes.ErrGRPCLeaseIDReserved)
+
+	// nor kept alive or attached to keys put without a TTL
+	_, err = kv.KeepAliveOnce(ctx, clientv3.LeaseID(gresp.Kvs[0].Lease))
+	require.ErrorIs(t, err, rpctypes.ErrLeaseIDReserved)
+	_, err = kv.Put(ctx, "bar", "baz", clientv3.WithLease(clientv3.LeaseID(gresp.Kvs[0].Lease)))
+	require.ErrorIs(t, err, rpctypes.ErrLeaseIDReserved)
+
+	// keys are removed once their shared lease expires
+	_, err = kv.Txn(ctx).Then(clientv3.OpPut("short", "bar", clientv3.WithTTL(1))).Commit()
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		gresp, err := kv.Get(ctx, "short")
+		return err == nil && len(gresp.Kvs) == 0
+	}, 10*time.Second, 100*time.Millisecond)
+}
+
+func TestKVGetContinue(t *testing.T) {
+	integration.BeforeTest(t)
+
+	clus := integration.NewCluster(t, &integration.ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	kv := clus.RandClient()
+	ctx := t.Context()
+
+	var want []string
+	for i := range 10 {
+		key := fmt.Sprintf("foo%d", i)
+		_, err := kv.Put(ctx, key, "bar")
+		require.NoError(t, err)
+		want = append([]string{key}, want...)
+	}
+
+	opts := []clientv3.OpOption{clientv3.WithPrefix(), clientv3.WithLimit(3), clientv3.WithSort(clientv3.SortByCreateRevision, clientv3.SortDescend)}
+	resp, err := kv.Get(ctx, "foo", opts...)
+	require.NoError(t, err)
+
+	// writes after the first page are not visible to the next pages
+	_, err = kv.Put(ctx, "foo10", "bar")
+	require.NoError(t, err)
+
+	var got []string
+	for {
+		for _, kv := range resp.Kvs {
+			got = append(got, string(kv.Key))
+		}
+		if len(resp.ContinueToken) == 0 {
+			break
+		}
+		resp, err = kv.Get(ctx, "foo", append(opts, clientv3.WithContinue(resp.ContinueToken))...)
+		require.NoError(t, err)
+	}
+	require.Equal(t, want, got)
+
+	resp, err = kv.Get(ctx, "foo", opts...)
+	require.NoError(t, err)
+	presp, err := kv.Put(ctx, "foo11", "bar")
+	require.NoError(t, err)
+	_, err = kv.Compact(ctx, presp.Header.Revision)
+	require.NoError(t, err)
+	_, err = kv.Get(ctx, "foo", append(opts, clientv3.WithContinue(resp.ContinueToken))...)
+	require.ErrorIs(t, err, rpctypes.ErrCompacted)
+
+	_, err = kv.Get(ctx, "foo", clientv3.WithPrefix(), clientv3.WithContinue([]byte("bad")))
+	require.ErrorIs(t, err, rpctypes.ErrInvalidContinueToken)
+}
+
 func TestKVPutWithRequireLeader(t *testing.T) {
 	integration.BeforeTest(t)
 
@@ -378,6 +530,60 @@ func TestKVDeleteRange(t *testing.T) {
 	}
 }
 
+// TestKVDeleteRangeBatched ensures a batched delete removes the whole range
+// over successive revisions, that it can be resumed after stopping early, and
+// that an atomic batched delete only deletes ranges that fit in one batch.
+func TestKVDeleteRangeBatched(t *testing.T) {
+	integration.BeforeTest(t)
+
+	clus := integration.NewCluster(t, &integration.ClusterConfig{Size: 3})
+	defer clus.Terminate(t)
+
+	kv := clus.RandClient()
+	ctx := t.Context()
+
+	put := func(n int) int64 {
+		var rev int64
+		for i := range n {
+			resp, err := kv.Put(ctx, fmt.Sprintf("foo%02d", i), "bar")
+			require.NoError(t, err)
+			rev = resp.Header.Revision
+		}
+		return rev
+	}
+
+	rev := put(10)
+	resp, err := kv.Delete(ctx, "foo", clientv3.WithPrefix(), clientv3.WithBatchSize(3))
+	require.NoError(t, err)
+	require.Equal(t, int64(10), resp.Deleted)
+	require.Equal(t, rev+4, resp.Header.Revision, "expected 4 batches")
+	require.Empty(t, resp.NextKey)
+	gresp, err := kv.Get(ctx, "foo", clientv3.WithPrefix(), clientv3.WithCountOnly())
+	require.NoError(t, err)
+	require.Zero(t, gresp.Count)
+
+	put(10)
+	resp, err = kv.Delete(ctx, "foo", clientv3.WithPrefix(), clientv3.WithBatchSize(3), clientv3.WithMaxBatches(2))
+	require.NoError(t, err)
+	require.Equal(t, int64(6), resp.Deleted)
+	require.Equal(t, "foo05\x00", string(resp.NextKey))
+	resp, err = kv.Delete(ctx, string(resp.NextKey), clientv3.WithRange(clientv3.GetPrefixRangeEnd("foo")), clientv3.WithBatchSize(3), clientv3.WithMaxBatches(2))
+	require.NoError(t, err)
+	require.Equal(t, int64(4), resp.Deleted)
+	require.Empty(t, resp.NextKey)
+
+	rev = put(5)
+	_, err = kv.Delete(ctx, "foo", clientv3.WithPrefix(), clientv3.WithBatchSize(3), clientv3.WithAtomicBatch())
+	require.ErrorIs(t, err, rpctypes.ErrRequestTooLarge)
+	resp, err = kv.Delete(ctx, "foo", clientv3.WithPrefix(), clientv3.WithBatchSize(5), clientv3.WithAtomicBatch())
+	require.NoError(t, err)
+	require.Equal(t, int64(5), resp.Deleted)
+	require.Equal(t, rev+1, resp.Header.Revision)
+
+	_, err = kv.Txn(ctx).Then(clientv3.OpDelete("foo", clientv3.WithPrefix(), clientv3.WithBatchSize(3))).Commit()
+	require.ErrorIs(t, err, rpctypes.ErrInvalidBatchedDelete)
+}
+
 func TestKVCompactError(t *testing.T) {
 	integration.BeforeTest(t)
 
@@ -466,9 +672,6 @@ func TestKVCompact(t *testing.T) {
 // rpctypes.ErrCompacted error from the server (matching Get's behavior),
 // rather than the raw gRPC status.
 func TestKVGetStreamCompactedError(t *testing.T) {
-	if integration.ThroughProxy {
-		t.Skip("RangeStream is not supported by the KV client adapter")
-	}
 	integration.BeforeTest(t)
 
 	clus := integration.NewCluster(t, &integration.ClusterConfig{Size: 1})
@@ -494,6 +697,92 @@ func TestKVGetStreamCompactedError(t *testing.T) {
 	require.ErrorIsf(t, err, rpctypes.ErrCompacted, "GetStream returned %T %v", err, err)
 }
 
+// TestKVGetStreamResume ensures GetStream resumes a stream broken by a
+// dropped connection, without repeating or missing keys.
+func TestKVGetStreamResume(t *testing.T) {
+	integration.BeforeTest(t)
+
+	clus := integration.NewCluster(t, &integration.ClusterConfig{Size: 1, UseBridge: true})
+	defer clus.Terminate(t)
+
+	kv := clus.Client(0)
+	ctx := t.Context()
+
+	const nKeys = 100
+	value := strings.Repeat("x", 100*1024)
+	for i := 0; i < nKeys; i++ {
+		_, err := kv.Put(ctx, fmt.Sprintf("stream-%03d", i), value)
+		require.NoError(t, err)
+	}
+
+	stream, err := kv.GetStream(ctx, "stream-", clientv3.WithPrefix())
+	require.NoError(t, err)
+	first := <-stream
+	require.NoError(t, first.Err())
+	require.NotEmpty(t, first.ResumeToken())
+	clus.Members[0].Bridge().DropConnections()
+
+	resp := &clientv3.GetResponse{}
+	resp.Kvs = first.Kvs
+	rest, err := clientv3.GetStreamToGetResponse(stream)
+	require.NoError(t, err)
+	resp.Kvs = append(resp.Kvs, rest.Kvs...)
+
+	require.Len(t, resp.Kvs, nKeys)
+	for i, kv := range resp.Kvs {
+		require.Equal(t, fmt.Sprintf("stream-%03d", i), string(kv.Key))
+	}
+	require.Equal(t, int64(nKeys), rest.Count)
+}
+
+// TestKVKeyHistory ensures KeyHistory returns the retained revisions of a key,
+// deletions included, and fails on compacted revisions.
+func TestKVKeyHistory(t *testing.T) {
+	integration.BeforeTest(t)
+
+	clus := integration.NewCluster(t, &integration.ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	kv := clus.RandClient()
+	ctx := t.Context()
+
+	for _, v := range []string{"bar0", "bar1"} {
+		_, err := kv.Put(ctx, "foo", v)
+		require.NoError(t, err)
+	}
+	_, err := kv.Delete(ctx, "foo")
+	require.NoError(t, err)
+	_, err = kv.Put(ctx, "foo", "bar2")
+	require.NoError(t, err)
+
+	resp, err := kv.KeyHistory(ctx, "foo")
+	require.NoError(t, err)
+	require.Len(t, resp.Kvs, 4)
+	require.Equal(t, int64(4), resp.Count)
+	var values []string
+	for _, kv := range resp.Kvs {
+		values = append(values, string(kv.Value))
+	}
+	require.Equal(t, []string{"bar0", "bar1", "", "bar2"}, values)
+	require.Equal(t, int64(0), resp.Kvs[2].Version)
+	require.Equal(t, int64(4), resp.Kvs[2].ModRevision)
+
+	resp, err = kv.KeyHistory(ctx, "foo", clientv3.WithLimit(1), clientv3.WithSort(clientv3.SortByModRevision, clientv3.SortDescend))
+	require.NoError(t, err)
+	require.Len(t, resp.Kvs, 1)
+	require.True(t, resp.More)
+	require.Equal(t, "bar2", string(resp.Kvs[0].Value))
+
+	_, err = kv.Compact(ctx, 3)
+	require.NoError(t, err)
+	_, err = kv.KeyHistory(ctx, "foo", clientv3.WithRev(2))
+	require.ErrorIs(t, err, rpctypes.ErrCompacted)
+	resp, err = kv.KeyHistory(ctx, "foo")
+	require.NoError(t, err)
+	require.Len(t, resp.Kvs, 3)
+	require.Equal(t, int64(3), resp.Kvs[0].ModRevision)
+}
+
 // TestKVGetKeysOnlyWithCountOnly asserts that when a Range operation
 // with both KeysOnly and CountOnly are specified, CountOnly takes precedence.
 func TestKVGetKeysOnlyWithCountOnly(t *testing.T) {
@@ -27,6 +27,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
 	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
 	clientv3 "go.etcd.io/etcd/client/v3"
 	"go.etcd.io/etcd/client/v3/concurrency"
@@ -91,6 +92,175 @@ func TestLeaseRevoke(t *testing.T) {
 	require.ErrorIsf(t, err, rpctypes.ErrLeaseNotFound, "err = %v, want %v", err, rpctypes.ErrLeaseNotFound)
 }
 
+func TestLeaseUpdateTTL(t *testing.T) {
+	integration.BeforeTest(t)
+
+	clus := integration.NewCluster(t, &integration.ClusterConfig{Size: 3})
+	defer clus.Terminate(t)
+
+	lapi := clus.Client(0)
+
+	resp, err := lapi.Grant(t.Context(), 10)
+	require.NoError(t, err)
+	_, err = lapi.Put(t.Context(), "foo", "bar", clientv3.WithLease(resp.ID))
+	require.NoError(t, err)
+
+	uresp, err := lapi.UpdateTTL(t.Context(), resp.ID, 100)
+	require.NoError(t, err)
+	require.Equal(t, resp.ID, clientv3.LeaseID(uresp.ID))
+	require.Equal(t, int64(100), uresp.TTL)
+
+	// the new TTL is applied on every member
+	c := clus.Client(1)
+	_, err = c.Get(t.Context(), "abc")
+	require.NoError(t, err)
+	lresp, err := c.TimeToLive(t.Context(), resp.ID, clientv3.WithAttachedKeys())
+	require.NoError(t, err)
+	require.Equal(t, int64(100), lresp.GrantedTTL)
+	if lresp.TTL <= 10 || lresp.TTL > lresp.GrantedTTL {
+		t.Fatalf("unexpected TTL %d (granted %d)", lresp.TTL, lresp.GrantedTTL)
+	}
+	require.Equal(t, [][]byte{[]byte("foo")}, lresp.Keys)
+
+	_, err = lapi.UpdateTTL(t.Context(), clientv3.LeaseID(resp.ID+1), 100)
+	require.ErrorIs(t, err, rpctypes.ErrLeaseNotFound)
+}
+
+func TestLeaseRevokeBatch(t *testing.T) {
+	integration.BeforeTest(t)
+
+	clus := integration.NewCluster(t, &integration.ClusterConfig{Size: 3})
+	defer clus.Terminate(t)
+
+	lapi := clus.Client(0)
+
+	var ids []clientv3.LeaseID
+	for i := 0; i < 3; i++ {
+		resp, err := lapi.Grant(t.Context(), 10)
+		require.NoError(t, err)
+		_, err = lapi.Put(t.Context(), fmt.Sprintf("foo%d", i), "bar", clientv3.WithLease(resp.ID))
+		require.NoError(t, err)
+		ids = append(ids, resp.ID)
+	}
+
+	// the lease that does not exist is skipped
+	rresp, err := lapi.RevokeBatch(t.Context(), []clientv3.LeaseID{ids[0], ids[2], ids[2] + 100})
+	require.NoError(t, err)
+	require.Equal(t, []int64{int64(ids[0]), int64(ids[2])}, rresp.Revoked)
+
+	gresp, err := clus.Client(1).Get(t.Context(), "foo", clientv3.WithPrefix())
+	require.NoError(t, err)
+	require.Len(t, gresp.Kvs, 1)
+	require.Equal(t, "foo1", string(gresp.Kvs[0].Key))
+
+	_, err = lapi.Put(t.Context(), "foo", "bar", clientv3.WithLease(ids[0]))
+	require.ErrorIs(t, err, rpctypes.ErrLeaseNotFound)
+}
+
+func TestLeaseGrantChild(t *testing.T) {
+	integration.BeforeTest(t)
+
+	clus := integration.NewCluster(t, &integration.ClusterConfig{Size: 3})
+	defer clus.Terminate(t)
+
+	lapi := clus.Client(0)
+
+	_, err := lapi.GrantChild(t.Context(), clientv3.LeaseID(1), 10)
+	require.ErrorIs(t, err, rpctypes.ErrLeaseNotFound)
+
+	presp, err := lapi.Grant(t.Context(), 10)
+	require.NoError(t, err)
+	cresp, err := lapi.GrantChild(t.Context(), presp.ID, 100)
+	require.NoError(t, err)
+	gresp, err := lapi.GrantChild(t.Context(), cresp.ID, 100)
+	require.NoError(t, err)
+	for i, id := range []clientv3.LeaseID{presp.ID, cresp.ID, gresp.ID} {
+		_, err = lapi.Put(t.Context(), fmt.Sprintf("foo%d", i), "bar", clientv3.WithLease(id))
+		require.NoError(t, err)
+	}
+
+	_, err = lapi.Revoke(t.Context(), presp.ID)
+	require.NoError(t, err)
+
+	// the child leases are revoked on every member
+	c := clus.Client(1)
+	resp, err := c.Get(t.Context(), "foo", clientv3.WithPrefix())
+	require.NoError(t, err)
+	require.Empty(t, resp.Kvs)
+	for _, id := range []clientv3.LeaseID{cresp.ID, gresp.ID} {
+		lresp, lerr := c.TimeToLive(t.Context(), id)
+		require.NoError(t, lerr)
+		require.Equal(t, int64(-1), lresp.TTL)
+	}
+}
+
+func TestLeaseWatch(t *testing.T) {
+	integration.BeforeTest(t)
+
+	clus := integration.NewCluster(t, &integration.ClusterConfig{Size: 3})
+	defer clus.Terminate(t)
+
+	lapi := clus.Client(0)
+	// the events are published by every member as it applies them
+	wch, err := clus.Client(1).WatchLeases(t.Context(), clientv3.NoLease, clientv3.WithAttachedKeys())
+	require.NoError(t, err)
+
+	var pending []*pb.LeaseEvent
+	next := func() *pb.LeaseEvent {
+		for len(pending) == 0 {
+			select {
+			case resp, ok := <-wch:
+				require.True(t, ok)
+				require.NoError(t, resp.Err())
+				pending = resp.Events
+			case <-time.After(integration.RequestWaitTimeout):
+				t.Fatal("timed out waiting for a lease event")
+			}
+		}
+		ev := pending[0]
+		pending = pending[1:]
+		return ev
+	}
+
+	// the events are not stored, so grant leases until the watch gets one of
+	// them, then skip to the last one granted
+	var last clientv3.LeaseID
+	for len(pending) == 0 {
+		resp, gerr := lapi.Grant(t.Context(), 60)
+		require.NoError(t, gerr)
+		last = resp.ID
+		select {
+		case wresp := <-wch:
+			require.NoError(t, wresp.Err())
+			pending = wresp.Events
+		case <-time.After(100 * time.Millisecond):
+		}
+	}
+	for next().ID != int64(last) {
+	}
+
+	resp, err := lapi.Grant(t.Context(), 1)
+	require.NoError(t, err)
+	_, err = lapi.Put(t.Context(), "foo", "bar", clientv3.WithLease(resp.ID))
+	require.NoError(t, err)
+	ev := next()
+	require.Equal(t, pb.LeaseEvent_GRANT, ev.Type)
+	require.Equal(t, int64(resp.ID), ev.ID)
+	require.Equal(t, int64(1), ev.TTL)
+
+	// an expired lease is told apart from a revoked one
+	ev = next()
+	require.Equal(t, pb.LeaseEvent_EXPIRE, ev.Type)
+	require.Equal(t, int64(resp.ID), ev.ID)
+	require.Equal(t, [][]byte{[]byte("foo")}, ev.Keys)
+
+	_, err = lapi.Revoke(t.Context(), last)
+	require.NoError(t, err)
+	ev = next()
+	require.Equal(t, pb.LeaseEvent_REVOKE, ev.Type)
+	require.Equal(t, int64(last), ev.ID)
+}
+
 func TestLeaseKeepAliveOnce(t *testing.T) {
 	integration.BeforeTest(t)
 
@@ -552,6 +722,41 @@ func TestLeaseTimeToLive(t *testing.T) {
 	require.Emptyf(t, lresp.Keys, "unexpected keys %+v", lresp.Keys)
 }
 
+func TestLeaseTimeToLiveKeysPage(t *testing.T) {
+	integration.BeforeTest(t)
+	if integration.ThroughProxy {
+		t.Skip("the proxy namespace does not prefix the first attached key")
+	}
+
+	clus := integration.NewCluster(t, &integration.ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	cli := clus.RandClient()
+	resp, err := cli.Grant(t.Context(), 10)
+	require.NoError(t, err)
+	keys := []string{"foo1", "foo2", "foo3", "foo4", "foo5"}
+	for i := range keys {
+		_, err = cli.Put(t.Context(), keys[i], "bar", clientv3.WithLease(resp.ID))
+		require.NoError(t, err)
+	}
+
+	var ks []string
+	from := ""
+	for {
+		lresp, lerr := cli.TimeToLive(t.Context(), resp.ID, clientv3.WithAttachedKeys(), clientv3.WithAttachedKeysLimit(2), clientv3.WithAttachedKeysFrom(from))
+		require.NoError(t, lerr)
+		require.LessOrEqual(t, len(lresp.Keys), 2)
+		for i := range lresp.Keys {
+			ks = append(ks, string(lresp.Keys[i]))
+		}
+		if !lresp.MoreKeys {
+			break
+		}
+		from = string(lresp.Keys[len(lresp.Keys)-1]) + "\x00"
+	}
+	require.Equal(t, keys, ks)
+}
+
 func TestLeaseTimeToLiveLeaseNotFound(t *testing.T) {
 	integration.BeforeTest(t)
 
@@ -602,6 +807,55 @@ func TestLeaseLeases(t *testing.T) {
 	}
 }
 
+func TestLeaseLeasesAttachedKeys(t *testing.T) {
+	integration.BeforeTest(t)
+	if integration.ThroughProxy {
+		t.Skip("the proxy namespace does not prefix the attached key range")
+	}
+
+	clus := integration.NewCluster(t, &integration.ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	cli := clus.RandClient()
+
+	var ids []clientv3.LeaseID
+	for _, k := range []string{"/jobs/a", "/jobs/b", "/other"} {
+		resp, err := cli.Grant(t.Context(), 10)
+		require.NoError(t, err)
+		_, err = cli.Put(t.Context(), k, "bar", clientv3.WithLease(resp.ID))
+		require.NoError(t, err)
+		ids = append(ids, resp.ID)
+	}
+	// a lease without keys never matches a key range
+	_, err := cli.Grant(t.Context(), 10)
+	require.NoError(t, err)
+
+	resp, err := cli.Leases(t.Context(), clientv3.WithAttachedKeyPrefix("/jobs/"))
+	require.NoError(t, err)
+	require.Len(t, resp.Leases, 2)
+	for i := range resp.Leases {
+		require.Equal(t, ids[i], resp.Leases[i].ID)
+		require.Empty(t, resp.Leases[i].Keys)
+	}
+
+	// the keys listed per lease are limited
+	_, err = cli.Put(t.Context(), "/jobs/a2", "bar", clientv3.WithLease(ids[0]))
+	require.NoError(t, err)
+	resp, err = cli.Leases(t.Context(), clientv3.WithAttachedKeyPrefix("/jobs/"), clientv3.WithAttachedKeys(), clientv3.WithAttachedKeysLimit(1))
+	require.NoError(t, err)
+	require.Len(t, resp.Leases, 2)
+	require.Equal(t, [][]byte{[]byte("/jobs/a")}, resp.Leases[0].Keys)
+	require.True(t, resp.Leases[0].MoreKeys)
+	require.Equal(t, [][]byte{[]byte("/jobs/b")}, resp.Leases[1].Keys)
+	require.False(t, resp.Leases[1].MoreKeys)
+
+	resp, err = cli.Leases(t.Context(), clientv3.WithAttachedKeyPrefix("/other"), clientv3.WithAttachedKeys())
+	require.NoError(t, err)
+	require.Len(t, resp.Leases, 1)
+	require.Equal(t, ids[2], resp.Leases[0].ID)
+	require.Equal(t, [][]byte{[]byte("/other")}, resp.Leases[0].Keys)
+}
+
 // TestLeaseRenewLostQuorum ensures keepalives work after losing quorum
 // for a while.
 func TestLeaseRenewLostQuorum(t *testing.T) {
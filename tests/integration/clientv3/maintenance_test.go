@@ -33,6 +33,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
 	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
 	"go.etcd.io/etcd/api/v3/version"
 	clientv3 "go.etcd.io/etcd/client/v3"
@@ -144,6 +145,165 @@ func TestMaintenanceMoveLeader(t *testing.T) {
 	}
 }
 
+// TestMaintenanceRevisionHold ensures that compactions are clamped to the
+// oldest held revision and that holds are released with their lease.
+func TestMaintenanceRevisionHold(t *testing.T) {
+	integration.BeforeTest(t)
+
+	clus := integration.NewCluster(t, &integration.ClusterConfig{Size: 3})
+	defer clus.Terminate(t)
+
+	cli := clus.RandClient()
+	var revs []int64
+	for i := 0; i < 5; i++ {
+		resp, err := cli.Put(t.Context(), "foo", fmt.Sprint(i))
+		require.NoError(t, err)
+		revs = append(revs, resp.Header.Revision)
+	}
+
+	lresp, err := cli.Grant(t.Context(), 60)
+	require.NoError(t, err)
+	leaseHold, err := cli.HoldRevision(t.Context(), revs[1], lresp.ID)
+	require.NoError(t, err)
+	require.Len(t, leaseHold.Holds, 1)
+	hold, err := cli.HoldRevision(t.Context(), revs[2], clientv3.NoLease)
+	require.NoError(t, err)
+	require.Len(t, hold.Holds, 1)
+	assert.Equal(t, revs[2], hold.Holds[0].Revision)
+
+	_, err = cli.HoldRevision(t.Context(), 0, clientv3.LeaseID(12345))
+	require.ErrorIs(t, err, rpctypes.ErrLeaseNotFound)
+
+	_, err = cli.Compact(t.Context(), revs[4])
+	require.NoError(t, err)
+	_, err = cli.Get(t.Context(), "foo", clientv3.WithRev(revs[0]))
+	require.ErrorIs(t, err, rpctypes.ErrCompacted)
+	gresp, err := cli.Get(t.Context(), "foo", clientv3.WithRev(revs[1]))
+	require.NoError(t, err)
+	assert.Equal(t, "1", string(gresp.Kvs[0].Value))
+
+	// holds are persisted and replicated
+	clus.Members[0].Stop(t)
+	require.NoError(t, clus.Members[0].Restart(t))
+	clus.WaitLeader(t)
+	hresp, err := clus.Client(0).RevisionHolds(t.Context())
+	require.NoError(t, err)
+	require.Len(t, hresp.Holds, 2)
+	assert.Equal(t, []int64{revs[1], revs[2]}, []int64{hresp.Holds[0].Revision, hresp.Holds[1].Revision})
+
+	_, err = cli.Revoke(t.Context(), lresp.ID)
+	require.NoError(t, err)
+	hresp, err = cli.RevisionHolds(t.Context())
+	require.NoError(t, err)
+	require.Len(t, hresp.Holds, 1)
+	assert.Equal(t, hold.Holds[0].ID, hresp.Holds[0].ID)
+
+	_, err = cli.Compact(t.Context(), revs[4])
+	require.NoError(t, err)
+	_, err = cli.Get(t.Context(), "foo", clientv3.WithRev(revs[1]))
+	require.ErrorIs(t, err, rpctypes.ErrCompacted)
+
+	_, err = cli.ReleaseRevisionHold(t.Context(), hold.Holds[0].ID)
+	require.NoError(t, err)
+	_, err = cli.ReleaseRevisionHold(t.Context(), hold.Holds[0].ID)
+	require.ErrorIs(t, err, rpctypes.ErrRevisionHoldNotFound)
+
+	_, err = cli.Compact(t.Context(), revs[4])
+	require.NoError(t, err)
+	_, err = cli.Get(t.Context(), "foo", clientv3.WithRev(revs[2]))
+	require.ErrorIs(t, err, rpctypes.ErrCompacted)
+}
+
+func TestMaintenanceCompactionPolicy(t *testing.T) {
+	integration.BeforeTest(t)
+
+	clus := integration.NewCluster(t, &integration.ClusterConfig{
+		Size:                          3,
+		AutoCompactionMode:            "revision",
+		AutoCompactionRetention:       1000,
+		AutoCompactionPrefixRetention: map[string]time.Duration{"/events/": 10, "/config/": 0},
+	})
+	defer clus.Terminate(t)
+
+	cli := clus.RandClient()
+	var revs []int64
+	for i := 0; i < 4; i++ {
+		for _, key := range []string{"/events/e", "/config/c", "foo"} {
+			resp, err := cli.Put(t.Context(), key, fmt.Sprint(i))
+			require.NoError(t, err)
+			revs = append(revs, resp.Header.Revision)
+		}
+	}
+
+	// compact like the compactor of the leader once the retention of the
+	// events and of all other keys elapses; /config/ keeps all its history
+	kvc := pb.NewKVClient(cli.ActiveConnection())
+	_, err := kvc.Compact(t.Context(), &pb.CompactionRequest{
+		Revision: 0,
+		Prefixes: []*pb.PrefixCompaction{
+			{Prefix: []byte(""), Revision: revs[3]},
+			{Prefix: []byte("/config/"), Revision: 0},
+			{Prefix: []byte("/events/"), Revision: revs[9]},
+		},
+		Physical: true,
+	})
+	require.NoError(t, err)
+
+	_, err = cli.Get(t.Context(), "/events/e", clientv3.WithRev(revs[6]))
+	require.ErrorIs(t, err, rpctypes.ErrCompacted)
+	_, err = cli.Get(t.Context(), "foo", clientv3.WithRev(revs[2]))
+	require.ErrorIs(t, err, rpctypes.ErrCompacted)
+	gresp, err := cli.Get(t.Context(), "foo", clientv3.WithRev(revs[5]))
+	require.NoError(t, err)
+	assert.Equal(t, "1", string(gresp.Kvs[0].Value))
+	gresp, err = cli.Get(t.Context(), "/config/c", clientv3.WithRev(revs[1]))
+	require.NoError(t, err)
+	assert.Equal(t, "0", string(gresp.Kvs[0].Value))
+
+	for i := range clus.Members {
+		presp, err := cli.CompactionPolicy(t.Context(), clus.Members[i].GRPCURL)
+		require.NoError(t, err)
+		assert.Equal(t, "revision", presp.Mode)
+		assert.Equal(t, int64(0), presp.CompactRevision)
+		want := []*pb.CompactionRetention{
+			{Prefix: nil, Retention: 1000, CompactRevision: revs[3]},
+			{Prefix: []byte("/config/"), Retention: 0, CompactRevision: 0},
+			{Prefix: []byte("/events/"), Retention: 10, CompactRevision: revs[9]},
+		}
+		assert.Equal(t, want, presp.Retentions)
+	}
+}
+
+func TestMaintenanceWatchers(t *testing.T) {
+	if integration.ThroughProxy {
+		t.Skipf("grpc-proxy registers the watchers of its clients in its namespace")
+	}
+	integration.BeforeTest(t)
+
+	clus := integration.NewCluster(t, &integration.ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	cli := clus.RandClient()
+	wch := cli.Watch(t.Context(), "foo", clientv3.WithPrefix(), clientv3.WithCreatedNotify())
+	wresp := <-wch
+	require.True(t, wresp.Created)
+
+	presp, err := cli.Put(t.Context(), "foo/bar", "1")
+	require.NoError(t, err)
+	wresp = <-wch
+	require.Len(t, wresp.Events, 1)
+
+	resp, err := cli.Watchers(t.Context(), clus.Members[0].GRPCURL)
+	require.NoError(t, err)
+	require.Len(t, resp.Watchers, 1)
+	w := resp.Watchers[0]
+	assert.Equal(t, []*pb.KeyRange{{Key: []byte("foo"), RangeEnd: []byte("fop")}}, w.Ranges)
+	assert.GreaterOrEqual(t, w.Revision, presp.Header.Revision)
+	assert.True(t, w.Synced)
+	assert.False(t, w.Victim)
+	assert.NotEmpty(t, w.RemoteAddress)
+}
+
 // TestMaintenanceSnapshotCancel ensures that context cancel
 // before snapshot reading returns corresponding context errors.
 func TestMaintenanceSnapshotCancel(t *testing.T) {
@@ -117,3 +117,35 @@ func testWatchFragment(t *testing.T, fragment, exceedRecvLimit bool) {
 		t.Fatalf("took too long to receive events")
 	}
 }
+
+// TestWatchFragmentTxnRevisions ensures that the events of a transaction
+// arriving in fragmented watch responses are grouped into one revision.
+func TestWatchFragmentTxnRevisions(t *testing.T) {
+	integration.BeforeTest(t)
+
+	clus := integration.NewCluster(t, &integration.ClusterConfig{Size: 1, MaxRequestBytes: 1.5 * 1024 * 1024})
+	defer clus.Terminate(t)
+
+	cli := clus.Client(0)
+	_, err := cli.Put(t.Context(), "foo", strings.Repeat("a", 1024*1024))
+	require.NoError(t, err)
+	var ops []clientv3.Op
+	for i := 0; i < 3; i++ {
+		ops = append(ops, clientv3.OpPut(fmt.Sprint("foo", i), strings.Repeat("a", 400*1024)))
+	}
+	txnResp, err := cli.Txn(t.Context()).Then(ops...).Commit()
+	require.NoError(t, err)
+
+	wch := cli.Watch(t.Context(), "foo", clientv3.WithPrefix(), clientv3.WithRev(1), clientv3.WithFragment())
+	select {
+	case ws := <-wch:
+		require.NoError(t, ws.Err())
+		revs := ws.Revisions()
+		require.Len(t, revs, 2)
+		require.Len(t, revs[0].Events, 1)
+		require.Equal(t, txnResp.Header.Revision, revs[1].Revision)
+		require.Len(t, revs[1].Events, 3)
+	case <-time.After(testutil.RequestTimeout):
+		t.Fatalf("took too long to receive events")
+	}
+}
@@ -32,6 +32,7 @@ import (
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/testing/protocmp"
 
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
 	mvccpb "go.etcd.io/etcd/api/v3/mvccpb"
 	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
 	"go.etcd.io/etcd/api/v3/version"
@@ -502,6 +503,47 @@ func TestWatchCompactRevision(t *testing.T) {
 	}
 }
 
+// TestWatchBootstrap ensures a watch from a compacted revision lists the
+// watched keys and keeps watching after the listing.
+func TestWatchBootstrap(t *testing.T) {
+	integration.BeforeTest(t)
+
+	clus := integration.NewCluster(t, &integration.ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	kv := clus.RandClient()
+	for _, key := range []string{"foo/a", "foo/b", "foo/c"} {
+		_, err := kv.Put(t.Context(), key, "bar")
+		require.NoError(t, err)
+	}
+	_, err := kv.Delete(t.Context(), "foo/c")
+	require.NoError(t, err)
+	resp, err := kv.Compact(t.Context(), 4)
+	require.NoError(t, err)
+
+	w := clus.RandClient()
+	known := func() []string { return []string{"foo/a", "foo/c"} }
+	wch := w.Watch(t.Context(), "foo/", clientv3.WithPrefix(), clientv3.WithRev(2), clientv3.WithBootstrap(known))
+
+	wresp := <-wch
+	require.NoError(t, wresp.Err())
+	require.True(t, wresp.Bootstrap)
+	require.Equal(t, resp.Header.Revision, wresp.Header.Revision)
+	var events []string
+	for _, ev := range wresp.Events {
+		events = append(events, fmt.Sprintf("%s %s", ev.Type, ev.Kv.Key))
+	}
+	require.Equal(t, []string{"PUT foo/a", "PUT foo/b", "DELETE foo/c"}, events)
+
+	_, err = kv.Put(t.Context(), "foo/d", "bar")
+	require.NoError(t, err)
+	wresp = <-wch
+	require.NoError(t, wresp.Err())
+	require.False(t, wresp.Bootstrap)
+	require.Len(t, wresp.Events, 1)
+	require.Equal(t, "foo/d", string(wresp.Events[0].Kv.Key))
+}
+
 func TestWatchWithProgressNotify2(t *testing.T)       { testWatchWithProgressNotify(t, true) }
 func TestWatchWithProgressNotifyNoEvent(t *testing.T) { testWatchWithProgressNotify(t, false) }
 
@@ -648,6 +690,126 @@ func TestWatchRequestProgress(t *testing.T) {
 	}
 }
 
+func TestWatchRequestLinearizableProgress(t *testing.T) {
+	if integration.ThroughProxy {
+		t.Skipf("grpc-proxy does not support WatchProgress yet")
+	}
+	integration.BeforeTest(t)
+
+	clus := integration.NewCluster(t, &integration.ClusterConfig{Size: 3})
+	defer clus.Terminate(t)
+
+	var lastRev int64
+	for i := 0; i < 100; i++ {
+		resp, err := clus.Client(1).Put(t.Context(), fmt.Sprintf("/%d", i), "1")
+		require.NoError(t, err)
+		lastRev = resp.Header.Revision
+	}
+
+	// the watcher is not synced yet when the progress is requested, and the
+	// member it is on may not have applied the puts made through another one
+	wc := clus.Client(0)
+	wch := wc.Watch(t.Context(), "/", clientv3.WithPrefix(), clientv3.WithRev(1))
+	require.NoError(t, wc.RequestLinearizableProgress(t.Context()))
+
+	events := 0
+	for {
+		select {
+		case resp := <-wch:
+			require.NoError(t, resp.Err())
+			if !resp.IsProgressNotify() {
+				events += len(resp.Events)
+				continue
+			}
+			require.GreaterOrEqual(t, resp.Header.Revision, lastRev)
+			require.Equalf(t, 100, events, "progress notify before all events")
+			return
+		case <-time.After(5 * time.Second):
+			t.Fatalf("progress response expected, but timed out")
+		}
+	}
+}
+
+// TestWatchRequestLinearizableProgressRepeated checks that linearizable progress
+// requests arriving while one is served are answered once it is done.
+func TestWatchRequestLinearizableProgressRepeated(t *testing.T) {
+	if integration.ThroughProxy {
+		t.Skipf("grpc-proxy does not support WatchProgress yet")
+	}
+	integration.BeforeTest(t)
+
+	clus := integration.NewCluster(t, &integration.ClusterConfig{Size: 3})
+	defer clus.Terminate(t)
+
+	wc := clus.Client(0)
+	wch := wc.Watch(t.Context(), "/", clientv3.WithPrefix(), clientv3.WithCreatedNotify())
+	require.True(t, (<-wch).Created)
+
+	var lastRev int64
+	for i := 0; i < 10; i++ {
+		resp, err := clus.Client(1).Put(t.Context(), fmt.Sprintf("/%d", i), "1")
+		require.NoError(t, err)
+		lastRev = resp.Header.Revision
+		require.NoError(t, wc.RequestLinearizableProgress(t.Context()))
+	}
+
+	for {
+		select {
+		case resp := <-wch:
+			require.NoError(t, resp.Err())
+			if resp.IsProgressNotify() && resp.Header.Revision >= lastRev {
+				return
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("progress response past the last put expected, but timed out")
+		}
+	}
+}
+
+// TestWatchRequestLinearizableProgressPartition checks that a linearizable
+// progress request is answered once the member it was sent to, which cannot
+// serve linearizable reads while partitioned, rejoins the cluster.
+func TestWatchRequestLinearizableProgressPartition(t *testing.T) {
+	if integration.ThroughProxy {
+		t.Skipf("grpc-proxy does not support WatchProgress yet")
+	}
+	integration.BeforeTest(t)
+
+	clus := integration.NewCluster(t, &integration.ClusterConfig{Size: 3})
+	defer clus.Terminate(t)
+
+	wc := clus.Client(0)
+	wch := wc.Watch(t.Context(), "/", clientv3.WithPrefix(), clientv3.WithCreatedNotify())
+	require.True(t, (<-wch).Created)
+
+	clus.Members[0].InjectPartition(t, clus.Members[1:]...)
+	clus.WaitMembersForLeader(t, clus.Members[1:])
+	resp, err := clus.Client(1).Put(t.Context(), "/foo", "1")
+	require.NoError(t, err)
+	lastRev := resp.Header.Revision
+
+	require.NoError(t, wc.RequestLinearizableProgress(t.Context()))
+	expectMemberLog(t, clus.Members[0], 2*clus.Members[0].Server.Cfg.ReqTimeout(), "failed to wait for linearizable watch progress", 1)
+	clus.Members[0].RecoverPartition(t, clus.Members[1:]...)
+
+	events := 0
+	for {
+		select {
+		case resp := <-wch:
+			require.NoError(t, resp.Err())
+			if !resp.IsProgressNotify() {
+				events += len(resp.Events)
+				continue
+			}
+			require.GreaterOrEqual(t, resp.Header.Revision, lastRev)
+			require.Equalf(t, 1, events, "progress notify before all events")
+			return
+		case <-time.After(10 * time.Second):
+			t.Fatalf("progress response expected after the partition is recovered, but timed out")
+		}
+	}
+}
+
 func TestWatchEventType(t *testing.T) {
 	integration.BeforeTest(t)
 
@@ -880,6 +1042,356 @@ func TestWatchWithFilter(t *testing.T) {
 	}
 }
 
+// TestWatchWithRanges checks that a watch with several key ranges receives
+// the events of all ranges on one channel in revision order.
+func TestWatchWithRanges(t *testing.T) {
+	integration.BeforeTest(t)
+
+	cluster := integration.NewCluster(t, &integration.ClusterConfig{Size: 1})
+	defer cluster.Terminate(t)
+
+	client := cluster.RandClient()
+	ctx := t.Context()
+
+	presp, err := client.Put(ctx, "a/1", "v")
+	require.NoError(t, err)
+	_, err = client.Put(ctx, "c/1", "v")
+	require.NoError(t, err)
+	_, err = client.Put(ctx, "b/1", "v")
+	require.NoError(t, err)
+	_, err = client.Txn(ctx).Then(clientv3.OpPut("b/2", "v"), clientv3.OpPut("a/2", "v")).Commit()
+	require.NoError(t, err)
+	_, err = client.Delete(ctx, "d")
+	require.NoError(t, err)
+
+	wch := client.Watch(ctx, "a/", clientv3.WithPrefix(), clientv3.WithRev(presp.Header.Revision),
+		clientv3.WithRanges(clientv3.KeyRange{Key: "b/", End: clientv3.GetPrefixRangeEnd("b/")}, clientv3.KeyRange{Key: "d"}))
+
+	_, err = client.Put(ctx, "d", "v")
+	require.NoError(t, err)
+
+	var got []string
+	for len(got) < 5 {
+		wresp, ok := <-wch
+		require.Truef(t, ok, "watch channel closed after %v", got)
+		require.NoError(t, wresp.Err())
+		for _, ev := range wresp.Events {
+			got = append(got, fmt.Sprintf("%s@%d", ev.Kv.Key, ev.Kv.ModRevision))
+		}
+	}
+	rev := presp.Header.Revision
+	want := []string{
+		fmt.Sprintf("a/1@%d", rev),
+		fmt.Sprintf("b/1@%d", rev+2),
+		fmt.Sprintf("b/2@%d", rev+3),
+		fmt.Sprintf("a/2@%d", rev+3),
+		fmt.Sprintf("d@%d", rev+4),
+	}
+	require.Equal(t, want, got)
+}
+
+// TestWatchWithValueFilters checks that the server filters out the events
+// on unchanged values, updates, values without a prefix, and keys not attached
+// to a lease.
+func TestWatchWithValueFilters(t *testing.T) {
+	integration.BeforeTest(t)
+
+	cluster := integration.NewCluster(t, &integration.ClusterConfig{Size: 1})
+	defer cluster.Terminate(t)
+
+	client := cluster.RandClient()
+	ctx := t.Context()
+
+	lresp, err := client.Grant(ctx, 60)
+	require.NoError(t, err)
+	presp, err := client.Put(ctx, "k/a", "x1", clientv3.WithLease(lresp.ID))
+	require.NoError(t, err)
+	ops := []clientv3.Op{
+		clientv3.OpPut("k/a", "x1", clientv3.WithLease(lresp.ID)),
+		clientv3.OpPut("k/a", "y1"),
+		clientv3.OpPut("k/b", "x2", clientv3.WithLease(lresp.ID)),
+		clientv3.OpDelete("k/b"),
+		clientv3.OpPut("k/c", "x3"),
+		clientv3.OpDelete("k/c"),
+	}
+	for _, op := range ops {
+		_, err = client.Do(ctx, op)
+		require.NoError(t, err)
+	}
+
+	rev := presp.Header.Revision
+	tests := []struct {
+		name string
+		opts []clientv3.OpOption
+		want []string
+	}{
+		{
+			name: "unchanged",
+			opts: []clientv3.OpOption{clientv3.WithFilterUnchanged()},
+			want: []string{"PUT k/a@0", "PUT k/a@2", "PUT k/b@3", "DELETE k/b@4", "PUT k/c@5", "DELETE k/c@6"},
+		},
+		{
+			name: "lease",
+			opts: []clientv3.OpOption{clientv3.WithLease(lresp.ID)},
+			want: []string{"PUT k/a@0", "PUT k/a@1", "PUT k/b@3", "DELETE k/b@4"},
+		},
+		{
+			name: "creates with value prefix",
+			opts: []clientv3.OpOption{clientv3.WithFilterUpdate(), clientv3.WithFilterDelete(), clientv3.WithValuePrefix("x")},
+			want: []string{"PUT k/a@0", "PUT k/b@3", "PUT k/c@5"},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			opts := append([]clientv3.OpOption{clientv3.WithPrefix(), clientv3.WithRev(rev)}, tc.opts...)
+			wch := client.Watch(ctx, "k/", opts...)
+			var got []string
+			for len(got) < len(tc.want) {
+				wresp, ok := <-wch
+				require.Truef(t, ok, "watch channel closed after %v", got)
+				require.NoError(t, wresp.Err())
+				for _, ev := range wresp.Events {
+					got = append(got, fmt.Sprintf("%s %s@%d", ev.Type, ev.Kv.Key, ev.Kv.ModRevision-rev))
+				}
+			}
+			require.Equal(t, tc.want, got)
+		})
+	}
+}
+
+// TestWatchCoalesce checks that a coalescing watcher catching up on the store
+// only gets the latest event of each key.
+func TestWatchCoalesce(t *testing.T) {
+	integration.BeforeTest(t)
+
+	cluster := integration.NewCluster(t, &integration.ClusterConfig{Size: 1})
+	defer cluster.Terminate(t)
+
+	client := cluster.RandClient()
+	ctx := t.Context()
+
+	presp, err := client.Put(ctx, "k/a", "v0")
+	require.NoError(t, err)
+	ops := []clientv3.Op{
+		clientv3.OpPut("k/b", "v1"),
+		clientv3.OpPut("k/a", "v2"),
+		clientv3.OpPut("k/c", "v3"),
+		clientv3.OpDelete("k/b"),
+		clientv3.OpPut("k/a", "v5"),
+	}
+	for _, op := range ops {
+		_, err = client.Do(ctx, op)
+		require.NoError(t, err)
+	}
+
+	rev := presp.Header.Revision
+	wch := client.Watch(ctx, "k/", clientv3.WithPrefix(), clientv3.WithRev(rev), clientv3.WithCoalesce())
+	want := []string{"PUT k/c@3", "DELETE k/b@4", "PUT k/a@5"}
+	var got []string
+	coalesced := false
+	for len(got) < len(want) {
+		wresp, ok := <-wch
+		require.Truef(t, ok, "watch channel closed after %v", got)
+		require.NoError(t, wresp.Err())
+		coalesced = coalesced || wresp.Coalesced
+		for _, ev := range wresp.Events {
+			got = append(got, fmt.Sprintf("%s %s@%d", ev.Type, ev.Kv.Key, ev.Kv.ModRevision-rev))
+		}
+	}
+	require.Equal(t, want, got)
+	require.True(t, coalesced)
+
+	// events are coalesced before they are filtered, so a key put then
+	// deleted is left out by a watcher filtering out deletes
+	wctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	fch := client.Watch(wctx, "k/", clientv3.WithPrefix(), clientv3.WithRev(rev), clientv3.WithCoalesce(), clientv3.WithFilterDelete())
+	want = []string{"PUT k/c@3", "PUT k/a@5"}
+	got = nil
+	for len(got) < len(want) {
+		wresp, ok := <-fch
+		require.Truef(t, ok, "watch channel closed after %v", got)
+		require.NoError(t, wresp.Err())
+		for _, ev := range wresp.Events {
+			got = append(got, fmt.Sprintf("%s %s@%d", ev.Type, ev.Kv.Key, ev.Kv.ModRevision-rev))
+		}
+	}
+	require.Equal(t, want, got)
+	cancel()
+
+	// once synced, every revision is sent again
+	_, err = client.Put(ctx, "k/a", "v6")
+	require.NoError(t, err)
+	_, err = client.Put(ctx, "k/a", "v7")
+	require.NoError(t, err)
+	for _, v := range []string{"v6", "v7"} {
+		wresp := <-wch
+		require.NoError(t, wresp.Err())
+		require.False(t, wresp.Coalesced)
+		require.Len(t, wresp.Events, 1)
+		require.Equal(t, v, string(wresp.Events[0].Kv.Value))
+	}
+}
+
+// TestWatchSubscription checks a watch with a subscription resumes after the
+// acknowledged revision, which is kept from compaction.
+func TestWatchSubscription(t *testing.T) {
+	integration.BeforeTest(t)
+
+	cluster := integration.NewCluster(t, &integration.ClusterConfig{Size: 1})
+	defer cluster.Terminate(t)
+
+	client := cluster.RandClient()
+	ctx := t.Context()
+
+	presp, err := client.Put(ctx, "k", "v1")
+	require.NoError(t, err)
+	aresp, err := client.AckSubscription(ctx, "sub", presp.Header.Revision)
+	require.NoError(t, err)
+	require.Len(t, aresp.Subscriptions, 1)
+	require.Equal(t, presp.Header.Revision, aresp.Subscriptions[0].Revision)
+
+	for _, v := range []string{"v2", "v3"} {
+		presp, err = client.Put(ctx, "k", v)
+		require.NoError(t, err)
+	}
+	// the compaction is clamped to the revision the subscription resumes from
+	_, err = client.Compact(ctx, presp.Header.Revision)
+	require.NoError(t, err)
+
+	wctx, cancel := context.WithCancel(ctx)
+	wch := client.Watch(wctx, "k", clientv3.WithSubscription("sub"))
+	var got []string
+	for len(got) < 2 {
+		wresp, ok := <-wch
+		require.Truef(t, ok, "watch channel closed after %v", got)
+		require.NoError(t, wresp.Err())
+		for _, ev := range wresp.Events {
+			got = append(got, string(ev.Kv.Value))
+		}
+	}
+	cancel()
+	require.Equal(t, []string{"v2", "v3"}, got)
+
+	_, err = client.AckSubscription(ctx, "sub", presp.Header.Revision)
+	require.NoError(t, err)
+	lresp, err := client.Subscriptions(ctx)
+	require.NoError(t, err)
+	require.Len(t, lresp.Subscriptions, 1)
+	require.Equal(t, presp.Header.Revision, lresp.Subscriptions[0].Revision)
+
+	_, err = client.DeleteSubscription(ctx, "sub")
+	require.NoError(t, err)
+	_, err = client.DeleteSubscription(ctx, "sub")
+	require.ErrorIs(t, err, rpctypes.ErrSubscriptionNotFound)
+	_, err = client.AckSubscription(ctx, "", 0)
+	require.ErrorIs(t, err, rpctypes.ErrSubscriptionNameNotProvided)
+}
+
+// TestWatchLimits checks the watchers of a watch stream and the rate of events
+// sent on it are limited.
+func TestWatchLimits(t *testing.T) {
+	if integration.ThroughProxy {
+		t.Skipf("grpc-proxy does not limit its watch streams")
+	}
+	integration.BeforeTest(t)
+
+	cluster := integration.NewCluster(t, &integration.ClusterConfig{
+		Size:                      1,
+		WatchMaxWatchersPerStream: 2,
+		WatchMaxEventsPerSecond:   20,
+	})
+	defer cluster.Terminate(t)
+
+	client := cluster.RandClient()
+	ctx := t.Context()
+
+	wctx, cancel := context.WithCancel(ctx)
+	wch := client.Watch(wctx, "k", clientv3.WithPrefix(), clientv3.WithCreatedNotify())
+	require.True(t, (<-wch).Created)
+	wch2 := client.Watch(ctx, "x", clientv3.WithCreatedNotify())
+	require.True(t, (<-wch2).Created)
+
+	wresp := <-client.Watch(ctx, "y", clientv3.WithCreatedNotify())
+	require.True(t, wresp.Canceled)
+	require.ErrorIs(t, wresp.Err(), rpctypes.ErrTooManyWatchersOnStream)
+
+	// canceling a watcher makes room for another one
+	cancel()
+	for range wch {
+	}
+	require.Eventually(t, func() bool {
+		wch = client.Watch(ctx, "k", clientv3.WithPrefix(), clientv3.WithCreatedNotify())
+		wresp = <-wch
+		return wresp.Created && !wresp.Canceled
+	}, 5*time.Second, 100*time.Millisecond)
+
+	ops := make([]clientv3.Op, 20)
+	for i := range ops {
+		ops[i] = clientv3.OpPut(fmt.Sprintf("k%d", i), "v")
+	}
+	_, err := client.Txn(ctx).Then(ops...).Commit()
+	require.NoError(t, err)
+	_, err = client.Txn(ctx).Then(ops...).Commit()
+	require.NoError(t, err)
+
+	// the first 20 events are sent at once, the others at 20 events per second
+	start := time.Now()
+	n := 0
+	for n < 2*len(ops) {
+		wresp := <-wch
+		require.NoError(t, wresp.Err())
+		n += len(wresp.Events)
+	}
+	require.GreaterOrEqual(t, time.Since(start), 900*time.Millisecond)
+
+	// a watcher whose events would wait too long for the rate limit is
+	// canceled with a reason instead of stalling the stream
+	wAPI := integration.ToGRPC(client).Watch
+	ws, err := wAPI.Watch(ctx)
+	require.NoError(t, err)
+	for _, key := range []string{"r", "x"} {
+		creq := &pb.WatchCreateRequest{Key: []byte(key), RangeEnd: []byte(key + "\xff")}
+		require.NoError(t, ws.Send(&pb.WatchRequest{RequestUnion: &pb.WatchRequest_CreateRequest{CreateRequest: creq}}))
+		cresp, rerr := ws.Recv()
+		require.NoError(t, rerr)
+		require.True(t, cresp.Created)
+	}
+	ops = make([]clientv3.Op, 20)
+	for i := range ops {
+		ops[i] = clientv3.OpPut(fmt.Sprintf("x%d", i), "v")
+	}
+	_, err = client.Txn(ctx).Then(ops...).Commit()
+	require.NoError(t, err)
+	// more events than the rate allows within the throttle delay, but few
+	// enough to be sent once the stream is idle
+	ops = make([]clientv3.Op, 110)
+	for i := range ops {
+		ops[i] = clientv3.OpPut(fmt.Sprintf("r%d", i), "v")
+	}
+	_, err = client.Txn(ctx).Then(ops...).Commit()
+	require.NoError(t, err)
+	_, err = client.Put(ctx, "x", "v")
+	require.NoError(t, err)
+
+	resp, err := ws.Recv()
+	require.NoError(t, err)
+	require.False(t, resp.Canceled)
+	require.Len(t, resp.Events, 20)
+
+	resp, err = ws.Recv()
+	require.NoError(t, err)
+	require.True(t, resp.Canceled)
+	require.Empty(t, resp.Events)
+	require.Equal(t, rpctypes.ErrorDesc(rpctypes.ErrGRPCWatchRateExceeded), resp.CancelReason)
+
+	// the other watchers on the stream are not affected
+	resp, err = ws.Recv()
+	require.NoError(t, err)
+	require.False(t, resp.Canceled)
+	require.Len(t, resp.Events, 1)
+}
+
 // TestWatchWithCreatedNotification checks that WithCreatedNotify returns a
 // Created watch response.
 func TestWatchWithCreatedNotification(t *testing.T) {
@@ -362,6 +362,50 @@ func TestV3AuthWithLeaseAttach(t *testing.T) {
 	}
 }
 
+// TestV3AuthSubscription ensures only the root can acknowledge or delete a subscription.
+func TestV3AuthSubscription(t *testing.T) {
+	integration.BeforeTest(t)
+	clus := integration.NewCluster(t, &integration.ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	users := []user{
+		{
+			name:     "user1",
+			password: "user1-123",
+			role:     "role1",
+			key:      "k1",
+			end:      "k3",
+		},
+	}
+	authSetupUsers(t, integration.ToGRPC(clus.Client(0)).Auth, users)
+
+	authSetupRoot(t, integration.ToGRPC(clus.Client(0)).Auth)
+
+	rootc, cerr := integration.NewClient(t, clientv3.Config{Endpoints: clus.Client(0).Endpoints(), Username: "root", Password: "123"})
+	require.NoError(t, cerr)
+	defer rootc.Close()
+
+	user1c, cerr := integration.NewClient(t, clientv3.Config{Endpoints: clus.Client(0).Endpoints(), Username: "user1", Password: "user1-123"})
+	require.NoError(t, cerr)
+	defer user1c.Close()
+
+	_, err := user1c.AckSubscription(t.Context(), "sub", 0)
+	require.ErrorIs(t, err, rpctypes.ErrPermissionDenied)
+
+	_, err = rootc.AckSubscription(t.Context(), "sub", 0)
+	require.NoError(t, err)
+
+	resp, err := user1c.Subscriptions(t.Context())
+	require.NoError(t, err)
+	require.Len(t, resp.Subscriptions, 1)
+
+	_, err = user1c.DeleteSubscription(t.Context(), "sub")
+	require.ErrorIs(t, err, rpctypes.ErrPermissionDenied)
+
+	_, err = rootc.DeleteSubscription(t.Context(), "sub")
+	require.NoError(t, err)
+}
+
 func authSetupUsers(t *testing.T, auth pb.AuthClient, users []user) {
 	for _, user := range users {
 		_, err := auth.UserAdd(t.Context(), &pb.AuthUserAddRequest{Name: user.name, Password: user.password, Options: &authpb.UserAddOptions{NoPassword: false}})
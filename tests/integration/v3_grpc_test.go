@@ -1562,7 +1562,7 @@ func TestV3RangeRequest(t *testing.T) {
 					t.Errorf("#%d.%d: Range error: %v", i, j, err)
 					continue
 				}
-				if !integration.ThroughProxy && !tt.streamUnsupported[j] {
+				if !tt.streamUnsupported[j] {
 					got := rangeStream(t, kvc, req)
 					require.Emptyf(t, cmp.Diff(resp, got, protocmp.Transform()),
 						"RangeStream response must match Range response")
@@ -1616,9 +1616,6 @@ func rangeStream(t *testing.T, kvc pb.KVClient, req *pb.RangeRequest) *pb.RangeR
 // including the case where the stream truncates at Limit with more matching
 // keys pending (exercises the CountOnly fallback query at the pinned revision).
 func TestV3RangeStreamCount(t *testing.T) {
-	if integration.ThroughProxy {
-		t.Skip("RangeStream is not supported by the gRPC proxy")
-	}
 	integration.BeforeTest(t)
 	clus := integration.NewCluster(t, &integration.ClusterConfig{Size: 1})
 	defer clus.Terminate(t)
@@ -1714,15 +1711,45 @@ func TestV3RangeStreamCount(t *testing.T) {
 	}
 }
 
+// TestV3RangeStreamSortOrderNone verifies that a range sorted on another target
+// than the key with the order NONE streams in ascending order across chunks,
+// like Range sorts it.
+func TestV3RangeStreamSortOrderNone(t *testing.T) {
+	integration.BeforeTest(t)
+	clus := integration.NewCluster(t, &integration.ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	kvc := integration.ToGRPC(clus.RandClient()).KV
+	// keys created in descending key order
+	for i := 24; i >= 0; i-- {
+		_, err := kvc.Put(t.Context(), &pb.PutRequest{
+			Key:   []byte(fmt.Sprintf("k%02d", i)),
+			Value: []byte("v"),
+		})
+		require.NoError(t, err)
+	}
+
+	req := &pb.RangeRequest{
+		Key:        []byte("k"),
+		RangeEnd:   []byte("l"),
+		SortOrder:  pb.RangeRequest_NONE,
+		SortTarget: pb.RangeRequest_CREATE,
+	}
+	resp, err := kvc.Range(t.Context(), req)
+	require.NoError(t, err)
+	require.Len(t, resp.Kvs, 25)
+	require.Equal(t, []byte("k24"), resp.Kvs[0].Key)
+
+	got := rangeStream(t, kvc, req)
+	require.Emptyf(t, cmp.Diff(resp, got, protocmp.Transform()),
+		"RangeStream response must match Range response")
+}
+
 // TestV3RangeStreamPartialThenCompacted verifies that once the stream has
 // emitted partial results at a pinned revision, a compaction past that
 // revision causes the next chunk to surface ErrCompacted instead of silently
 // returning inconsistent data.
 func TestV3RangeStreamPartialThenCompacted(t *testing.T) {
-	if integration.ThroughProxy {
-		t.Skip("RangeStream is not supported by the gRPC proxy")
-	}
-
 	integration.BeforeTest(t)
 	clus := integration.NewCluster(t, &integration.ClusterConfig{Size: 1})
 	defer clus.Terminate(t)
@@ -1781,10 +1808,6 @@ func TestV3RangeStreamPartialThenCompacted(t *testing.T) {
 }
 
 func TestV3RangeStreamWriteBetweenChunks(t *testing.T) {
-	if integration.ThroughProxy {
-		t.Skip("RangeStream is not supported by the gRPC proxy")
-	}
-
 	integration.BeforeTest(t)
 	integration.SkipIfNoGoFail(t)
 	clus := integration.NewCluster(t, &integration.ClusterConfig{Size: 1})
@@ -1852,7 +1875,7 @@ func TestV3RangeStreamWriteBetweenChunks(t *testing.T) {
 // KVs when individual stored values exceed the chunk target.
 func TestV3RangeStreamLargeValues(t *testing.T) {
 	if integration.ThroughProxy {
-		t.Skip("RangeStream is not supported by the gRPC proxy")
+		t.Skip("values exceed the max send message size of the gRPC proxy's client")
 	}
 	integration.BeforeTest(t)
 
@@ -1909,6 +1932,62 @@ func TestV3RangeStreamLargeValues(t *testing.T) {
 	require.GreaterOrEqualf(t, recvs, 2, "expected multi-chunk stream for %d-byte values", valueSize)
 }
 
+// TestV3RangeStreamResume verifies that chunks are cut to the server's byte
+// budget and that a stream resumed from the resume token of a chunk returns
+// the remaining keys at the revision of the original stream.
+func TestV3RangeStreamResume(t *testing.T) {
+	integration.BeforeTest(t)
+	clus := integration.NewCluster(t, &integration.ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	const nKeys = 100
+	kvc := integration.ToGRPC(clus.RandClient()).KV
+	value := []byte(strings.Repeat("x", 100*1024))
+	for i := 0; i < nKeys; i++ {
+		_, err := kvc.Put(t.Context(), &pb.PutRequest{
+			Key:   []byte(fmt.Sprintf("stream-%03d", i)),
+			Value: value,
+		})
+		require.NoError(t, err)
+	}
+
+	req := &pb.RangeRequest{Key: []byte("stream-"), RangeEnd: []byte("stream.")}
+	stream, err := kvc.RangeStream(t.Context(), req)
+	require.NoError(t, err)
+	var chunks []*pb.RangeStreamResponse
+	for {
+		chunk, rerr := stream.Recv()
+		if errors.Is(rerr, io.EOF) {
+			break
+		}
+		require.NoError(t, rerr)
+		chunks = append(chunks, chunk)
+	}
+	require.Greater(t, len(chunks), 2)
+	for i, chunk := range chunks {
+		last := i == len(chunks)-1
+		require.Equalf(t, last, len(chunk.ResumeToken) == 0, "chunk %d resume token", i)
+		require.LessOrEqualf(t, proto.Size(chunk), int(embed.DefaultMaxRequestBytes), "chunk %d size", i)
+	}
+
+	// a write after the token was issued must not show up in the resumed stream
+	_, err = kvc.Put(t.Context(), &pb.PutRequest{Key: []byte("stream-999"), Value: []byte("v")})
+	require.NoError(t, err)
+
+	want := &pb.RangeResponse{}
+	for _, chunk := range chunks[2:] {
+		proto.Merge(want, chunk.RangeResponse)
+	}
+	resumed := proto.Clone(req).(*pb.RangeRequest)
+	resumed.ContinueToken = chunks[1].ResumeToken
+	got := rangeStream(t, kvc, resumed)
+	require.Equal(t, want.Header.Revision, got.Header.Revision-1)
+	got.Header = want.Header
+	// the count of the resumed stream only includes the keys after the token
+	want.Count -= int64(len(chunks[0].RangeResponse.Kvs) + len(chunks[1].RangeResponse.Kvs))
+	require.Truef(t, proto.Equal(want, got), "resumed stream must return the remaining keys")
+}
+
 // TestTLSGRPCRejectInsecureClient checks that connection is rejected if server is TLS but not client.
 func TestTLSGRPCRejectInsecureClient(t *testing.T) {
 	integration.BeforeTest(t)
@@ -253,6 +253,10 @@ func (c *RecordingClient) GetStream(ctx context.Context, key string, opts ...cli
 	panic("not implemented")
 }
 
+func (c *RecordingClient) KeyHistory(ctx context.Context, key string, opts ...clientv3.OpOption) (*clientv3.KeyHistoryResponse, error) {
+	panic("not implemented")
+}
+
 func (c *RecordingClient) Compact(ctx context.Context, rev int64, _ ...clientv3.CompactOption) (*clientv3.CompactResponse, error) {
 	c.kvMux.Lock()
 	defer c.kvMux.Unlock()
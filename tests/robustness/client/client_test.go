@@ -37,6 +37,22 @@ func (m *mockWatcher) RequestProgress(ctx context.Context) error {
 	return nil
 }
 
+func (m *mockWatcher) RequestLinearizableProgress(ctx context.Context) error {
+	return nil
+}
+
+func (m *mockWatcher) AckSubscription(ctx context.Context, name string, rev int64) (*clientv3.SubscriptionResponse, error) {
+	return nil, nil
+}
+
+func (m *mockWatcher) DeleteSubscription(ctx context.Context, name string) (*clientv3.SubscriptionResponse, error) {
+	return nil, nil
+}
+
+func (m *mockWatcher) Subscriptions(ctx context.Context) (*clientv3.SubscriptionResponse, error) {
+	return nil, nil
+}
+
 func (m *mockWatcher) Close() error {
 	return nil
 }